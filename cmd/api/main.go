@@ -6,8 +6,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
-	"sync"
-	"time"
+	"syscall"
+	_ "time/tzdata" // event timezones must load on hosts without a zoneinfo database
 
 	"github.com/biairmal/go-sdk/lib/config"
 	"github.com/biairmal/go-sdk/lib/ctxkit"
@@ -21,11 +21,21 @@ import (
 	_ "github.com/biairmal/guest-management-be/api/swagger"
 	"github.com/biairmal/guest-management-be/internal/app"
 	appconfig "github.com/biairmal/guest-management-be/internal/config"
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/etag"
+	"github.com/biairmal/guest-management-be/internal/core/idempotency"
+	"github.com/biairmal/guest-management-be/internal/core/lifecycle"
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
+	"github.com/biairmal/guest-management-be/internal/core/tracing"
 	"github.com/biairmal/guest-management-be/internal/core/validation"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	_ "github.com/lib/pq"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"go.opentelemetry.io/otel"
 )
 
 // @title           Guest Management API
@@ -34,6 +44,11 @@ import (
 // @host            localhost:8080
 // @BasePath        /
 
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 "Bearer <access token>" for users or "Bearer gm_…" for API keys.
+
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 func main() {
@@ -55,6 +70,18 @@ func main() {
 	if err := cfg.Tracing.Validate(); err != nil {
 		panic("Invalid tracing configuration: " + err.Error())
 	}
+	if err := cfg.Metrics.Validate(); err != nil {
+		panic("Invalid metrics configuration: " + err.Error())
+	}
+	if err := cfg.Auth.Validate(); err != nil {
+		panic("Invalid auth configuration: " + err.Error())
+	}
+	if err := cfg.Idempotency.Validate(); err != nil {
+		panic("Invalid idempotency configuration: " + err.Error())
+	}
+	if err := cfg.RateLimit.Validate(); err != nil {
+		panic("Invalid rate limit configuration: " + err.Error())
+	}
 
 	ctx := context.Background()
 
@@ -63,12 +90,25 @@ func main() {
 	cfg.Logger.ContextExtractor = ctxkit.LoggerExtractor()
 	log := logger.NewZerolog(&cfg.Logger)
 
+	// Components start in registration order and stop in reverse, so the
+	// HTTP server stops first and the tracer last.
+	lc := lifecycle.New(log, lifecycle.Config{
+		StartTimeout:    cfg.Server.StartupTimeout,
+		DrainPeriod:     cfg.Server.DrainPeriod,
+		ShutdownTimeout: cfg.Server.ShutdownTimeout,
+	})
+
 	// Initialize tracer
 	tr, err := newTracer(&cfg, log)
 	if err != nil {
 		log.Panicf("Tracer config failed: %v", err)
 	}
-	defer func() { _ = tr.Shutdown(context.Background()) }()
+	if cfg.Tracing.Enabled {
+		// tracer.NewOTel installs its provider globally, so the app's own
+		// spans (repositories, cache, outbound HTTP) join the request traces.
+		tracing.Enable(otel.GetTracerProvider())
+	}
+	lc.Register(lifecycle.Component{Name: "tracer", Stop: tr.Shutdown})
 
 	// Initialize database
 	db, err := sqlkit.New(ctx, &cfg.Database)
@@ -76,7 +116,11 @@ func main() {
 		log.Panicf("Database config failed: %v", err)
 	}
 	if db != nil {
-		defer func() { _ = db.Close() }()
+		lc.Register(lifecycle.Component{
+			Name:  "database",
+			Ready: func(ctx context.Context) error { return db.Leader().PingContext(ctx) },
+			Stop:  func(context.Context) error { return db.Close() },
+		})
 	}
 
 	// Initialize Redis
@@ -84,25 +128,54 @@ func main() {
 	if err != nil {
 		log.Panicf("Redis config failed: %v", err)
 	}
-	defer func() { _ = redisClient.Close() }()
+	lc.Register(lifecycle.Component{
+		Name:  "redis",
+		Ready: redisClient.Ping,
+		Stop:  func(context.Context) error { return redisClient.Close() },
+	})
+
+	// Initialize rate limiter. Counters live in Redis so every instance shares
+	// one budget, falling back to per-instance memory while Redis is down.
+	limiter := ratelimit.NewFallbackLimiter(log,
+		ratelimit.NewRedisLimiter(redisClient, cfg.RateLimit.Prefix), ratelimit.NewMemoryLimiter())
+
+	// Initialize boundary validator
+	val := validation.New(cfg.Validator)
 
-	// Initialize router
+	// Initialize router and application. cfg.App carries every registered
+	// feature's own config (app.<feature>.* in config.yaml); internal/app
+	// resolves each feature's section itself when it wires that feature's
+	// repositories in Initialize, once the middleware chain is in place.
 	r := chi.NewRouter()
-	r.Use(middleware.Recover(), middleware.RequestID(), middleware.Tracing(tr), middleware.Logging(log, nil))
+	application := app.NewApp(log, db, r, val, redisClient, limiter, cfg.App)
+	if cfg.Metrics.Enabled {
+		if db != nil {
+			if err := metrics.RegisterDB(db); err != nil {
+				log.Panicf("Metrics config failed: %v", err)
+			}
+		}
+		r.Use(metrics.Middleware())
+	}
+	r.Use(middleware.Recover(), middleware.RequestID(), middleware.Tracing(tr), middleware.Logging(log, nil), etag.Middleware())
+	// Callers authenticate with a user access token or an API key; either
+	// seeds the ctxkit identity that route guards and rate limits read.
+	r.Use(auth.Middleware(auth.NewVerifier(cfg.Auth)), application.Authenticate)
+	if cfg.RateLimit.Enabled {
+		r.Use(application.RateLimit(cfg.RateLimit))
+	}
+	if cfg.Idempotency.Enabled {
+		r.Use(idempotency.Middleware(log, idempotency.NewRedisStore(redisClient), cfg.Idempotency))
+	}
 
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) { problem.WriteCode(w, r, errcode.RouteNotFound) })
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) { problem.WriteCode(w, r, errcode.MethodNotAllowed) })
 	r.Get("/health", httpkit.Health())
-	r.Get("/ready", httpkit.Readiness(readinessCheck(db, redisClient)))
+	r.Get("/ready", httpkit.Readiness(readinessCheck(lc, db, redisClient)))
 
 	// Setup Swagger
 	setupSwagger(&cfg, r)
 
-	// Initialize boundary validator
-	val := validation.New(cfg.Validator)
-
-	// Initialize application. cfg.App carries every registered feature's own
-	// config (app.<feature>.* in config.yaml); internal/app resolves each
-	// feature's section itself when it wires that feature's repositories.
-	application := app.NewApp(log, db, r, val, redisClient, cfg.App)
+	// Initialize application
 	if err := application.Initialize(); err != nil {
 		panic("Failed to initialize application: " + err.Error())
 	}
@@ -114,17 +187,24 @@ func main() {
 		ReadTimeout:       cfg.Server.ReadTimeout,
 		WriteTimeout:      cfg.Server.WriteTimeout,
 	}
+	server.RegisterOnShutdown(application.CloseStreams)
 
-	serverErr := make(chan error, 1)
-	var wg sync.WaitGroup
-
-	wg.Add(1)
-
-	startServer(&wg, log, &cfg, server, serverErr)
-	gracefulShutdown(log, &cfg, serverErr, server)
-
-	wg.Wait()
+	// Background workers (webhook deliveries, guest imports) stop once the
+	// HTTP server no longer hands them work.
+	lc.Register(lifecycle.Component{Name: "workers", Start: application.Start, Stop: application.Shutdown})
+	if metricsServer := newMetricsServer(&cfg); metricsServer != nil {
+		lc.Register(lc.HTTPServer("metrics server", metricsServer))
+	}
+	lc.Register(lc.HTTPServer("server", server))
 
+	// SIGINT and SIGTERM start the shutdown, and a second one cuts the drain
+	// short; SIGKILL can't be caught.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	if err := lc.Run(ctx, signals); err != nil {
+		log.Fatalf("Server stopped with errors: %v", err)
+	}
 	log.Info("Server shutdown completed")
 }
 
@@ -137,10 +217,18 @@ func newTracer(cfg *appconfig.Config, log logger.Logger) (tracer.Tracer, error)
 	return tracer.NewOTel(cfg.Tracing.Tracer, tracer.WithLogger(log))
 }
 
-// readinessCheck pings the leader database and Redis so /ready reflects real
+// errNotServing is reported by /ready before startup completes and once
+// shutdown has begun.
+var errNotServing = errors.New("not serving")
+
+// readinessCheck answers 503 while lc isn't ready (starting or draining) and
+// otherwise pings the leader database and Redis, so /ready reflects real
 // dependency health instead of always returning 200.
-func readinessCheck(db *sqlkit.DB, redisClient redis.Client) func(context.Context) error {
+func readinessCheck(lc *lifecycle.Manager, db *sqlkit.DB, redisClient redis.Client) func(context.Context) error {
 	return func(ctx context.Context) error {
+		if !lc.Ready() {
+			return errorz.Wrap(errNotServing).WithCode(errorz.CodeServiceUnavailable).WithMessage("server not serving")
+		}
 		if err := db.Leader().PingContext(ctx); err != nil {
 			return errorz.Wrap(err).WithCode(errorz.CodeServiceUnavailable).WithMessage("database not ready")
 		}
@@ -165,44 +253,20 @@ func setupSwagger(cfg *appconfig.Config, r *chi.Mux) {
 	}
 }
 
-func startServer(
-	wg *sync.WaitGroup, log logger.Logger, cfg *appconfig.Config, server *http.Server, serverErr chan error,
-) {
-	go func() {
-		defer wg.Done()
-		log.Infof("Starting server on %s", cfg.Server.Addr())
-		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			serverErr <- err
-		}
-	}()
-
-	select {
-	case err := <-serverErr:
-		log.Fatalf("Failed to start server: %v", err)
-	case <-time.After(100 * time.Millisecond):
-		log.Info("Server started successfully")
-	}
-}
-
-func gracefulShutdown(log logger.Logger, cfg *appconfig.Config, serverErr chan error, server *http.Server) {
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
-	defer stop()
-
-	select {
-	case <-ctx.Done():
-		log.Info("Shutdown signal received, initiating graceful shutdown...")
-	case err := <-serverErr:
-		log.Infof("Server error occurred: %v, initiating shutdown...", err)
+// newMetricsServer returns the server for /metrics on cfg.Metrics.Addr(),
+// behind basic auth, or nil when metrics are disabled.
+func newMetricsServer(cfg *appconfig.Config) *http.Server {
+	if !cfg.Metrics.Enabled {
+		return nil
 	}
-
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
-	defer cancel()
-
-	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Infof("Error during server shutdown: %v", err)
-		if errors.Is(err, context.DeadlineExceeded) {
-			log.Info("Shutdown timeout exceeded, forcing server close...")
-			_ = server.Close()
-		}
+	r := chi.NewRouter()
+	r.Use(chiMiddleware.BasicAuth("metrics", map[string]string{
+		cfg.Metrics.Username: cfg.Metrics.Password,
+	}))
+	r.Handle("/metrics", metrics.Handler())
+	return &http.Server{
+		Addr:              cfg.Metrics.Addr(),
+		Handler:           r,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
 	}
 }
@@ -1,39 +1,53 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"net/http"
+
 	"github.com/biairmal/go-sdk/lib/logger"
 	"github.com/biairmal/go-sdk/lib/redis"
 	"github.com/biairmal/go-sdk/lib/sqlkit"
 	appconfig "github.com/biairmal/guest-management-be/internal/config"
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/background"
+	"github.com/biairmal/guest-management-be/internal/core/pubsub"
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
 	"github.com/biairmal/guest-management-be/internal/core/validation"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
 	"github.com/go-chi/chi/v5"
 )
 
 // App is the composition root: it wires repositories, services, handlers, and routes.
 type App struct {
-	logger        logger.Logger
-	db            *sqlkit.DB
-	router        *chi.Mux
-	validator     validation.Validator
-	redisClient   redis.Client
-	featureConfig appconfig.FeatureConfig
-	repositories  *repositories
-	service       *service
-	handler       *handler
+	logger            logger.Logger
+	db                *sqlkit.DB
+	router            *chi.Mux
+	validator         validation.Validator
+	redisClient       redis.Client
+	limiter           ratelimit.Limiter
+	featureConfig     appconfig.FeatureConfig
+	repositories      *repositories
+	service           *service
+	handler           *handler
+	importRunner      *background.Runner
+	liveHub           *pubsub.Hub
+	webhookDispatcher *webhooks.Dispatcher
 }
 
 // NewApp returns an App ready to be Initialize()d. featureConfig is the
 // app.<feature>.* config tree; redisClient feeds any feature repository that
-// opts into caching. Each feature's own config lives under featureConfig
+// opts into caching; limiter counts both the app-wide rate limits and the
+// ones features mount themselves (portal, registration). Each feature's own config lives under featureConfig
 // (e.g. featureConfig.Events) — registering a new feature means reading its
 // section here, not changing this constructor's signature.
 func NewApp(
 	logger logger.Logger, db *sqlkit.DB, router *chi.Mux, validator validation.Validator,
-	redisClient redis.Client, featureConfig appconfig.FeatureConfig,
+	redisClient redis.Client, limiter ratelimit.Limiter, featureConfig appconfig.FeatureConfig,
 ) *App {
 	return &App{
 		logger: logger, db: db, router: router, validator: validator,
-		redisClient: redisClient, featureConfig: featureConfig,
+		redisClient: redisClient, limiter: limiter, featureConfig: featureConfig,
 	}
 }
 
@@ -44,8 +58,64 @@ func (a *App) Initialize() error {
 		return err
 	}
 	a.repositories = repositories
-	a.service = a.initializeService(a.logger, a.repositories)
-	a.handler = a.initializeHandler(a.logger, a.validator, a.service)
+	a.service = a.initializeService(a.logger, a.validator, a.repositories, a.featureConfig)
+	a.handler = a.initializeHandler(a.logger, a.validator, a.service, a.featureConfig)
 	a.initializeRoutes(a.logger, a.router, a.handler)
 	return nil
 }
+
+// Authenticate is the API key authentication middleware (see
+// apikeys.Middleware) for main.go's chain. It may be mounted before
+// Initialize, which builds the service it resolves keys with, but must not
+// serve requests before it.
+func (a *App) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.handler.apiKeyAuth(next).ServeHTTP(w, r)
+	})
+}
+
+// RateLimit is the app-wide rate limiting middleware (see ratelimit.Routes)
+// for main.go's chain. Mount it after Authenticate, so requests are counted
+// by user, tenant and API key as well as by IP.
+func (a *App) RateLimit(cfg ratelimit.Config) func(http.Handler) http.Handler {
+	return ratelimit.Routes(a.logger, a.limiter, cfg, map[ratelimit.KeyType]ratelimit.Identity{
+		ratelimit.KeyAPIKey: func(r *http.Request) string { return auth.KeyID(r.Context()) },
+	})
+}
+
+// CloseStreams ends long-lived streaming responses (live attendance SSE) so
+// the HTTP server's graceful shutdown doesn't wait for them until its
+// timeout. Register it with http.Server.RegisterOnShutdown.
+func (a *App) CloseStreams() {
+	if a.liveHub != nil {
+		a.liveHub.Close()
+	}
+}
+
+// Start fails the guest imports left behind by a stopped instance and starts
+// the features' background workers (the webhook dispatcher, when webhooks are
+// enabled). Call it after Initialize.
+func (a *App) Start(ctx context.Context) error {
+	if err := a.service.guestImportService.FailInterrupted(ctx); err != nil {
+		return err
+	}
+	if a.webhookDispatcher != nil && a.featureConfig.Webhooks.Enabled {
+		a.webhookDispatcher.Start()
+	}
+	return nil
+}
+
+// Shutdown waits for background work started by the features (guest imports,
+// webhook deliveries in flight) to finish, cancelling what is still running
+// when ctx expires. Call it after the HTTP server has stopped accepting
+// requests.
+func (a *App) Shutdown(ctx context.Context) error {
+	var errs []error
+	if a.importRunner != nil {
+		errs = append(errs, a.importRunner.Shutdown(ctx))
+	}
+	if a.webhookDispatcher != nil {
+		errs = append(errs, a.webhookDispatcher.Shutdown(ctx))
+	}
+	return errors.Join(errs...)
+}
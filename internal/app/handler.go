@@ -1,17 +1,79 @@
 package app
 
 import (
+	"net/http"
+
 	"github.com/biairmal/go-sdk/lib/logger"
+	appconfig "github.com/biairmal/guest-management-be/internal/config"
 	"github.com/biairmal/guest-management-be/internal/core/validation"
+	"github.com/biairmal/guest-management-be/internal/features/apikeys"
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
 	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	"github.com/biairmal/guest-management-be/internal/features/reports"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/staffing"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
 )
 
 type handler struct {
-	categoryHandler *events.CategoryHandler
+	categoryHandler     *events.CategoryHandler
+	dayHandler          *events.DayHandler
+	calendarHandler     *calendar.Handler
+	guestHandler        *guests.GuestHandler
+	guestFieldHandler   *guests.FieldHandler
+	guestGroupHandler   *guests.GroupHandler
+	guestImportHandler  *guests.ImportHandler
+	guestExportHandler  *guests.ExportHandler
+	scanExportHandler   *scans.ExportHandler
+	scanLiveHandler     *scans.LiveHandler
+	scanHandler         *scans.ScanHandler
+	reportHandler       *reports.Handler
+	ticketHandler       *tickets.Handler
+	ticketLifecycle     *tickets.LifecycleHandler
+	portalHandler       *portal.Handler
+	registrationHandler *registration.Handler
+	staffHandler        *staffing.Handler
+	deviceHandler       *devices.Handler
+	webhookHandler      *webhooks.Handler
+	apiKeyHandler       *apikeys.Handler
+	apiKeyAuth          func(http.Handler) http.Handler
 }
 
-func (a *App) initializeHandler(_ logger.Logger, validator validation.Validator, service *service) *handler {
+func (a *App) initializeHandler(
+	logger logger.Logger, validator validation.Validator, service *service, featureConfig appconfig.FeatureConfig,
+) *handler {
 	return &handler{
-		categoryHandler: events.NewCategoryHandler(service.categoryService, validator),
+		categoryHandler:   events.NewCategoryHandler(service.categoryService, validator),
+		dayHandler:        events.NewDayHandler(service.dayService, validator),
+		calendarHandler:   calendar.NewHandler(logger, service.calendarService),
+		guestHandler:      guests.NewGuestHandler(service.guestService, validator),
+		guestFieldHandler: guests.NewFieldHandler(service.guestFieldService, validator),
+		guestGroupHandler: guests.NewGroupHandler(service.guestGroupService, validator),
+		guestImportHandler: guests.NewImportHandler(
+			service.guestImportService, validator, featureConfig.Guests.Handler,
+		),
+		guestExportHandler: guests.NewExportHandler(logger, service.guestExportService),
+		scanExportHandler:  scans.NewExportHandler(logger, service.scanExportService),
+		scanLiveHandler:    scans.NewLiveHandler(logger, service.scanLiveService),
+		scanHandler:        scans.NewScanHandler(service.scanService, validator),
+		reportHandler:      reports.NewHandler(service.reportService),
+		ticketHandler:      tickets.NewHandler(service.ticketService, validator),
+		ticketLifecycle:    tickets.NewLifecycleHandler(service.ticketLifecycle, validator),
+		portalHandler: portal.NewHandler(
+			logger, service.portalService, validator, a.limiter, featureConfig.Portal.Handler,
+		),
+		registrationHandler: registration.NewHandler(
+			logger, service.registrationService, validator, a.limiter, featureConfig.Registration.Handler,
+		),
+		staffHandler:   staffing.NewHandler(service.staffService, validator),
+		deviceHandler:  devices.NewHandler(service.deviceService, validator),
+		webhookHandler: webhooks.NewHandler(service.webhookService, validator),
+		apiKeyHandler:  apikeys.NewHandler(service.apiKeyService, validator),
+		apiKeyAuth:     apikeys.Middleware(service.apiKeyService),
 	}
 }
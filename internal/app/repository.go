@@ -3,16 +3,50 @@ package app
 import (
 	"github.com/biairmal/go-sdk/lib/logger"
 	"github.com/biairmal/go-sdk/lib/redis"
-	sdkrepository "github.com/biairmal/go-sdk/lib/repository"
 	"github.com/biairmal/go-sdk/lib/sqlkit"
 	appconfig "github.com/biairmal/guest-management-be/internal/config"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/features/apikeys"
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
 	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	"github.com/biairmal/guest-management-be/internal/features/reports"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/staffing"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
 	"github.com/google/uuid"
 )
 
 // repositories holds all feature repositories wired for the application.
 type repositories struct {
-	categoryRepository sdkrepository.Repository[events.EventCategory, uuid.UUID]
+	categoryRepository    corerepository.Repository[events.EventCategory, uuid.UUID]
+	dayStore              events.DayStore
+	calendarStore         calendar.Store
+	guestRepository       corerepository.Repository[guests.Guest, uuid.UUID]
+	guestStore            guests.GuestStore
+	guestFieldRepository  corerepository.Repository[guests.FieldDefinition, uuid.UUID]
+	guestFieldStore       guests.FieldStore
+	guestGroupRepository  corerepository.Repository[guests.GuestGroup, uuid.UUID]
+	guestGroupStore       guests.GroupStore
+	guestImportRepository corerepository.Repository[guests.GuestImport, uuid.UUID]
+	guestImportStore      guests.ImportStore
+	guestExportStore      guests.ExportStore
+	scanExportStore       scans.ExportStore
+	scanLiveStore         scans.LiveStore
+	scanStore             scans.ScanStore
+	reportStore           reports.Store
+	ticketStore           tickets.Store
+	ticketLifecycleStore  tickets.LifecycleStore
+	portalStore           portal.Store
+	registrationStore     registration.Store
+	staffStore            staffing.Store
+	deviceStore           devices.Store
+	webhookStore          webhooks.Store
+	apiKeyStore           apikeys.Store
 }
 
 func (a *App) initializeRepository(
@@ -23,6 +57,29 @@ func (a *App) initializeRepository(
 		return nil, err
 	}
 	return &repositories{
-		categoryRepository: events.NewCategoryRepository(log, db, categoryCacheOpts),
+		categoryRepository:    events.NewCategoryRepository(log, db, categoryCacheOpts),
+		dayStore:              events.NewDayStore(db),
+		calendarStore:         calendar.NewStore(db),
+		guestRepository:       guests.NewGuestRepository(log, db),
+		guestStore:            guests.NewGuestStore(db),
+		guestFieldRepository:  guests.NewFieldRepository(log, db),
+		guestFieldStore:       guests.NewFieldStore(db),
+		guestGroupRepository:  guests.NewGroupRepository(log, db),
+		guestGroupStore:       guests.NewGroupStore(db),
+		guestImportRepository: guests.NewImportRepository(log, db),
+		guestImportStore:      guests.NewImportStore(db),
+		guestExportStore:      guests.NewExportStore(db),
+		scanExportStore:       scans.NewExportStore(db),
+		scanLiveStore:         scans.NewLiveStore(db),
+		scanStore:             scans.NewScanStore(db),
+		reportStore:           reports.NewStore(db),
+		ticketStore:           tickets.NewStore(db),
+		ticketLifecycleStore:  tickets.NewLifecycleStore(db),
+		portalStore:           portal.NewStore(db),
+		registrationStore:     registration.NewStore(db),
+		staffStore:            staffing.NewStore(db),
+		deviceStore:           devices.NewStore(db),
+		webhookStore:          webhooks.NewStore(db),
+		apiKeyStore:           apikeys.NewStore(db),
 	}, nil
 }
@@ -2,10 +2,45 @@ package app
 
 import (
 	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/guest-management-be/internal/features/apikeys"
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
 	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	"github.com/biairmal/guest-management-be/internal/features/reports"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/staffing"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
 	"github.com/go-chi/chi/v5"
 )
 
 func (a *App) initializeRoutes(_ logger.Logger, mux *chi.Mux, handler *handler) {
 	events.InitCategoryRoutes(mux, handler.categoryHandler)
+	events.InitDayRoutes(mux, handler.dayHandler)
+	calendar.InitCalendarRoutes(mux, handler.calendarHandler)
+	guests.InitGuestRoutes(mux, handler.guestHandler)
+	guests.InitFieldRoutes(mux, handler.guestFieldHandler)
+	guests.InitGroupRoutes(mux, handler.guestGroupHandler)
+	guests.InitImportRoutes(mux, handler.guestImportHandler)
+	guests.InitExportRoutes(mux, handler.guestExportHandler)
+	scans.InitExportRoutes(mux, handler.scanExportHandler)
+	scans.InitLiveRoutes(mux, handler.scanLiveHandler)
+	scans.InitScanRoutes(mux, handler.scanHandler)
+	reports.InitReportRoutes(mux, handler.reportHandler)
+	tickets.InitTicketRoutes(mux, handler.ticketHandler)
+	tickets.InitLifecycleRoutes(mux, handler.ticketLifecycle)
+	registration.InitRegistrationRoutes(mux, handler.registrationHandler)
+	staffing.InitStaffRoutes(mux, handler.staffHandler)
+	devices.InitDeviceRoutes(mux, handler.deviceHandler)
+	webhooks.InitWebhookRoutes(mux, handler.webhookHandler)
+	apikeys.InitAPIKeyRoutes(mux, handler.apiKeyHandler)
+	if a.featureConfig.Portal.Enabled {
+		portal.InitPortalRoutes(mux, handler.portalHandler)
+	}
+	if a.featureConfig.Calendar.FeedEnabled {
+		calendar.InitFeedRoutes(mux, handler.calendarHandler)
+	}
 }
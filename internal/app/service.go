@@ -2,15 +2,111 @@ package app
 
 import (
 	"github.com/biairmal/go-sdk/lib/logger"
+	appconfig "github.com/biairmal/guest-management-be/internal/config"
+	"github.com/biairmal/guest-management-be/internal/core/background"
+	"github.com/biairmal/guest-management-be/internal/core/pubsub"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+	"github.com/biairmal/guest-management-be/internal/features/apikeys"
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
 	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	"github.com/biairmal/guest-management-be/internal/features/reports"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/staffing"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
 )
 
 type service struct {
-	categoryService events.CategoryService
+	categoryService     events.CategoryService
+	dayService          events.DayService
+	calendarService     calendar.Service
+	guestService        guests.GuestService
+	guestFieldService   guests.FieldService
+	guestGroupService   guests.GroupService
+	guestImportService  guests.ImportService
+	guestExportService  guests.ExportService
+	scanExportService   scans.ExportService
+	scanLiveService     scans.LiveService
+	scanService         scans.ScanService
+	reportService       reports.Service
+	ticketService       tickets.Service
+	ticketLifecycle     tickets.LifecycleService
+	portalService       portal.Service
+	registrationService registration.Service
+	staffService        staffing.Service
+	deviceService       devices.Service
+	webhookService      webhooks.Service
+	apiKeyService       apikeys.Service
 }
 
-func (a *App) initializeService(logger logger.Logger, repositories *repositories) *service {
+func (a *App) initializeService(
+	logger logger.Logger, validator validation.Validator, repositories *repositories,
+	featureConfig appconfig.FeatureConfig,
+) *service {
+	importCfg := featureConfig.Guests.Service.Import
+	a.importRunner = background.NewRunner(logger, importCfg.Workers)
+	a.liveHub = pubsub.NewHub(logger, a.redisClient)
+	txManager := transaction.NewTxManager(logger, a.db)
+	webhookCfg := featureConfig.Webhooks
+	a.webhookDispatcher = webhooks.NewDispatcher(logger, txManager, repositories.webhookStore, nil, webhookCfg.Delivery)
+	webhookPublisher := webhooks.NewPublisher(repositories.webhookStore)
+	ticketNotifier := tickets.NewLogNotifier(logger)
+	ticketIssuer := tickets.NewIssuer(logger, txManager, repositories.ticketStore, ticketNotifier, webhookPublisher)
+	guestService := guests.NewGuestService(
+		logger, txManager, repositories.guestRepository, repositories.guestStore, repositories.guestFieldStore,
+		ticketIssuer, webhookPublisher,
+	)
+	deviceService := devices.NewService(logger, txManager, repositories.deviceStore)
 	return &service{
 		categoryService: events.NewCategoryService(logger, repositories.categoryRepository),
+		dayService:      events.NewDayService(logger, txManager, repositories.dayStore),
+		calendarService: calendar.NewService(
+			logger, repositories.calendarStore, featureConfig.Calendar.Service,
+		),
+		guestService: guestService,
+		guestFieldService: guests.NewFieldService(
+			logger, repositories.guestFieldRepository, repositories.guestFieldStore,
+		),
+		guestGroupService: guests.NewGroupService(
+			logger, txManager, repositories.guestGroupRepository, repositories.guestRepository,
+			repositories.guestGroupStore, repositories.guestFieldStore, ticketIssuer, webhookPublisher,
+		),
+		guestImportService: guests.NewImportService(
+			logger, validator, repositories.guestImportRepository, repositories.guestImportStore,
+			repositories.guestFieldStore, a.importRunner, importCfg,
+		),
+		guestExportService: guests.NewExportService(
+			logger, repositories.guestExportStore, repositories.guestFieldStore,
+		),
+		scanExportService: scans.NewExportService(logger, repositories.scanExportStore),
+		scanLiveService: scans.NewLiveService(
+			logger, repositories.scanLiveStore, a.liveHub, featureConfig.Scans.Service.Live,
+		),
+		scanService: scans.NewScanService(
+			logger, txManager, repositories.scanStore, deviceService, webhookPublisher,
+			scans.NewLivePublisher(a.redisClient, featureConfig.Scans.Service.Live),
+		),
+		reportService: reports.NewService(logger, repositories.reportStore),
+		ticketService: tickets.NewService(logger, txManager, repositories.ticketStore, ticketIssuer),
+		ticketLifecycle: tickets.NewLifecycleService(
+			logger, txManager, repositories.ticketStore, repositories.ticketLifecycleStore, ticketIssuer, ticketNotifier,
+		),
+		portalService: portal.NewService(
+			logger, repositories.portalStore, guestService, repositories.guestFieldStore,
+			portal.NewLogNotifier(logger), featureConfig.Portal.Service,
+		),
+		registrationService: registration.NewService(
+			logger, txManager, repositories.registrationStore, guestService, repositories.guestFieldStore,
+			registration.NewVerifier(featureConfig.Registration.Service.Captcha),
+		),
+		staffService:   staffing.NewService(logger, txManager, validator, repositories.staffStore),
+		deviceService:  deviceService,
+		webhookService: webhooks.NewService(logger, repositories.webhookStore, webhookCfg.Service),
+		apiKeyService:  apikeys.NewService(logger, txManager, repositories.apiKeyStore),
 	}
 }
@@ -1,6 +1,14 @@
 package config
 
-import "github.com/biairmal/guest-management-be/internal/features/events"
+import (
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+)
 
 // FeatureConfig aggregates configuration owned by individual features,
 // nested under the "app" YAML section (app.<feature>.<config_name>).
@@ -9,10 +17,34 @@ import "github.com/biairmal/guest-management-be/internal/features/events"
 // a feature means adding a field here, not touching the root Config or
 // cmd/api/main.go.
 type FeatureConfig struct {
-	Events events.Config `mapstructure:"events"`
+	Events       events.Config       `mapstructure:"events"`
+	Guests       guests.Config       `mapstructure:"guests"`
+	Scans        scans.Config        `mapstructure:"scans"`
+	Portal       portal.Config       `mapstructure:"portal"`
+	Registration registration.Config `mapstructure:"registration"`
+	Calendar     calendar.Config     `mapstructure:"calendar"`
+	Webhooks     webhooks.Config     `mapstructure:"webhooks"`
 }
 
 // Validate validates every registered feature's configuration.
 func (c *FeatureConfig) Validate() error {
-	return c.Events.Validate()
+	if err := c.Events.Validate(); err != nil {
+		return err
+	}
+	if err := c.Guests.Validate(); err != nil {
+		return err
+	}
+	if err := c.Scans.Validate(); err != nil {
+		return err
+	}
+	if err := c.Portal.Validate(); err != nil {
+		return err
+	}
+	if err := c.Registration.Validate(); err != nil {
+		return err
+	}
+	if err := c.Calendar.Validate(); err != nil {
+		return err
+	}
+	return c.Webhooks.Validate()
 }
@@ -3,7 +3,13 @@ package config
 import (
 	"testing"
 
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
 	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
 )
 
 func TestFeatureConfigValidate(t *testing.T) {
@@ -12,16 +18,91 @@ func TestFeatureConfigValidate(t *testing.T) {
 		cfg     FeatureConfig
 		wantErr bool
 	}{
-		{name: "default events config is valid", cfg: FeatureConfig{Events: events.DefaultConfig()}},
+		{
+			name: "default feature configs are valid",
+			cfg: FeatureConfig{
+				Events: events.DefaultConfig(), Guests: guests.DefaultConfig(), Scans: scans.DefaultConfig(),
+				Portal: portal.DefaultConfig(), Registration: registration.DefaultConfig(),
+				Calendar: calendar.DefaultConfig(), Webhooks: webhooks.DefaultConfig(),
+			},
+		},
 		{
 			name: "invalid events config is rejected",
 			cfg: FeatureConfig{Events: func() events.Config {
 				c := events.DefaultConfig()
 				c.Repository.CategoryCache.Strategy = "bogus"
 				return c
+			}(), Guests: guests.DefaultConfig(), Scans: scans.DefaultConfig()},
+			wantErr: true,
+		},
+		{
+			name: "invalid guests config is rejected",
+			cfg: FeatureConfig{Events: events.DefaultConfig(), Guests: func() guests.Config {
+				c := guests.DefaultConfig()
+				c.Service.Import.BatchSize = 0
+				return c
+			}(), Scans: scans.DefaultConfig()},
+			wantErr: true,
+		},
+		{
+			name: "invalid scans config is rejected",
+			cfg: FeatureConfig{Events: events.DefaultConfig(), Guests: guests.DefaultConfig(), Scans: func() scans.Config {
+				c := scans.DefaultConfig()
+				c.Service.Live.IdleInterval = 0
+				return c
 			}()},
 			wantErr: true,
 		},
+		{
+			name: "enabled portal without a token secret is rejected",
+			cfg: FeatureConfig{
+				Events: events.DefaultConfig(), Guests: guests.DefaultConfig(), Scans: scans.DefaultConfig(),
+				Portal: func() portal.Config {
+					c := portal.DefaultConfig()
+					c.Enabled = true
+					return c
+				}(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid registration config is rejected",
+			cfg: FeatureConfig{
+				Events: events.DefaultConfig(), Guests: guests.DefaultConfig(), Scans: scans.DefaultConfig(),
+				Registration: func() registration.Config {
+					c := registration.DefaultConfig()
+					c.Service.Captcha.Provider = registration.CaptchaStatic
+					return c
+				}(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "enabled calendar feed without a secret is rejected",
+			cfg: FeatureConfig{
+				Events: events.DefaultConfig(), Guests: guests.DefaultConfig(), Scans: scans.DefaultConfig(),
+				Portal: portal.DefaultConfig(), Registration: registration.DefaultConfig(),
+				Calendar: func() calendar.Config {
+					c := calendar.DefaultConfig()
+					c.FeedEnabled = true
+					return c
+				}(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid webhooks config is rejected",
+			cfg: FeatureConfig{
+				Events: events.DefaultConfig(), Guests: guests.DefaultConfig(), Scans: scans.DefaultConfig(),
+				Portal: portal.DefaultConfig(), Registration: registration.DefaultConfig(),
+				Calendar: calendar.DefaultConfig(), Webhooks: func() webhooks.Config {
+					c := webhooks.DefaultConfig()
+					c.Delivery.BackoffMax = c.Delivery.BackoffBase / 2
+					return c
+				}(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
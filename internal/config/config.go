@@ -5,6 +5,9 @@ import (
 	"github.com/biairmal/go-sdk/lib/redis"
 	"github.com/biairmal/go-sdk/lib/sqlkit"
 	"github.com/biairmal/go-sdk/lib/validator"
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/idempotency"
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
 )
 
 // Config is the root configuration tree for the application. It embeds go-sdk
@@ -12,12 +15,16 @@ import (
 // App (FeatureConfig) — the aggregate of every registered feature's own
 // config, nested under the "app" YAML section.
 type Config struct {
-	Logger    logger.Options
-	Server    ServerConfig
-	Database  sqlkit.Config
-	Redis     redis.Config
-	Validator validator.Config
-	Swagger   SwaggerConfig
-	Tracing   TracingConfig
-	App       FeatureConfig
+	Logger      logger.Options
+	Server      ServerConfig
+	Database    sqlkit.Config
+	Redis       redis.Config
+	Validator   validator.Config
+	Swagger     SwaggerConfig
+	Tracing     TracingConfig
+	Metrics     MetricsConfig
+	Auth        auth.Config
+	Idempotency idempotency.Config
+	RateLimit   ratelimit.Config `mapstructure:"rate_limit"`
+	App         FeatureConfig
 }
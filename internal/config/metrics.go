@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// MetricsConfig configures the Prometheus endpoint. It is served on its own
+// listener, not the API's, behind basic auth like Swagger.
+type MetricsConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+// Addr returns the "host:port" address the metrics listener should bind.
+func (c MetricsConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
+// Validate checks the metrics listener and credentials only when enabled.
+func (c *MetricsConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return errorz.Internal().WithMessage(fmt.Sprintf("metrics: port must be between 1 and 65535, got %d", c.Port))
+	}
+	if c.Username == "" || c.Password == "" {
+		return errorz.Internal().WithMessage("metrics: username and password are required when enabled")
+	}
+	return nil
+}
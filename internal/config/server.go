@@ -14,7 +14,13 @@ type ServerConfig struct {
 	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout"`
 	ReadTimeout       time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout      time.Duration `mapstructure:"write_timeout"`
-	ShutdownTimeout   time.Duration `mapstructure:"shutdown_timeout"`
+	// StartupTimeout bounds each component's start, including waiting for the
+	// database and Redis to answer.
+	StartupTimeout time.Duration `mapstructure:"startup_timeout"`
+	// DrainPeriod is how long /ready answers 503 before shutdown stops
+	// anything, so load balancers take the instance out first.
+	DrainPeriod     time.Duration `mapstructure:"drain_period"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
 }
 
 // DefaultServerConfig returns a ServerConfig with sensible defaults.
@@ -25,6 +31,8 @@ func DefaultServerConfig() ServerConfig {
 		ReadHeaderTimeout: 5 * time.Second,
 		ReadTimeout:       15 * time.Second,
 		WriteTimeout:      15 * time.Second,
+		StartupTimeout:    30 * time.Second,
+		DrainPeriod:       5 * time.Second,
 		ShutdownTimeout:   30 * time.Second,
 	}
 }
@@ -39,5 +47,8 @@ func (c *ServerConfig) Validate() error {
 	if c.Port <= 0 || c.Port > 65535 {
 		return errorz.Internal().WithMessage(fmt.Sprintf("server: port must be between 1 and 65535, got %d", c.Port))
 	}
+	if c.StartupTimeout < 0 || c.DrainPeriod < 0 || c.ShutdownTimeout < 0 {
+		return errorz.Internal().WithMessage("server: startup_timeout, drain_period and shutdown_timeout must not be negative")
+	}
 	return nil
 }
@@ -13,13 +13,21 @@ import (
 // AuditableRepository wraps a repository.Repository and adds audit field management.
 //
 // Behavior:
-//   - Create: sets created_at and updated_at to time.Now(), then delegates to inner repo.
-//   - Update: sets updated_at to time.Now(), then delegates to inner repo.
-//   - Delete: performs soft-delete by setting deleted_at and updated_at to time.Now(),
+//   - Create: sets created_at and updated_at to now, then delegates to inner repo.
+//   - Update: sets updated_at to now, then delegates to inner repo.
+//   - UpdateIfMatch: as Update, but through the inner repo's UpdateIfMatch so the
+//     write is conditional on the client's If-Match (see internal/core/etag).
+//   - Delete: performs soft-delete by setting deleted_at and updated_at to now,
 //     then calls inner repo's Update (not Delete).
 //   - GetByID: delegates to inner repo and returns ErrNotFound if entity is soft-deleted.
-//   - List / Count: merges a "deleted_at IS NULL" condition into the filter before delegating.
+//   - List / Count: merges a "deleted_at IS NULL" condition into the filter before delegating,
+//     unless the context was marked with IncludeDeleted.
 //   - Exists: delegates to GetByID (respects soft-delete).
+//   - Restore: clears deleted_at on a soft-deleted entity and persists via inner repo's Update.
+//   - Purge: hard-deletes an already soft-deleted entity via inner repo's Delete.
+//
+// "now" is truncated to microseconds to match TIMESTAMPTZ, so updated_at doubles as
+// the entity's version token (see internal/core/etag).
 //
 // The entity type must have struct fields with db tags: "created_at" (time.Time),
 // "updated_at" (time.Time), and "deleted_at" (*time.Time).
@@ -28,17 +36,19 @@ type AuditableRepository[TEntity any, TID comparable] struct {
 }
 
 // NewAuditableRepository creates a new AuditableRepository wrapping the given repository.
+// The concrete type is returned so callers can reach Restore and Purge, which are not
+// part of repository.Repository.
 func NewAuditableRepository[TEntity any, TID comparable](
 	inner repository.Repository[TEntity, TID],
-) repository.Repository[TEntity, TID] {
+) *AuditableRepository[TEntity, TID] {
 	return &AuditableRepository[TEntity, TID]{inner: inner}
 }
 
 // Create sets created_at and updated_at, then delegates to the inner repository.
 func (r *AuditableRepository[TEntity, TID]) Create(ctx context.Context, entity *TEntity) error {
-	now := time.Now()
-	setTimeField(entity, "created_at", now)
-	setTimeField(entity, "updated_at", now)
+	createdAt := now()
+	setTimeField(entity, "created_at", createdAt)
+	setTimeField(entity, "updated_at", createdAt)
 	return r.inner.Create(ctx, entity)
 }
 
@@ -54,9 +64,28 @@ func (r *AuditableRepository[TEntity, TID]) GetByID(ctx context.Context, id TID)
 	return entity, nil
 }
 
-// Update sets updated_at to time.Now(), then delegates to the inner repository.
+// Update sets updated_at to now, then delegates to the inner repository.
 func (r *AuditableRepository[TEntity, TID]) Update(ctx context.Context, id TID, entity *TEntity) error {
-	setTimeField(entity, "updated_at", time.Now())
+	setTimeField(entity, "updated_at", now())
+	return r.inner.Update(ctx, id, entity)
+}
+
+// conditionalUpdater is implemented by an inner repository that can make an
+// update conditional on the entity's stored version.
+type conditionalUpdater[TEntity any, TID comparable] interface {
+	UpdateIfMatch(ctx context.Context, id TID, entity *TEntity, ifMatch string) error
+}
+
+// UpdateIfMatch sets updated_at to now, then delegates to the inner repository's
+// UpdateIfMatch. An inner repository without one cannot check the precondition,
+// so the write falls back to its plain Update.
+func (r *AuditableRepository[TEntity, TID]) UpdateIfMatch(
+	ctx context.Context, id TID, entity *TEntity, ifMatch string,
+) error {
+	setTimeField(entity, "updated_at", now())
+	if inner, ok := r.inner.(conditionalUpdater[TEntity, TID]); ok {
+		return inner.UpdateIfMatch(ctx, id, entity, ifMatch)
+	}
 	return r.inner.Update(ctx, id, entity)
 }
 
@@ -70,21 +99,57 @@ func (r *AuditableRepository[TEntity, TID]) Delete(ctx context.Context, id TID)
 	if isSoftDeleted(entity) {
 		return repository.ErrNotFound
 	}
-	now := time.Now()
-	setPtrTimeField(entity, "deleted_at", &now)
-	setTimeField(entity, "updated_at", now)
+	deletedAt := now()
+	setPtrTimeField(entity, "deleted_at", &deletedAt)
+	setTimeField(entity, "updated_at", deletedAt)
+	return r.inner.Update(ctx, id, entity)
+}
+
+// Restore undoes a soft-delete: reads the entity, clears deleted_at, sets updated_at,
+// then persists via the inner repository's Update method. Returns ErrNotFound if the
+// entity does not exist or is not soft-deleted. A unique-key collision with a live row
+// surfaces as whatever the inner repository returns (ErrAlreadyExists for repository/sql).
+func (r *AuditableRepository[TEntity, TID]) Restore(ctx context.Context, id TID) error {
+	entity, err := r.inner.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !isSoftDeleted(entity) {
+		return repository.ErrNotFound
+	}
+	setPtrTimeField(entity, "deleted_at", nil)
+	setTimeField(entity, "updated_at", now())
 	return r.inner.Update(ctx, id, entity)
 }
 
-// List merges a "deleted_at IS NULL" condition, then delegates to the inner repository.
+// Purge permanently removes an entity via the inner repository's Delete method. Only
+// soft-deleted entities can be purged (ErrNotFound otherwise), so erasing data is always
+// a deliberate second step after Delete and a live row is never removed in one call.
+func (r *AuditableRepository[TEntity, TID]) Purge(ctx context.Context, id TID) error {
+	entity, err := r.inner.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !isSoftDeleted(entity) {
+		return repository.ErrNotFound
+	}
+	return r.inner.Delete(ctx, id)
+}
+
+// List merges a "deleted_at IS NULL" condition (unless ctx carries IncludeDeleted),
+// then delegates to the inner repository.
 func (r *AuditableRepository[TEntity, TID]) List(
 	ctx context.Context, opts *repository.ListOptions,
 ) (entities []*TEntity, total int64, err error) {
 	if opts == nil {
 		opts = &repository.ListOptions{}
 	}
+	filter := opts.Filter
+	if !includesDeleted(ctx) {
+		filter = appendSoftDeleteFilter(filter)
+	}
 	merged := &repository.ListOptions{
-		Filter:     appendSoftDeleteFilter(opts.Filter),
+		Filter:     filter,
 		Pagination: opts.Pagination,
 		Sorts:      opts.Sorts,
 		SkipCount:  opts.SkipCount,
@@ -92,8 +157,12 @@ func (r *AuditableRepository[TEntity, TID]) List(
 	return r.inner.List(ctx, merged)
 }
 
-// Count merges a "deleted_at IS NULL" condition, then delegates to the inner repository.
+// Count merges a "deleted_at IS NULL" condition (unless ctx carries IncludeDeleted),
+// then delegates to the inner repository.
 func (r *AuditableRepository[TEntity, TID]) Count(ctx context.Context, filter repository.Filter) (int64, error) {
+	if includesDeleted(ctx) {
+		return r.inner.Count(ctx, filter)
+	}
 	return r.inner.Count(ctx, appendSoftDeleteFilter(filter))
 }
 
@@ -115,6 +184,13 @@ func (r *AuditableRepository[TEntity, TID]) Exists(ctx context.Context, id TID)
 
 var timeType = reflect.TypeOf(time.Time{})
 
+// now returns the current time truncated to microseconds, the precision
+// PostgreSQL stores TIMESTAMPTZ with, so an in-memory entity's updated_at
+// (and the ETag derived from it) equals what a later read returns.
+func now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
 // appendSoftDeleteFilter adds a "deleted_at IS NULL" condition to the filter.
 func appendSoftDeleteFilter(f repository.Filter) repository.Filter {
 	f.Conditions = append(f.Conditions, repository.FilterCondition{
@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	mockrepository "github.com/biairmal/go-sdk/mocks/repository"
+	"go.uber.org/mock/gomock"
+)
+
+type testEntity struct {
+	ID        string     `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func deletedEntity() *testEntity {
+	now := time.Now()
+	return &testEntity{ID: "1", DeletedAt: &now}
+}
+
+func TestAuditableRepository_Restore(t *testing.T) {
+	tests := []struct {
+		name          string
+		getRes        *testEntity
+		getErr        error
+		expectsUpdate bool
+		updateErr     error
+		wantErr       error
+	}{
+		{name: "missing entity", getErr: repository.ErrNotFound, wantErr: repository.ErrNotFound},
+		{name: "live entity is not restorable", getRes: &testEntity{ID: "1"}, wantErr: repository.ErrNotFound},
+		{
+			name:          "unique-key collision is passed through",
+			getRes:        deletedEntity(),
+			expectsUpdate: true,
+			updateErr:     repository.ErrAlreadyExists,
+			wantErr:       repository.ErrAlreadyExists,
+		},
+		{name: "happy path clears deleted_at", getRes: deletedEntity(), expectsUpdate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inner := mockrepository.NewMockRepository[testEntity, string](ctrl)
+			inner.EXPECT().GetByID(gomock.Any(), "1").Return(tt.getRes, tt.getErr)
+			if tt.expectsUpdate {
+				inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, e *testEntity) error {
+						if e.DeletedAt != nil {
+							t.Errorf("DeletedAt = %v, want nil", e.DeletedAt)
+						}
+						return tt.updateErr
+					})
+			}
+
+			err := NewAuditableRepository[testEntity, string](inner).Restore(context.Background(), "1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Restore() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuditableRepository_Purge(t *testing.T) {
+	tests := []struct {
+		name          string
+		getRes        *testEntity
+		getErr        error
+		expectsDelete bool
+		wantErr       error
+	}{
+		{name: "missing entity", getErr: repository.ErrNotFound, wantErr: repository.ErrNotFound},
+		{name: "live entity must be soft-deleted first", getRes: &testEntity{ID: "1"}, wantErr: repository.ErrNotFound},
+		{name: "soft-deleted entity is hard-deleted", getRes: deletedEntity(), expectsDelete: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inner := mockrepository.NewMockRepository[testEntity, string](ctrl)
+			inner.EXPECT().GetByID(gomock.Any(), "1").Return(tt.getRes, tt.getErr)
+			if tt.expectsDelete {
+				inner.EXPECT().Delete(gomock.Any(), "1").Return(nil)
+			}
+
+			err := NewAuditableRepository[testEntity, string](inner).Purge(context.Background(), "1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Purge() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuditableRepository_ListIncludeDeleted(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		wantConditions int
+	}{
+		{name: "default hides soft-deleted rows", ctx: context.Background(), wantConditions: 1},
+		{name: "IncludeDeleted skips the filter", ctx: IncludeDeleted(context.Background()), wantConditions: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inner := mockrepository.NewMockRepository[testEntity, string](ctrl)
+			inner.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, opts *repository.ListOptions) ([]*testEntity, int64, error) {
+					if got := len(opts.Filter.Conditions); got != tt.wantConditions {
+						t.Errorf("conditions = %d, want %d", got, tt.wantConditions)
+					}
+					return nil, 0, nil
+				})
+
+			if _, _, err := NewAuditableRepository[testEntity, string](inner).List(tt.ctx, nil); err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+		})
+	}
+}
+
+// conditionalRepository adds UpdateIfMatch to the go-sdk mock, recording the
+// precondition it was handed.
+type conditionalRepository struct {
+	*mockrepository.MockRepository[testEntity, string]
+	ifMatch string
+}
+
+func (r *conditionalRepository) UpdateIfMatch(_ context.Context, _ string, e *testEntity, ifMatch string) error {
+	if e.UpdatedAt.IsZero() {
+		return errors.New("updated_at not stamped")
+	}
+	r.ifMatch = ifMatch
+	return nil
+}
+
+func TestAuditableRepository_UpdateIfMatch(t *testing.T) {
+	t.Run("passes the precondition to the inner repository", func(t *testing.T) {
+		inner := &conditionalRepository{MockRepository: mockrepository.NewMockRepository[testEntity, string](gomock.NewController(t))}
+		err := NewAuditableRepository[testEntity, string](inner).
+			UpdateIfMatch(context.Background(), "1", &testEntity{ID: "1"}, `"1"`)
+		if err != nil {
+			t.Fatalf("UpdateIfMatch() error = %v", err)
+		}
+		if inner.ifMatch != `"1"` {
+			t.Errorf("inner If-Match = %q, want %q", inner.ifMatch, `"1"`)
+		}
+	})
+
+	t.Run("falls back to Update without a conditional inner", func(t *testing.T) {
+		inner := mockrepository.NewMockRepository[testEntity, string](gomock.NewController(t))
+		inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(nil)
+		err := NewAuditableRepository[testEntity, string](inner).
+			UpdateIfMatch(context.Background(), "1", &testEntity{ID: "1"}, `"1"`)
+		if err != nil {
+			t.Errorf("UpdateIfMatch() error = %v", err)
+		}
+	})
+}
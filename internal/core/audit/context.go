@@ -0,0 +1,21 @@
+package audit
+
+import "context"
+
+// includeDeletedKey is the context key marking a List/Count call that should
+// also return soft-deleted rows.
+type includeDeletedKey struct{}
+
+// IncludeDeleted returns a copy of ctx that makes AuditableRepository.List and
+// Count skip the "deleted_at IS NULL" condition, for admin views that need to
+// see (and restore) soft-deleted rows. GetByID, Exists, Update and Delete are
+// unaffected: they always treat soft-deleted rows as not found.
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// includesDeleted reports whether ctx was marked with IncludeDeleted.
+func includesDeleted(ctx context.Context) bool {
+	v, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return v
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// minSecretLen is the shortest accepted HS256 secret.
+const minSecretLen = 32
+
+// Config is the YAML/mapstructure-decodable shape for user access tokens (the
+// "auth:" section of config.yaml). Tokens are HS256 JWTs minted by the
+// identity provider with Secret; an empty Secret turns user tokens off, so
+// only API keys authenticate.
+type Config struct {
+	Secret string        `mapstructure:"secret"` // HS256 signing key shared with the identity provider
+	Issuer string        `mapstructure:"issuer"` // required "iss" claim; empty accepts any issuer
+	Leeway time.Duration `mapstructure:"leeway"` // clock skew tolerated on exp and nbf
+}
+
+// Validate checks the configuration. It is a no-op when tokens are off.
+func (c *Config) Validate() error {
+	if c.Secret == "" {
+		return nil
+	}
+	if len(c.Secret) < minSecretLen {
+		return errorz.Internal().WithMessage("auth: secret must be at least 32 bytes")
+	}
+	if c.Leeway < 0 {
+		return errorz.Internal().WithMessage("auth: leeway must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func TestMiddleware(t *testing.T) {
+	userID, tenantID := uuid.NewString(), uuid.NewString()
+	token := sign(t, testSecret, "HS256", map[string]any{
+		"sub": userID, "tenant_id": tenantID, "permissions": []string{ManageAPIKeys},
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+		wantUser   string
+	}{
+		{name: "no credentials pass through", wantStatus: http.StatusOK},
+		{name: "an API key passes through", header: "Bearer gm_abcdef", wantStatus: http.StatusOK},
+		{name: "basic auth passes through", header: "Basic Z206eA==", wantStatus: http.StatusOK},
+		{name: "valid token sets the identity", header: "Bearer " + token, wantStatus: http.StatusOK, wantUser: userID},
+		{name: "invalid token is refused", header: "Bearer " + token + "x", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user, tenant string
+			var perms []string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx := r.Context()
+				user, tenant, perms = ctxkit.UserID(ctx), ctxkit.TenantID(ctx), ctxkit.Permissions(ctx)
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/events", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			Middleware(NewVerifier(Config{Secret: testSecret}))(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if user != tt.wantUser {
+				t.Errorf("user = %q, want %q", user, tt.wantUser)
+			}
+			if tt.wantUser != "" && (tenant != tenantID || !slices.Equal(perms, []string{ManageAPIKeys})) {
+				t.Errorf("tenant = %q, permissions = %v, want %q with %s", tenant, perms, tenantID, ManageAPIKeys)
+			}
+		})
+	}
+}
+
+func TestGuards(t *testing.T) {
+	tenantID := uuid.NewString()
+	tests := []struct {
+		name       string
+		tenant     string
+		perms      []string
+		wantStatus int
+	}{
+		{name: "anonymous", wantStatus: http.StatusUnauthorized},
+		{name: "missing permission", tenant: tenantID, perms: []string{"check_in"}, wantStatus: http.StatusForbidden},
+		{name: "other tenant", tenant: uuid.NewString(), perms: []string{ManageAPIKeys}, wantStatus: http.StatusForbidden},
+		{name: "allowed", tenant: tenantID, perms: []string{"check_in", ManageAPIKeys}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			r.With(Require(ManageAPIKeys), TenantParam("tenantId")).
+				Get("/tenants/{tenantId}", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+			ctx := context.Background()
+			if tt.tenant != "" {
+				ctx = ctxkit.WithPermissions(ctxkit.WithTenantID(ctx, tt.tenant), tt.perms)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tenants/"+tenantID, nil).WithContext(ctx))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package auth
+
+// Permission codes checked by route guards. Each is a row of the permissions
+// table, seeded by a migration, that roles and API keys grant.
+const (
+	// CheckIn allows recording ticket scans at an event's workflow steps.
+	CheckIn = "check_in"
+	// ManageGuests allows staff to act for the guests of the tenant's events,
+	// such as minting a guest's RSVP portal link or invalidating, reissuing,
+	// transferring and reactivating their tickets.
+	ManageGuests = "manage_guests"
+	// ManageWebhooks allows registering, changing and removing the tenant's
+	// webhook endpoints, rotating their secrets and redelivering events.
+	ManageWebhooks = "manage_webhooks"
+	// ManageStaff allows assigning the tenant's users to an event's staff,
+	// changing their roles and taking them off it.
+	ManageStaff = "manage_staff"
+	// ManageDevices allows registering, changing and removing an event's
+	// scanner devices and rotating their credentials.
+	ManageDevices = "manage_devices"
+	// ManageAPIKeys allows listing, creating and revoking the tenant's API
+	// keys.
+	ManageAPIKeys = "manage_api_keys"
+)
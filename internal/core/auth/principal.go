@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/google/uuid"
+)
+
+// keyIDKey is the context key of the authenticating API key's id.
+type keyIDKey struct{}
+
+// WithKeyID returns a copy of ctx recording that the request authenticated
+// with the API key id.
+func WithKeyID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, keyIDKey{}, id)
+}
+
+// KeyID returns the id of the API key the request authenticated with, or ""
+// when it didn't use one.
+func KeyID(ctx context.Context) string {
+	id, _ := ctx.Value(keyIDKey{}).(string)
+	return id
+}
+
+// Principal returns a stable name for the caller of ctx — its tenant and
+// either its API key or its user — or "" for an anonymous request. It scopes
+// per-caller state, such as idempotency keys, so callers never share it.
+func Principal(ctx context.Context) string {
+	tenant := ctxkit.TenantID(ctx)
+	if tenant == "" {
+		return ""
+	}
+	if id := KeyID(ctx); id != "" {
+		return tenant + "/key/" + id
+	}
+	return tenant + "/user/" + ctxkit.UserID(ctx)
+}
+
+// InTenant reports whether the caller of ctx is authenticated for tenantID.
+// Services call it on resources found by id, whose tenant no route parameter
+// names.
+func InTenant(ctx context.Context, tenantID uuid.UUID) bool {
+	return strings.EqualFold(ctxkit.TenantID(ctx), tenantID.String())
+}
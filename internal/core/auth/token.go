@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// errInvalidToken is returned for a malformed, tampered, expired or not yet
+// valid token.
+var errInvalidToken = errors.New("invalid or expired access token")
+
+// Claims is the identity an access token asserts: the user, their tenant and
+// the permission codes their role grants.
+type Claims struct {
+	UserID      string
+	TenantID    string
+	Permissions []string
+	ExpiresAt   time.Time
+}
+
+// header is a token's JOSE header.
+type header struct {
+	Alg string `json:"alg"`
+}
+
+// payload is a token's claim set.
+type payload struct {
+	Subject     string   `json:"sub"`
+	Issuer      string   `json:"iss"`
+	TenantID    string   `json:"tenant_id"`
+	Permissions []string `json:"permissions"`
+	ExpiresAt   int64    `json:"exp"`
+	NotBefore   int64    `json:"nbf"`
+}
+
+// Verifier checks access tokens: compact HS256 JWTs whose "sub" is the user's
+// id, "tenant_id" their tenant's and "permissions" the codes their role
+// grants. "exp" is required.
+type Verifier struct {
+	secret []byte
+	issuer string
+	leeway time.Duration
+	now    func() time.Time
+}
+
+// NewVerifier returns a Verifier for cfg. With no secret it rejects every
+// token.
+func NewVerifier(cfg Config) *Verifier {
+	return &Verifier{secret: []byte(cfg.Secret), issuer: cfg.Issuer, leeway: cfg.Leeway, now: time.Now}
+}
+
+// Verify checks token's signature, issuer and validity window and returns its
+// claims, or errInvalidToken.
+func (v *Verifier) Verify(token string) (Claims, error) {
+	if len(v.secret) == 0 {
+		return Claims{}, errInvalidToken
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, errInvalidToken
+	}
+	enc := base64.RawURLEncoding
+	sig, err := enc.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sig, v.mac(parts[0]+"."+parts[1])) {
+		return Claims{}, errInvalidToken
+	}
+	var h header
+	if err := decode(parts[0], &h); err != nil || h.Alg != "HS256" {
+		return Claims{}, errInvalidToken
+	}
+	var p payload
+	if err := decode(parts[1], &p); err != nil {
+		return Claims{}, errInvalidToken
+	}
+	if v.issuer != "" && p.Issuer != v.issuer {
+		return Claims{}, errInvalidToken
+	}
+	if _, err := uuid.Parse(p.Subject); err != nil {
+		return Claims{}, errInvalidToken
+	}
+	if _, err := uuid.Parse(p.TenantID); err != nil {
+		return Claims{}, errInvalidToken
+	}
+	now := v.now()
+	expires := time.Unix(p.ExpiresAt, 0)
+	if p.ExpiresAt == 0 || !now.Before(expires.Add(v.leeway)) {
+		return Claims{}, errInvalidToken
+	}
+	if p.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(p.NotBefore, 0)) {
+		return Claims{}, errInvalidToken
+	}
+	return Claims{UserID: p.Subject, TenantID: p.TenantID, Permissions: p.Permissions, ExpiresAt: expires}, nil
+}
+
+// mac returns the HMAC-SHA256 of the token's signing input.
+func (v *Verifier) mac(input string) []byte {
+	h := hmac.New(sha256.New, v.secret)
+	h.Write([]byte(input))
+	return h.Sum(nil)
+}
+
+// decode unmarshals a base64url JSON token segment into dst.
+func decode(segment string, dst any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// testSecret signs the tokens of these tests.
+var testSecret = strings.Repeat("s", 32)
+
+// sign returns a compact JWT of claims with the given alg, signed with secret.
+func sign(t *testing.T, secret, alg string, claims map[string]any) string {
+	t.Helper()
+	enc := base64.RawURLEncoding
+	h, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	p, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	input := enc.EncodeToString(h) + "." + enc.EncodeToString(p)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(input))
+	return input + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	userID, tenantID := uuid.NewString(), uuid.NewString()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	claims := func(edit func(map[string]any)) map[string]any {
+		c := map[string]any{
+			"sub": userID, "tenant_id": tenantID, "iss": "idp",
+			"permissions": []string{"manage_api_keys"}, "exp": now.Add(time.Hour).Unix(),
+		}
+		if edit != nil {
+			edit(c)
+		}
+		return c
+	}
+	idp := Config{Secret: testSecret, Issuer: "idp"}
+	valid := sign(t, testSecret, "HS256", claims(nil))
+	parts := strings.Split(valid, ".")
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		token   string
+		wantErr bool
+	}{
+		{name: "valid token", cfg: idp, token: valid},
+		{name: "any issuer when none is configured", cfg: Config{Secret: testSecret}, token: valid},
+		{name: "within leeway of expiry", cfg: Config{Secret: testSecret, Leeway: time.Minute},
+			token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { c["exp"] = now.Add(-30 * time.Second).Unix() }))},
+		{name: "expired", cfg: idp, token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { c["exp"] = now.Unix() })), wantErr: true},
+		{name: "no expiry", cfg: idp, token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { delete(c, "exp") })), wantErr: true},
+		{name: "not yet valid", cfg: idp,
+			token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { c["nbf"] = now.Add(time.Minute).Unix() })), wantErr: true},
+		{name: "other issuer", cfg: idp, token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { c["iss"] = "other" })), wantErr: true},
+		{name: "subject not a uuid", cfg: idp,
+			token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { c["sub"] = "admin" })), wantErr: true},
+		{name: "no tenant", cfg: idp, token: sign(t, testSecret, "HS256", claims(func(c map[string]any) { delete(c, "tenant_id") })), wantErr: true},
+		{name: "other secret", cfg: idp, token: sign(t, strings.Repeat("x", 32), "HS256", claims(nil)), wantErr: true},
+		{name: "alg none", cfg: idp, token: sign(t, testSecret, "none", claims(nil)), wantErr: true},
+		{name: "tampered payload", cfg: idp, token: parts[0] + "." + parts[1] + "AA." + parts[2], wantErr: true},
+		{name: "missing signature", cfg: idp, token: parts[0] + "." + parts[1], wantErr: true},
+		{name: "tokens off", cfg: Config{}, token: valid, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVerifier(tt.cfg)
+			v.now = func() time.Time { return now }
+			c, err := v.Verify(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if c.UserID != userID || c.TenantID != tenantID || !slices.Equal(c.Permissions, []string{"manage_api_keys"}) {
+				t.Errorf("claims = %+v, want user %s tenant %s with manage_api_keys", c, userID, tenantID)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "tokens off", cfg: Config{}},
+		{name: "valid", cfg: Config{Secret: testSecret, Leeway: time.Minute}},
+		{name: "short secret", cfg: Config{Secret: "short"}, wantErr: true},
+		{name: "negative leeway", cfg: Config{Secret: testSecret, Leeway: -time.Second}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Package background runs work off the request path: jobs started by a
+// handler (e.g. a guest import) keep running after the response is written,
+// with bounded concurrency, and are waited for on shutdown.
+package background
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+// ErrClosed is returned by Go once Shutdown has started.
+var ErrClosed = errors.New("background: runner is shut down")
+
+// Runner executes jobs on at most Workers goroutines at a time. Jobs submitted
+// while all workers are busy wait for a free slot.
+type Runner struct {
+	log    logger.Logger
+	slots  chan struct{}
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewRunner returns a Runner with the given number of workers (minimum 1).
+func NewRunner(log logger.Logger, workers int) *Runner {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Runner{log: log, slots: make(chan struct{}, workers), ctx: ctx, cancel: cancel}
+}
+
+// Go schedules fn. The job context keeps the values of ctx (request ID, trace,
+// user) but not its cancellation, so the job outlives the request; it is
+// cancelled only when Shutdown gives up waiting. A panic in fn is recovered
+// and logged. Go returns ErrClosed once Shutdown has started.
+func (r *Runner) Go(ctx context.Context, name string, fn func(ctx context.Context)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return ErrClosed
+	}
+
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(r.ctx, cancel)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer cancel()
+		defer stop()
+
+		select {
+		case r.slots <- struct{}{}:
+		case <-jobCtx.Done():
+			r.log.WarnWithContext(jobCtx, "background job dropped before start", logger.F("job", name))
+			return
+		}
+		defer func() { <-r.slots }()
+
+		defer func() {
+			if p := recover(); p != nil {
+				r.log.ErrorWithContext(jobCtx, "background job panicked", logger.F("job", name), logger.F("panic", p))
+			}
+		}()
+		fn(jobCtx)
+	}()
+	return nil
+}
+
+// Shutdown stops accepting jobs and waits for running and queued ones to
+// finish. When ctx expires first, the remaining jobs' contexts are cancelled
+// and ctx.Err() is returned.
+func (r *Runner) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		r.cancel()
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		return ctx.Err()
+	}
+}
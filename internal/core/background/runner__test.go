@@ -0,0 +1,80 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+func TestRunner_RunsJobsAndWaitsOnShutdown(t *testing.T) {
+	r := NewRunner(logger.NewNoOp(), 2)
+	var done atomic.Int32
+
+	for range 5 {
+		if err := r.Go(context.Background(), "job", func(context.Context) {
+			time.Sleep(5 * time.Millisecond)
+			done.Add(1)
+		}); err != nil {
+			t.Fatalf("Go() error = %v", err)
+		}
+	}
+
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if got := done.Load(); got != 5 {
+		t.Errorf("completed jobs = %d, want 5", got)
+	}
+}
+
+func TestRunner_JobOutlivesRequestContext(t *testing.T) {
+	r := NewRunner(logger.NewNoOp(), 1)
+	reqCtx, cancel := context.WithCancel(context.Background())
+	var jobErr error
+
+	_ = r.Go(reqCtx, "job", func(ctx context.Context) {
+		cancel()
+		jobErr = ctx.Err()
+	})
+	_ = r.Shutdown(context.Background())
+
+	if jobErr != nil {
+		t.Errorf("job context err = %v, want nil after request context was cancelled", jobErr)
+	}
+}
+
+func TestRunner_ShutdownTimeoutCancelsJobs(t *testing.T) {
+	r := NewRunner(logger.NewNoOp(), 1)
+	cancelled := make(chan struct{})
+
+	_ = r.Go(context.Background(), "slow", func(ctx context.Context) {
+		<-ctx.Done()
+		close(cancelled)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := r.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown() error = %v, want DeadlineExceeded", err)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("job context was not cancelled after shutdown timeout")
+	}
+	if err := r.Go(context.Background(), "late", func(context.Context) {}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Go() after Shutdown error = %v, want ErrClosed", err)
+	}
+}
+
+func TestRunner_RecoversPanics(t *testing.T) {
+	r := NewRunner(logger.NewNoOp(), 1)
+	_ = r.Go(context.Background(), "boom", func(context.Context) { panic("boom") })
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+}
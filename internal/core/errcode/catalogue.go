@@ -0,0 +1,217 @@
+package errcode
+
+import "net/http"
+
+// Generic codes, reported for errors that carry no more specific code.
+const (
+	BadRequest          Code = "BAD_REQUEST"
+	InvalidRequestBody  Code = "INVALID_REQUEST_BODY"
+	InvalidID           Code = "INVALID_ID"
+	ValidationFailed    Code = "VALIDATION_FAILED"
+	Unauthorized        Code = "UNAUTHORIZED"
+	Forbidden           Code = "FORBIDDEN"
+	NotFound            Code = "NOT_FOUND"
+	RouteNotFound       Code = "ROUTE_NOT_FOUND"
+	MethodNotAllowed    Code = "METHOD_NOT_ALLOWED"
+	Conflict            Code = "CONFLICT"
+	VersionMismatch     Code = "VERSION_MISMATCH"
+	RequestTooLarge     Code = "REQUEST_TOO_LARGE"
+	UnprocessableEntity Code = "UNPROCESSABLE_ENTITY"
+	TooManyRequests     Code = "TOO_MANY_REQUESTS"
+	Internal            Code = "INTERNAL_ERROR"
+	ServiceUnavailable  Code = "SERVICE_UNAVAILABLE"
+)
+
+// Authentication.
+const (
+	LoginRequired            Code = "LOGIN_REQUIRED"
+	AccessTokenInvalid       Code = "ACCESS_TOKEN_INVALID"
+	PermissionDenied         Code = "PERMISSION_DENIED"
+	TenantAccessDenied       Code = "TENANT_ACCESS_DENIED"
+	APIKeyInvalid            Code = "API_KEY_INVALID"
+	APIKeyRevoked            Code = "API_KEY_REVOKED"
+	APIKeyExpired            Code = "API_KEY_EXPIRED"
+	PortalLinkInvalid        Code = "PORTAL_LINK_INVALID"
+	DeviceCredentialRequired Code = "DEVICE_CREDENTIAL_REQUIRED"
+	DeviceCredentialInvalid  Code = "DEVICE_CREDENTIAL_INVALID"
+	CaptchaFailed            Code = "CAPTCHA_FAILED"
+	TenantMismatch           Code = "TENANT_MISMATCH"
+)
+
+// Events, categories and days.
+const (
+	EventNotFound         Code = "EVENT_NOT_FOUND"
+	EventCategoryNotFound Code = "EVENT_CATEGORY_NOT_FOUND"
+	EventCategoryExists   Code = "EVENT_CATEGORY_EXISTS"
+	EventDayNotFound      Code = "EVENT_DAY_NOT_FOUND"
+	WorkflowStepNotFound  Code = "WORKFLOW_STEP_NOT_FOUND"
+	TenantNotFound        Code = "TENANT_NOT_FOUND"
+	UserNotFound          Code = "USER_NOT_FOUND"
+	StaffMemberNotFound   Code = "STAFF_MEMBER_NOT_FOUND"
+	CalendarFeedNotFound  Code = "CALENDAR_FEED_NOT_FOUND"
+)
+
+// Guests, groups, fields, imports and registration.
+const (
+	GuestNotFound               Code = "GUEST_NOT_FOUND"
+	GuestEmailTaken             Code = "GUEST_EMAIL_TAKEN"
+	GuestOnWaitlist             Code = "GUEST_ON_WAITLIST"
+	GuestAlreadyWaitlisted      Code = "GUEST_ALREADY_WAITLISTED"
+	GuestAlreadyTicketed        Code = "GUEST_ALREADY_TICKETED"
+	GuestHoldsOtherTicket       Code = "GUEST_HOLDS_OTHER_TICKET"
+	GuestGroupNotFound          Code = "GUEST_GROUP_NOT_FOUND"
+	GuestNotInGroup             Code = "GUEST_NOT_IN_GROUP"
+	GroupPrimaryContactRequired Code = "GROUP_PRIMARY_CONTACT_REQUIRED"
+	GroupMembershipChanged      Code = "GROUP_MEMBERSHIP_CHANGED"
+	GuestFieldNotFound          Code = "GUEST_FIELD_NOT_FOUND"
+	GuestFieldKeyTaken          Code = "GUEST_FIELD_KEY_TAKEN"
+	GuestImportNotFound         Code = "GUEST_IMPORT_NOT_FOUND"
+	RegistrationClosed          Code = "REGISTRATION_CLOSED"
+	RegistrationFull            Code = "REGISTRATION_FULL"
+)
+
+// Tickets.
+const (
+	TicketNotFound           Code = "TICKET_NOT_FOUND"
+	TicketTypeNotFound       Code = "TICKET_TYPE_NOT_FOUND"
+	TicketTypeSoldOut        Code = "TICKET_TYPE_SOLD_OUT"
+	TicketAlreadyInvalidated Code = "TICKET_ALREADY_INVALIDATED"
+	TicketAlreadyActive      Code = "TICKET_ALREADY_ACTIVE"
+	TicketNotActive          Code = "TICKET_NOT_ACTIVE"
+	TicketHolderNotGuest     Code = "TICKET_HOLDER_NOT_GUEST"
+	TicketAlreadyUsed        Code = "TICKET_ALREADY_USED"
+)
+
+// Devices, shifts and scanning.
+const (
+	DeviceNotFound        Code = "DEVICE_NOT_FOUND"
+	DeviceNameTaken       Code = "DEVICE_NAME_TAKEN"
+	DeviceInactive        Code = "DEVICE_INACTIVE"
+	DeviceAlreadyOperated Code = "DEVICE_ALREADY_OPERATED"
+	DeviceStaffOnly       Code = "DEVICE_STAFF_ONLY"
+	ShiftNotFound         Code = "SHIFT_NOT_FOUND"
+	ShiftAlreadyOpen      Code = "SHIFT_ALREADY_OPEN"
+	ScanDeviceInactive    Code = "SCAN_DEVICE_INACTIVE"
+	ScanDeviceOtherEvent  Code = "SCAN_DEVICE_OTHER_EVENT"
+	ScanStepNotAllowed    Code = "SCAN_STEP_NOT_ALLOWED"
+	ScanStepNotToday      Code = "SCAN_STEP_NOT_TODAY"
+	ScanTicketInvalidated Code = "SCAN_TICKET_INVALIDATED"
+)
+
+// Webhooks and API keys.
+const (
+	WebhookEndpointNotFound Code = "WEBHOOK_ENDPOINT_NOT_FOUND"
+	WebhookEndpointInactive Code = "WEBHOOK_ENDPOINT_INACTIVE"
+	WebhookDeliveryNotFound Code = "WEBHOOK_DELIVERY_NOT_FOUND"
+	APIKeyNotFound          Code = "API_KEY_NOT_FOUND"
+	APIKeyGrantExceeded     Code = "API_KEY_GRANT_EXCEEDED"
+)
+
+// entry is a code's HTTP status and default message.
+type entry struct {
+	status  int
+	message string
+}
+
+// catalogue holds every code. Keep it in the order of the constants above.
+var catalogue = map[Code]entry{
+	BadRequest:          {http.StatusBadRequest, "bad request"},
+	InvalidRequestBody:  {http.StatusBadRequest, "invalid request body"},
+	InvalidID:           {http.StatusBadRequest, "invalid id"},
+	ValidationFailed:    {http.StatusBadRequest, "request validation failed"},
+	Unauthorized:        {http.StatusUnauthorized, "unauthorized"},
+	Forbidden:           {http.StatusForbidden, "forbidden"},
+	NotFound:            {http.StatusNotFound, "not found"},
+	RouteNotFound:       {http.StatusNotFound, "no such route"},
+	MethodNotAllowed:    {http.StatusMethodNotAllowed, "method not allowed"},
+	Conflict:            {http.StatusConflict, "conflict"},
+	VersionMismatch:     {http.StatusPreconditionFailed, "resource was modified by someone else"},
+	RequestTooLarge:     {http.StatusRequestEntityTooLarge, "request body too large"},
+	UnprocessableEntity: {http.StatusUnprocessableEntity, "unprocessable entity"},
+	TooManyRequests:     {http.StatusTooManyRequests, "too many requests, try again later"},
+	Internal:            {http.StatusInternalServerError, "internal server error"},
+	ServiceUnavailable:  {http.StatusServiceUnavailable, "service unavailable"},
+
+	LoginRequired:            {http.StatusUnauthorized, "login required"},
+	AccessTokenInvalid:       {http.StatusUnauthorized, "invalid or expired access token"},
+	PermissionDenied:         {http.StatusForbidden, "missing permission"},
+	TenantAccessDenied:       {http.StatusForbidden, "not allowed to act for this tenant"},
+	APIKeyInvalid:            {http.StatusUnauthorized, "invalid api key"},
+	APIKeyRevoked:            {http.StatusUnauthorized, "api key revoked"},
+	APIKeyExpired:            {http.StatusUnauthorized, "api key expired"},
+	PortalLinkInvalid:        {http.StatusUnauthorized, "this link is invalid or has expired"},
+	DeviceCredentialRequired: {http.StatusUnauthorized, "device credential required"},
+	DeviceCredentialInvalid:  {http.StatusUnauthorized, "invalid device credential"},
+	CaptchaFailed:            {http.StatusBadRequest, "captcha verification failed"},
+	TenantMismatch:           {http.StatusForbidden, "user belongs to another tenant"},
+
+	EventNotFound:         {http.StatusNotFound, "event not found"},
+	EventCategoryNotFound: {http.StatusNotFound, "event category not found"},
+	EventCategoryExists:   {http.StatusConflict, "event category already exists"},
+	EventDayNotFound:      {http.StatusNotFound, "event day not found"},
+	WorkflowStepNotFound:  {http.StatusNotFound, "workflow step not found"},
+	TenantNotFound:        {http.StatusNotFound, "tenant not found"},
+	UserNotFound:          {http.StatusNotFound, "user not found"},
+	StaffMemberNotFound:   {http.StatusNotFound, "staff member not found"},
+	CalendarFeedNotFound:  {http.StatusNotFound, "calendar feed not found"},
+
+	GuestNotFound:               {http.StatusNotFound, "guest not found"},
+	GuestEmailTaken:             {http.StatusConflict, "a guest with this email already exists in the event"},
+	GuestOnWaitlist:             {http.StatusConflict, "guest is on the waitlist"},
+	GuestAlreadyWaitlisted:      {http.StatusConflict, "guest is already on the waitlist"},
+	GuestAlreadyTicketed:        {http.StatusConflict, "guest already holds a ticket"},
+	GuestHoldsOtherTicket:       {http.StatusConflict, "guest holds another ticket"},
+	GuestGroupNotFound:          {http.StatusNotFound, "guest group not found"},
+	GuestNotInGroup:             {http.StatusNotFound, "guest is not a member of the group"},
+	GroupPrimaryContactRequired: {http.StatusConflict, "make another member the primary contact before removing this one"},
+	GroupMembershipChanged:      {http.StatusConflict, "a guest joined another group meanwhile; retry"},
+	GuestFieldNotFound:          {http.StatusNotFound, "guest field not found"},
+	GuestFieldKeyTaken:          {http.StatusConflict, "a guest field with this key already exists"},
+	GuestImportNotFound:         {http.StatusNotFound, "guest import not found"},
+	RegistrationClosed:          {http.StatusForbidden, "registration for this event is not open"},
+	RegistrationFull:            {http.StatusConflict, "registration for this event is full"},
+
+	TicketNotFound:           {http.StatusNotFound, "ticket not found"},
+	TicketTypeNotFound:       {http.StatusNotFound, "ticket type not found"},
+	TicketTypeSoldOut:        {http.StatusConflict, "no capacity left for the ticket type"},
+	TicketAlreadyInvalidated: {http.StatusConflict, "ticket is already invalidated"},
+	TicketAlreadyActive:      {http.StatusConflict, "ticket is already active"},
+	TicketNotActive:          {http.StatusConflict, "ticket is not active"},
+	TicketHolderNotGuest:     {http.StatusConflict, "ticket holder is no longer a guest of the event"},
+	TicketAlreadyUsed:        {http.StatusConflict, "ticket was already used at this step"},
+
+	DeviceNotFound:        {http.StatusNotFound, "device not found"},
+	DeviceNameTaken:       {http.StatusConflict, "a device with this name already exists in the event"},
+	DeviceInactive:        {http.StatusConflict, "device is inactive"},
+	DeviceAlreadyOperated: {http.StatusConflict, "device already has an operator"},
+	DeviceStaffOnly:       {http.StatusForbidden, "only the event's staff may operate its devices"},
+	ShiftNotFound:         {http.StatusNotFound, "no open shift on this device"},
+	ShiftAlreadyOpen:      {http.StatusConflict, "device or operator already has an open shift"},
+	ScanDeviceInactive:    {http.StatusForbidden, "device is inactive"},
+	ScanDeviceOtherEvent:  {http.StatusForbidden, "device is registered to another event"},
+	ScanStepNotAllowed:    {http.StatusForbidden, "device may not scan for this step"},
+	ScanStepNotToday:      {http.StatusConflict, "workflow step does not run today"},
+	ScanTicketInvalidated: {http.StatusConflict, "ticket was invalidated"},
+
+	WebhookEndpointNotFound: {http.StatusNotFound, "webhook endpoint not found"},
+	WebhookEndpointInactive: {http.StatusConflict, "webhook endpoint is inactive"},
+	WebhookDeliveryNotFound: {http.StatusNotFound, "webhook delivery not found"},
+	APIKeyNotFound:          {http.StatusNotFound, "api key not found"},
+	APIKeyGrantExceeded:     {http.StatusForbidden, "a key can only grant permissions its creator holds"},
+}
+
+// generic maps HTTP statuses to the code ForStatus reports.
+var generic = map[int]Code{
+	http.StatusBadRequest:            BadRequest,
+	http.StatusUnauthorized:          Unauthorized,
+	http.StatusForbidden:             Forbidden,
+	http.StatusNotFound:              NotFound,
+	http.StatusMethodNotAllowed:      MethodNotAllowed,
+	http.StatusConflict:              Conflict,
+	http.StatusPreconditionFailed:    VersionMismatch,
+	http.StatusRequestEntityTooLarge: RequestTooLarge,
+	http.StatusUnprocessableEntity:   UnprocessableEntity,
+	http.StatusTooManyRequests:       TooManyRequests,
+	http.StatusInternalServerError:   Internal,
+	http.StatusServiceUnavailable:    ServiceUnavailable,
+}
@@ -0,0 +1,128 @@
+// Package errcode is the app-wide catalogue of stable, machine-readable error
+// codes. Clients branch on and localize by the code, never by the message,
+// so a code is never renamed or reused once shipped; messages may change.
+//
+// Services still return errorz errors: Code.New and Code.WithMessage build
+// one of the code's errorz class (CodeNotFound for a 404, …) that carries the
+// Code in its chain, so errorz-based handling and tests keep working while
+// internal/core/problem reports the code in the response.
+package errcode
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// Code is a catalogued error code such as "EVENT_NOT_FOUND". It is itself an
+// error so it can sit in an error chain; see Of.
+type Code string
+
+// Error implements error.
+func (c Code) Error() string {
+	return string(c)
+}
+
+// Status returns c's HTTP status, 500 for an uncatalogued code.
+func (c Code) Status() int {
+	if e, ok := catalogue[c]; ok {
+		return e.status
+	}
+	return http.StatusInternalServerError
+}
+
+// Message returns c's default message.
+func (c Code) Message() string {
+	if e, ok := catalogue[c]; ok {
+		return e.message
+	}
+	return http.StatusText(http.StatusInternalServerError)
+}
+
+// New returns an errorz error of c's class with c's default message.
+func (c Code) New() error {
+	return c.WithMessage(c.Message())
+}
+
+// WithMessage returns an errorz error of c's class with msg, for cases that
+// say more than the default message (e.g. which entity's id was invalid).
+func (c Code) WithMessage(msg string) error {
+	return errorz.Wrap(c).WithCode(class(c.Status())).WithMessage(msg)
+}
+
+// WithFields returns an errorz error of c's class with msg and per-field
+// errors, reported in the response's errors list.
+func (c Code) WithFields(msg string, fields []FieldError) error {
+	return errorz.Wrap(&fieldsError{code: c, fields: fields}).WithCode(class(c.Status())).WithMessage(msg)
+}
+
+// FieldError is one invalid field of a request.
+type FieldError struct {
+	// Field is the field's JSON name.
+	Field string `json:"field" example:"email"`
+	// Message says what is wrong with it.
+	Message string `json:"message" example:"email must be a valid email"`
+}
+
+// fieldsError carries field errors under a code.
+type fieldsError struct {
+	code   Code
+	fields []FieldError
+}
+
+func (e *fieldsError) Error() string { return string(e.code) }
+func (e *fieldsError) Unwrap() error { return e.code }
+
+// Of returns the code in err's chain.
+func Of(err error) (Code, bool) {
+	var c Code
+	ok := errors.As(err, &c)
+	return c, ok
+}
+
+// FieldsOf returns the field errors in err's chain, if any.
+func FieldsOf(err error) []FieldError {
+	var fe *fieldsError
+	if errors.As(err, &fe) {
+		return fe.fields
+	}
+	return nil
+}
+
+// ForStatus returns the generic code of an HTTP status, for errors that carry
+// no code of their own.
+func ForStatus(status int) Code {
+	if c, ok := generic[status]; ok {
+		return c
+	}
+	if status >= 500 {
+		return Internal
+	}
+	return BadRequest
+}
+
+// class returns the errorz code of an HTTP status.
+func class(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return errorz.CodeBadRequest
+	case http.StatusUnauthorized:
+		return errorz.CodeUnauthorized
+	case http.StatusForbidden:
+		return errorz.CodeForbidden
+	case http.StatusNotFound:
+		return errorz.CodeNotFound
+	case http.StatusConflict:
+		return errorz.CodeConflict
+	case http.StatusPreconditionFailed:
+		return errorz.CodePreconditionFailed
+	case http.StatusUnprocessableEntity:
+		return errorz.CodeUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return errorz.CodeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return errorz.CodeServiceUnavailable
+	}
+	return errorz.CodeInternal
+}
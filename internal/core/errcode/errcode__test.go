@@ -0,0 +1,70 @@
+package errcode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+func TestCode_New(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCode  Code
+		wantClass string
+	}{
+		{name: "not found", err: EventCategoryNotFound.New(), wantCode: EventCategoryNotFound, wantClass: errorz.CodeNotFound},
+		{name: "conflict", err: TicketAlreadyUsed.New(), wantCode: TicketAlreadyUsed, wantClass: errorz.CodeConflict},
+		{name: "forbidden", err: ScanStepNotAllowed.New(), wantCode: ScanStepNotAllowed, wantClass: errorz.CodeForbidden},
+		{name: "custom message", err: InvalidID.WithMessage("invalid event id"), wantCode: InvalidID, wantClass: errorz.CodeBadRequest},
+		{
+			name:      "fields",
+			err:       ValidationFailed.WithFields("request validation failed", []FieldError{{Field: "email", Message: "email is required"}}),
+			wantCode:  ValidationFailed,
+			wantClass: errorz.CodeBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ez *errorz.Error
+			if !errors.As(tt.err, &ez) || ez.Code != tt.wantClass {
+				t.Errorf("error = %v, want errorz class %s", tt.err, tt.wantClass)
+			}
+			if got, ok := Of(tt.err); !ok || got != tt.wantCode {
+				t.Errorf("Of() = %q, %v, want %q", got, ok, tt.wantCode)
+			}
+		})
+	}
+
+	fields := FieldsOf(ValidationFailed.WithFields("x", []FieldError{{Field: "email"}}))
+	if len(fields) != 1 || fields[0].Field != "email" {
+		t.Errorf("FieldsOf() = %v, want the email field", fields)
+	}
+	if fields := FieldsOf(EventNotFound.New()); fields != nil {
+		t.Errorf("FieldsOf() = %v for an error without fields, want nil", fields)
+	}
+}
+
+func TestCatalogue(t *testing.T) {
+	for code, e := range catalogue {
+		if e.message == "" {
+			t.Errorf("%s has no default message", code)
+		}
+		if http.StatusText(e.status) == "" {
+			t.Errorf("%s has unknown status %d", code, e.status)
+		}
+	}
+	for status, code := range generic {
+		if code.Status() != status {
+			t.Errorf("generic code for %d is %s, whose status is %d", status, code, code.Status())
+		}
+	}
+	if got := ForStatus(http.StatusTeapot); got != BadRequest {
+		t.Errorf("ForStatus(418) = %s, want %s", got, BadRequest)
+	}
+	if got := ForStatus(http.StatusBadGateway); got != Internal {
+		t.Errorf("ForStatus(502) = %s, want %s", got, Internal)
+	}
+}
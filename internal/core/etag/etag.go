@@ -0,0 +1,162 @@
+// Package etag provides the optimistic-concurrency plumbing shared by every
+// feature: a version token derived from an entity's updated_at, sent as the
+// ETag response header; If-Match read by the handler and passed explicitly to
+// the service's update, which hands it to the repository's conditional UPDATE;
+// and If-None-Match answered with 304 Not Modified on GET.
+//
+// Middleware must run in the chain for Set to have any effect; without it
+// (e.g. in unit tests) Set is a no-op.
+package etag
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMalformed is returned by Parse for a tag that is not a version token
+// produced by Format.
+var ErrMalformed = errors.New("etag: malformed version token")
+
+// state is the per-request conditional-request state Middleware seeds into
+// the context: the If-None-Match precondition and the ETag the handler
+// recorded.
+type state struct {
+	ifNoneMatch string
+	etag        string
+}
+
+type stateKey struct{}
+
+// Format returns the strong ETag for an entity last modified at updatedAt.
+// The token is the UTC Unix time in microseconds, the precision PostgreSQL
+// stores TIMESTAMPTZ with, so a token read back from the database always
+// equals the one issued from the in-memory entity.
+func Format(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 10) + `"`
+}
+
+// Parse returns the updated_at encoded in an ETag produced by Format. A weak
+// prefix (W/) is tolerated.
+func Parse(tag string) (time.Time, error) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return time.Time{}, ErrMalformed
+	}
+	micros, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil {
+		return time.Time{}, ErrMalformed
+	}
+	return time.UnixMicro(micros).UTC(), nil
+}
+
+// Set records the version of the entity a handler is about to return, so
+// Middleware can send it as the ETag header (and compare it to If-None-Match).
+func Set(ctx context.Context, updatedAt time.Time) {
+	if st, ok := ctx.Value(stateKey{}).(*state); ok {
+		st.etag = Format(updatedAt)
+	}
+}
+
+// IfMatch returns the request's If-Match header, trimmed, for the handler to
+// pass to an update that honours it ("" when absent).
+func IfMatch(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get("If-Match"))
+}
+
+// Precondition returns the version an If-Match value expects the entity to be
+// at. ok is false when there is no precondition ("" or "*"); err is
+// ErrMalformed when the value is not a token produced by Format, which can
+// never match.
+func Precondition(ifMatch string) (expected time.Time, ok bool, err error) {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return time.Time{}, false, nil
+	}
+	expected, err = Parse(ifMatch)
+	if err != nil {
+		return time.Time{}, true, err
+	}
+	return expected, true, nil
+}
+
+// Middleware seeds the conditional-request state into the request context and
+// wraps the response so that a 2xx carries the ETag recorded via Set, and a
+// GET/HEAD whose ETag matches If-None-Match is answered with 304 and no body.
+func Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			st := &state{ifNoneMatch: strings.TrimSpace(r.Header.Get("If-None-Match"))}
+			ctx := context.WithValue(r.Context(), stateKey{}, st)
+			rw := &responseWriter{ResponseWriter: w, state: st, method: r.Method}
+			next.ServeHTTP(rw, r.WithContext(ctx))
+		})
+	}
+}
+
+// responseWriter applies the recorded ETag when the status is written.
+type responseWriter struct {
+	http.ResponseWriter
+	state       *state
+	method      string
+	wroteHeader bool
+	notModified bool
+}
+
+// WriteHeader adds the ETag header to successful responses and downgrades a
+// matching conditional GET/HEAD to 304 Not Modified.
+func (w *responseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if w.state.etag != "" && code >= 200 && code < 300 {
+		w.Header().Set("ETag", w.state.etag)
+		if code == http.StatusOK && (w.method == http.MethodGet || w.method == http.MethodHead) &&
+			noneMatchHit(w.state.ifNoneMatch, w.state.etag) {
+			w.notModified = true
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.ResponseWriter.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write discards the body of a 304 response.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// noneMatchHit reports whether If-None-Match (a "*" or a comma-separated list
+// of tags) matches etag, using the weak comparison RFC 9110 prescribes.
+func noneMatchHit(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package etag
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	updatedAt := time.Date(2026, 3, 1, 12, 30, 0, 123456789, time.UTC)
+
+	got, err := Parse(Format(updatedAt))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := updatedAt.Truncate(time.Microsecond); !got.Equal(want) {
+		t.Errorf("Parse(Format()) = %v, want %v (microsecond precision)", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantErr bool
+	}{
+		{name: "strong tag", tag: `"1700000000000000"`},
+		{name: "weak tag", tag: `W/"1700000000000000"`},
+		{name: "unquoted", tag: `1700000000000000`, wantErr: true},
+		{name: "not a version", tag: `"abc"`, wantErr: true},
+		{name: "empty", tag: ``, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.tag, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrecondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		wantOK  bool
+		wantErr error
+	}{
+		{name: "absent is no precondition"},
+		{name: "wildcard is no precondition", ifMatch: "*"},
+		{name: "valid token", ifMatch: ` "1700000000000000" `, wantOK: true},
+		{name: "malformed token", ifMatch: `"nope"`, wantOK: true, wantErr: ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok, err := Precondition(tt.ifMatch)
+			if ok != tt.wantOK {
+				t.Errorf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	if got := IfMatch(req); got != "" {
+		t.Errorf("IfMatch without header = %q, want empty", got)
+	}
+	req.Header.Set("If-Match", ` "1700000000000000" `)
+	if got := IfMatch(req); got != `"1700000000000000"` {
+		t.Errorf("IfMatch = %q, want the trimmed header", got)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	updatedAt := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	current := Format(updatedAt)
+
+	tests := []struct {
+		name        string
+		method      string
+		ifNoneMatch string
+		status      int
+		wantStatus  int
+		wantETag    string
+		wantBody    string
+	}{
+		{name: "GET carries the ETag", method: http.MethodGet, status: http.StatusOK, wantStatus: http.StatusOK, wantETag: current, wantBody: "{}"},
+		{name: "matching If-None-Match is 304", method: http.MethodGet, ifNoneMatch: current, status: http.StatusOK, wantStatus: http.StatusNotModified, wantETag: current},
+		{name: "stale If-None-Match is 200", method: http.MethodGet, ifNoneMatch: `"1"`, status: http.StatusOK, wantStatus: http.StatusOK, wantETag: current, wantBody: "{}"},
+		{name: "If-None-Match ignored on PUT", method: http.MethodPut, ifNoneMatch: current, status: http.StatusOK, wantStatus: http.StatusOK, wantETag: current, wantBody: "{}"},
+		{name: "errors carry no ETag", method: http.MethodGet, status: http.StatusNotFound, wantStatus: http.StatusNotFound, wantBody: "{}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Set(r.Context(), updatedAt)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("{}"))
+			}))
+			req := httptest.NewRequest(tt.method, "/", nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Errorf("ETag = %q, want %q", got, tt.wantETag)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Package export streams tabular downloads (CSV, XLSX, JSON Lines) over HTTP.
+// Rows are written as they are produced, so an export's memory use does not
+// grow with its size, and the server's WriteTimeout is replaced by a rolling
+// per-batch deadline, so a long export is not cut off while a stalled client
+// still is.
+package export
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+	"github.com/biairmal/guest-management-be/internal/core/tabular"
+)
+
+const (
+	// flushEvery is how many rows are buffered between flushes to the client.
+	flushEvery = 500
+	// writeWindow is how long the client gets to accept each flushed batch.
+	writeWindow = 30 * time.Second
+)
+
+// Source produces an export's rows by calling emit once per row, in order.
+// It returns emit's error unchanged when emit fails.
+type Source func(ctx context.Context, emit func(row []string) error) error
+
+// Write streams the rows of src to w as an attachment named
+// "<basename>.<format>". An error returned by src before its first row is
+// rendered like any other handler error (problem.Write); after that the
+// response is already committed, so the error is logged and the connection
+// aborted, leaving the client with a visibly truncated download rather than a
+// well-formed partial file.
+func Write(
+	w http.ResponseWriter, r *http.Request, log logger.Logger,
+	format tabular.Format, basename string, header []string, src Source,
+) {
+	ctx := r.Context()
+	rc := http.NewResponseController(w)
+	extendDeadline(ctx, log, rc)
+
+	var tw tabular.Writer
+	rows := 0
+	emit := func(row []string) error {
+		if tw == nil {
+			var err error
+			if tw, err = start(w, format, basename, header); err != nil {
+				return err
+			}
+		}
+		if err := tw.Write(row); err != nil {
+			return err
+		}
+		rows++
+		if rows%flushEvery == 0 {
+			if err := tw.Flush(); err != nil {
+				return err
+			}
+			_ = rc.Flush()
+			extendDeadline(ctx, log, rc)
+		}
+		return nil
+	}
+
+	err := src(ctx, emit)
+	if err != nil && tw == nil {
+		Error(w, r, err)
+		return
+	}
+	if err == nil && tw == nil {
+		// No rows: still a valid file with just the header.
+		if tw, err = start(w, format, basename, header); err != nil {
+			log.ErrorWithContext(ctx, "export start failed", logger.F("error", err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+	if err == nil {
+		extendDeadline(ctx, log, rc)
+		err = tw.Close()
+	}
+	if err != nil {
+		log.ErrorWithContext(ctx, "export aborted", logger.F("rows", rows), logger.F("error", err))
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// Error renders err as problem details. Handlers use it for
+// failures detected before calling Write (bad format, bad filters).
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	problem.Write(w, r, err)
+}
+
+// start commits the response headers and opens the format writer.
+func start(w http.ResponseWriter, format tabular.Format, basename string, header []string) (tabular.Writer, error) {
+	name := strings.ReplaceAll(basename, `"`, "") + "." + string(format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	return tabular.NewWriter(format, w, header)
+}
+
+// extendDeadline pushes the write deadline writeWindow into the future. A
+// writer chain that cannot reach the connection leaves the server's
+// WriteTimeout in force, which is logged once per request at debug level.
+func extendDeadline(ctx context.Context, log logger.Logger, rc *http.ResponseController) {
+	if err := rc.SetWriteDeadline(time.Now().Add(writeWindow)); err != nil && errors.Is(err, http.ErrNotSupported) {
+		log.DebugWithContext(ctx, "export cannot extend write deadline; server WriteTimeout applies")
+	}
+}
@@ -0,0 +1,103 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+
+	"github.com/biairmal/guest-management-be/internal/core/tabular"
+)
+
+// rowsSource emits n rows ("<i>", "name<i>") and then returns err.
+func rowsSource(n int, err error) Source {
+	return func(_ context.Context, emit func([]string) error) error {
+		for i := 0; i < n; i++ {
+			if e := emit([]string{strconv.Itoa(i), "name" + strconv.Itoa(i)}); e != nil {
+				return e
+			}
+		}
+		return err
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     tabular.Format
+		src        Source
+		wantStatus int
+		wantType   string
+		wantLines  int // lines of body, header included; -1 to skip
+		wantAbort  bool
+	}{
+		{
+			name: "csv rows across several flushes", format: tabular.FormatCSV, src: rowsSource(flushEvery*2+3, nil),
+			wantStatus: http.StatusOK, wantType: "text/csv; charset=utf-8", wantLines: flushEvery*2 + 4,
+		},
+		{
+			name: "no rows still yields a header", format: tabular.FormatCSV, src: rowsSource(0, nil),
+			wantStatus: http.StatusOK, wantType: "text/csv; charset=utf-8", wantLines: 1,
+		},
+		{
+			name: "ndjson has no header line", format: tabular.FormatNDJSON, src: rowsSource(3, nil),
+			wantStatus: http.StatusOK, wantType: "application/x-ndjson", wantLines: 3,
+		},
+		{
+			name: "error before the first row is a regular error response", format: tabular.FormatCSV,
+			src:        rowsSource(0, errorz.NotFound().WithMessage("event not found")),
+			wantStatus: http.StatusNotFound, wantLines: -1,
+		},
+		{
+			name: "error mid-stream aborts the response", format: tabular.FormatCSV,
+			src:        rowsSource(2, errors.New("connection reset")),
+			wantStatus: http.StatusOK, wantLines: -1, wantAbort: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/export", nil)
+
+			aborted := func() (aborted bool) {
+				defer func() {
+					if p := recover(); p != nil {
+						if p != http.ErrAbortHandler {
+							panic(p)
+						}
+						aborted = true
+					}
+				}()
+				Write(rec, req, logger.NewNoOp(), tt.format, "guests", []string{"id", "name"}, tt.src)
+				return false
+			}()
+
+			if aborted != tt.wantAbort {
+				t.Fatalf("aborted = %v, want %v", aborted, tt.wantAbort)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantType != "" {
+				if got := rec.Header().Get("Content-Type"); got != tt.wantType {
+					t.Errorf("Content-Type = %q, want %q", got, tt.wantType)
+				}
+				want := `attachment; filename="guests.` + string(tt.format) + `"`
+				if got := rec.Header().Get("Content-Disposition"); got != want {
+					t.Errorf("Content-Disposition = %q, want %q", got, want)
+				}
+			}
+			if tt.wantLines >= 0 {
+				if got := strings.Count(rec.Body.String(), "\n"); got != tt.wantLines {
+					t.Errorf("lines = %d, want %d", got, tt.wantLines)
+				}
+			}
+		})
+	}
+}
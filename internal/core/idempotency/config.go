@@ -0,0 +1,50 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// Config is the YAML/mapstructure-decodable shape for the idempotency
+// middleware (the "idempotency:" section of config.yaml).
+type Config struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	TTL            time.Duration `mapstructure:"ttl"`              // how long a completed response is replayable
+	LockTTL        time.Duration `mapstructure:"lock_ttl"`         // how long an in-flight reservation blocks retries
+	Prefix         string        `mapstructure:"prefix"`           // namespaces the store keys
+	MaxBodyBytes   int64         `mapstructure:"max_body_bytes"`   // request bodies above this are rejected with 413
+	MaxUploadBytes int64         `mapstructure:"max_upload_bytes"` // multipart bodies above this are rejected with 413
+}
+
+// DefaultConfig returns a Config with idempotency enabled and sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:        true,
+		TTL:            24 * time.Hour,
+		LockTTL:        time.Minute,
+		Prefix:         "guest-management",
+		MaxBodyBytes:   1 << 20,
+		MaxUploadBytes: 10 << 20,
+	}
+}
+
+// Validate checks the configuration. It is a no-op when disabled.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.TTL <= 0 {
+		return errorz.Internal().WithMessage("idempotency: ttl must be positive when enabled")
+	}
+	if c.LockTTL <= 0 {
+		return errorz.Internal().WithMessage("idempotency: lock_ttl must be positive when enabled")
+	}
+	if c.MaxBodyBytes <= 0 {
+		return errorz.Internal().WithMessage("idempotency: max_body_bytes must be positive when enabled")
+	}
+	if c.MaxUploadBytes <= 0 {
+		return errorz.Internal().WithMessage("idempotency: max_upload_bytes must be positive when enabled")
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+// Package idempotency makes unsafe requests safe to retry. A client sends an
+// Idempotency-Key header with a POST; the first request with that key runs
+// normally and its response is stored, and any retry with the same key and
+// body gets the stored response replayed instead of running the handler again.
+// A retry with a different body is rejected with 422, and a retry while the
+// original is still running is rejected with 409.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+const (
+	// HeaderKey is the request header carrying the client's idempotency key.
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses served from the store.
+	HeaderReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+)
+
+// Middleware returns HTTP middleware enforcing Idempotency-Key semantics on
+// POST requests. Requests without the header, and other methods, pass through
+// untouched. When the store is unreachable the request is served without
+// idempotency protection (logged), rather than failing the write outright.
+func Middleware(log logger.Logger, store Store, cfg Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idemKey := r.Header.Get(HeaderKey)
+			if r.Method != http.MethodPost || idemKey == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(idemKey) > maxKeyLength {
+				problem.Write(w, r, errorz.BadRequest().WithMessage("Idempotency-Key must be at most 255 characters"))
+				return
+			}
+
+			fingerprint, upload, ok := requestFingerprint(w, r, cfg)
+			if !ok {
+				return
+			}
+
+			ctx := r.Context()
+			key := storeKey(cfg.Prefix, r, idemKey)
+
+			existing, reserved, err := store.Reserve(ctx, key, fingerprint, cfg.LockTTL)
+			if err != nil {
+				log.WarnWithContext(ctx, "idempotency store unavailable, serving without replay protection",
+					logger.F("error", err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !reserved {
+				if upload != nil && existing.Completed {
+					// A stored upload response is keyed by the whole body's
+					// hash; read the retry's to compare.
+					if fingerprint, err = upload.fingerprint(r); err != nil {
+						writeBodyError(w, r, err)
+						return
+					}
+				}
+				replayOrReject(w, r, existing, fingerprint)
+				return
+			}
+
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				if p := recover(); p != nil {
+					release(ctx, log, store, key)
+					panic(p)
+				}
+			}()
+			next.ServeHTTP(rec, r)
+			if upload != nil {
+				if fingerprint, err = upload.fingerprint(r); err != nil {
+					log.WarnWithContext(ctx, "idempotency: upload body not read to the end, response not stored",
+						logger.F("error", err))
+					release(ctx, log, store, key)
+					return
+				}
+			}
+			finish(ctx, log, store, key, fingerprint, rec, cfg)
+		})
+	}
+}
+
+// requestFingerprint returns the hash a retry must match to be replayed: the method,
+// URI and body. The body is buffered and put back for the handler, and one
+// over cfg.MaxBodyBytes is a 413 — except a multipart upload's, which may be
+// far larger and streams to the handler through an uploadHasher instead. Its
+// fingerprint is then only provisional (method and URI, enough to spot a
+// retry while the original is in flight); the body's hash is added once the
+// handler is done, before the response is stored. ok is false when the
+// request has already been answered.
+func requestFingerprint(w http.ResponseWriter, r *http.Request, cfg Config) (string, *uploadHasher, bool) {
+	if isMultipart(r) {
+		upload := newUploadHasher(http.MaxBytesReader(w, r.Body, cfg.MaxUploadBytes))
+		r.Body = upload
+		return hash(r.Method, r.URL.RequestURI()), upload, true
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, cfg.MaxBodyBytes))
+	if err != nil {
+		writeBodyError(w, r, err)
+		return "", nil, false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return hash(r.Method, r.URL.RequestURI(), string(body)), nil, true
+}
+
+// writeBodyError answers a request whose body could not be read: 413 when it
+// went over its limit, 400 otherwise.
+func writeBodyError(w http.ResponseWriter, r *http.Request, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		problem.WriteCode(w, r, errcode.RequestTooLarge)
+		return
+	}
+	problem.Write(w, r, errcode.InvalidRequestBody.New())
+}
+
+// uploadHasher hashes a multipart body as the handler streams it, so an
+// upload is fingerprinted without being buffered.
+type uploadHasher struct {
+	io.ReadCloser
+	tee io.Reader
+	sum func([]byte) []byte // the SHA-256 fed by tee
+	eof bool
+}
+
+// newUploadHasher returns body wrapped to feed everything read from it into
+// a SHA-256.
+func newUploadHasher(body io.ReadCloser) *uploadHasher {
+	h := sha256.New()
+	return &uploadHasher{ReadCloser: body, tee: io.TeeReader(body, h), sum: h.Sum}
+}
+
+// Read implements io.Reader.
+func (u *uploadHasher) Read(p []byte) (int, error) {
+	n, err := u.tee.Read(p)
+	if errors.Is(err, io.EOF) {
+		u.eof = true
+	}
+	return n, err
+}
+
+// fingerprint reads whatever of the body the handler left unread and returns
+// the full fingerprint: method, URI and the body's hash. It fails when the
+// rest of the body can't be read, e.g. because it is over the upload limit.
+func (u *uploadHasher) fingerprint(r *http.Request) (string, error) {
+	if !u.eof {
+		if _, err := io.Copy(io.Discard, u); err != nil {
+			return "", err
+		}
+	}
+	return hash(r.Method, r.URL.RequestURI(), hex.EncodeToString(u.sum(nil))), nil
+}
+
+// isMultipart reports whether r carries a multipart/form-data body.
+func isMultipart(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// replayOrReject answers a request whose key is already taken.
+func replayOrReject(w http.ResponseWriter, r *http.Request, existing *Record, fingerprint string) {
+	switch {
+	case existing.Fingerprint != fingerprint:
+		problem.Write(w, r, errorz.UnprocessableEntity().
+			WithMessage("Idempotency-Key was already used with a different request"))
+	case !existing.Completed:
+		problem.Write(w, r, errorz.Conflict().
+			WithMessage("a request with this Idempotency-Key is still in progress"))
+	default:
+		for k, vs := range existing.Header {
+			for _, v := range vs {
+				w.Header().Add(k, v)
+			}
+		}
+		w.Header().Set(HeaderReplayed, "true")
+		w.WriteHeader(existing.StatusCode)
+		_, _ = w.Write(existing.Body)
+	}
+}
+
+// finish stores the response of a reserved request, or releases the key when
+// the handler failed with a 5xx so the client can retry for real.
+func finish(
+	ctx context.Context, log logger.Logger, store Store, key, fingerprint string, rec *recorder, cfg Config,
+) {
+	if rec.status >= http.StatusInternalServerError {
+		release(ctx, log, store, key)
+		return
+	}
+	header := rec.Header().Clone()
+	header.Del("Content-Length")
+	err := store.Complete(ctx, key, &Record{
+		Fingerprint: fingerprint,
+		Completed:   true,
+		StatusCode:  rec.status,
+		Header:      header,
+		Body:        rec.body.Bytes(),
+	}, cfg.TTL)
+	if err != nil {
+		log.WarnWithContext(ctx, "idempotency store write failed", logger.F("error", err))
+	}
+}
+
+// release drops a reservation, logging a failure (the lock expires anyway).
+func release(ctx context.Context, log logger.Logger, store Store, key string) {
+	if err := store.Release(ctx, key); err != nil {
+		log.WarnWithContext(ctx, "idempotency key release failed", logger.F("error", err))
+	}
+}
+
+// storeKey scopes the client's key to the caller that sent it and the route
+// it was sent to, so two tenants — or two keys of one tenant — picking the
+// same Idempotency-Key never see each other's responses.
+func storeKey(prefix string, r *http.Request, idemKey string) string {
+	k := "idempotency:" + hash(auth.Principal(r.Context()), r.Method, r.URL.Path, idemKey)
+	if prefix != "" {
+		k = prefix + ":" + k
+	}
+	return k
+}
+
+// hash returns the hex SHA-256 of the length-prefixed parts, so that
+// ("ab", "c") and ("a", "bc") never collide.
+func hash(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		_, _ = io.WriteString(h, strconv.Itoa(len(p))+":"+p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recorder passes the response through while keeping a copy to store.
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+// WriteHeader records the status code.
+func (r *recorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.wroteHeader = true
+		r.status = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write records the body.
+func (r *recorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (r *recorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,311 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/logger"
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+)
+
+// redisBackedStore returns the Redis store over a generated mock client whose
+// SETNX/GET/SET/DEL act on kv, so middleware tests see real store semantics.
+func redisBackedStore(ctrl *gomock.Controller, kv map[string]string) Store {
+	client := mockredis.NewMockClient(ctrl)
+	client.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key string, value any, _ time.Duration) (bool, error) {
+			if _, ok := kv[key]; ok {
+				return false, nil
+			}
+			kv[key] = value.(string)
+			return true, nil
+		}).AnyTimes()
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key string) (string, error) { return kv[key], nil }).AnyTimes()
+	client.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key string, value any, _ time.Duration) error {
+			kv[key] = value.(string)
+			return nil
+		}).AnyTimes()
+	client.EXPECT().Del(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, keys ...string) error {
+			for _, k := range keys {
+				delete(kv, k)
+			}
+			return nil
+		}).AnyTimes()
+	return NewRedisStore(client)
+}
+
+// countingHandler answers with status and counts how often it ran.
+func countingHandler(calls *int, status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"echo":` + string(body) + `}`))
+	})
+}
+
+func post(h http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/event-categories", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(HeaderKey, key)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_ReplaysCompletedResponse(t *testing.T) {
+	calls := 0
+	h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), DefaultConfig())(countingHandler(&calls, http.StatusCreated))
+
+	first := post(h, "k-1", `{"name":"a"}`)
+	second := post(h, "k-1", `{"name":"a"}`)
+
+	if calls != 1 {
+		t.Fatalf("handler ran %d times, want 1", calls)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("replay = %d %q, want %d %q", second.Code, second.Body, first.Code, first.Body)
+	}
+	if second.Header().Get(HeaderReplayed) != "true" {
+		t.Errorf("replay missing %s header", HeaderReplayed)
+	}
+	if got := second.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("replay Content-Type = %q", got)
+	}
+	if first.Header().Get(HeaderReplayed) != "" {
+		t.Errorf("original response must not be marked replayed")
+	}
+}
+
+func TestMiddleware_DifferentBodyIs422(t *testing.T) {
+	calls := 0
+	h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), DefaultConfig())(countingHandler(&calls, http.StatusCreated))
+
+	post(h, "k-1", `{"name":"a"}`)
+	got := post(h, "k-1", `{"name":"b"}`)
+
+	if got.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want 422", got.Code)
+	}
+	if calls != 1 {
+		t.Errorf("handler ran %d times, want 1", calls)
+	}
+}
+
+func TestMiddleware_InFlightIs409(t *testing.T) {
+	store := redisBackedStore(gomock.NewController(t), map[string]string{})
+	cfg := DefaultConfig()
+	calls := 0
+	var inner *httptest.ResponseRecorder
+	var h http.Handler
+	h = Middleware(logger.NewNoOp(), store, cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// A retry arriving while the original is still running.
+		inner = post(h, "k-1", `{"name":"a"}`)
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	post(h, "k-1", `{"name":"a"}`)
+
+	if inner.Code != http.StatusConflict {
+		t.Errorf("concurrent retry status = %d, want 409", inner.Code)
+	}
+	if calls != 1 {
+		t.Errorf("handler ran %d times, want 1", calls)
+	}
+}
+
+func TestMiddleware_ServerErrorReleasesKey(t *testing.T) {
+	calls := 0
+	kv := map[string]string{}
+	h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), kv), DefaultConfig())(
+		countingHandler(&calls, http.StatusInternalServerError))
+
+	post(h, "k-1", `{}`)
+	post(h, "k-1", `{}`)
+
+	if calls != 2 {
+		t.Errorf("handler ran %d times, want 2 (5xx must not be replayed)", calls)
+	}
+	if len(kv) != 0 {
+		t.Errorf("store kept %d keys after 5xx", len(kv))
+	}
+}
+
+func TestMiddleware_PassThrough(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		key    string
+	}{
+		{name: "no key", method: http.MethodPost},
+		{name: "not POST", method: http.MethodPut, key: "k-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), DefaultConfig())(countingHandler(&calls, http.StatusOK))
+			for range 2 {
+				req := httptest.NewRequest(tt.method, "/x", strings.NewReader(`{}`))
+				if tt.key != "" {
+					req.Header.Set(HeaderKey, tt.key)
+				}
+				h.ServeHTTP(httptest.NewRecorder(), req)
+			}
+			if calls != 2 {
+				t.Errorf("handler ran %d times, want 2", calls)
+			}
+		})
+	}
+}
+
+func TestMiddleware_StoreErrorFailsOpen(t *testing.T) {
+	calls := 0
+	client := mockredis.NewMockClient(gomock.NewController(t))
+	client.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("redis down"))
+	h := Middleware(logger.NewNoOp(), NewRedisStore(client), DefaultConfig())(countingHandler(&calls, http.StatusCreated))
+
+	got := post(h, "k-1", `{}`)
+
+	if got.Code != http.StatusCreated || calls != 1 {
+		t.Errorf("status = %d, calls = %d; want 201 and 1", got.Code, calls)
+	}
+}
+
+func TestMiddleware_KeyTooLong(t *testing.T) {
+	calls := 0
+	h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), DefaultConfig())(countingHandler(&calls, http.StatusCreated))
+
+	got := post(h, strings.Repeat("k", maxKeyLength+1), `{}`)
+
+	if got.Code != http.StatusBadRequest || calls != 0 {
+		t.Errorf("status = %d, calls = %d; want 400 and 0", got.Code, calls)
+	}
+}
+
+func TestMiddleware_ScopedToCaller(t *testing.T) {
+	calls := 0
+	h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), DefaultConfig())(countingHandler(&calls, http.StatusCreated))
+	send := func(ctx context.Context) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/event-categories", strings.NewReader(`{}`)).WithContext(ctx)
+		req.Header.Set(HeaderKey, "k-1")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+	tenant := ctxkit.WithTenantID(context.Background(), "tenant-a")
+
+	send(ctxkit.WithUserID(tenant, "user-1"))
+	send(ctxkit.WithUserID(tenant, "user-2"))
+	send(auth.WithKeyID(tenant, "key-1"))
+	send(ctxkit.WithUserID(ctxkit.WithTenantID(context.Background(), "tenant-b"), "user-1"))
+	replayed := send(ctxkit.WithUserID(tenant, "user-1"))
+
+	if calls != 4 {
+		t.Errorf("handler ran %d times, want 4 (one per caller)", calls)
+	}
+	if replayed.Header().Get(HeaderReplayed) != "true" {
+		t.Errorf("the same caller's retry was not replayed")
+	}
+}
+
+func TestMiddleware_BodyLimit(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxBodyBytes = 16
+	large := strings.Repeat("x", 64)
+	tests := []struct {
+		name        string
+		contentType string
+		wantStatus  int
+		wantCalls   int
+	}{
+		{name: "json body over the limit", contentType: "application/json", wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "multipart upload is not buffered", contentType: "multipart/form-data; boundary=b", wantStatus: http.StatusCreated, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			var got string
+			h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), cfg)(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					calls++
+					body, _ := io.ReadAll(r.Body)
+					got = string(body)
+					w.WriteHeader(http.StatusCreated)
+				}))
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/events/e/guests/imports", strings.NewReader(large))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set(HeaderKey, "k-1")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus || calls != tt.wantCalls {
+				t.Fatalf("status = %d, calls = %d; want %d and %d", rec.Code, calls, tt.wantStatus, tt.wantCalls)
+			}
+			if calls == 1 && got != large {
+				t.Errorf("handler read %d bytes, want the whole %d-byte upload", len(got), len(large))
+			}
+		})
+	}
+}
+
+// TestMiddleware_UploadFingerprint expects a multipart upload to be matched on
+// its whole body, hashed as it streams, even when the handler reads none of it.
+func TestMiddleware_UploadFingerprint(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxUploadBytes = 16
+	tests := []struct {
+		name       string
+		first      string
+		retry      string
+		wantStatus int
+		wantCalls  int
+	}{
+		{name: "same upload is replayed", first: "file-a", retry: "file-a", wantStatus: http.StatusCreated, wantCalls: 1},
+		{name: "same length, other content is 422", first: "file-a", retry: "file-b",
+			wantStatus: http.StatusUnprocessableEntity, wantCalls: 1},
+		{name: "unreadable upload is not stored", first: strings.Repeat("x", 32), retry: strings.Repeat("x", 32),
+			wantStatus: http.StatusCreated, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			h := Middleware(logger.NewNoOp(), redisBackedStore(gomock.NewController(t), map[string]string{}), cfg)(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					calls++
+					w.WriteHeader(http.StatusCreated)
+				}))
+			upload := func(body string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/events/e/guests/imports", strings.NewReader(body))
+				req.Header.Set("Content-Type", "multipart/form-data; boundary=b")
+				req.Header.Set(HeaderKey, "k-1")
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				return rec
+			}
+
+			upload(tt.first)
+			got := upload(tt.retry)
+
+			if got.Code != tt.wantStatus || calls != tt.wantCalls {
+				t.Errorf("retry status = %d, calls = %d; want %d and %d", got.Code, calls, tt.wantStatus, tt.wantCalls)
+			}
+		})
+	}
+}
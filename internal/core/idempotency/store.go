@@ -0,0 +1,83 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/redis"
+)
+
+// Record is what the store keeps per idempotency key: the fingerprint of the
+// request that claimed it and, once that request finished, its response.
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	Completed   bool        `json:"completed"`
+	StatusCode  int         `json:"status_code,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// Store persists idempotency records.
+type Store interface {
+	// Reserve atomically claims key for a new in-flight request with the given
+	// fingerprint, for lockTTL. When the key is already taken it returns the
+	// existing record and reserved == false.
+	Reserve(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (existing *Record, reserved bool, err error)
+	// Complete stores the finished response under key for ttl.
+	Complete(ctx context.Context, key string, rec *Record, ttl time.Duration) error
+	// Release drops key so the request can be retried (e.g. after a 5xx).
+	Release(ctx context.Context, key string) error
+}
+
+// redisStore implements Store on the shared redis.Client.
+type redisStore struct {
+	client redis.Client
+}
+
+// NewRedisStore returns a Store backed by Redis. Reservation relies on SETNX,
+// so concurrent retries across API instances race on one atomic command.
+func NewRedisStore(client redis.Client) Store {
+	return &redisStore{client: client}
+}
+
+// Reserve implements Store.
+func (s *redisStore) Reserve(
+	ctx context.Context, key, fingerprint string, lockTTL time.Duration,
+) (*Record, bool, error) {
+	claim, err := json.Marshal(&Record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, err
+	}
+	reserved, err := s.client.SetNX(ctx, key, string(claim), lockTTL)
+	if err != nil {
+		return nil, false, err
+	}
+	if reserved {
+		return nil, true, nil
+	}
+	raw, err := s.client.Get(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+	var rec Record
+	if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+		return nil, false, err
+	}
+	return &rec, false, nil
+}
+
+// Complete implements Store.
+func (s *redisStore) Complete(ctx context.Context, key string, rec *Record, ttl time.Duration) error {
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, key, string(raw), ttl)
+}
+
+// Release implements Store.
+func (s *redisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, key)
+}
@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedisStore_Reserve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("free key is reserved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mockredis.NewMockClient(ctrl)
+		client.EXPECT().SetNX(ctx, "k", `{"fingerprint":"fp","completed":false}`, time.Minute).Return(true, nil)
+
+		existing, reserved, err := NewRedisStore(client).Reserve(ctx, "k", "fp", time.Minute)
+		if err != nil || !reserved || existing != nil {
+			t.Fatalf("Reserve() = %v, %v, %v; want nil, true, nil", existing, reserved, err)
+		}
+	})
+
+	t.Run("taken key returns existing record", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mockredis.NewMockClient(ctrl)
+		client.EXPECT().SetNX(ctx, "k", gomock.Any(), time.Minute).Return(false, nil)
+		client.EXPECT().Get(ctx, "k").Return(`{"fingerprint":"fp","completed":true,"status_code":201}`, nil)
+
+		existing, reserved, err := NewRedisStore(client).Reserve(ctx, "k", "fp", time.Minute)
+		if err != nil || reserved {
+			t.Fatalf("Reserve() reserved = %v, err = %v; want false, nil", reserved, err)
+		}
+		if !existing.Completed || existing.StatusCode != 201 {
+			t.Errorf("existing = %+v", existing)
+		}
+	})
+}
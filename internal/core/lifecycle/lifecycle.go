@@ -0,0 +1,185 @@
+// Package lifecycle starts the process's components in order and stops them
+// in reverse on shutdown. Run gates each start on the component's health, so
+// the HTTP server only listens once the database and Redis answer; on a
+// signal it first reports not ready (so load balancers stop routing), waits
+// a drain period, then stops everything under one deadline. A second signal
+// cuts the drain short.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+// readyPollInterval is how often a component's Ready check is retried while
+// it starts.
+const readyPollInterval = 250 * time.Millisecond
+
+// Component is one part of the process. Every hook is optional.
+type Component struct {
+	// Name identifies the component in logs and errors.
+	Name string
+	// Start brings the component up and returns once it is running; work that
+	// keeps running (e.g. serving) belongs in a goroutine that reports a
+	// fatal error through Manager.Fail.
+	Start func(ctx context.Context) error
+	// Ready reports whether the started component is healthy. Run retries it
+	// until it succeeds or Config.StartTimeout runs out.
+	Ready func(ctx context.Context) error
+	// Stop shuts the component down, giving up when ctx expires.
+	Stop func(ctx context.Context) error
+}
+
+// Config bounds the phases of Run.
+type Config struct {
+	// StartTimeout bounds each component's Start and Ready gate.
+	StartTimeout time.Duration
+	// DrainPeriod is how long Run keeps serving, reporting not ready, between
+	// the shutdown signal and the first Stop.
+	DrainPeriod time.Duration
+	// ShutdownTimeout bounds stopping every component.
+	ShutdownTimeout time.Duration
+}
+
+// Manager runs registered components. It is not reusable: Run it once.
+type Manager struct {
+	log        logger.Logger
+	cfg        Config
+	components []Component
+	ready      atomic.Bool
+	failed     chan error
+	failOnce   sync.Once
+}
+
+// New returns a Manager with no components.
+func New(log logger.Logger, cfg Config) *Manager {
+	return &Manager{log: log, cfg: cfg, failed: make(chan error, 1)}
+}
+
+// Register appends c; components start in registration order and stop in
+// reverse.
+func (m *Manager) Register(c Component) {
+	m.components = append(m.components, c)
+}
+
+// Ready reports whether every component has started and shutdown hasn't
+// begun. /ready answers 503 whenever it is false.
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+// Fail starts shutdown because a running component broke (e.g. its server
+// stopped serving). Only the first failure is kept; Run returns it.
+func (m *Manager) Fail(err error) {
+	m.failOnce.Do(func() { m.failed <- err })
+}
+
+// Run starts the components, blocks until a signal arrives on signals, ctx
+// is done or a component fails, then drains and stops them. A second signal
+// (or ctx ending, after a signal) during the drain skips straight to
+// stopping. A component that fails to start or become ready stops the ones
+// already started and Run returns its error. Otherwise Run returns the
+// runtime failure, if any, joined with every Stop error.
+func (m *Manager) Run(ctx context.Context, signals <-chan os.Signal) error {
+	for i, c := range m.components {
+		if err := m.start(ctx, c); err != nil {
+			return errors.Join(fmt.Errorf("lifecycle: start %s: %w", c.Name, err), m.stop(m.components[:i]))
+		}
+		m.log.Infof("Started %s", c.Name)
+	}
+	m.ready.Store(true)
+	m.log.Info("All components started")
+
+	var failure error
+	done := ctx.Done()
+	select {
+	case sig := <-signals:
+		m.log.Infof("Signal %v received, draining...", sig)
+	case <-done:
+		m.log.Info("Shutdown requested, draining...")
+		done = nil
+	case failure = <-m.failed:
+		m.log.Infof("Component failed: %v, shutting down...", failure)
+	}
+	m.ready.Store(false)
+	if failure == nil && m.cfg.DrainPeriod > 0 {
+		m.drain(signals, done)
+	}
+	return errors.Join(failure, m.stop(m.components))
+}
+
+// drain waits out DrainPeriod while load balancers notice the instance is
+// no longer ready, returning early on another signal or on done.
+func (m *Manager) drain(signals <-chan os.Signal, done <-chan struct{}) {
+	timer := time.NewTimer(m.cfg.DrainPeriod)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case sig := <-signals:
+		m.log.Infof("Signal %v received, skipping the rest of the drain", sig)
+	case <-done:
+		m.log.Info("Shutdown forced, skipping the rest of the drain")
+	}
+}
+
+// start runs c's Start and then polls its Ready gate, both under
+// StartTimeout.
+func (m *Manager) start(ctx context.Context, c Component) error {
+	if m.cfg.StartTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.cfg.StartTimeout)
+		defer cancel()
+	}
+	if c.Start != nil {
+		if err := c.Start(ctx); err != nil {
+			return err
+		}
+	}
+	if c.Ready == nil {
+		return nil
+	}
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+	for {
+		err := c.Ready(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("not ready: %w", err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop stops components in reverse order under ShutdownTimeout, carrying on
+// past failures so every component gets its chance to close.
+func (m *Manager) stop(components []Component) error {
+	ctx := context.Background()
+	if m.cfg.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.cfg.ShutdownTimeout)
+		defer cancel()
+	}
+	var errs []error
+	for i := len(components) - 1; i >= 0; i-- {
+		c := components[i]
+		if c.Stop == nil {
+			continue
+		}
+		if err := c.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("lifecycle: stop %s: %w", c.Name, err))
+			continue
+		}
+		m.log.Infof("Stopped %s", c.Name)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,161 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+// recorder registers components that log their hooks into calls.
+type recorder struct {
+	m     *Manager
+	calls []string
+}
+
+func (r *recorder) add(name string, startErr error) {
+	r.m.Register(Component{
+		Name: name,
+		Start: func(context.Context) error {
+			r.calls = append(r.calls, "start "+name)
+			return startErr
+		},
+		Stop: func(context.Context) error {
+			r.calls = append(r.calls, "stop "+name)
+			if r.m.Ready() {
+				r.calls = append(r.calls, "still ready")
+			}
+			return nil
+		},
+	})
+}
+
+func TestManager_Run(t *testing.T) {
+	errStart := errors.New("port taken")
+	errServe := errors.New("listener closed")
+
+	tests := []struct {
+		name      string
+		failStart bool
+		failRun   bool
+		wantCalls []string
+		wantErr   error
+	}{
+		{
+			name:      "starts in order, stops in reverse after the signal",
+			wantCalls: []string{"start db", "start redis", "start http", "stop http", "stop redis", "stop db"},
+		},
+		{
+			name:      "failed start stops what already started",
+			failStart: true,
+			wantCalls: []string{"start db", "start redis", "start http", "stop redis", "stop db"},
+			wantErr:   errStart,
+		},
+		{
+			name:      "component failure shuts down",
+			failRun:   true,
+			wantCalls: []string{"start db", "start redis", "start http", "stop http", "stop redis", "stop db"},
+			wantErr:   errServe,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(logger.NewNoOp(), Config{DrainPeriod: time.Millisecond, ShutdownTimeout: time.Second})
+			r := &recorder{m: m}
+			r.add("db", nil)
+			r.add("redis", nil)
+			var httpErr error
+			if tt.failStart {
+				httpErr = errStart
+			}
+			r.add("http", httpErr)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				for !m.Ready() {
+					time.Sleep(time.Millisecond)
+				}
+				if tt.failRun {
+					m.Fail(errServe)
+				} else {
+					cancel()
+				}
+			}()
+
+			err := m.Run(ctx, nil)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Run() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(r.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", r.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestManager_ReadyGate(t *testing.T) {
+	m := New(logger.NewNoOp(), Config{StartTimeout: 50 * time.Millisecond})
+	m.Register(Component{Name: "db", Ready: func(context.Context) error { return errors.New("connection refused") }})
+
+	start := time.Now()
+	if err := m.Run(context.Background(), nil); err == nil {
+		t.Fatal("Run() error = nil, want not ready")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Run() gave up after %v, want about StartTimeout", elapsed)
+	}
+	if m.Ready() {
+		t.Error("Ready() = true after a failed start")
+	}
+}
+
+func TestManager_Drain(t *testing.T) {
+	tests := []struct {
+		name        string
+		second      bool
+		wantAtLeast time.Duration
+		wantUnder   time.Duration
+	}{
+		{name: "a signal drains for the period", wantAtLeast: 50 * time.Millisecond, wantUnder: time.Second},
+		{name: "a second signal skips the drain", second: true, wantUnder: 40 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(logger.NewNoOp(), Config{DrainPeriod: 50 * time.Millisecond})
+			if tt.second {
+				m.cfg.DrainPeriod = time.Hour
+			}
+			r := &recorder{m: m}
+			r.add("http", nil)
+
+			signals := make(chan os.Signal)
+			var signalled time.Time
+			go func() {
+				for !m.Ready() {
+					time.Sleep(time.Millisecond)
+				}
+				signalled = time.Now()
+				signals <- os.Interrupt
+				if tt.second {
+					signals <- os.Interrupt
+				}
+			}()
+
+			if err := m.Run(context.Background(), signals); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			elapsed := time.Since(signalled)
+			if elapsed < tt.wantAtLeast || elapsed >= tt.wantUnder {
+				t.Errorf("stopped %v after the first signal, want in [%v, %v)", elapsed, tt.wantAtLeast, tt.wantUnder)
+			}
+			if want := []string{"start http", "stop http"}; !reflect.DeepEqual(r.calls, want) {
+				t.Errorf("calls = %v, want %v", r.calls, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// HTTPServer returns the component serving srv on srv.Addr. Start returns
+// once the listener is bound, so a taken port fails startup instead of
+// surfacing later; if serving stops on its own the manager is told to shut
+// down. Stop shuts srv down gracefully and closes the connections still open
+// when the deadline passes.
+func (m *Manager) HTTPServer(name string, srv *http.Server) Component {
+	return Component{
+		Name: name,
+		Start: func(ctx context.Context) error {
+			ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+			m.log.Infof("Serving %s on %s", name, ln.Addr())
+			go func() {
+				if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					m.Fail(fmt.Errorf("%s: %w", name, err))
+				}
+			}()
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			err := srv.Shutdown(ctx)
+			if errors.Is(err, context.DeadlineExceeded) {
+				m.log.Infof("Shutdown timeout exceeded, forcing %s closed", name)
+				_ = srv.Close()
+			}
+			return err
+		},
+	}
+}
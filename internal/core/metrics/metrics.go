@@ -0,0 +1,119 @@
+// Package metrics holds the app's Prometheus collectors: HTTP RED metrics by
+// route pattern, database pool stats, repository cache lookups, and domain
+// counters that features bump through the helpers below. Everything is
+// registered on Registry, which Handler serves; nothing touches the
+// prometheus default registry.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every metric name.
+const namespace = "guest_management"
+
+// Registry is the registry Handler serves.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "http_requests_total",
+		Help: "HTTP requests served, by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace, Name: "http_request_duration_seconds",
+		Help:    "Time to serve an HTTP request, by method and route pattern.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+	cacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "cache_lookups_total",
+		Help: "Repository cache lookups, by cache namespace and result (hit, miss, error).",
+	}, []string{"cache", "result"})
+	scans = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "scans_total",
+		Help: "Ticket scans, by result (accepted, rejected) and rejection reason.",
+	}, []string{"result", "reason"})
+	ticketsIssued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "tickets_issued_total",
+		Help: "Tickets issued, by source (direct, waitlist, replacement).",
+	}, []string{"source"})
+	messages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "messages_total",
+		Help: "Outbound messages, by channel (notification, webhook) and status (sent, failed).",
+	}, []string{"channel", "status"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests, httpDuration, cacheLookups, scans, ticketsIssued, messages,
+	)
+}
+
+// Handler serves Registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterDB registers the connection pool stats of db's leader
+// (go_sql_* metrics from database/sql.DBStats, db_name="leader").
+func RegisterDB(db *sqlkit.DB) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db.Leader(), "leader"))
+}
+
+// Cache lookup results.
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheError = "error"
+)
+
+// CacheLookup counts one lookup in the cache namespace with the given result.
+func CacheLookup(cache, result string) {
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+// ScanAccepted counts an accepted scan.
+func ScanAccepted() {
+	scans.WithLabelValues("accepted", "").Inc()
+}
+
+// ScanRejected counts a scan rejected for reason, a short snake_case code
+// such as "device_inactive".
+func ScanRejected(reason string) {
+	scans.WithLabelValues("rejected", reason).Inc()
+}
+
+// Ticket sources.
+const (
+	TicketDirect      = "direct"      // issued on request
+	TicketWaitlist    = "waitlist"    // promoted from the waitlist
+	TicketReplacement = "replacement" // replacing a reissued or transferred ticket
+)
+
+// TicketIssued counts a ticket issued from source.
+func TicketIssued(source string) {
+	ticketsIssued.WithLabelValues(source).Inc()
+}
+
+// Message channels.
+const (
+	ChannelNotification = "notification" // guest notifications
+	ChannelWebhook      = "webhook"      // webhook delivery attempts
+)
+
+// MessageSent counts a message sent on channel.
+func MessageSent(channel string) {
+	messages.WithLabelValues(channel, "sent").Inc()
+}
+
+// MessageFailed counts a message on channel that failed to send.
+func MessageFailed(channel string) {
+	messages.WithLabelValues(channel, "failed").Inc()
+}
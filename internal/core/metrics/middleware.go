@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// unmatched is the route label of requests no route matched, so probing
+// random paths can't grow the label set.
+const unmatched = "unmatched"
+
+// Middleware returns HTTP middleware recording each request's count and
+// duration by method, chi route pattern (e.g. /api/v1/events/{eventId}) and
+// status code. Mount it outermost, so the 500s of recovered panics count too.
+func Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := &responseWriter{ResponseWriter: w}
+			next.ServeHTTP(rw, r)
+
+			route := unmatched
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+			status := rw.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(status)).Inc()
+			httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+// responseWriter records the status code written through it.
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the first status code.
+func (w *responseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write records an implicit 200.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
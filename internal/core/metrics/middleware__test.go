@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddleware(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Middleware())
+	r.Get("/api/v1/events/{eventId}", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	r.Get("/api/v1/events/{eventId}/report", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte("ok")) })
+
+	tests := []struct {
+		name   string
+		path   string
+		route  string
+		status string
+	}{
+		{name: "labels by route pattern", path: "/api/v1/events/e1", route: "/api/v1/events/{eventId}", status: "204"},
+		{name: "implicit 200", path: "/api/v1/events/e1/report", route: "/api/v1/events/{eventId}/report", status: "200"},
+		{name: "unmatched paths share a label", path: "/wp-login.php", route: unmatched, status: "404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := httpRequests.WithLabelValues(http.MethodGet, tt.route, tt.status)
+			before := testutil.ToFloat64(counter)
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("http_requests_total{route=%q,status=%q} grew by %v, want 1", tt.route, tt.status, got)
+			}
+		})
+	}
+}
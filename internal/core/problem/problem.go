@@ -0,0 +1,91 @@
+// Package problem renders errors as RFC 7807 problem details
+// (application/problem+json) carrying the errcode catalogue's code, the
+// request ID and any field errors. Handle replaces go-sdk's handler.Handle
+// for every route: success responses are still written by handler.Handle.
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit"
+	"github.com/biairmal/go-sdk/lib/httpkit/handler"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+)
+
+// ContentType is the media type of problem responses.
+const ContentType = "application/problem+json"
+
+// Problem is the body of every error response.
+type Problem struct {
+	// Type is "about:blank": the code, not a URI, identifies the problem.
+	Type string `json:"type" example:"about:blank"`
+	// Title is the status's reason phrase.
+	Title string `json:"title" example:"Not Found"`
+	// Status is the HTTP status code.
+	Status int `json:"status" example:"404"`
+	// Detail is a human-readable message; don't parse it, use Code.
+	Detail string `json:"detail" example:"event not found"`
+	// Instance is the request path.
+	Instance string `json:"instance" example:"/api/v1/events/0b6f7c1e-4a51-4d8e-9d7a-1f0b2c3d4e5f"`
+	// Code is the stable, machine-readable error code (see internal/core/errcode).
+	Code errcode.Code `json:"code" swaggertype:"string" example:"EVENT_NOT_FOUND"`
+	// RequestID is the request's X-Request-ID, for support requests.
+	RequestID string `json:"request_id,omitempty" example:"c0a8012e-7f3b-4f3c-9c1d-2b6f0e9a8d77"`
+	// Errors lists invalid fields, for VALIDATION_FAILED.
+	Errors []errcode.FieldError `json:"errors,omitempty"`
+}
+
+// Handle adapts fn like handler.Handle, but writes errors as problem details.
+func Handle(fn func(*http.Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := fn(r)
+		if err != nil {
+			Write(w, r, err)
+			return
+		}
+		handler.Handle(func(*http.Request) (any, error) { return res, nil })(w, r)
+	}
+}
+
+// Write writes err as problem details. The status is the one httpkit maps
+// err's errorz class to; the code is the catalogued code in err's chain when
+// it agrees with that status (a coded error wrapped as internal reports
+// INTERNAL_ERROR, not its inner code), otherwise the status's generic code.
+// Internal errors never expose their message.
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	status := httpkit.StatusCodeFromError(err)
+	code, ok := errcode.Of(err)
+	if !ok || code.Status() != status {
+		code = errcode.ForStatus(status)
+	}
+	detail := code.Message()
+	var ez *errorz.Error
+	if status < http.StatusInternalServerError && errors.As(err, &ez) && ez.Message != "" {
+		detail = ez.Message
+	}
+	write(w, r, Problem{
+		Status: status, Detail: detail, Code: code, Errors: errcode.FieldsOf(err),
+	})
+}
+
+// WriteCode writes code's status with its default message, for responses
+// that don't come from an error (unknown routes, disallowed methods).
+func WriteCode(w http.ResponseWriter, r *http.Request, code errcode.Code) {
+	write(w, r, Problem{Status: code.Status(), Detail: code.Message(), Code: code})
+}
+
+// write fills p's Type, Title, Instance and RequestID and writes it.
+func write(w http.ResponseWriter, r *http.Request, p Problem) {
+	p.Type = "about:blank"
+	p.Title = http.StatusText(p.Status)
+	p.Instance = r.URL.Path
+	p.RequestID = ctxkit.RequestID(r.Context())
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
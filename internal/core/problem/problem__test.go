@@ -0,0 +1,81 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+)
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   errcode.Code
+		wantDetail string
+		wantFields int
+	}{
+		{
+			name: "catalogued code", err: errcode.EventCategoryNotFound.New(),
+			wantStatus: http.StatusNotFound, wantCode: errcode.EventCategoryNotFound, wantDetail: "event category not found",
+		},
+		{
+			name: "uncoded error gets the generic code", err: errorz.BadRequest().WithMessage("from must be before to"),
+			wantStatus: http.StatusBadRequest, wantCode: errcode.BadRequest, wantDetail: "from must be before to",
+		},
+		{
+			name:       "coded error wrapped as internal hides the inner code",
+			err:        errorz.Wrap(errcode.GuestNotFound.New()).WithCode(errorz.CodeInternal).WithMessage("failed to load guest"),
+			wantStatus: http.StatusInternalServerError, wantCode: errcode.Internal, wantDetail: "internal server error",
+		},
+		{
+			name: "field errors",
+			err: errcode.ValidationFailed.WithFields("request validation failed",
+				[]errcode.FieldError{{Field: "email", Message: "email must be a valid email"}}),
+			wantStatus: http.StatusBadRequest, wantCode: errcode.ValidationFailed, wantDetail: "request validation failed", wantFields: 1,
+		},
+		{
+			name: "plain error is internal", err: errors.New("boom"),
+			wantStatus: http.StatusInternalServerError, wantCode: errcode.Internal, wantDetail: "internal server error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			Handle(func(*http.Request) (any, error) { return nil, tt.err })(rec, httptest.NewRequest(http.MethodGet, "/api/v1/things", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != ContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, ContentType)
+			}
+			var p Problem
+			if err := json.NewDecoder(rec.Body).Decode(&p); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if p.Code != tt.wantCode || p.Status != tt.wantStatus || p.Detail != tt.wantDetail || len(p.Errors) != tt.wantFields {
+				t.Errorf("problem = %+v, want code %s, status %d, detail %q, %d field errors",
+					p, tt.wantCode, tt.wantStatus, tt.wantDetail, tt.wantFields)
+			}
+			if p.Instance != "/api/v1/things" || p.Title != http.StatusText(tt.wantStatus) {
+				t.Errorf("instance = %q, title = %q", p.Instance, p.Title)
+			}
+		})
+	}
+}
+
+func TestHandle_Success(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handle(func(*http.Request) (any, error) { return response.NoContent(), nil })(rec, httptest.NewRequest(http.MethodDelete, "/", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+}
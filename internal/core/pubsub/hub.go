@@ -0,0 +1,141 @@
+// Package pubsub fans Redis pub/sub messages out to in-process listeners.
+// Each API instance holds at most one Redis subscription per channel, however
+// many of its clients (e.g. SSE connections) listen to it, so a message
+// published by any instance reaches every connected client exactly once.
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/redis"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/core/pubsub/mock_subscriber.go -package=mockpubsub github.com/biairmal/guest-management-be/internal/core/pubsub Subscriber
+
+// listenerBuffer is how many undelivered messages a listener may queue before
+// further messages to it are dropped.
+const listenerBuffer = 16
+
+// ErrClosed is returned by Subscribe once the Hub has been closed.
+var ErrClosed = errors.New("pubsub: hub closed")
+
+// Subscriber hands out in-process subscriptions to pub/sub channels.
+type Subscriber interface {
+	// Subscribe returns a channel receiving the payload of every message
+	// published on channel until ctx is done or the subscriber is closed,
+	// after which it is closed. A listener that falls listenerBuffer
+	// messages behind misses messages rather than stalling the others, so
+	// payloads should be notifications, not data that must not be lost.
+	Subscribe(ctx context.Context, channel string) (<-chan string, error)
+}
+
+// Hub is a Subscriber over a redis.Client.
+type Hub struct {
+	logger logger.Logger
+	client redis.Client
+
+	mu     sync.Mutex
+	topics map[string]*topic
+	closed bool
+}
+
+// topic is one Redis subscription and its local listeners.
+type topic struct {
+	sub       redis.PubSub
+	listeners map[chan string]struct{}
+}
+
+// NewHub returns a Hub subscribing through client.
+func NewHub(log logger.Logger, client redis.Client) *Hub {
+	return &Hub{logger: log, client: client, topics: make(map[string]*topic)}
+}
+
+// Subscribe implements Subscriber. The Redis subscription for channel is
+// opened by its first listener and closed with its last.
+func (h *Hub) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, ErrClosed
+	}
+
+	t, ok := h.topics[channel]
+	if !ok {
+		// The subscription outlives the request that opened it.
+		t = &topic{
+			sub:       h.client.Subscribe(context.WithoutCancel(ctx), channel),
+			listeners: make(map[chan string]struct{}),
+		}
+		h.topics[channel] = t
+		go h.dispatch(channel, t, t.sub.Channel())
+	}
+	ch := make(chan string, listenerBuffer)
+	t.listeners[ch] = struct{}{}
+
+	context.AfterFunc(ctx, func() { h.unsubscribe(channel, ch) })
+	return ch, nil
+}
+
+// dispatch copies t's Redis messages to its listeners until the subscription
+// is closed.
+func (h *Hub) dispatch(channel string, t *topic, messages <-chan *redis.Message) {
+	for msg := range messages {
+		h.mu.Lock()
+		for ch := range t.listeners {
+			select {
+			case ch <- msg.Payload:
+			default:
+				h.logger.Warn("pubsub listener too slow; message dropped", logger.F("channel", channel))
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// unsubscribe removes listener ch from channel, closing the Redis
+// subscription when it was the last one.
+func (h *Hub) unsubscribe(channel string, ch chan string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	t, ok := h.topics[channel]
+	if !ok {
+		return
+	}
+	if _, ok := t.listeners[ch]; !ok {
+		return
+	}
+	delete(t.listeners, ch)
+	close(ch)
+	if len(t.listeners) == 0 {
+		delete(h.topics, channel)
+		h.closeTopic(channel, t)
+	}
+}
+
+// Close ends every subscription, closing all listener channels, and makes
+// further Subscribe calls fail with ErrClosed. Long-lived responses fed by
+// the Hub (SSE) end with it, so the HTTP server's shutdown need not wait them
+// out.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for channel, t := range h.topics {
+		for ch := range t.listeners {
+			delete(t.listeners, ch)
+			close(ch)
+		}
+		delete(h.topics, channel)
+		h.closeTopic(channel, t)
+	}
+}
+
+// closeTopic closes t's Redis subscription; h.mu must be held.
+func (h *Hub) closeTopic(channel string, t *topic) {
+	if err := t.sub.Close(); err != nil {
+		h.logger.Warn("pubsub unsubscribe failed", logger.F("channel", channel), logger.F("error", err))
+	}
+}
@@ -0,0 +1,87 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/redis"
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	"go.uber.org/mock/gomock"
+)
+
+// receive returns the next payload on ch, failing the test after a second.
+func receive(t *testing.T, ch <-chan string) (string, bool) {
+	t.Helper()
+	select {
+	case p, ok := <-ch:
+		return p, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a message")
+		return "", false
+	}
+}
+
+func TestHub_FansOutOneSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockredis.NewMockClient(ctrl)
+	sub := mockredis.NewMockPubSub(ctrl)
+	messages := make(chan *redis.Message, 1)
+	sub.EXPECT().Channel().Return(messages)
+	client.EXPECT().Subscribe(gomock.Any(), "live:e1").Return(sub).Times(1)
+
+	hub := NewHub(logger.NewNoOp(), client)
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	a, err := hub.Subscribe(ctx1, "live:e1")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	b, err := hub.Subscribe(ctx2, "live:e1")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	messages <- &redis.Message{Channel: "live:e1", Payload: "scan"}
+	for _, ch := range []<-chan string{a, b} {
+		if p, ok := receive(t, ch); !ok || p != "scan" {
+			t.Errorf("received (%q, %v), want (\"scan\", true)", p, ok)
+		}
+	}
+
+	// The Redis subscription stays open until its last listener leaves.
+	cancel1()
+	if _, ok := receive(t, a); ok {
+		t.Error("first listener not closed after its context ended")
+	}
+	sub.EXPECT().Close().DoAndReturn(func() error { close(messages); return nil })
+	cancel2()
+	if _, ok := receive(t, b); ok {
+		t.Error("second listener not closed after its context ended")
+	}
+}
+
+func TestHub_Close(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockredis.NewMockClient(ctrl)
+	sub := mockredis.NewMockPubSub(ctrl)
+	messages := make(chan *redis.Message)
+	sub.EXPECT().Channel().Return(messages)
+	sub.EXPECT().Close().DoAndReturn(func() error { close(messages); return nil })
+	client.EXPECT().Subscribe(gomock.Any(), "live:e1").Return(sub)
+
+	hub := NewHub(logger.NewNoOp(), client)
+	ch, err := hub.Subscribe(context.Background(), "live:e1")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	hub.Close()
+	if _, ok := receive(t, ch); ok {
+		t.Error("listener not closed by Close")
+	}
+	if _, err := hub.Subscribe(context.Background(), "live:e1"); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe after Close: err = %v, want ErrClosed", err)
+	}
+}
@@ -24,12 +24,20 @@ const (
 // bounds for ParseListParams. A feature typically only needs to set
 // AllowedSortFields and AllowedFilterFields; DefaultPage, DefaultSize, and
 // MaxSize fall back to the package-level defaults above when left zero.
+// AllowIncludeDeleted opts the endpoint into the "include_deleted" flag; when
+// false the parameter is ignored, so soft-deleted rows stay hidden.
+// AllowedFilterPrefixes admits every filter key starting with one of the
+// prefixes (e.g. "cf." for custom fields), for endpoints whose filterable
+// fields are only known at request time; the caller must check those keys
+// itself, as ToSQL skips them.
 type ListParseConfig struct {
-	DefaultPage         int
-	DefaultSize         int
-	MaxSize             int
-	AllowedSortFields   []string
-	AllowedFilterFields []string
+	DefaultPage           int
+	DefaultSize           int
+	MaxSize               int
+	AllowedSortFields     []string
+	AllowedFilterFields   []string
+	AllowedFilterPrefixes []string
+	AllowIncludeDeleted   bool
 }
 
 // withDefaults returns a copy of c with zero-valued pagination fields filled
@@ -52,7 +60,8 @@ func (c ListParseConfig) withDefaults() ListParseConfig {
 // shared by every list endpoint so no feature needs its own params type.
 type ListParams struct {
 	common.BasePageRequest
-	Filters map[string]string // field -> value (simple equality filters)
+	Filters        map[string]string // field -> value (simple equality filters)
+	IncludeDeleted bool              // also return soft-deleted rows (admin views)
 }
 
 // ParseListParams parses pagination, sort, and equality-filter query
@@ -65,7 +74,9 @@ type ListParams struct {
 // - page: 1-based page number (int, defaults to cfg.DefaultPage).
 // - size: items per page (int, defaults to cfg.DefaultSize, clamped to cfg.MaxSize).
 // - sort: repeatable, format "field,DIRECTION" where DIRECTION is ASC or DESC (case-insensitive).
-// - Any key matching cfg.AllowedFilterFields is treated as a simple equality filter.
+// - include_deleted: "true"/"false", only honoured when cfg.AllowIncludeDeleted is set.
+// - Any key matching cfg.AllowedFilterFields, or starting with one of
+// cfg.AllowedFilterPrefixes, is treated as a simple equality filter.
 func ParseListParams(q url.Values, cfg ListParseConfig) (*ListParams, error) {
 	cfg = cfg.withDefaults()
 
@@ -81,10 +92,15 @@ func ParseListParams(q url.Values, cfg ListParseConfig) (*ListParams, error) {
 	if err != nil {
 		return nil, err
 	}
+	includeDeleted, err := parseIncludeDeleted(q, cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ListParams{
 		BasePageRequest: *common.NewBasePageRequest(page, size, sorts),
 		Filters:         parseFilters(q, cfg),
+		IncludeDeleted:  includeDeleted,
 	}, nil
 }
 
@@ -148,22 +164,47 @@ func parseSorts(q url.Values, cfg ListParseConfig) ([]common.SortSpec, error) {
 	return sorts, nil
 }
 
+// parseIncludeDeleted parses the "include_deleted" query parameter. It is
+// always false when cfg.AllowIncludeDeleted is unset.
+func parseIncludeDeleted(q url.Values, cfg ListParseConfig) (bool, error) {
+	v := q.Get("include_deleted")
+	if v == "" || !cfg.AllowIncludeDeleted {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid include_deleted value: %s", v)
+	}
+	return b, nil
+}
+
 // parseFilters extracts simple equality filters for keys in
-// cfg.AllowedFilterFields, ignoring pagination/sort keys.
+// cfg.AllowedFilterFields or under cfg.AllowedFilterPrefixes, ignoring
+// pagination/sort keys.
 func parseFilters(q url.Values, cfg ListParseConfig) map[string]string {
 	allowedFilters := toSet(cfg.AllowedFilterFields)
 	filters := make(map[string]string)
 	for key := range q {
-		if key == "page" || key == "size" || key == "sort" {
+		if key == "page" || key == "size" || key == "sort" || key == "include_deleted" {
 			continue
 		}
-		if allowedFilters[key] {
+		if allowedFilters[key] || hasAnyPrefix(key, cfg.AllowedFilterPrefixes) {
 			filters[key] = q.Get(key)
 		}
 	}
 	return filters
 }
 
+// hasAnyPrefix reports whether s starts with one of prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
 // toSet converts a string slice to a set for O(1) lookup.
 func toSet(ss []string) map[string]bool {
 	m := make(map[string]bool, len(ss))
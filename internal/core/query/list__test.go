@@ -175,3 +175,63 @@ func TestParseListParamsFilters(t *testing.T) {
 		}
 	}
 }
+
+func TestParseListParamsFilterPrefixes(t *testing.T) {
+	cfg := ListParseConfig{
+		AllowedFilterFields:   []string{"name"},
+		AllowedFilterPrefixes: []string{"cf."},
+	}
+	q := url.Values{
+		"name":     {"Ann"},
+		"cf.vip":   {"true"},
+		"cf.table": {"7"},
+		"cfvip":    {"ignored"},
+	}
+
+	params, err := ParseListParams(q, cfg)
+	if err != nil {
+		t.Fatalf("ParseListParams() error = %v, want nil", err)
+	}
+	want := map[string]string{"name": "Ann", "cf.vip": "true", "cf.table": "7"}
+	if len(params.Filters) != len(want) {
+		t.Fatalf("filters = %v, want %v", params.Filters, want)
+	}
+	for k, v := range want {
+		if params.Filters[k] != v {
+			t.Errorf("filters[%q] = %q, want %q", k, params.Filters[k], v)
+		}
+	}
+}
+
+func TestParseListParamsIncludeDeleted(t *testing.T) {
+	tests := []struct {
+		name    string
+		allow   bool
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "absent defaults to false", allow: true},
+		{name: "true when allowed", allow: true, value: "true", want: true},
+		{name: "explicit false when allowed", allow: true, value: "false"},
+		{name: "ignored when not allowed", value: "true"},
+		{name: "malformed when allowed", allow: true, value: "maybe", wantErr: true},
+		{name: "malformed ignored when not allowed", value: "maybe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := url.Values{}
+			if tt.value != "" {
+				q.Set("include_deleted", tt.value)
+			}
+			params, err := ParseListParams(q, ListParseConfig{AllowIncludeDeleted: tt.allow})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseListParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && params.IncludeDeleted != tt.want {
+				t.Errorf("IncludeDeleted = %v, want %v", params.IncludeDeleted, tt.want)
+			}
+		})
+	}
+}
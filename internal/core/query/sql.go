@@ -0,0 +1,60 @@
+package query
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+)
+
+// SQLClauses is the WHERE/ORDER BY translation of ListParams for a
+// hand-written query (exports, reports, joins) that cannot go through
+// repository.ListOptions.
+type SQLClauses struct {
+	Where   []string // "<expr> = $n" conditions, to be ANDed with the query's own
+	Args    []any    // bind values for Where, numbered after the query's own
+	OrderBy string   // "<expr> ASC, <expr> DESC"; empty when no sort was requested
+}
+
+// ToSQL translates params' filters and sorts into SQL using columns, which
+// maps each allow-listed field name to the SQL expression it stands for (e.g.
+// "rsvp_status" -> "g.rsvp_status"). Fields missing from columns are skipped,
+// so only expressions chosen by the caller ever reach the SQL text; values are
+// always bound. Placeholders start at $firstArg. Filters are emitted in sorted
+// field order so the generated SQL is stable.
+func ToSQL(params *ListParams, columns map[string]string, firstArg int) SQLClauses {
+	var c SQLClauses
+	if params == nil {
+		return c
+	}
+
+	fields := make([]string, 0, len(params.Filters))
+	for field := range params.Filters {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		expr, ok := columns[field]
+		if !ok {
+			continue
+		}
+		c.Args = append(c.Args, params.Filters[field])
+		c.Where = append(c.Where, fmt.Sprintf("%s = $%d", expr, firstArg+len(c.Args)-1))
+	}
+
+	var order []string
+	for _, s := range params.Sorts {
+		expr, ok := columns[s.Field]
+		if !ok {
+			continue
+		}
+		dir := "ASC"
+		if s.Direction == common.SortDesc {
+			dir = "DESC"
+		}
+		order = append(order, expr+" "+dir)
+	}
+	c.OrderBy = strings.Join(order, ", ")
+	return c
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+)
+
+func TestToSQL(t *testing.T) {
+	columns := map[string]string{"name": "g.name", "rsvp_status": "g.rsvp_status"}
+	params := &ListParams{
+		BasePageRequest: common.BasePageRequest{Sorts: []common.SortSpec{
+			{Field: "name", Direction: common.SortDesc},
+			{Field: "unmapped", Direction: common.SortAsc},
+			{Field: "rsvp_status", Direction: common.SortAsc},
+		}},
+		Filters: map[string]string{"rsvp_status": "confirmed", "name": "Ann", "unmapped": "x"},
+	}
+
+	got := ToSQL(params, columns, 2)
+
+	if want := []string{"g.name = $2", "g.rsvp_status = $3"}; !reflect.DeepEqual(got.Where, want) {
+		t.Errorf("Where = %q, want %q", got.Where, want)
+	}
+	if want := []any{"Ann", "confirmed"}; !reflect.DeepEqual(got.Args, want) {
+		t.Errorf("Args = %v, want %v", got.Args, want)
+	}
+	if want := "g.name DESC, g.rsvp_status ASC"; got.OrderBy != want {
+		t.Errorf("OrderBy = %q, want %q", got.OrderBy, want)
+	}
+}
+
+func TestToSQLNilParams(t *testing.T) {
+	got := ToSQL(nil, map[string]string{"name": "name"}, 1)
+	if len(got.Where) != 0 || len(got.Args) != 0 || got.OrderBy != "" {
+		t.Errorf("ToSQL(nil) = %+v, want empty", got)
+	}
+}
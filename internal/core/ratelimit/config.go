@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// Rule is the YAML/mapstructure-decodable shape of one limit: at most
+// Requests requests per client in each Window.
+type Rule struct {
+	Requests int           `mapstructure:"requests"`
+	Window   time.Duration `mapstructure:"window"`
+}
+
+// Validate checks the rule.
+func (r *Rule) Validate() error {
+	if r.Requests < 1 {
+		return errorz.Internal().WithMessage("ratelimit: requests must be at least 1")
+	}
+	if r.Window <= 0 {
+		return errorz.Internal().WithMessage("ratelimit: window must be positive")
+	}
+	return nil
+}
+
+// KeyType names what a request is counted by.
+type KeyType string
+
+const (
+	KeyIP     KeyType = "ip"      // client address, every request
+	KeyUser   KeyType = "user"    // logged-in user
+	KeyTenant KeyType = "tenant"  // tenant of the user or API key
+	KeyAPIKey KeyType = "api_key" // API key the request authenticated with
+)
+
+// Group limits the routes under Paths: each request there is counted once
+// per key type in Limits that it has an identity for.
+type Group struct {
+	Paths  []string         `mapstructure:"paths"`  // URL path prefixes, e.g. /api/v1/
+	Limits map[KeyType]Rule `mapstructure:"limits"` // rule per key type
+}
+
+// Config is the YAML/mapstructure-decodable shape for the app-wide rate
+// limits (the "rate_limit:" section of config.yaml). Groups are named; the
+// name namespaces their counters.
+type Config struct {
+	Enabled bool             `mapstructure:"enabled"`
+	Prefix  string           `mapstructure:"prefix"` // namespaces the Redis keys
+	Groups  map[string]Group `mapstructure:"groups"`
+}
+
+// Validate checks the configuration. It is a no-op when disabled.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	paths := make(map[string]string)
+	for name, g := range c.Groups {
+		if len(g.Paths) == 0 {
+			return errorz.Internal().WithMessage("ratelimit: group " + name + " needs at least one path")
+		}
+		for _, p := range g.Paths {
+			if !strings.HasPrefix(p, "/") {
+				return errorz.Internal().WithMessage("ratelimit: group " + name + " path " + p + " must start with /")
+			}
+			if other, ok := paths[p]; ok {
+				return errorz.Internal().WithMessage("ratelimit: path " + p + " is in groups " + other + " and " + name)
+			}
+			paths[p] = name
+		}
+		for kt, rule := range g.Limits {
+			switch kt {
+			case KeyIP, KeyUser, KeyTenant, KeyAPIKey:
+			default:
+				return errorz.Internal().WithMessage("ratelimit: group " + name + " has unknown key type " + string(kt))
+			}
+			if err := rule.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package ratelimit caps how many requests a client may make in a window.
+// Middleware keys each request by client address and a scope, and Routes by
+// the IP, user, tenant or API key limits configured for its route group; both
+// ask a Limiter whether it may proceed, report the budget in RateLimit-*
+// headers, and answer 429 with Retry-After when it may not.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/core/ratelimit/mock_limiter.go -package=mockratelimit github.com/biairmal/guest-management-be/internal/core/ratelimit Limiter
+
+// Decision is a Limiter's answer for one request against one budget.
+type Decision struct {
+	Allowed    bool
+	Limit      int           // the rule's request budget per window
+	Remaining  int           // requests left in the current window
+	RetryAfter time.Duration // time until the window resets
+}
+
+// Check is one budget a request is counted against: a key and its rule.
+type Check struct {
+	Key  string
+	Rule Rule
+}
+
+// Limiter counts requests per key in windows.
+type Limiter interface {
+	// Allow judges a request against every check and records it against all
+	// of them only when each one allows it: a request refused by any budget
+	// uses up none. The decisions are in checks' order, each judging its own
+	// budget.
+	Allow(ctx context.Context, checks ...Check) ([]Decision, error)
+}
+
+// memoryLimiter implements Limiter in process memory. Counts are per
+// instance, so behind a load balancer each replica enforces its own budget.
+type memoryLimiter struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	windows map[string]*window
+}
+
+// window is one key's count in its current fixed window.
+type window struct {
+	count int
+	reset time.Time
+}
+
+// sweepThreshold is the number of tracked keys above which expired windows
+// are dropped on the next Allow.
+const sweepThreshold = 10000
+
+// NewMemoryLimiter returns a Limiter that keeps its counters in memory.
+func NewMemoryLimiter() Limiter {
+	return &memoryLimiter{now: time.Now, windows: make(map[string]*window)}
+}
+
+// Allow implements Limiter.
+func (l *memoryLimiter) Allow(_ context.Context, checks ...Check) ([]Decision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if len(l.windows) > sweepThreshold {
+		for k, w := range l.windows {
+			if !now.Before(w.reset) {
+				delete(l.windows, k)
+			}
+		}
+	}
+	decisions := make([]Decision, len(checks))
+	windows := make([]*window, len(checks))
+	allowed := true
+	for i, c := range checks {
+		w, ok := l.windows[c.Key]
+		if !ok || !now.Before(w.reset) {
+			w = &window{reset: now.Add(c.Rule.Window)}
+			l.windows[c.Key] = w
+		}
+		windows[i] = w
+		decisions[i] = decide(w.count+1, c.Rule.Requests, w.reset.Sub(now))
+		allowed = allowed && decisions[i].Allowed
+	}
+	if allowed {
+		for _, w := range windows {
+			w.count++
+		}
+	}
+	return decisions, nil
+}
+
+// decide builds the Decision for the count-th request of a window with limit
+// requests that resets after ttl.
+func decide(count, limit int, ttl time.Duration) Decision {
+	return Decision{
+		Allowed:    count <= limit,
+		Limit:      limit,
+		Remaining:  max(limit-count, 0),
+		RetryAfter: ttl,
+	}
+}
+
+// probeInterval is how long a fallbackLimiter stays on its fallback after the
+// primary failed before trying the primary again.
+const probeInterval = 5 * time.Second
+
+// fallbackLimiter implements Limiter with a primary that may fail (Redis) and
+// a fallback that can't (memory). While the primary is down requests skip it
+// instead of each waiting for it to time out.
+type fallbackLimiter struct {
+	log       logger.Logger
+	primary   Limiter
+	fallback  Limiter
+	now       func() time.Time
+	mu        sync.Mutex
+	downUntil time.Time // zero while the primary is healthy
+}
+
+// NewFallbackLimiter returns a Limiter that asks primary and, when it fails,
+// fallback. The switch and the recovery are logged once each.
+func NewFallbackLimiter(log logger.Logger, primary, fallback Limiter) Limiter {
+	return &fallbackLimiter{log: log, primary: primary, fallback: fallback, now: time.Now}
+}
+
+// Allow implements Limiter.
+func (l *fallbackLimiter) Allow(ctx context.Context, checks ...Check) ([]Decision, error) {
+	l.mu.Lock()
+	down := l.now().Before(l.downUntil)
+	l.mu.Unlock()
+	if down {
+		return l.fallback.Allow(ctx, checks...)
+	}
+
+	d, err := l.primary.Allow(ctx, checks...)
+	l.mu.Lock()
+	wasDown := !l.downUntil.IsZero()
+	if err == nil {
+		l.downUntil = time.Time{}
+	} else {
+		l.downUntil = l.now().Add(probeInterval)
+	}
+	l.mu.Unlock()
+
+	switch {
+	case err == nil && wasDown:
+		l.log.InfoWithContext(ctx, "rate limiter recovered")
+	case err != nil && !wasDown:
+		l.log.WarnWithContext(ctx, "rate limiter unavailable, falling back to in-memory limits", logger.F("error", err))
+	}
+	if err != nil {
+		return l.fallback.Allow(ctx, checks...)
+	}
+	return d, nil
+}
@@ -0,0 +1,211 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	rule := Rule{Requests: 2, Window: time.Minute}
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		key           string
+		at            time.Duration // offset from start
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}{
+		{name: "first request", key: "a", wantAllowed: true, wantRemaining: 1, wantRetry: time.Minute},
+		{name: "second request", key: "a", at: 10 * time.Second, wantAllowed: true, wantRetry: 50 * time.Second},
+		{name: "over the limit", key: "a", at: 20 * time.Second, wantRetry: 40 * time.Second},
+		{
+			name: "other keys keep their own budget", key: "b", at: 20 * time.Second,
+			wantAllowed: true, wantRemaining: 1, wantRetry: time.Minute,
+		},
+		{name: "window resets", key: "a", at: time.Minute, wantAllowed: true, wantRemaining: 1, wantRetry: time.Minute},
+	}
+	l := &memoryLimiter{windows: make(map[string]*window)}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l.now = func() time.Time { return start.Add(tt.at) }
+			ds, err := l.Allow(context.Background(), Check{Key: tt.key, Rule: rule})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := ds[0]; d.Allowed != tt.wantAllowed || d.Remaining != tt.wantRemaining || d.RetryAfter != tt.wantRetry || d.Limit != 2 {
+				t.Errorf("decision = %+v, want allowed=%v remaining=%d retry=%v limit=2",
+					d, tt.wantAllowed, tt.wantRemaining, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestMemoryLimiter_RefusalsAreNotCounted(t *testing.T) {
+	l := &memoryLimiter{now: time.Now, windows: make(map[string]*window)}
+	rule := Rule{Requests: 1, Window: time.Minute}
+	for range 3 {
+		_, _ = l.Allow(context.Background(), Check{Key: "a", Rule: rule})
+	}
+	if n := l.windows["a"].count; n != 1 {
+		t.Errorf("count = %d after one allowed and two refused requests, want 1", n)
+	}
+
+	// "a" is spent, so a request also counted against "b" uses up neither.
+	ds, _ := l.Allow(context.Background(), Check{Key: "b", Rule: rule}, Check{Key: "a", Rule: rule})
+	if !ds[0].Allowed || ds[1].Allowed {
+		t.Errorf("decisions = %+v, want b allowed and a refused", ds)
+	}
+	if n := l.windows["b"].count; n != 0 {
+		t.Errorf("b count = %d after a request refused by a, want 0", n)
+	}
+}
+
+func TestRedisLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	rule := Rule{Requests: 10, Window: time.Minute}
+	// 15s into window 29,000,000 (a minute each since the epoch).
+	now := time.Unix(29_000_000*60+15, 0)
+	keys := []string{"gm:ratelimit:api:ip:10.0.0.1:29000000", "gm:ratelimit:api:ip:10.0.0.1:28999999"}
+	tests := []struct {
+		name      string
+		result    any // the script's {current count with this request, previous count}
+		evalErr   error
+		want      Decision
+		wantError bool
+	}{
+		{
+			name: "first request of the window", result: []any{int64(1), int64(0)},
+			want: Decision{Allowed: true, Limit: 10, Remaining: 9, RetryAfter: 45 * time.Second},
+		},
+		{
+			// 8 * 45/60 = 6 carried over from the previous window.
+			name: "previous window is weighted", result: []any{int64(4), int64(8)},
+			want: Decision{Allowed: true, Limit: 10, Remaining: 0, RetryAfter: 45 * time.Second},
+		},
+		{
+			// Fits once the carried-over share drops below 5: 7.5s from now.
+			name: "refused while the previous window weighs in", result: []any{int64(5), int64(8)},
+			want: Decision{Limit: 10, RetryAfter: 7500 * time.Millisecond},
+		},
+		{
+			// Fits in the next window once 11 * left/60 drops below 10.
+			name: "refused until the next window", result: []any{int64(11), int64(0)},
+			want: Decision{Limit: 10, RetryAfter: 45*time.Second + time.Minute - time.Minute*10/11},
+		},
+		{name: "script fails", evalErr: errors.New("boom"), wantError: true},
+		{name: "unexpected script result", result: "OK", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := mockredis.NewMockClient(ctrl)
+			// One script call judges and counts the request: limit, time left
+			// and window in ns, counter TTL of two windows in ms.
+			client.EXPECT().Eval(ctx, allowScript, keys,
+				10, int64(45*time.Second), int64(time.Minute), int64(120_000),
+			).Return(tt.result, tt.evalErr)
+
+			l := &redisLimiter{client: client, prefix: "gm", now: func() time.Time { return now }}
+			ds, err := l.Allow(ctx, Check{Key: "api:ip:10.0.0.1", Rule: rule})
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ds[0] != tt.want {
+				t.Errorf("decision = %+v, want %+v", ds[0], tt.want)
+			}
+		})
+	}
+}
+
+// TestRedisLimiter_AllowSeveral expects every budget to go to one script
+// call, each with its own pair of keys and four arguments.
+func TestRedisLimiter_AllowSeveral(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(29_000_000*60+15, 0)
+	client := mockredis.NewMockClient(gomock.NewController(t))
+	client.EXPECT().Eval(ctx, allowScript,
+		[]string{
+			"gm:ratelimit:api:ip:10.0.0.1:29000000", "gm:ratelimit:api:ip:10.0.0.1:28999999",
+			"gm:ratelimit:api:user:u1:8700000", "gm:ratelimit:api:user:u1:8699999",
+		},
+		10, int64(45*time.Second), int64(time.Minute), int64(120_000),
+		1, int64(185*time.Second), int64(200*time.Second), int64(400_000),
+	).Return([]any{int64(3), int64(0), int64(2), int64(0)}, nil)
+
+	l := &redisLimiter{client: client, prefix: "gm", now: func() time.Time { return now }}
+	ds, err := l.Allow(ctx,
+		Check{Key: "api:ip:10.0.0.1", Rule: Rule{Requests: 10, Window: time.Minute}},
+		Check{Key: "api:user:u1", Rule: Rule{Requests: 1, Window: 200 * time.Second}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ds) != 2 || !ds[0].Allowed || ds[1].Allowed {
+		t.Errorf("decisions = %+v, want the ip budget allowed and the user budget refused", ds)
+	}
+}
+
+// limiterFunc adapts a function to Limiter.
+type limiterFunc func() (Decision, error)
+
+func (f limiterFunc) Allow(context.Context, ...Check) ([]Decision, error) {
+	d, err := f()
+	return []Decision{d}, err
+}
+
+func TestFallbackLimiter_Allow(t *testing.T) {
+	rule := Rule{Requests: 1, Window: time.Minute}
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	primaryErr := errors.New("redis down")
+	primary := Decision{Allowed: true, Limit: 100}
+	fallback := Decision{Allowed: true, Limit: 1}
+	tests := []struct {
+		name        string
+		at          time.Duration // offset from start
+		primaryErr  error
+		wantCalled  bool
+		wantLimiter Decision
+	}{
+		{name: "healthy primary answers", wantCalled: true, wantLimiter: primary},
+		{name: "failing primary falls back", at: time.Second, primaryErr: primaryErr, wantCalled: true, wantLimiter: fallback},
+		{name: "primary is skipped while down", at: 2 * time.Second, wantLimiter: fallback},
+		{name: "primary is probed again", at: 7 * time.Second, wantCalled: true, wantLimiter: primary},
+	}
+	var called bool
+	var err error
+	l := &fallbackLimiter{
+		log: logger.NewNoOp(),
+		primary: limiterFunc(func() (Decision, error) {
+			called = true
+			return primary, err
+		}),
+		fallback: limiterFunc(func() (Decision, error) { return fallback, nil }),
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called, err = false, tt.primaryErr
+			l.now = func() time.Time { return start.Add(tt.at) }
+			ds, gotErr := l.Allow(context.Background(), Check{Key: "k", Rule: rule})
+			if gotErr != nil {
+				t.Fatalf("unexpected error: %v", gotErr)
+			}
+			if called != tt.wantCalled || ds[0] != tt.wantLimiter {
+				t.Errorf("primary called = %v, decision = %+v; want %v, %+v", called, ds[0], tt.wantCalled, tt.wantLimiter)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package ratelimit
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/logger"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+const (
+	// HeaderRetryAfter tells a limited client how many seconds to wait.
+	HeaderRetryAfter = "Retry-After"
+	// HeaderLimit is the request budget of the window.
+	HeaderLimit = "RateLimit-Limit"
+	// HeaderRemaining is how many requests are left in the window.
+	HeaderRemaining = "RateLimit-Remaining"
+	// HeaderReset is how many seconds until the budget is renewed.
+	HeaderReset = "RateLimit-Reset"
+)
+
+// Middleware returns HTTP middleware allowing each client rule.Requests
+// requests per rule.Window across the routes it wraps. scope namespaces the
+// counters so separately limited route groups don't share a budget. When the
+// limiter fails the request is served unlimited (logged), rather than taking
+// the routes down with it.
+func Middleware(log logger.Logger, limiter Limiter, scope string, rule Rule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d, err := limiter.Allow(r.Context(), Check{Key: scope + ":" + ClientIP(r), Rule: rule})
+			if err != nil {
+				log.WarnWithContext(r.Context(), "rate limiter unavailable, serving without limit",
+					logger.F("scope", scope), logger.F("error", err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if refused(w, r, d[0]) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Identity returns what a request is counted as for one key type, or "" when
+// it has no such identity (e.g. an anonymous request for KeyUser).
+type Identity func(r *http.Request) string
+
+// keyTypes is the order Routes checks a group's limits in.
+var keyTypes = []KeyType{KeyIP, KeyUser, KeyTenant, KeyAPIKey}
+
+// Routes returns HTTP middleware enforcing cfg's groups. A request belongs to
+// the group with the longest path prefix matching it, and is counted once per
+// key type that group limits: by ClientIP, by the ctxkit user and tenant that
+// authentication seeded, and by whatever identities adds (KeyAPIKey has no
+// default). All of those budgets are judged in one Limiter call: the request
+// is refused when any of them is spent, and then counted in none, so a client
+// turned away by one limit doesn't drain the others. The headers report the
+// tightest budget. Requests outside every group pass untouched, and a failing
+// limiter serves the request unlimited (logged), as in Middleware.
+func Routes(log logger.Logger, limiter Limiter, cfg Config, identities map[KeyType]Identity) func(http.Handler) http.Handler {
+	ids := map[KeyType]Identity{
+		KeyIP:     ClientIP,
+		KeyUser:   func(r *http.Request) string { return ctxkit.UserID(r.Context()) },
+		KeyTenant: func(r *http.Request) string { return ctxkit.TenantID(r.Context()) },
+	}
+	for kt, id := range identities {
+		ids[kt] = id
+	}
+	type route struct{ prefix, group string }
+	var routes []route
+	for name, g := range cfg.Groups {
+		for _, p := range g.Paths {
+			routes = append(routes, route{prefix: p, group: name})
+		}
+	}
+	slices.SortFunc(routes, func(a, b route) int { return len(b.prefix) - len(a.prefix) })
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			i := slices.IndexFunc(routes, func(rt route) bool { return strings.HasPrefix(r.URL.Path, rt.prefix) })
+			if i < 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			name := routes[i].group
+			group := cfg.Groups[name]
+
+			var checks []Check
+			for _, kt := range keyTypes {
+				rule, ok := group.Limits[kt]
+				if !ok || ids[kt] == nil {
+					continue
+				}
+				if id := ids[kt](r); id != "" {
+					checks = append(checks, Check{Key: name + ":" + string(kt) + ":" + id, Rule: rule})
+				}
+			}
+			if len(checks) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			decisions, err := limiter.Allow(r.Context(), checks...)
+			if err != nil {
+				log.WarnWithContext(r.Context(), "rate limiter unavailable, serving without limit",
+					logger.F("scope", name), logger.F("error", err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			tightest := decisions[0]
+			for _, d := range decisions[1:] {
+				if tighter(d, tightest) {
+					tightest = d
+				}
+			}
+			if refused(w, r, tightest) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// tighter reports whether a leaves the client less room than b: a refusal
+// over an allowance, the longer wait of two refusals, the fewer remaining
+// requests of two allowances.
+func tighter(a, b Decision) bool {
+	if a.Allowed != b.Allowed {
+		return !a.Allowed
+	}
+	if !a.Allowed {
+		return a.RetryAfter > b.RetryAfter
+	}
+	return a.Remaining < b.Remaining
+}
+
+// refused sets d's RateLimit-* headers and, when d refuses the request,
+// answers 429 with Retry-After. It reports whether it answered.
+func refused(w http.ResponseWriter, r *http.Request, d Decision) bool {
+	seconds := strconv.Itoa(int(math.Ceil(d.RetryAfter.Seconds())))
+	w.Header().Set(HeaderLimit, strconv.Itoa(d.Limit))
+	w.Header().Set(HeaderRemaining, strconv.Itoa(d.Remaining))
+	w.Header().Set(HeaderReset, seconds)
+	if d.Allowed {
+		return false
+	}
+	w.Header().Set(HeaderRetryAfter, seconds)
+	problem.Write(w, r, errcode.TooManyRequests.New())
+	return true
+}
+
+// ClientIP returns the host part of the request's remote address, the key
+// clients are limited by. Deployments behind a proxy should rewrite
+// RemoteAddr (e.g. chi's RealIP) before this runs.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
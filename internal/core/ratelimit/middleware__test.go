@@ -0,0 +1,145 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
+	mockratelimit "github.com/biairmal/guest-management-be/mocks/core/ratelimit"
+)
+
+func get(h http.Handler, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/public/rsvp/token", nil)
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	rule := ratelimit.Rule{Requests: 1, Window: time.Minute}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	t.Run("limits each client separately", func(t *testing.T) {
+		h := ratelimit.Middleware(logger.NewNoOp(), ratelimit.NewMemoryLimiter(), "portal", rule)(ok)
+
+		if rec := get(h, "10.0.0.1:5000"); rec.Code != http.StatusOK {
+			t.Fatalf("first request = %d, want 200", rec.Code)
+		}
+		rec := get(h, "10.0.0.1:5001")
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("second request = %d, want 429", rec.Code)
+		}
+		if got := rec.Header().Get(ratelimit.HeaderRetryAfter); got != "60" {
+			t.Errorf("Retry-After = %q, want 60", got)
+		}
+		if rec := get(h, "10.0.0.2:5000"); rec.Code != http.StatusOK {
+			t.Errorf("other client = %d, want 200", rec.Code)
+		}
+	})
+
+	t.Run("serves unlimited when the limiter fails", func(t *testing.T) {
+		limiter := mockratelimit.NewMockLimiter(gomock.NewController(t))
+		limiter.EXPECT().Allow(gomock.Any(), ratelimit.Check{Key: "portal:10.0.0.1", Rule: rule}).
+			Return(nil, errors.New("redis down"))
+		h := ratelimit.Middleware(logger.NewNoOp(), limiter, "portal", rule)(ok)
+
+		if rec := get(h, "10.0.0.1:5000"); rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want 200", rec.Code)
+		}
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	cfg := ratelimit.Config{Enabled: true, Groups: map[string]ratelimit.Group{
+		"api": {Paths: []string{"/api/v1/"}, Limits: map[ratelimit.KeyType]ratelimit.Rule{
+			ratelimit.KeyIP:     {Requests: 3, Window: time.Minute},
+			ratelimit.KeyUser:   {Requests: 1, Window: time.Minute},
+			ratelimit.KeyAPIKey: {Requests: 2, Window: time.Minute},
+		}},
+		"login": {Paths: []string{"/api/v1/auth/"}, Limits: map[ratelimit.KeyType]ratelimit.Rule{
+			ratelimit.KeyIP: {Requests: 1, Window: time.Minute},
+		}},
+	}}
+	identities := map[ratelimit.KeyType]ratelimit.Identity{
+		ratelimit.KeyAPIKey: func(r *http.Request) string { return r.Header.Get("X-Test-Key") },
+	}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	do := func(h http.Handler, path, user, apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "10.0.0.1:5000"
+		if user != "" {
+			req = req.WithContext(ctxkit.WithUserID(req.Context(), user))
+		}
+		if apiKey != "" {
+			req.Header.Set("X-Test-Key", apiKey)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("tightest budget is reported and enforced", func(t *testing.T) {
+		h := ratelimit.Routes(logger.NewNoOp(), ratelimit.NewMemoryLimiter(), cfg, identities)(ok)
+		rec := do(h, "/api/v1/events", "u1", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("first request = %d, want 200", rec.Code)
+		}
+		if got := rec.Header().Get(ratelimit.HeaderRemaining); got != "0" {
+			t.Errorf("RateLimit-Remaining = %q, want 0 (the user budget)", got)
+		}
+		if got := rec.Header().Get(ratelimit.HeaderLimit); got != "1" {
+			t.Errorf("RateLimit-Limit = %q, want 1", got)
+		}
+		rec = do(h, "/api/v1/events", "u1", "")
+		if rec.Code != http.StatusTooManyRequests || rec.Header().Get(ratelimit.HeaderRetryAfter) != "60" {
+			t.Fatalf("second request = %d, Retry-After %q; want 429, 60", rec.Code, rec.Header().Get(ratelimit.HeaderRetryAfter))
+		}
+		if rec := do(h, "/api/v1/events", "u2", ""); rec.Code != http.StatusOK {
+			t.Errorf("other user = %d, want 200", rec.Code)
+		}
+		// The refused request didn't use up the IP budget: 2 of 3 are spent.
+		if rec := do(h, "/api/v1/events", "", ""); rec.Code != http.StatusOK {
+			t.Errorf("third counted request from the IP = %d, want 200", rec.Code)
+		}
+		if rec := do(h, "/api/v1/events", "", ""); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("fourth counted request from the IP = %d, want 429", rec.Code)
+		}
+	})
+
+	t.Run("most specific group wins", func(t *testing.T) {
+		h := ratelimit.Routes(logger.NewNoOp(), ratelimit.NewMemoryLimiter(), cfg, identities)(ok)
+		if rec := do(h, "/api/v1/auth/login", "", ""); rec.Code != http.StatusOK {
+			t.Fatalf("first login = %d, want 200", rec.Code)
+		}
+		if rec := do(h, "/api/v1/auth/login", "", ""); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("second login = %d, want 429", rec.Code)
+		}
+		if rec := do(h, "/api/v1/events", "", ""); rec.Code != http.StatusOK {
+			t.Errorf("api request = %d, want 200 (separate budget)", rec.Code)
+		}
+	})
+
+	t.Run("api keys use the supplied identity", func(t *testing.T) {
+		h := ratelimit.Routes(logger.NewNoOp(), ratelimit.NewMemoryLimiter(), cfg, identities)(ok)
+		if rec := do(h, "/api/v1/events", "", "k1"); rec.Header().Get(ratelimit.HeaderRemaining) != "1" {
+			t.Errorf("RateLimit-Remaining = %q, want 1 (the key budget)", rec.Header().Get(ratelimit.HeaderRemaining))
+		}
+	})
+
+	t.Run("unmatched paths are not limited", func(t *testing.T) {
+		limiter := mockratelimit.NewMockLimiter(gomock.NewController(t))
+		h := ratelimit.Routes(logger.NewNoOp(), limiter, cfg, identities)(ok)
+		rec := do(h, "/health", "", "")
+		if rec.Code != http.StatusOK || rec.Header().Get(ratelimit.HeaderLimit) != "" {
+			t.Errorf("status = %d, RateLimit-Limit = %q; want 200 and no header", rec.Code, rec.Header().Get(ratelimit.HeaderLimit))
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/redis"
+)
+
+// redisLimiter implements Limiter on the shared redis.Client, so every API
+// instance draws on the same budget. It is a sliding window counter: requests
+// are counted per fixed window, and a request is judged by the current
+// window's count plus the previous window's weighted by how much of it the
+// sliding window ending now still covers. That smooths out the double burst a
+// fixed window allows at its edge, for two counters per key. Refused requests
+// are not counted, so a client retrying while limited regains its budget as
+// the window slides on rather than extending its own ban.
+type redisLimiter struct {
+	client redis.Client
+	prefix string
+	now    func() time.Time
+}
+
+// NewRedisLimiter returns a Limiter backed by Redis, keeping its counters
+// under <prefix>:ratelimit:.
+func NewRedisLimiter(client redis.Client, prefix string) Limiter {
+	return &redisLimiter{client: client, prefix: prefix, now: time.Now}
+}
+
+// allowScript judges a request against every budget and counts it in all of
+// them in a single atomic step, so concurrent requests can neither all slip
+// under the limit between a read and an increment nor leave a fresh counter
+// without its expiry, and a request refused by one budget is counted in none.
+// KEYS are each budget's current and previous windows' counters, in pairs;
+// ARGV four values per budget: the limit, the time left in the current window
+// and the window length (both in nanoseconds, weighed in the same order slide
+// does), and the counter TTL in milliseconds. It returns each budget's current
+// count including this request, counted or not, and its previous window's
+// count, in pairs.
+const allowScript = `
+local counts = {}
+local fits = true
+for i = 1, #KEYS / 2 do
+	local a = (i - 1) * 4
+	local cur = tonumber(redis.call('GET', KEYS[2 * i - 1]) or '0') + 1
+	local prev = tonumber(redis.call('GET', KEYS[2 * i]) or '0')
+	if math.floor(prev * tonumber(ARGV[a + 2]) / tonumber(ARGV[a + 3])) + cur > tonumber(ARGV[a + 1]) then
+		fits = false
+	end
+	counts[2 * i - 1] = cur
+	counts[2 * i] = prev
+end
+if fits then
+	for i = 1, #KEYS / 2 do
+		if redis.call('INCR', KEYS[2 * i - 1]) == 1 then
+			redis.call('PEXPIRE', KEYS[2 * i - 1], ARGV[i * 4])
+		end
+	end
+end
+return counts
+`
+
+// Allow implements Limiter.
+func (l *redisLimiter) Allow(ctx context.Context, checks ...Check) ([]Decision, error) {
+	now := l.now().UnixNano()
+	keys := make([]string, 0, 2*len(checks))
+	args := make([]any, 0, 4*len(checks))
+	elapsed := make([]time.Duration, len(checks))
+	for i, c := range checks {
+		slot := now / int64(c.Rule.Window)
+		elapsed[i] = time.Duration(now - slot*int64(c.Rule.Window))
+		// The counter is read as the previous window for one window more.
+		keys = append(keys, l.key(c.Key, slot), l.key(c.Key, slot-1))
+		args = append(args, c.Rule.Requests, int64(c.Rule.Window-elapsed[i]), int64(c.Rule.Window),
+			(2 * c.Rule.Window).Milliseconds())
+	}
+
+	res, err := l.client.Eval(ctx, allowScript, keys, args...)
+	if err != nil {
+		return nil, err
+	}
+	counts, ok := res.([]any)
+	if !ok || len(counts) != 2*len(checks) {
+		return nil, fmt.Errorf("ratelimit: unexpected script result %v", res)
+	}
+	decisions := make([]Decision, len(checks))
+	for i, c := range checks {
+		cur, ok1 := counts[2*i].(int64)
+		prev, ok2 := counts[2*i+1].(int64)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("ratelimit: unexpected script result %v", res)
+		}
+		decisions[i] = slide(prev, cur, c.Rule, elapsed[i])
+	}
+	return decisions, nil
+}
+
+// key returns the Redis key of key's counter in window slot.
+func (l *redisLimiter) key(key string, slot int64) string {
+	return l.prefix + ":ratelimit:" + key + ":" + strconv.FormatInt(slot, 10)
+}
+
+// slide builds the Decision for a request counted into cur, elapsed into the
+// current fixed window, whose predecessor counted prev. An allowed request is
+// told when the current window ends; a refused one how long until the next
+// request would fit, assuming no more arrive meanwhile.
+func slide(prev, cur int64, rule Rule, elapsed time.Duration) Decision {
+	left := rule.Window - elapsed
+	limit := int64(rule.Requests)
+	count := int64(float64(prev)*float64(left)/float64(rule.Window)) + cur
+	d := decide(int(count), rule.Requests, left)
+	if d.Allowed {
+		return d
+	}
+	if cur < limit {
+		// Fits once the previous window's share drops below limit-cur.
+		d.RetryAfter = left - time.Duration(float64(rule.Window)*float64(limit-cur)/float64(prev))
+	} else {
+		// Fits in the next window, once this window's share drops below limit.
+		d.RetryAfter = left + rule.Window - time.Duration(float64(rule.Window)*float64(limit)/float64(cur))
+	}
+	d.RetryAfter = max(d.RetryAfter, time.Second)
+	return d
+}
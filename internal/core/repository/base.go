@@ -4,6 +4,7 @@
 package repository
 
 import (
+	"context"
 	"time"
 
 	"github.com/biairmal/go-sdk/lib/logger"
@@ -13,8 +14,30 @@ import (
 	"github.com/biairmal/go-sdk/lib/repository/sql"
 	"github.com/biairmal/go-sdk/lib/sqlkit"
 	"github.com/biairmal/guest-management-be/internal/core/audit"
+	"github.com/biairmal/guest-management-be/internal/core/tracing"
 )
 
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/core/repository/mock_repository.go -package=mockcorerepository github.com/biairmal/guest-management-be/internal/core/repository Repository
+
+// Repository is the go-sdk repository.Repository plus the soft-delete
+// lifecycle operations added by the audit decorator. Services that need to
+// undo a delete or erase data depend on this instead of the go-sdk interface.
+type Repository[TEntity any, TID comparable] interface {
+	repository.Repository[TEntity, TID]
+	// UpdateIfMatch is Update made conditional on a client's If-Match value
+	// (see internal/core/etag); "" or "*" means no precondition. Returns
+	// ErrVersionMismatch when the row has moved on, was soft-deleted, or
+	// ifMatch is malformed.
+	UpdateIfMatch(ctx context.Context, id TID, entity *TEntity, ifMatch string) error
+	// Restore clears deleted_at on a soft-deleted entity. Returns
+	// repository.ErrNotFound when the entity is missing or not deleted, and
+	// repository.ErrAlreadyExists when restoring would violate a unique key.
+	Restore(ctx context.Context, id TID) error
+	// Purge permanently removes an already soft-deleted entity. Returns
+	// repository.ErrNotFound when the entity is missing or still live.
+	Purge(ctx context.Context, id TID) error
+}
+
 // CacheOptions configures the optional caching decorator NewRepository applies
 // on top of the audit-wrapped repository. Client is nil when Redis isn't
 // wired; NewRepository treats a nil Client the same as Enabled == false, so
@@ -32,38 +55,62 @@ type CacheOptions struct {
 // selectColumns used for reads (GetByID, List). TID is kept typed all the
 // way through the service layer — never widen it to `any`.
 //
+// UpdateIfMatch takes the If-Match precondition a handler read from its
+// request (see internal/core/etag): the write becomes a conditional UPDATE on
+// updated_at and fails with ErrVersionMismatch when the row has moved on.
+// Every other write, Update and the soft-delete lifecycle included, is
+// unconditional.
+//
 // When cacheOpts.Enabled is true and cacheOpts.Client is non-nil, the result
-// is additionally wrapped in the go-sdk cache decorator, keyed by table name
-// (optionally namespaced under cacheOpts.Prefix).
+// is additionally wrapped in the go-sdk cache decorator, which caches
+// entities by id (lists and counts always read the database), keyed by table
+// name (optionally namespaced under cacheOpts.Prefix), and its lookups are counted
+// as hits and misses per table in internal/core/metrics and traced as spans.
+// Inside a transaction opened by internal/core/transaction the cache is
+// bypassed and written keys are invalidated only after commit.
+//
+// When tracing is enabled (see internal/core/tracing) the whole stack is
+// wrapped once more so every call is a span named <table>.<Operation>.
 func NewRepository[TEntity any, TID comparable](
 	log logger.Logger,
 	db *sqlkit.DB,
 	table string,
 	selectColumns []string,
 	cacheOpts CacheOptions,
-) repository.Repository[TEntity, TID] {
+) Repository[TEntity, TID] {
 	sqlRepo := sql.NewSQLRepository[TEntity, TID](
 		log,
 		db,
 		table,
 		sql.WithSelectColumns[TEntity, TID](selectColumns),
 	)
-	auditRepo := audit.NewAuditableRepository[TEntity, TID](sqlRepo)
+	versionedRepo := newVersionedRepository[TEntity, TID](sqlRepo, db, table)
+	auditRepo := audit.NewAuditableRepository[TEntity, TID](versionedRepo)
 
-	if !cacheOpts.Enabled || cacheOpts.Client == nil {
-		return auditRepo
+	var repo Repository[TEntity, TID] = auditRepo
+	if cacheOpts.Enabled && cacheOpts.Client != nil {
+		namespace := table
+		if cacheOpts.Prefix != "" {
+			namespace = cacheOpts.Prefix + ":" + table
+		}
+		keys := cache.NewDefaultKeyGenerator(namespace)
+		repo = &cachedRepository[TEntity, TID]{
+			Repository: cache.NewCachedRepository[TEntity, TID](
+				auditRepo,
+				&instrumentedClient{Client: cacheOpts.Client, table: table},
+				cache.WithKeyGenerator(keys),
+				cache.WithTTL(cacheOpts.TTL),
+				cache.WithStrategy(cacheOpts.Strategy),
+			),
+			audit:  auditRepo,
+			client: cacheOpts.Client,
+			keys:   keys,
+			log:    log,
+		}
 	}
 
-	namespace := table
-	if cacheOpts.Prefix != "" {
-		namespace = cacheOpts.Prefix + ":" + table
+	if tracing.Enabled() {
+		repo = newTracedRepository[TEntity, TID](repo, table)
 	}
-
-	return cache.NewCachedRepository[TEntity, TID](
-		auditRepo,
-		cacheOpts.Client,
-		cache.WithKeyGenerator(cache.NewDefaultKeyGenerator(namespace)),
-		cache.WithTTL(cacheOpts.TTL),
-		cache.WithStrategy(cacheOpts.Strategy),
-	)
+	return repo
 }
@@ -41,7 +41,10 @@ func TestNewRepository_CacheWrapping(t *testing.T) {
 			repo := NewRepository[testEntity, string](
 				logger.NewNoOp(), nil, "test_entities", []string{"id"}, tt.cacheOpts,
 			)
-			_, isCached := repo.(*cache.CachedRepository[testEntity, string])
+			var isCached bool
+			if wrapped, ok := repo.(*cachedRepository[testEntity, string]); ok {
+				_, isCached = wrapped.Repository.(*cache.CachedRepository[testEntity, string])
+			}
 			if isCached != tt.wantCache {
 				t.Errorf("cached = %v, want %v", isCached, tt.wantCache)
 			}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/redis"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/repository/cache"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/biairmal/guest-management-be/internal/core/audit"
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/tracing"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+// cachedRepository caches entities by id through the go-sdk cache decorator.
+// UpdateIfMatch, Restore and Purge go to the audit decorator and then evict
+// the entity's key, like any other write.
+//
+// List and Count are never cached: any write can change any page or total,
+// and audit.IncludeDeleted travels on the context, where the decorator's keys
+// can't see it, so one cached page could answer for both views.
+//
+// Inside a transaction (see internal/core/transaction) every call bypasses
+// the cache: reads must see the transaction's own uncommitted writes and must
+// not populate the cache with them, and a write's cache entry is deleted only
+// once the transaction commits, so a concurrent reader can never re-cache the
+// pre-commit row after an early invalidation.
+type cachedRepository[TEntity any, TID comparable] struct {
+	repository.Repository[TEntity, TID]
+	audit  *audit.AuditableRepository[TEntity, TID]
+	client redis.Client
+	keys   cache.KeyGenerator
+	log    logger.Logger
+}
+
+// GetByID reads through the cache outside a transaction.
+func (r *cachedRepository[TEntity, TID]) GetByID(ctx context.Context, id TID) (*TEntity, error) {
+	if transaction.InTx(ctx) {
+		return r.audit.GetByID(ctx, id)
+	}
+	return r.Repository.GetByID(ctx, id)
+}
+
+// List reads from the database.
+func (r *cachedRepository[TEntity, TID]) List(
+	ctx context.Context, opts *repository.ListOptions,
+) (entities []*TEntity, total int64, err error) {
+	return r.audit.List(ctx, opts)
+}
+
+// Count reads from the database.
+func (r *cachedRepository[TEntity, TID]) Count(ctx context.Context, filter repository.Filter) (int64, error) {
+	return r.audit.Count(ctx, filter)
+}
+
+// Exists reads through the cache outside a transaction.
+func (r *cachedRepository[TEntity, TID]) Exists(ctx context.Context, id TID) (bool, error) {
+	if transaction.InTx(ctx) {
+		return r.audit.Exists(ctx, id)
+	}
+	return r.Repository.Exists(ctx, id)
+}
+
+// Create writes through the cache decorator outside a transaction. A new row
+// has no cache entry, so nothing needs invalidating after commit.
+func (r *cachedRepository[TEntity, TID]) Create(ctx context.Context, entity *TEntity) error {
+	if transaction.InTx(ctx) {
+		return r.audit.Create(ctx, entity)
+	}
+	return r.Repository.Create(ctx, entity)
+}
+
+// Update writes through the cache decorator outside a transaction; inside
+// one it invalidates the entity's key after commit.
+func (r *cachedRepository[TEntity, TID]) Update(ctx context.Context, id TID, entity *TEntity) error {
+	if !transaction.InTx(ctx) {
+		return r.Repository.Update(ctx, id, entity)
+	}
+	if err := r.audit.Update(ctx, id, entity); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// UpdateIfMatch goes to the audit decorator, which the go-sdk cache decorator
+// doesn't expose it through, and evicts the entity.
+func (r *cachedRepository[TEntity, TID]) UpdateIfMatch(
+	ctx context.Context, id TID, entity *TEntity, ifMatch string,
+) error {
+	if err := r.audit.UpdateIfMatch(ctx, id, entity, ifMatch); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// Delete writes through the cache decorator outside a transaction; inside
+// one it invalidates the entity's key after commit.
+func (r *cachedRepository[TEntity, TID]) Delete(ctx context.Context, id TID) error {
+	if !transaction.InTx(ctx) {
+		return r.Repository.Delete(ctx, id)
+	}
+	if err := r.audit.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// Restore delegates to the audit decorator's Restore and evicts the entity.
+func (r *cachedRepository[TEntity, TID]) Restore(ctx context.Context, id TID) error {
+	if err := r.audit.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// Purge delegates to the audit decorator's Purge and evicts the entity.
+func (r *cachedRepository[TEntity, TID]) Purge(ctx context.Context, id TID) error {
+	if err := r.audit.Purge(ctx, id); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// invalidate deletes id's cache entry, under the key the cache decorator's
+// key generator gives it, once the transaction on ctx commits or right away
+// outside one. A failed delete is logged, not returned: the write is already
+// committed and the entry expires with its TTL anyway.
+func (r *cachedRepository[TEntity, TID]) invalidate(ctx context.Context, id TID) {
+	key := r.keys.GenerateKey(id)
+	transaction.AfterCommit(ctx, func(ctx context.Context) {
+		if err := r.client.Del(ctx, key); err != nil {
+			r.log.WarnWithContext(ctx, "cache invalidation after commit failed",
+				logger.F("key", key), logger.F("error", err))
+		}
+	})
+}
+
+// instrumentedClient is the redis.Client handed to the go-sdk cache
+// decorator. It counts the decorator's lookups (Get) as hits, misses or errors
+// of table and traces each as a span; every other call passes straight
+// through.
+type instrumentedClient struct {
+	redis.Client
+	table string
+}
+
+// Get implements redis.Client. A miss is an ordinary outcome, not a failed
+// span.
+func (c *instrumentedClient) Get(ctx context.Context, key string) (string, error) {
+	ctx, span := tracing.Start(ctx, "cache.Get", trace.SpanKindClient, attrTable.String(c.table))
+	v, err := c.Client.Get(ctx, key)
+	var result string
+	var spanErr error
+	switch {
+	case err == nil:
+		result = metrics.CacheHit
+	case errors.Is(err, redis.ErrNil):
+		result = metrics.CacheMiss
+	default:
+		result, spanErr = metrics.CacheError, err
+	}
+	metrics.CacheLookup(c.table, result)
+	span.SetAttributes(attrCacheResult.String(result))
+	tracing.End(span, spanErr)
+	return v, err
+}
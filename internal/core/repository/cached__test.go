@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/repository/cache"
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	mockrepository "github.com/biairmal/go-sdk/mocks/repository"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/audit"
+)
+
+// newTestCached returns a cachedRepository over an inner SQL repository mock
+// and a Redis mock that expects no calls unless a test adds them.
+func newTestCached(t *testing.T) (
+	*cachedRepository[versionedEntity, string],
+	*mockrepository.MockRepository[versionedEntity, string],
+	*mockredis.MockClient,
+) {
+	ctrl := gomock.NewController(t)
+	inner := mockrepository.NewMockRepository[versionedEntity, string](ctrl)
+	client := mockredis.NewMockClient(ctrl)
+	auditRepo := audit.NewAuditableRepository[versionedEntity, string](inner)
+	keys := cache.NewDefaultKeyGenerator("gm:test_entities")
+	return &cachedRepository[versionedEntity, string]{
+		Repository: cache.NewCachedRepository[versionedEntity, string](auditRepo, client, cache.WithKeyGenerator(keys)),
+		audit:      auditRepo, client: client, keys: keys, log: logger.NewNoOp(),
+	}, inner, client
+}
+
+func TestCachedRepository_RestorePurgeInvalidate(t *testing.T) {
+	deleted := time.Now()
+	key := cache.NewDefaultKeyGenerator("gm:test_entities").GenerateKey("1")
+	tests := []struct {
+		name    string
+		call    func(*cachedRepository[versionedEntity, string]) error
+		expect  func(*mockrepository.MockRepository[versionedEntity, string])
+		wantErr error
+	}{
+		{
+			name: "restore",
+			call: func(r *cachedRepository[versionedEntity, string]) error { return r.Restore(context.Background(), "1") },
+			expect: func(inner *mockrepository.MockRepository[versionedEntity, string]) {
+				inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "purge",
+			call: func(r *cachedRepository[versionedEntity, string]) error { return r.Purge(context.Background(), "1") },
+			expect: func(inner *mockrepository.MockRepository[versionedEntity, string]) {
+				inner.EXPECT().Delete(gomock.Any(), "1").Return(nil)
+			},
+		},
+		{
+			name: "failed restore keeps the entry",
+			call: func(r *cachedRepository[versionedEntity, string]) error { return r.Restore(context.Background(), "1") },
+			expect: func(inner *mockrepository.MockRepository[versionedEntity, string]) {
+				inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(repository.ErrAlreadyExists)
+			},
+			wantErr: repository.ErrAlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, inner, client := newTestCached(t)
+			inner.EXPECT().GetByID(gomock.Any(), "1").Return(&versionedEntity{ID: "1", DeletedAt: &deleted}, nil)
+			tt.expect(inner)
+			if tt.wantErr == nil {
+				client.EXPECT().Del(gomock.Any(), key).Return(nil)
+			}
+			if err := tt.call(repo); err != tt.wantErr {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCachedRepository_UpdateIfMatchInvalidates expects a conditional update,
+// which bypasses the go-sdk cache decorator, to evict the entity itself.
+func TestCachedRepository_UpdateIfMatchInvalidates(t *testing.T) {
+	repo, inner, client := newTestCached(t)
+	inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(nil)
+	client.EXPECT().Del(gomock.Any(), cache.NewDefaultKeyGenerator("gm:test_entities").GenerateKey("1")).Return(nil)
+
+	if err := repo.UpdateIfMatch(context.Background(), "1", &versionedEntity{ID: "1"}, ""); err != nil {
+		t.Errorf("UpdateIfMatch() error = %v", err)
+	}
+}
+
+// TestCachedRepository_ListsReadTheDatabase expects lists and counts, with
+// and without soft-deleted rows, to skip Redis entirely (the client mock
+// fails on any call) and to keep the soft-delete filter per context.
+func TestCachedRepository_ListsReadTheDatabase(t *testing.T) {
+	for _, includeDeleted := range []bool{false, true} {
+		repo, inner, _ := newTestCached(t)
+		ctx := context.Background()
+		if includeDeleted {
+			ctx = audit.IncludeDeleted(ctx)
+		}
+		wantConditions := 1
+		if includeDeleted {
+			wantConditions = 0
+		}
+		inner.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, opts *repository.ListOptions) ([]*versionedEntity, int64, error) {
+				if len(opts.Filter.Conditions) != wantConditions {
+					t.Errorf("include deleted %v: list conditions = %v", includeDeleted, opts.Filter.Conditions)
+				}
+				return nil, 0, nil
+			})
+		inner.EXPECT().Count(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, f repository.Filter) (int64, error) {
+				if len(f.Conditions) != wantConditions {
+					t.Errorf("include deleted %v: count conditions = %v", includeDeleted, f.Conditions)
+				}
+				return 0, nil
+			})
+
+		if _, _, err := repo.List(ctx, &repository.ListOptions{}); err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if _, err := repo.Count(ctx, repository.Filter{}); err != nil {
+			t.Fatalf("Count: %v", err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+)
+
+// Querier is the subset of *sql.DB and *sql.Tx used by hand-written queries.
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Conn returns the transaction carried by ctx (see internal/core/transaction),
+// or the leader pool when there is none, so hand-written SQL joins an open
+// unit of work exactly like the repositories built by NewRepository.
+func Conn(ctx context.Context, db *sqlkit.DB) Querier {
+	if tx, ok := sqlkit.TxFromContext(ctx); ok {
+		return tx
+	}
+	return db.Leader()
+}
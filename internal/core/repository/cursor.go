@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+)
+
+// DefaultFetchSize is the number of rows Stream fetches per round trip when
+// called with fetchSize <= 0.
+const DefaultFetchSize = 1000
+
+// Stream runs query through a server-side cursor and calls scan once per row,
+// fetching fetchSize rows per round trip, so arbitrarily large result sets
+// (exports) are read with bounded memory on both ends. It runs in its own
+// read-only transaction, which a cursor requires. scan must only
+// read the current row; returning an error stops the stream and is returned.
+func Stream(
+	ctx context.Context, db *sqlkit.DB, fetchSize int, query string, args []any, scan func(*sql.Rows) error,
+) error {
+	if fetchSize <= 0 {
+		fetchSize = DefaultFetchSize
+	}
+	tx, err := db.Leader().BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Read-only: rolling back is how the cursor and snapshot are released.
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "DECLARE stream_cursor NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return err
+	}
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM stream_cursor", fetchSize)
+	for {
+		n, err := fetchBatch(ctx, tx, fetch, scan)
+		if err != nil {
+			return err
+		}
+		if n < fetchSize {
+			return nil
+		}
+	}
+}
+
+// fetchBatch runs one FETCH and scans its rows, returning how many it got.
+func fetchBatch(ctx context.Context, tx *sql.Tx, fetch string, scan func(*sql.Rows) error) (int, error) {
+	rows, err := tx.QueryContext(ctx, fetch)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+	n := 0
+	for rows.Next() {
+		n++
+		if err := scan(rows); err != nil {
+			return n, err
+		}
+	}
+	return n, rows.Err()
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/biairmal/guest-management-be/internal/core/tracing"
+)
+
+// Repository span attributes.
+const (
+	attrTable     = attribute.Key("db.table")
+	attrOperation = attribute.Key("db.operation")
+	attrRows      = attribute.Key("db.rows")
+	// attrCacheResult is the outcome of a cache lookup span: hit, miss or
+	// error, as counted in internal/core/metrics.
+	attrCacheResult = attribute.Key("cache.result")
+)
+
+// tracedRepository is the outermost decorator: one span per call named
+// <table>.<Operation>, so a request's trace shows how long each repository
+// call took, cache included, and how many rows it touched. A
+// repository.ErrNotFound is an ordinary outcome (zero rows), not a failed
+// span.
+type tracedRepository[TEntity any, TID comparable] struct {
+	inner Repository[TEntity, TID]
+	table string
+}
+
+// newTracedRepository wraps inner with spans for table's operations.
+func newTracedRepository[TEntity any, TID comparable](
+	inner Repository[TEntity, TID], table string,
+) Repository[TEntity, TID] {
+	return &tracedRepository[TEntity, TID]{inner: inner, table: table}
+}
+
+// start opens the span of operation op.
+func (r *tracedRepository[TEntity, TID]) start(ctx context.Context, op string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, r.table+"."+op, trace.SpanKindInternal,
+		attrTable.String(r.table), attrOperation.String(op))
+}
+
+// end records rows and err on span and ends it.
+func end(span trace.Span, rows int64, err error) {
+	if errors.Is(err, repository.ErrNotFound) {
+		rows, err = 0, nil
+	}
+	span.SetAttributes(attrRows.Int64(rows))
+	tracing.End(span, err)
+}
+
+// affected is the row count of a single-row write that returned err.
+func affected(err error) int64 {
+	if err != nil {
+		return 0
+	}
+	return 1
+}
+
+// Create implements Repository.
+func (r *tracedRepository[TEntity, TID]) Create(ctx context.Context, entity *TEntity) error {
+	ctx, span := r.start(ctx, "Create")
+	err := r.inner.Create(ctx, entity)
+	end(span, affected(err), err)
+	return err
+}
+
+// GetByID implements Repository.
+func (r *tracedRepository[TEntity, TID]) GetByID(ctx context.Context, id TID) (*TEntity, error) {
+	ctx, span := r.start(ctx, "GetByID")
+	entity, err := r.inner.GetByID(ctx, id)
+	end(span, affected(err), err)
+	return entity, err
+}
+
+// List implements Repository. The row count is the page's length, not the
+// total.
+func (r *tracedRepository[TEntity, TID]) List(
+	ctx context.Context, opts *repository.ListOptions,
+) (entities []*TEntity, total int64, err error) {
+	ctx, span := r.start(ctx, "List")
+	entities, total, err = r.inner.List(ctx, opts)
+	end(span, int64(len(entities)), err)
+	return entities, total, err
+}
+
+// Count implements Repository.
+func (r *tracedRepository[TEntity, TID]) Count(ctx context.Context, filter repository.Filter) (int64, error) {
+	ctx, span := r.start(ctx, "Count")
+	n, err := r.inner.Count(ctx, filter)
+	end(span, n, err)
+	return n, err
+}
+
+// Exists implements Repository.
+func (r *tracedRepository[TEntity, TID]) Exists(ctx context.Context, id TID) (bool, error) {
+	ctx, span := r.start(ctx, "Exists")
+	ok, err := r.inner.Exists(ctx, id)
+	var rows int64
+	if ok {
+		rows = 1
+	}
+	end(span, rows, err)
+	return ok, err
+}
+
+// Update implements Repository.
+func (r *tracedRepository[TEntity, TID]) Update(ctx context.Context, id TID, entity *TEntity) error {
+	ctx, span := r.start(ctx, "Update")
+	err := r.inner.Update(ctx, id, entity)
+	end(span, affected(err), err)
+	return err
+}
+
+// UpdateIfMatch implements Repository.
+func (r *tracedRepository[TEntity, TID]) UpdateIfMatch(
+	ctx context.Context, id TID, entity *TEntity, ifMatch string,
+) error {
+	ctx, span := r.start(ctx, "UpdateIfMatch")
+	err := r.inner.UpdateIfMatch(ctx, id, entity, ifMatch)
+	end(span, affected(err), err)
+	return err
+}
+
+// Delete implements Repository.
+func (r *tracedRepository[TEntity, TID]) Delete(ctx context.Context, id TID) error {
+	ctx, span := r.start(ctx, "Delete")
+	err := r.inner.Delete(ctx, id)
+	end(span, affected(err), err)
+	return err
+}
+
+// Restore implements Repository.
+func (r *tracedRepository[TEntity, TID]) Restore(ctx context.Context, id TID) error {
+	ctx, span := r.start(ctx, "Restore")
+	err := r.inner.Restore(ctx, id)
+	end(span, affected(err), err)
+	return err
+}
+
+// Purge implements Repository.
+func (r *tracedRepository[TEntity, TID]) Purge(ctx context.Context, id TID) error {
+	ctx, span := r.start(ctx, "Purge")
+	err := r.inner.Purge(ctx, id)
+	end(span, affected(err), err)
+	return err
+}
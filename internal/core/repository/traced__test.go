@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/tracing"
+	mockcorerepository "github.com/biairmal/guest-management-be/mocks/core/repository"
+)
+
+func TestTracedRepository(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracing.Enable(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name    string
+		call    func(repo Repository[testEntity, string]) error
+		expect  func(inner *mockcorerepository.MockRepository[testEntity, string])
+		span    string
+		rows    int64
+		failed  bool
+		wantErr error
+	}{
+		{
+			name: "get counts the row",
+			call: func(repo Repository[testEntity, string]) error {
+				_, err := repo.GetByID(context.Background(), "1")
+				return err
+			},
+			expect: func(inner *mockcorerepository.MockRepository[testEntity, string]) {
+				inner.EXPECT().GetByID(gomock.Any(), "1").Return(&testEntity{ID: "1"}, nil)
+			},
+			span: "test_entities.GetByID", rows: 1,
+		},
+		{
+			name: "not found is not a failure",
+			call: func(repo Repository[testEntity, string]) error {
+				_, err := repo.GetByID(context.Background(), "1")
+				return err
+			},
+			expect: func(inner *mockcorerepository.MockRepository[testEntity, string]) {
+				inner.EXPECT().GetByID(gomock.Any(), "1").Return(nil, repository.ErrNotFound)
+			},
+			span: "test_entities.GetByID", wantErr: repository.ErrNotFound,
+		},
+		{
+			name: "list counts the page",
+			call: func(repo Repository[testEntity, string]) error {
+				_, _, err := repo.List(context.Background(), &repository.ListOptions{})
+				return err
+			},
+			expect: func(inner *mockcorerepository.MockRepository[testEntity, string]) {
+				inner.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*testEntity{{ID: "1"}, {ID: "2"}}, int64(9), nil)
+			},
+			span: "test_entities.List", rows: 2,
+		},
+		{
+			name: "failed write marks the span",
+			call: func(repo Repository[testEntity, string]) error {
+				return repo.Update(context.Background(), "1", &testEntity{ID: "1"})
+			},
+			expect: func(inner *mockcorerepository.MockRepository[testEntity, string]) {
+				inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(errBoom)
+			},
+			span: "test_entities.Update", failed: true, wantErr: errBoom,
+		},
+		{
+			name: "conditional write passes the precondition",
+			call: func(repo Repository[testEntity, string]) error {
+				return repo.UpdateIfMatch(context.Background(), "1", &testEntity{ID: "1"}, `"1"`)
+			},
+			expect: func(inner *mockcorerepository.MockRepository[testEntity, string]) {
+				inner.EXPECT().UpdateIfMatch(gomock.Any(), "1", gomock.Any(), `"1"`).Return(ErrVersionMismatch)
+			},
+			span: "test_entities.UpdateIfMatch", failed: true, wantErr: ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := mockcorerepository.NewMockRepository[testEntity, string](gomock.NewController(t))
+			tt.expect(inner)
+			before := len(recorder.Ended())
+
+			err := tt.call(newTracedRepository[testEntity, string](inner, "test_entities"))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			spans := recorder.Ended()[before:]
+			if len(spans) != 1 {
+				t.Fatalf("ended %d spans, want 1", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != tt.span {
+				t.Errorf("span name = %q, want %q", span.Name(), tt.span)
+			}
+			attrs := map[attribute.Key]attribute.Value{}
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value
+			}
+			if got := attrs[attrTable].AsString(); got != "test_entities" {
+				t.Errorf("%s = %q, want test_entities", attrTable, got)
+			}
+			if got := attrs[attrRows].AsInt64(); got != tt.rows {
+				t.Errorf("%s = %d, want %d", attrRows, got, tt.rows)
+			}
+			if got := attrs[tracing.AttrError].AsBool(); got != tt.failed {
+				t.Errorf("%s = %v, want %v", tracing.AttrError, got, tt.failed)
+			}
+			if failed := span.Status().Code == codes.Error; failed != tt.failed {
+				t.Errorf("error status = %v, want %v", failed, tt.failed)
+			}
+		})
+	}
+}
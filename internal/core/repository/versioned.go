@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/lib/pq"
+
+	"github.com/biairmal/guest-management-be/internal/core/etag"
+)
+
+// ErrVersionMismatch is returned by UpdateIfMatch when the If-Match
+// precondition (see internal/core/etag) fails: the row's updated_at no longer
+// equals the expected version because someone else modified it first.
+var ErrVersionMismatch = errors.New("repository: version mismatch")
+
+// uniqueViolation is the PostgreSQL SQLSTATE of a unique index violation.
+const uniqueViolation = "23505"
+
+// versionedRepository sits directly on top of the go-sdk SQL repository and
+// adds UpdateIfMatch, a conditional UPDATE on the version the caller passes.
+// Every repository.Repository call, Update included, is delegated unchanged,
+// so internal writes (soft delete, restore, jobs) are never subject to a
+// client's precondition. The UPDATE joins the transaction on ctx, if any (see
+// internal/core/transaction). It must stay innermost: the audit decorator has
+// already stamped the new updated_at by the time UpdateIfMatch reaches it.
+type versionedRepository[TEntity any, TID comparable] struct {
+	repository.Repository[TEntity, TID]
+	db    *sqlkit.DB
+	table string
+}
+
+// newVersionedRepository wraps inner with If-Match-aware updates against table.
+func newVersionedRepository[TEntity any, TID comparable](
+	inner repository.Repository[TEntity, TID], db *sqlkit.DB, table string,
+) *versionedRepository[TEntity, TID] {
+	return &versionedRepository[TEntity, TID]{Repository: inner, db: db, table: table}
+}
+
+// UpdateIfMatch writes entity only if the stored updated_at equals the
+// version in ifMatch; an empty or "*" ifMatch is a plain Update. It returns
+// ErrVersionMismatch when the versions differ, the token is malformed, or the
+// row was soft-deleted (the version the client holds is no longer current);
+// repository.ErrNotFound when the row doesn't exist; and
+// repository.ErrAlreadyExists when the new values collide with a unique key,
+// as the go-sdk SQL repository reports it.
+func (r *versionedRepository[TEntity, TID]) UpdateIfMatch(
+	ctx context.Context, id TID, entity *TEntity, ifMatch string,
+) error {
+	expected, ok, err := etag.Precondition(ifMatch)
+	if !ok {
+		return r.Repository.Update(ctx, id, entity)
+	}
+	if err != nil {
+		return ErrVersionMismatch
+	}
+
+	columns, values := updatableColumns(entity)
+	if len(columns) == 0 {
+		return repository.ErrInvalidEntity
+	}
+	assignments := make([]string, len(columns))
+	for i, col := range columns {
+		assignments[i] = fmt.Sprintf("%s = $%d", col, i+1)
+	}
+	n := len(values)
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE id = $%d AND updated_at = $%d AND deleted_at IS NULL",
+		r.table, strings.Join(assignments, ", "), n+1, n+2,
+	)
+	args := append(values, id, expected)
+
+	conn := Conn(ctx, r.db)
+	res, err := conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return driverError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected > 0 {
+		return nil
+	}
+	// Nothing matched: tell a row that moved on or was soft-deleted (both
+	// fail the precondition) apart from one that never existed.
+	var exists bool
+	err = conn.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE id = $1)", r.table), id,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return repository.ErrNotFound
+	}
+	return ErrVersionMismatch
+}
+
+// driverError maps a unique index violation to repository.ErrAlreadyExists
+// and returns any other error unchanged.
+func driverError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return repository.ErrAlreadyExists
+	}
+	return err
+}
+
+// updatableColumns returns the db-tagged columns of entity and their values,
+// excluding the immutable id and created_at.
+func updatableColumns[TEntity any](entity *TEntity) (columns []string, values []any) {
+	v := reflect.ValueOf(entity).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		col := columnName(t.Field(i))
+		if col == "" || col == "id" || col == "created_at" {
+			continue
+		}
+		columns = append(columns, col)
+		values = append(values, v.Field(i).Interface())
+	}
+	return columns, values
+}
+
+// columnName extracts the column name from a struct field's "db" tag, or ""
+// when the tag is absent or "-".
+func columnName(f reflect.StructField) string {
+	tag := f.Tag.Get("db")
+	if tag == "" || tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	mockrepository "github.com/biairmal/go-sdk/mocks/repository"
+	"github.com/lib/pq"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/etag"
+)
+
+func TestVersionedRepository_UpdateIfMatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		ifMatch      string
+		expectsInner bool
+		wantErr      error
+	}{
+		{name: "no precondition delegates", expectsInner: true},
+		{name: "wildcard If-Match delegates", ifMatch: "*", expectsInner: true},
+		{name: "malformed If-Match can never match", ifMatch: `"not-a-version"`, wantErr: ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inner := mockrepository.NewMockRepository[testEntity, string](ctrl)
+			if tt.expectsInner {
+				inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(nil)
+			}
+
+			repo := newVersionedRepository[testEntity, string](inner, nil, "test_entities")
+			err := repo.UpdateIfMatch(context.Background(), "1", &testEntity{ID: "1"}, tt.ifMatch)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateIfMatch() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestVersionedRepository_Update checks a plain Update never applies a
+// precondition, so internal writes can't fail on a client's If-Match.
+func TestVersionedRepository_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	inner := mockrepository.NewMockRepository[testEntity, string](ctrl)
+	inner.EXPECT().Update(gomock.Any(), "1", gomock.Any()).Return(nil)
+
+	repo := newVersionedRepository[testEntity, string](inner, nil, "test_entities")
+	if err := repo.Update(context.Background(), "1", &testEntity{ID: "1"}); err != nil {
+		t.Errorf("Update() error = %v, want nil", err)
+	}
+}
+
+// versionedEntity is a soft-deletable entity with columns to update.
+type versionedEntity struct {
+	ID        string     `db:"id"`
+	Name      string     `db:"name"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+// fakeRow is the one stored row a fakeConn answers for.
+type fakeRow struct {
+	version time.Time
+	deleted bool
+}
+
+// fakeConn is a database/sql driver connection over at most one row. It
+// honours the "updated_at = $n" and "deleted_at IS NULL" conditions of the
+// conditional UPDATE and the existence check, fails every exec with execErr
+// when set, and is its own transaction.
+type fakeConn struct {
+	row     *fakeRow
+	execErr error
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+func (c *fakeConn) Prepare(string) (driver.Stmt, error)          { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                                 { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                    { return c, nil }
+func (c *fakeConn) Commit() error                                { return nil }
+func (c *fakeConn) Rollback() error                              { return nil }
+
+// visible reports whether the row passes query's soft-delete filter.
+func (c *fakeConn) visible(query string) bool {
+	return c.row != nil && !(c.row.deleted && strings.Contains(query, "deleted_at IS NULL"))
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if c.execErr != nil {
+		return nil, c.execErr
+	}
+	version, _ := args[len(args)-1].Value.(time.Time)
+	if c.visible(query) && c.row.version.Equal(version) {
+		return driver.RowsAffected(1), nil
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{values: []driver.Value{c.visible(query)}}, nil
+}
+
+// fakeRows is a single-row, single-column result.
+type fakeRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"exists"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+// TestVersionedRepository_ConditionalUpdate runs UpdateIfMatch through
+// the SQL path, inside a transaction on a fake connection.
+func TestVersionedRepository_ConditionalUpdate(t *testing.T) {
+	version := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		row     *fakeRow
+		execErr error
+		wantErr error
+	}{
+		{name: "current version updates", row: &fakeRow{version: version}},
+		{name: "stale version", row: &fakeRow{version: version.Add(time.Second)}, wantErr: ErrVersionMismatch},
+		{name: "soft-deleted row fails the precondition", row: &fakeRow{version: version, deleted: true},
+			wantErr: ErrVersionMismatch},
+		{name: "missing row", wantErr: repository.ErrNotFound},
+		{
+			name: "unique violation", row: &fakeRow{version: version},
+			execErr: &pq.Error{Code: "23505"}, wantErr: repository.ErrAlreadyExists,
+		},
+		{
+			name: "other driver errors pass through", row: &fakeRow{version: version},
+			execErr: driver.ErrBadConn, wantErr: driver.ErrBadConn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := sql.OpenDB(&fakeConn{row: tt.row, execErr: tt.execErr})
+			defer func() { _ = db.Close() }()
+			tx, err := db.BeginTx(context.Background(), nil)
+			if err != nil {
+				t.Fatalf("BeginTx: %v", err)
+			}
+			defer func() { _ = tx.Rollback() }()
+			ctx := sqlkit.WithTx(context.Background(), tx)
+
+			ctrl := gomock.NewController(t)
+			inner := mockrepository.NewMockRepository[versionedEntity, string](ctrl)
+			repo := newVersionedRepository[versionedEntity, string](inner, nil, "test_entities")
+			err = repo.UpdateIfMatch(ctx, "1",
+				&versionedEntity{ID: "1", Name: "renamed", UpdatedAt: version.Add(time.Hour)}, etag.Format(version))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateIfMatch() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
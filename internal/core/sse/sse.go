@@ -0,0 +1,68 @@
+// Package sse writes Server-Sent Events responses. A stream clears the
+// connection's write deadline, since its whole point is to stay open far
+// longer than server.write_timeout.
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+// Stream is an open text/event-stream response.
+type Stream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// Open commits w as an event stream and clears its write deadline. It fails
+// when w cannot be flushed, since events would sit in a buffer. A deadline
+// that cannot be cleared (a middleware's writer that doesn't Unwrap) is
+// logged instead: the stream then ends at server.write_timeout and
+// EventSource clients reconnect.
+func Open(ctx context.Context, log logger.Logger, w http.ResponseWriter) (*Stream, error) {
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.WarnWithContext(ctx, "sse cannot clear write deadline; server WriteTimeout applies", logger.F("error", err))
+	}
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	h.Set("X-Accel-Buffering", "no") // nginx: don't buffer the stream
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return nil, fmt.Errorf("sse: flush: %w", err)
+	}
+	return &Stream{w: w, rc: rc}, nil
+}
+
+// Send writes v, JSON-encoded, as one event named event and flushes it.
+func (s *Stream) Send(event string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if strings.ContainsAny(event, "\r\n") {
+		return errors.New("sse: event name contains a line break")
+	}
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// Ping writes a comment line, which clients ignore, so proxies and load
+// balancers see traffic on an otherwise idle stream.
+func (s *Stream) Ping() error {
+	if _, err := fmt.Fprint(s.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
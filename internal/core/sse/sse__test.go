@@ -0,0 +1,41 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+)
+
+func TestStream(t *testing.T) {
+	rec := httptest.NewRecorder()
+	s, err := Open(context.Background(), logger.NewNoOp(), rec)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if err := s.Send("snapshot", map[string]int{"tickets": 3}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if err := s.Ping(); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if err := s.Send("bad\nname", 1); err == nil {
+		t.Error("Send accepted an event name with a line break")
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", rec.Code)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if !rec.Flushed {
+		t.Error("stream was not flushed")
+	}
+	want := "event: snapshot\ndata: {\"tickets\":3}\n\n: ping\n\n"
+	if got := rec.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
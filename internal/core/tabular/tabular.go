@@ -0,0 +1,100 @@
+// Package tabular reads and writes the row-oriented file formats the API
+// exchanges with organizers' tools: CSV and XLSX both ways, and JSON Lines for
+// exports. Rows are plain []string so callers map columns themselves.
+package tabular
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format identifies a tabular file format.
+type Format string
+
+const (
+	// FormatCSV is comma-separated values (RFC 4180).
+	FormatCSV Format = "csv"
+	// FormatXLSX is an Office Open XML workbook; only the first sheet is read.
+	FormatXLSX Format = "xlsx"
+)
+
+// ErrUnsupportedFormat is returned for a format other than CSV or XLSX.
+var ErrUnsupportedFormat = errors.New("tabular: unsupported format")
+
+// FormatFromFilename derives the format from a file extension
+// (case-insensitive), returning ErrUnsupportedFormat when it isn't known.
+func FormatFromFilename(name string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ReadAll parses data in the given format and returns every row, header
+// included. Cells lose the apostrophe a Writer adds to formula-like values
+// and are trimmed of surrounding whitespace, trailing empty cells
+// may be missing (callers must bounds-check), and fully blank rows are kept so
+// row numbers match what the user sees in their spreadsheet.
+func ReadAll(format Format, data []byte) ([][]string, error) {
+	var rows [][]string
+	var err error
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(data)
+	case FormatXLSX:
+		rows, err = readXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(unescapeFormula(row[i]))
+		}
+	}
+	return rows, nil
+}
+
+func readCSV(data []byte) ([][]string, error) {
+	// Excel prepends a UTF-8 BOM when saving "CSV UTF-8".
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	var rows [][]string
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, rec)
+	}
+}
+
+func readXLSX(data []byte) ([][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil
+	}
+	return f.GetRows(sheets[0])
+}
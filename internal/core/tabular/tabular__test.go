@@ -0,0 +1,196 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestFormatFromFilename(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Format
+		wantErr error
+	}{
+		{name: "guests.csv", want: FormatCSV},
+		{name: "Guests.XLSX", want: FormatXLSX},
+		{name: "guests.xls", wantErr: ErrUnsupportedFormat},
+		{name: "guests", wantErr: ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatFromFilename(tt.name)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("FormatFromFilename(%q) = %q, %v; want %q, %v", tt.name, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadAll_CSV(t *testing.T) {
+	data := []byte("\ufeffName, Email \n Ann ,ann@example.com\n\nBob\n")
+
+	got, err := ReadAll(FormatCSV, data)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	want := [][]string{{"Name", "Email"}, {"Ann", "ann@example.com"}, {"Bob"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll() = %q, want %q", got, want)
+	}
+}
+
+func TestReadAll_XLSX(t *testing.T) {
+	f := excelize.NewFile()
+	_ = f.SetSheetRow("Sheet1", "A1", &[]any{"Name", "Email"})
+	_ = f.SetSheetRow("Sheet1", "A2", &[]any{" Ann ", "ann@example.com"})
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatalf("write workbook: %v", err)
+	}
+
+	got, err := ReadAll(FormatXLSX, buf.Bytes())
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	want := [][]string{{"Name", "Email"}, {"Ann", "ann@example.com"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll() = %q, want %q", got, want)
+	}
+}
+
+func TestReadAll_Unsupported(t *testing.T) {
+	if _, err := ReadAll("xls", nil); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("ReadAll() error = %v, want ErrUnsupportedFormat", err)
+	}
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	header := []string{"name", "email"}
+	rows := [][]string{{"Ann", "ann@example.com"}, {"Bob, Jr.", "bob@example.com"}}
+
+	for _, format := range []Format{FormatCSV, FormatXLSX} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf, header)
+			if err != nil {
+				t.Fatalf("NewWriter() error = %v", err)
+			}
+			for _, row := range rows {
+				if err := w.Write(row); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			got, err := ReadAll(format, buf.Bytes())
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			want := append([][]string{header}, rows...)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWriter_EscapesFormulas(t *testing.T) {
+	row := []string{"=HYPERLINK(\"http://x\")", "+62812", "-5", "@SUM(A1)", "\tx", "\rx", "Ann", "a=b", ""}
+	want := []string{"'=HYPERLINK(\"http://x\")", "'+62812", "'-5", "'@SUM(A1)", "'\tx", "'\rx", "Ann", "a=b", ""}
+	for _, format := range []Format{FormatCSV, FormatXLSX} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf, []string{"=name"})
+			if err != nil {
+				t.Fatalf("NewWriter() error = %v", err)
+			}
+			if err := w.Write(row); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			// The raw cells, as a spreadsheet opens them.
+			var raw [][]string
+			if format == FormatCSV {
+				r := csv.NewReader(bytes.NewReader(buf.Bytes()))
+				r.FieldsPerRecord = -1
+				raw, err = r.ReadAll()
+			} else {
+				raw, err = rawXLSX(buf.Bytes())
+			}
+			if err != nil {
+				t.Fatalf("read back: %v", err)
+			}
+			if len(raw) != 2 || raw[0][0] != "'=name" {
+				t.Fatalf("header = %q, want the formula-like header escaped", raw)
+			}
+			for i := range want {
+				var got string
+				if i < len(raw[1]) {
+					got = raw[1][i]
+				}
+				if got != want[i] {
+					t.Errorf("cell %d = %q, want %q", i, got, want[i])
+				}
+			}
+
+			// ReadAll strips the apostrophe again, so exports re-import as is.
+			got, err := ReadAll(format, buf.Bytes())
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if got[0][0] != "=name" || got[1][0] != row[0] || got[1][1] != "+62812" || got[1][2] != "-5" {
+				t.Errorf("ReadAll() = %q, want the original values", got)
+			}
+		})
+	}
+}
+
+// rawXLSX returns the first sheet's cells without ReadAll's clean-up.
+func rawXLSX(data []byte) ([][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return f.GetRows(xlsxSheet)
+}
+
+func TestWriter_NDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatNDJSON, &buf, []string{"name", "email"})
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	_ = w.Write([]string{"Ann", "ann@example.com"})
+	_ = w.Write([]string{"Bob"})
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	want := `{"email":"ann@example.com","name":"Ann"}` + "\n" + `{"name":"Bob"}` + "\n"
+	if buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestParseExportFormat(t *testing.T) {
+	for _, s := range []string{"csv", "xlsx", "ndjson"} {
+		if _, err := ParseExportFormat(s); err != nil {
+			t.Errorf("ParseExportFormat(%q) error = %v", s, err)
+		}
+	}
+	if _, err := ParseExportFormat("pdf"); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("ParseExportFormat(pdf) error = %v, want ErrUnsupportedFormat", err)
+	}
+}
@@ -0,0 +1,187 @@
+package tabular
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// FormatNDJSON is JSON Lines: one JSON object per row, keyed by the header.
+// It is write-only (exports), since imports need a fixed column layout.
+const FormatNDJSON Format = "ndjson"
+
+// ParseExportFormat validates an export format name ("csv", "xlsx", "ndjson").
+func ParseExportFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatCSV, FormatXLSX, FormatNDJSON:
+		return f, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type served for format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Writer writes rows in one format. Write may buffer; Flush pushes buffered
+// rows to the underlying writer where the format allows (CSV, NDJSON). Close
+// finishes the file and must be called exactly once.
+type Writer interface {
+	Write(row []string) error
+	Flush() error
+	Close() error
+}
+
+// NewWriter returns a Writer for format that first writes header (CSV, XLSX)
+// or uses it as the object keys of every line (NDJSON).
+//
+// CSV and XLSX cells that a spreadsheet would run as a formula are written
+// with a leading apostrophe (see escapeFormula); ReadAll removes it again.
+//
+// XLSX is a zip archive that can only be emitted once complete: rows go to
+// excelize's stream writer, which spills to a temporary file rather than
+// memory, and the workbook is written to w on Close.
+func NewWriter(format Format, w io.Writer, header []string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		c := &csvWriter{w: csv.NewWriter(w)}
+		if err := c.Write(header); err != nil {
+			return nil, err
+		}
+		return c, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{w: bufio.NewWriter(w), header: header}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w, header)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(row []string) error {
+	cells := make([]string, len(row))
+	for i, v := range row {
+		cells[i] = escapeFormula(v)
+	}
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error { return c.Flush() }
+
+type ndjsonWriter struct {
+	w      *bufio.Writer
+	header []string
+}
+
+func (n *ndjsonWriter) Write(row []string) error {
+	obj := make(map[string]string, len(n.header))
+	for i, key := range n.header {
+		if i < len(row) {
+			obj[key] = row[i]
+		}
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	if _, err := n.w.Write(b); err != nil {
+		return err
+	}
+	return n.w.WriteByte('\n')
+}
+
+func (n *ndjsonWriter) Flush() error { return n.w.Flush() }
+
+func (n *ndjsonWriter) Close() error { return n.w.Flush() }
+
+type xlsxWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+const xlsxSheet = "Sheet1"
+
+func newXLSXWriter(w io.Writer, header []string) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	sw, err := f.NewStreamWriter(xlsxSheet)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	x := &xlsxWriter{out: w, file: f, sw: sw}
+	if err := x.Write(header); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxWriter) Write(row []string) error {
+	x.row++
+	cells := make([]any, len(row))
+	for i, v := range row {
+		cells[i] = escapeFormula(v)
+	}
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.sw.SetRow(cell, cells)
+}
+
+// Flush is a no-op: nothing can be sent before the archive is complete.
+func (x *xlsxWriter) Flush() error { return nil }
+
+func (x *xlsxWriter) Close() error {
+	defer func() { _ = x.file.Close() }()
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
+
+// formulaTriggers are the leading characters that make Excel, LibreOffice and
+// Google Sheets evaluate a cell typed or opened from CSV as a formula.
+const formulaTriggers = "=+-@\t\r"
+
+// escapeFormula prefixes a cell starting with a formula trigger with an
+// apostrophe, which spreadsheets display as text and hide, so guest-supplied
+// values such as =HYPERLINK(...) in an export are never executed.
+func escapeFormula(v string) string {
+	if v != "" && strings.IndexByte(formulaTriggers, v[0]) >= 0 {
+		return "'" + v
+	}
+	return v
+}
+
+// unescapeFormula undoes escapeFormula, so exports re-import as they were.
+func unescapeFormula(v string) string {
+	if len(v) > 1 && v[0] == '\'' && strings.IndexByte(formulaTriggers, v[1]) >= 0 {
+		return v[1:]
+	}
+	return v
+}
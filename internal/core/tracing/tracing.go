@@ -0,0 +1,57 @@
+// Package tracing starts the app's own spans below the HTTP server span that
+// middleware.Tracing opens: repository operations, Redis cache lookups and
+// outbound HTTP calls. Until Enable is called every span is a no-op, so
+// instrumented code costs next to nothing with tracing switched off.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentation names the tracer spans are started with.
+const instrumentation = "github.com/biairmal/guest-management-be"
+
+// AttrError is set on every span this package ends: true when the operation
+// failed.
+const AttrError = attribute.Key("error")
+
+var (
+	enabled bool
+	tracer  trace.Tracer = noop.NewTracerProvider().Tracer(instrumentation)
+)
+
+// Enable starts recording spans on tp. main.go calls it once, before wiring
+// the app, when TracingConfig.Enabled is set.
+func Enable(tp trace.TracerProvider) {
+	enabled = true
+	tracer = tp.Tracer(instrumentation)
+}
+
+// Enabled reports whether Enable was called, letting decorators skip wrapping
+// altogether when tracing is off.
+func Enabled() bool {
+	return enabled
+}
+
+// Start starts a span named name as a child of the span on ctx, if any.
+func Start(
+	ctx context.Context, name string, kind trace.SpanKind, attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// End sets AttrError, records err with an error status when non-nil, and
+// ends span.
+func End(span trace.Span, err error) {
+	span.SetAttributes(AttrError.Bool(err != nil))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Outbound HTTP span attributes.
+const (
+	AttrHTTPMethod = attribute.Key("http.request.method")
+	AttrHTTPHost   = attribute.Key("server.address")
+	AttrHTTPStatus = attribute.Key("http.response.status_code")
+)
+
+// Transport returns base (http.DefaultTransport when nil) wrapped so every
+// request is a client span carrying method, host and status code, with the
+// trace context propagated to the receiver. Non-2xx responses are not errors
+// here; callers decide what a status means. With tracing off base is returned
+// unchanged.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !Enabled() {
+		return base
+	}
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := Start(req.Context(), fmt.Sprintf("HTTP %s", req.Method), trace.SpanKindClient,
+		AttrHTTPMethod.String(req.Method), AttrHTTPHost.String(req.URL.Host))
+	// RoundTrip must not modify req, so the headers go on a clone.
+	req = req.Clone(ctx)
+	propagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		span.SetAttributes(AttrHTTPStatus.Int(resp.StatusCode))
+	}
+	End(span, err)
+	return resp, err
+}
+
+// propagator returns the global propagator, falling back to W3C trace context
+// when none was installed.
+func propagator() propagation.TextMapPropagator {
+	if p := otel.GetTextMapPropagator(); len(p.Fields()) > 0 {
+		return p
+	}
+	return propagation.TraceContext{}
+}
@@ -0,0 +1,115 @@
+// Package transaction provides a unit of work spanning several repositories.
+// TxManager.WithinTx begins a transaction on the leader database and injects
+// it into the context through go-sdk's sqlkit.WithTx, which every repository
+// built by internal/core/repository.NewRepository (via go-sdk repository/sql)
+// honours, so a service only has to thread the callback's ctx through.
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/core/transaction/mock_manager.go -package=mocktransaction github.com/biairmal/guest-management-be/internal/core/transaction TxManager
+
+// TxManager runs a function inside a database transaction.
+type TxManager interface {
+	// WithinTx runs fn with a context carrying the transaction. It commits
+	// when fn returns nil and rolls back when fn returns an error or panics;
+	// fn's error is returned unchanged so services keep their errorz codes.
+	// A call made while ctx already carries a transaction joins it instead of
+	// opening a nested one; the outermost call decides commit or rollback.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// unitKey is the context key for the in-flight unit of work.
+type unitKey struct{}
+
+// unit tracks the callbacks to run once the outermost transaction commits.
+type unit struct {
+	afterCommit []func(ctx context.Context)
+}
+
+// txManager implements TxManager over the transactions begin opens.
+type txManager struct {
+	begin func(ctx context.Context) (*sql.Tx, error)
+	log   logger.Logger
+}
+
+// NewTxManager returns a TxManager that opens transactions on db's leader.
+func NewTxManager(log logger.Logger, db *sqlkit.DB) TxManager {
+	return &txManager{
+		begin: func(ctx context.Context) (*sql.Tx, error) { return db.Leader().BeginTx(ctx, nil) },
+		log:   log,
+	}
+}
+
+// WithinTx implements TxManager.
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := sqlkit.TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to begin transaction")
+	}
+	u := &unit{}
+	txCtx := context.WithValue(sqlkit.WithTx(ctx, tx), unitKey{}, u)
+
+	defer func() {
+		if p := recover(); p != nil {
+			m.rollback(ctx, tx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(txCtx); err != nil {
+		m.rollback(ctx, tx)
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to commit transaction")
+	}
+	u.runAfterCommit(ctx)
+	return nil
+}
+
+// rollback rolls tx back, logging (not returning) a failure so the caller's
+// original error is what surfaces.
+func (m *txManager) rollback(ctx context.Context, tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		m.log.ErrorWithContext(ctx, "transaction rollback failed", logger.F("error", err))
+	}
+}
+
+// AfterCommit registers fn to run once the transaction carried by ctx has
+// committed; it is dropped on rollback. Outside a transaction fn runs
+// immediately. Use it for side effects that must not observe uncommitted
+// state, such as cache invalidation or publishing events.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if u, ok := ctx.Value(unitKey{}).(*unit); ok {
+		u.afterCommit = append(u.afterCommit, fn)
+		return
+	}
+	fn(ctx)
+}
+
+// InTx reports whether ctx carries a transaction opened by WithinTx.
+func InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(unitKey{}).(*unit)
+	return ok
+}
+
+// runAfterCommit runs the registered callbacks in registration order. ctx is
+// the caller's original context, not the committed transaction's.
+func (u *unit) runAfterCommit(ctx context.Context) {
+	for _, fn := range u.afterCommit {
+		fn(ctx)
+	}
+}
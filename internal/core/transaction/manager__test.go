@@ -0,0 +1,200 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+)
+
+func TestAfterCommit(t *testing.T) {
+	tests := []struct {
+		name      string
+		inUnit    bool
+		commit    bool
+		wantCalls []string
+	}{
+		{name: "outside a transaction runs immediately", wantCalls: []string{"a", "b"}},
+		{name: "inside a transaction waits for commit", inUnit: true},
+		{name: "runs in registration order after commit", inUnit: true, commit: true, wantCalls: []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			u := &unit{}
+			if tt.inUnit {
+				ctx = context.WithValue(ctx, unitKey{}, u)
+			}
+			if InTx(ctx) != tt.inUnit {
+				t.Fatalf("InTx() = %v, want %v", InTx(ctx), tt.inUnit)
+			}
+
+			var calls []string
+			AfterCommit(ctx, func(context.Context) { calls = append(calls, "a") })
+			AfterCommit(ctx, func(context.Context) { calls = append(calls, "b") })
+			if tt.commit {
+				u.runAfterCommit(context.Background())
+			}
+
+			if len(calls) != len(tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			for i := range calls {
+				if calls[i] != tt.wantCalls[i] {
+					t.Errorf("calls[%d] = %q, want %q", i, calls[i], tt.wantCalls[i])
+				}
+			}
+		})
+	}
+}
+
+// fakeConn is a database/sql driver connection that only opens transactions
+// and counts how each one ends.
+type fakeConn struct {
+	begins, commits, rollbacks int
+	commitErr                  error
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+func (c *fakeConn) Prepare(string) (driver.Stmt, error)          { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                                 { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                    { c.begins++; return c, nil }
+func (c *fakeConn) Commit() error                                { c.commits++; return c.commitErr }
+func (c *fakeConn) Rollback() error                              { c.rollbacks++; return nil }
+
+// newFakeManager returns a txManager opening its transactions on conn.
+func newFakeManager(t *testing.T, conn *fakeConn) *txManager {
+	db := sql.OpenDB(conn)
+	t.Cleanup(func() { _ = db.Close() })
+	return &txManager{
+		begin: func(ctx context.Context) (*sql.Tx, error) { return db.BeginTx(ctx, nil) },
+		log:   logger.NewNoOp(),
+	}
+}
+
+func TestWithinTx(t *testing.T) {
+	errFn := errors.New("boom")
+	tests := []struct {
+		name          string
+		fn            func(m *txManager, calls *[]string) func(context.Context) error
+		commitErr     error
+		wantErr       error
+		wantCode      string
+		wantPanic     bool
+		wantCommits   int
+		wantRollbacks int
+		wantCalls     []string
+	}{
+		{
+			name: "commits and then runs after-commit callbacks",
+			fn: func(_ *txManager, calls *[]string) func(context.Context) error {
+				return func(ctx context.Context) error {
+					if _, ok := sqlkit.TxFromContext(ctx); !ok || !InTx(ctx) {
+						t.Error("callback context carries no transaction")
+					}
+					AfterCommit(ctx, func(context.Context) { *calls = append(*calls, "after commit") })
+					*calls = append(*calls, "fn")
+					return nil
+				}
+			},
+			wantCommits: 1, wantCalls: []string{"fn", "after commit"},
+		},
+		{
+			name: "rolls back on error and drops after-commit callbacks",
+			fn: func(_ *txManager, calls *[]string) func(context.Context) error {
+				return func(ctx context.Context) error {
+					AfterCommit(ctx, func(context.Context) { *calls = append(*calls, "after commit") })
+					return errFn
+				}
+			},
+			wantErr: errFn, wantRollbacks: 1,
+		},
+		{
+			name: "rolls back on panic and re-panics",
+			fn: func(_ *txManager, _ *[]string) func(context.Context) error {
+				return func(context.Context) error { panic("boom") }
+			},
+			wantPanic: true, wantRollbacks: 1,
+		},
+		{
+			name: "nested calls join the outer transaction",
+			fn: func(m *txManager, calls *[]string) func(context.Context) error {
+				return func(ctx context.Context) error {
+					outer, _ := sqlkit.TxFromContext(ctx)
+					return m.WithinTx(ctx, func(ctx context.Context) error {
+						if inner, _ := sqlkit.TxFromContext(ctx); inner != outer {
+							t.Error("nested call opened its own transaction")
+						}
+						AfterCommit(ctx, func(context.Context) { *calls = append(*calls, "nested after commit") })
+						*calls = append(*calls, "nested fn")
+						return nil
+					})
+				}
+			},
+			wantCommits: 1, wantCalls: []string{"nested fn", "nested after commit"},
+		},
+		{
+			name: "a nested error rolls back the outer transaction",
+			fn: func(m *txManager, _ *[]string) func(context.Context) error {
+				return func(ctx context.Context) error {
+					return m.WithinTx(ctx, func(context.Context) error { return errFn })
+				}
+			},
+			wantErr: errFn, wantRollbacks: 1,
+		},
+		{
+			name: "a failed commit is a 500 without after-commit callbacks",
+			fn: func(_ *txManager, calls *[]string) func(context.Context) error {
+				return func(ctx context.Context) error {
+					AfterCommit(ctx, func(context.Context) { *calls = append(*calls, "after commit") })
+					return nil
+				}
+			},
+			commitErr: errors.New("connection lost"), wantCode: errorz.CodeInternal, wantCommits: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{commitErr: tt.commitErr}
+			m := newFakeManager(t, conn)
+			var calls []string
+			var err error
+			panicked := func() (p bool) {
+				defer func() { p = recover() != nil }()
+				err = m.WithinTx(context.Background(), tt.fn(m, &calls))
+				return false
+			}()
+
+			if panicked != tt.wantPanic {
+				t.Fatalf("panicked = %v, want %v", panicked, tt.wantPanic)
+			}
+			if tt.wantCode != "" {
+				var ez *errorz.Error
+				if !errors.As(err, &ez) || ez.Code != tt.wantCode {
+					t.Errorf("error = %v, want code %s", err, tt.wantCode)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if conn.begins != 1 || conn.commits != tt.wantCommits || conn.rollbacks != tt.wantRollbacks {
+				t.Errorf("begins, commits, rollbacks = %d, %d, %d, want 1, %d, %d",
+					conn.begins, conn.commits, conn.rollbacks, tt.wantCommits, tt.wantRollbacks)
+			}
+			if len(calls) != len(tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			for i := range calls {
+				if calls[i] != tt.wantCalls[i] {
+					t.Errorf("calls[%d] = %q, want %q", i, calls[i], tt.wantCalls[i])
+				}
+			}
+		})
+	}
+}
@@ -1,10 +1,19 @@
 // Package validation adapts go-sdk's validator behind a minimal interface
 // (Struct) so handlers depend on an app-owned seam rather than importing
 // go-sdk/validator directly. Validation failures surface as an *errorz.Error
-// (errorz.CodeBadRequest) with per-field detail, produced by go-sdk.
+// (errorz.CodeBadRequest) coded errcode.ValidationFailed, listing each
+// invalid field.
 package validation
 
-import gosdkvalidator "github.com/biairmal/go-sdk/lib/validator"
+import (
+	"errors"
+	"fmt"
+
+	gosdkvalidator "github.com/biairmal/go-sdk/lib/validator"
+	playground "github.com/go-playground/validator/v10"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+)
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/validation/mock_validator.go -package=mockvalidation github.com/biairmal/guest-management-be/internal/core/validation Validator
 
@@ -26,7 +35,42 @@ func New(cfg gosdkvalidator.Config, opts ...gosdkvalidator.Option) Validator {
 	return &adapter{v: gosdkvalidator.New(cfg, opts...)}
 }
 
-// Struct delegates to the underlying go-sdk validator's ValidateStruct.
+// Struct delegates to the underlying go-sdk validator's ValidateStruct and
+// recodes its failure as errcode.ValidationFailed with one field error per
+// failed rule. Field names follow the configured field_name_tag (json). An
+// error without the validator's field errors in its chain is returned as is.
 func (a *adapter) Struct(v any) error {
-	return a.v.ValidateStruct(v)
+	err := a.v.ValidateStruct(v)
+	var verrs playground.ValidationErrors
+	if err == nil || !errors.As(err, &verrs) {
+		return err
+	}
+	fields := make([]errcode.FieldError, len(verrs))
+	for i, fe := range verrs {
+		fields[i] = errcode.FieldError{Field: fe.Field(), Message: fieldMessage(fe)}
+	}
+	return errcode.ValidationFailed.WithFields(errcode.ValidationFailed.Message(), fields)
+}
+
+// fieldMessage describes the rule fe failed.
+func fieldMessage(fe playground.FieldError) string {
+	switch fe.Tag() {
+	case "required", "required_if", "required_with", "required_without":
+		return fe.Field() + " is required"
+	case "email":
+		return fe.Field() + " must be a valid email"
+	case "uuid", "uuid4":
+		return fe.Field() + " must be a UUID"
+	case "url", "http_url":
+		return fe.Field() + " must be a URL"
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", fe.Field(), fe.Param())
+	case "min", "gte":
+		return fmt.Sprintf("%s must be at least %s", fe.Field(), fe.Param())
+	case "max", "lte":
+		return fmt.Sprintf("%s must be at most %s", fe.Field(), fe.Param())
+	case "len":
+		return fmt.Sprintf("%s must have length %s", fe.Field(), fe.Param())
+	}
+	return fmt.Sprintf("%s failed the %s rule", fe.Field(), fe.Tag())
 }
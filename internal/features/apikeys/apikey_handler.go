@@ -0,0 +1,132 @@
+package apikeys
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// Handler exposes HTTP handlers for a tenant's API keys.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+}
+
+// NewHandler returns a Handler that uses the given service and validator.
+func NewHandler(service Service, validator validation.Validator) *Handler {
+	return &Handler{service: service, validator: validator}
+}
+
+// List handles GET /tenants/{tenantId}/api-keys.
+//
+// List godoc
+//
+//	@Summary		List API keys
+//	@Description	The tenant's API keys, newest first, revoked ones included. Key values are never returned here.
+//	@Tags			api-keys
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Success		200			{array}		apikeys.Key
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_api_keys"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/api-keys [get]
+func (h *Handler) List(r *http.Request) (any, error) {
+	tenantID, err := parseID(r, "tenantId", "tenant")
+	if err != nil {
+		return nil, err
+	}
+	keys, err := h.service.List(r.Context(), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(keys), nil
+}
+
+// Create handles POST /tenants/{tenantId}/api-keys.
+//
+// Create godoc
+//
+//	@Summary		Create API key
+//	@Description	Issues a key for server-to-server access granting the listed permission codes, which the caller must hold themselves, optionally until expires_at. The key (gm_…) is shown only in this response; send it as "Authorization: Bearer gm_…".
+//	@Tags			api-keys
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			tenantId	path		string				true	"Tenant UUID"
+//	@Param			body		body		apikeys.KeyInput	true	"Key"
+//	@Success		201			{object}	apikeys.Issued
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id or body, unknown permission, or expiry in the past"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, missing manage_api_keys, or granting a permission the caller lacks"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/api-keys [post]
+func (h *Handler) Create(r *http.Request) (any, error) {
+	tenantID, err := parseID(r, "tenantId", "tenant")
+	if err != nil {
+		return nil, err
+	}
+	var body KeyInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	k, err := h.service.Create(r.Context(), tenantID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(k), nil
+}
+
+// Revoke handles DELETE /tenants/{tenantId}/api-keys/{keyId}.
+//
+// Revoke godoc
+//
+//	@Summary		Revoke API key
+//	@Description	Revokes the key; requests made with it are refused at once. The key stays listed with revoked_at.
+//	@Tags			api-keys
+//	@Security		BearerAuth
+//	@Param			tenantId	path	string	true	"Tenant UUID"
+//	@Param			keyId		path	string	true	"API key UUID"
+//	@Success		204
+//	@Failure		400	{object}	problem.Problem	"Invalid id"
+//	@Failure		401	{object}	problem.Problem	"Not authenticated"
+//	@Failure		403	{object}	problem.Problem	"Another tenant, or missing manage_api_keys"
+//	@Failure		404	{object}	problem.Problem	"API key not found"
+//	@Failure		500	{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/api-keys/{keyId} [delete]
+func (h *Handler) Revoke(r *http.Request) (any, error) {
+	tenantID, err := parseID(r, "tenantId", "tenant")
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(r, "keyId", "api key")
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Revoke(r.Context(), tenantID, id); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
@@ -0,0 +1,50 @@
+package apikeys
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// Middleware returns HTTP middleware authenticating requests that carry an
+// API key as "Authorization: Bearer gm_…". A valid key puts its tenant and
+// permissions on the request context through ctxkit, where authorization
+// reads them whichever way the caller authenticated, and its id for
+// auth.KeyID. A key carries no user, so ctxkit.UserID stays empty. An
+// invalid, revoked or expired key is a 401. Requests without a key — anonymous ones and those
+// bearing a JWT — pass through untouched.
+func Middleware(service Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value, ok := bearerKey(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			k, err := service.Authenticate(r.Context(), value)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				problem.Write(w, r, err)
+				return
+			}
+			ctx := ctxkit.WithTenantID(r.Context(), k.TenantID.String())
+			ctx = ctxkit.WithPermissions(ctx, k.Permissions)
+			ctx = auth.WithKeyID(ctx, k.ID.String())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// bearerKey returns the API key of the request's bearer token, if it is one.
+func bearerKey(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, strings.HasPrefix(token, keyPrefix)
+}
@@ -0,0 +1,82 @@
+package apikeys_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/features/apikeys"
+	mockapikeys "github.com/biairmal/guest-management-be/mocks/apikeys"
+)
+
+func TestMiddleware(t *testing.T) {
+	tenantID := uuid.New()
+	tests := []struct {
+		name       string
+		header     string
+		authErr    error
+		wantAuth   bool
+		wantStatus int
+		wantTenant string
+	}{
+		{name: "no credentials pass through", wantStatus: http.StatusOK},
+		{name: "a JWT passes through", header: "Bearer eyJhbGciOiJIUzI1NiJ9.e30.sig", wantStatus: http.StatusOK},
+		{name: "basic auth passes through", header: "Basic Z206eA==", wantStatus: http.StatusOK},
+		{
+			name: "valid key sets the identity", header: "Bearer gm_valid", wantAuth: true,
+			wantStatus: http.StatusOK, wantTenant: tenantID.String(),
+		},
+		{name: "scheme is case-insensitive", header: "bearer gm_valid", wantAuth: true, wantStatus: http.StatusOK,
+			wantTenant: tenantID.String()},
+		{
+			name: "invalid key is refused", header: "Bearer gm_wrong", wantAuth: true,
+			authErr: errorz.Unauthorized().WithMessage("invalid api key"), wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mockapikeys.NewMockService(ctrl)
+			calls := 0
+			if tt.wantAuth {
+				calls = 1
+			}
+			key := &apikeys.Key{ID: uuid.New(), TenantID: tenantID, Permissions: []string{"check_in"}}
+			svc.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Return(key, tt.authErr).Times(calls)
+
+			var tenant, keyID string
+			var perms []string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tenant, perms = ctxkit.TenantID(r.Context()), ctxkit.Permissions(r.Context())
+				keyID = auth.KeyID(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/events", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			apikeys.Middleware(svc)(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tenant != tt.wantTenant {
+				t.Errorf("tenant = %q, want %q", tenant, tt.wantTenant)
+			}
+			if tt.wantTenant != "" && !slices.Equal(perms, key.Permissions) {
+				t.Errorf("permissions = %v, want %v", perms, key.Permissions)
+			}
+			if tt.wantTenant != "" && keyID != key.ID.String() {
+				t.Errorf("key id = %q, want %q", keyID, key.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apikeys
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// keyPrefix starts every API key, so leaked ones are easy to recognise and
+// the authentication middleware can tell them from JWTs.
+const keyPrefix = "gm_"
+
+// Key represents a row in the api_keys table with the codes of the
+// permissions it grants. A key authenticates while it is neither revoked nor
+// expired.
+//
+// swagger:model APIKey
+type Key struct {
+	ID          uuid.UUID  `json:"id"`
+	TenantID    uuid.UUID  `json:"tenant_id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"key_prefix"` // first characters, to tell keys apart
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Usable reports whether the key may authenticate at t.
+func (k *Key) Usable(t time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || t.Before(*k.ExpiresAt))
+}
+
+// Issued is a key with its secret value, returned only when the key is
+// created; it can't be read back later.
+//
+// swagger:model IssuedAPIKey
+type Issued struct {
+	Key
+	Secret string `json:"key"`
+}
@@ -0,0 +1,172 @@
+package apikeys
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/apikeys/mock_store.go -package=mockapikeys github.com/biairmal/guest-management-be/internal/features/apikeys Store
+
+// keySelect reads the api_keys columns scanKey scans, with the codes of the
+// key's permissions; callers alias api_keys as k.
+const keySelect = `SELECT k.id, k.tenant_id, k.name, k.key_prefix,
+	ARRAY(SELECT p.code FROM api_key_permissions kp JOIN permissions p ON p.id = kp.permission_id
+		WHERE kp.api_key_id = k.id ORDER BY p.code),
+	k.expires_at, k.last_used_at, k.created_by, k.revoked_at, k.created_at, k.updated_at FROM api_keys k`
+
+// Store holds the API key queries.
+type Store interface {
+	// TenantExists returns repository.ErrNotFound unless the tenant is live.
+	TenantExists(ctx context.Context, tenantID uuid.UUID) error
+	// UnknownPermissions returns the codes that aren't in the permissions
+	// table.
+	UnknownPermissions(ctx context.Context, codes []string) ([]string, error)
+	// Keys returns the tenant's keys, revoked ones included, newest first.
+	Keys(ctx context.Context, tenantID uuid.UUID) ([]*Key, error)
+	// Key returns a key of the tenant, or repository.ErrNotFound.
+	Key(ctx context.Context, tenantID, id uuid.UUID) (*Key, error)
+	// ByHash returns the key of a live tenant whose value hashes to hash,
+	// revoked and expired ones included, or repository.ErrNotFound.
+	ByHash(ctx context.Context, hash string) (*Key, error)
+	// Insert inserts a key with its hash and grants it k.Permissions, setting
+	// its id and timestamps.
+	Insert(ctx context.Context, k *Key, hash string) error
+	// Revoke revokes a key of the tenant unless it already is. It returns
+	// repository.ErrNotFound when the tenant has no such key.
+	Revoke(ctx context.Context, tenantID, id uuid.UUID) error
+	// Touch records that the key was used at at, unless it was already
+	// recorded as used after since.
+	Touch(ctx context.Context, id uuid.UUID, at, since time.Time) error
+}
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// TenantExists implements Store.
+func (s *store) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT 1 FROM tenants WHERE id = $1 AND deleted_at IS NULL", tenantID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// UnknownPermissions implements Store.
+func (s *store) UnknownPermissions(ctx context.Context, codes []string) ([]string, error) {
+	var unknown pq.StringArray
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT ARRAY(SELECT c FROM unnest($1::text[]) c
+		WHERE NOT EXISTS (SELECT 1 FROM permissions p WHERE p.code = c) ORDER BY c)`,
+		pq.StringArray(codes)).Scan(&unknown)
+	return unknown, err
+}
+
+// Keys implements Store.
+func (s *store) Keys(ctx context.Context, tenantID uuid.UUID) ([]*Key, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx,
+		keySelect+" WHERE k.tenant_id = $1 ORDER BY k.created_at DESC, k.id", tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	keys := []*Key{}
+	for rows.Next() {
+		k, err := scanKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// Key implements Store.
+func (s *store) Key(ctx context.Context, tenantID, id uuid.UUID) (*Key, error) {
+	k, err := scanKey(corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		keySelect+" WHERE k.id = $1 AND k.tenant_id = $2", id, tenantID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return k, err
+}
+
+// ByHash implements Store.
+func (s *store) ByHash(ctx context.Context, hash string) (*Key, error) {
+	k, err := scanKey(corerepository.Conn(ctx, s.db).QueryRowContext(ctx, keySelect+` WHERE k.key_hash = $1
+		AND EXISTS (SELECT 1 FROM tenants t WHERE t.id = k.tenant_id AND t.deleted_at IS NULL)`, hash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return k, err
+}
+
+// Insert implements Store.
+func (s *store) Insert(ctx context.Context, k *Key, hash string) error {
+	conn := corerepository.Conn(ctx, s.db)
+	err := conn.QueryRowContext(ctx, `
+		INSERT INTO api_keys (tenant_id, name, key_hash, key_prefix, expires_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at`,
+		k.TenantID, k.Name, hash, k.Prefix, k.ExpiresAt, k.CreatedBy,
+	).Scan(&k.ID, &k.CreatedAt, &k.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	_, err = conn.ExecContext(ctx, `INSERT INTO api_key_permissions (api_key_id, permission_id)
+		SELECT $1, id FROM permissions WHERE code = ANY($2)`, k.ID, pq.StringArray(k.Permissions))
+	return err
+}
+
+// Revoke implements Store.
+func (s *store) Revoke(ctx context.Context, tenantID, id uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		UPDATE api_keys SET revoked_at = COALESCE(revoked_at, now()), updated_at = now()
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING 1`, id, tenantID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// Touch implements Store.
+func (s *store) Touch(ctx context.Context, id uuid.UUID, at, since time.Time) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE api_keys SET last_used_at = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $3)`, id, at, since)
+	return err
+}
+
+// scanKey scans one keySelect row.
+func scanKey(row interface{ Scan(dest ...any) error }) (*Key, error) {
+	var (
+		k     Key
+		perms pq.StringArray
+	)
+	if err := row.Scan(
+		&k.ID, &k.TenantID, &k.Name, &k.Prefix, &perms, &k.ExpiresAt, &k.LastUsedAt, &k.CreatedBy, &k.RevokedAt,
+		&k.CreatedAt, &k.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	k.Permissions = append([]string{}, perms...)
+	return &k, nil
+}
@@ -0,0 +1,19 @@
+package apikeys
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitAPIKeyRoutes registers the tenant API key routes on the given router.
+// They are open only to callers of the tenant holding auth.ManageAPIKeys.
+func InitAPIKeyRoutes(r *chi.Mux, apiKeyH *Handler) {
+	r.Route("/api/v1/tenants/{tenantId}/api-keys", func(r chi.Router) {
+		r.Use(auth.Require(auth.ManageAPIKeys), auth.TenantParam("tenantId"))
+		r.Get("/", problem.Handle(apiKeyH.List))
+		r.Post("/", problem.Handle(apiKeyH.Create))
+		r.Delete("/{keyId}", problem.Handle(apiKeyH.Revoke))
+	})
+}
@@ -0,0 +1,197 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/apikeys/mock_service.go -package=mockapikeys github.com/biairmal/guest-management-be/internal/features/apikeys Service
+
+const (
+	// prefixLen is how many leading characters of a key are kept readable.
+	prefixLen = 12
+	// touchEvery is how stale last_used_at may get before a request updates
+	// it, so busy keys don't write on every request.
+	touchEvery = time.Minute
+)
+
+// Service manages a tenant's API keys and authenticates requests made with
+// them.
+type Service interface {
+	// List returns the tenant's keys, revoked ones included.
+	List(ctx context.Context, tenantID uuid.UUID) ([]*Key, error)
+	// Create issues a key granting the given permissions, all of which the
+	// caller of ctx must hold.
+	Create(ctx context.Context, tenantID uuid.UUID, in KeyInput) (*Issued, error)
+	// Revoke revokes a key; it stops authenticating at once.
+	Revoke(ctx context.Context, tenantID, id uuid.UUID) error
+	// Authenticate resolves a key value, records that it was used and
+	// returns it. A key that is unknown, revoked or expired is a 401.
+	Authenticate(ctx context.Context, value string) (*Key, error)
+}
+
+// KeyInput is the body of a key create. Permissions are codes of the
+// permissions table; ExpiresAt, when set, must be in the future.
+//
+// swagger:model APIKeyInput
+type KeyInput struct {
+	Name        string     `json:"name" validate:"required,max=100"`
+	Permissions []string   `json:"permissions" validate:"required,min=1,dive,required,max=32"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	store  Store
+	now    func() time.Time
+}
+
+// NewService returns a Service with the given dependencies.
+func NewService(logger logger.Logger, tx transaction.TxManager, store Store) Service {
+	return &serviceImpl{logger: logger, tx: tx, store: store, now: time.Now}
+}
+
+// List implements Service.
+func (s *serviceImpl) List(ctx context.Context, tenantID uuid.UUID) ([]*Key, error) {
+	if err := s.checkTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	keys, err := s.store.Keys(ctx, tenantID)
+	if err != nil {
+		return nil, s.translate(ctx, "api keys read failed", tenantID, err)
+	}
+	return keys, nil
+}
+
+// Create implements Service. A key grants at most the permissions of the
+// caller creating it, and records the logged-in user who did, when there is
+// one.
+func (s *serviceImpl) Create(ctx context.Context, tenantID uuid.UUID, in KeyInput) (*Issued, error) {
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(s.now()) {
+		return nil, errorz.BadRequest().WithMessage("expires_at must be in the future")
+	}
+	if err := s.checkTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	perms := slices.Compact(slices.Sorted(slices.Values(in.Permissions)))
+	unknown, err := s.store.UnknownPermissions(ctx, perms)
+	if err != nil {
+		return nil, s.translate(ctx, "api key permissions read failed", tenantID, err)
+	}
+	if len(unknown) > 0 {
+		return nil, errorz.BadRequest().WithMessage("unknown permissions: " + strings.Join(unknown, ", "))
+	}
+	if missing := auth.Missing(ctx, perms); len(missing) > 0 {
+		return nil, errcode.APIKeyGrantExceeded.WithMessage("cannot grant permissions you don't hold: " + strings.Join(missing, ", "))
+	}
+	value, hash, err := newKey()
+	if err != nil {
+		return nil, s.translate(ctx, "api key generation failed", tenantID, err)
+	}
+	k := Key{TenantID: tenantID, Name: in.Name, Prefix: value[:prefixLen], Permissions: perms, ExpiresAt: in.ExpiresAt}
+	if userID, err := uuid.Parse(ctxkit.UserID(ctx)); err == nil {
+		k.CreatedBy = &userID
+	}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.store.Insert(ctx, &k, hash)
+	})
+	if err != nil {
+		return nil, s.translate(ctx, "api key insert failed", tenantID, err)
+	}
+	s.logger.InfoWithContext(ctx, "api key created", logger.F("tenant_id", tenantID), logger.F("api_key_id", k.ID))
+	return &Issued{Key: k, Secret: value}, nil
+}
+
+// Revoke implements Service. Revoking a revoked key changes nothing.
+func (s *serviceImpl) Revoke(ctx context.Context, tenantID, id uuid.UUID) error {
+	err := s.store.Revoke(ctx, tenantID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.APIKeyNotFound.New()
+	}
+	if err != nil {
+		return s.translate(ctx, "api key revoke failed", tenantID, err)
+	}
+	s.logger.InfoWithContext(ctx, "api key revoked", logger.F("tenant_id", tenantID), logger.F("api_key_id", id))
+	return nil
+}
+
+// Authenticate implements Service. Failing to record the use is logged; the
+// request still goes through.
+func (s *serviceImpl) Authenticate(ctx context.Context, value string) (*Key, error) {
+	k, err := s.store.ByHash(ctx, hashKey(value))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.APIKeyInvalid.New()
+	}
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "api key read failed", logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to authenticate")
+	}
+	at := s.now()
+	switch {
+	case k.RevokedAt != nil:
+		return nil, errcode.APIKeyRevoked.New()
+	case !k.Usable(at):
+		return nil, errcode.APIKeyExpired.New()
+	}
+	if k.LastUsedAt == nil || k.LastUsedAt.Before(at.Add(-touchEvery)) {
+		if err := s.store.Touch(ctx, k.ID, at, at.Add(-touchEvery)); err != nil {
+			s.logger.WarnWithContext(ctx, "api key touch failed", logger.F("api_key_id", k.ID), logger.F("error", err))
+		} else {
+			k.LastUsedAt = &at
+		}
+	}
+	return k, nil
+}
+
+// checkTenant returns 404 unless the tenant is live.
+func (s *serviceImpl) checkTenant(ctx context.Context, tenantID uuid.UUID) error {
+	if err := s.store.TenantExists(ctx, tenantID); err != nil {
+		return s.translate(ctx, "api key tenant read failed", tenantID, err)
+	}
+	return nil
+}
+
+// translate maps a store error to 404 for a missing tenant, or logs it as msg
+// and wraps it as a 500.
+func (s *serviceImpl) translate(ctx context.Context, msg string, tenantID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.TenantNotFound.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("tenant_id", tenantID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to process api keys")
+}
+
+// newKey returns a fresh key value and its hash.
+func newKey() (value, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	value = keyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return value, hashKey(value), nil
+}
+
+// hashKey returns the hex SHA-256 a key is stored as.
+func hashKey(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,148 @@
+package apikeys_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/apikeys"
+	mockapikeys "github.com/biairmal/guest-management-be/mocks/apikeys"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func newService(t *testing.T) (apikeys.Service, *mockapikeys.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockapikeys.NewMockStore(ctrl)
+	return apikeys.NewService(logger.NewNoOp(), inlineTx(ctrl), store), store
+}
+
+func TestService_Create(t *testing.T) {
+	tenantID, userID := uuid.New(), uuid.New()
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		perms     []string
+		held      []string
+		expiresAt *time.Time
+		tenantErr error
+		unknown   []string
+		wantCode  string
+	}{
+		{name: "created", perms: []string{"view_guests", "check_in", "view_guests"}, expiresAt: &future},
+		{name: "expiry in the past", perms: []string{"check_in"}, expiresAt: &past, wantCode: errorz.CodeBadRequest},
+		{
+			name: "unknown permission", perms: []string{"check_in", "launch_rockets"}, unknown: []string{"launch_rockets"},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{name: "tenant not found", perms: []string{"check_in"}, tenantErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{
+			name: "permission the caller lacks", perms: []string{"check_in", "view_guests"}, held: []string{"check_in"},
+			wantCode: errorz.CodeForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().TenantExists(gomock.Any(), tenantID).Return(tt.tenantErr).MaxTimes(1)
+			store.EXPECT().UnknownPermissions(gomock.Any(), gomock.Any()).Return(tt.unknown, nil).MaxTimes(1)
+			var hash string
+			store.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, k *apikeys.Key, h string) error {
+					if !slices.Equal(k.Permissions, []string{"check_in", "view_guests"}) {
+						t.Errorf("permissions = %v, want them sorted and distinct", k.Permissions)
+					}
+					if k.CreatedBy == nil || *k.CreatedBy != userID {
+						t.Errorf("created by = %v, want %v", k.CreatedBy, userID)
+					}
+					hash = h
+					return nil
+				}).MaxTimes(1)
+
+			held := tt.held
+			if held == nil {
+				held = []string{"check_in", "manage_api_keys", "view_guests"}
+			}
+			ctx := ctxkit.WithPermissions(ctxkit.WithUserID(context.Background(), userID.String()), held)
+			got, err := svc.Create(ctx, tenantID, apikeys.KeyInput{Name: "crm", Permissions: tt.perms, ExpiresAt: tt.expiresAt})
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				return
+			}
+			if !strings.HasPrefix(got.Secret, "gm_") || !strings.HasPrefix(got.Secret, got.Prefix) {
+				t.Errorf("key = %q with prefix %q, want a gm_ key starting with its prefix", got.Secret, got.Prefix)
+			}
+			if hash == "" || strings.Contains(hash, got.Secret) {
+				t.Errorf("stored hash = %q, want a hash of the key", hash)
+			}
+		})
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	recent := time.Now().Add(-time.Second)
+	tests := []struct {
+		name      string
+		key       *apikeys.Key
+		readErr   error
+		wantTouch bool
+		wantCode  string
+	}{
+		{name: "valid key", key: &apikeys.Key{ExpiresAt: &future}, wantTouch: true},
+		{name: "used moments ago", key: &apikeys.Key{LastUsedAt: &recent}},
+		{name: "unknown key", readErr: repository.ErrNotFound, wantCode: errorz.CodeUnauthorized},
+		{name: "revoked key", key: &apikeys.Key{RevokedAt: &past}, wantCode: errorz.CodeUnauthorized},
+		{name: "expired key", key: &apikeys.Key{ExpiresAt: &past}, wantCode: errorz.CodeUnauthorized},
+		{name: "read failure", readErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().ByHash(gomock.Any(), gomock.Any()).Return(tt.key, tt.readErr)
+			touches := 0
+			if tt.wantTouch {
+				touches = 1
+			}
+			store.EXPECT().Touch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(touches)
+
+			got, err := svc.Authenticate(context.Background(), "gm_secret")
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && got.LastUsedAt == nil {
+				t.Error("last used = nil, want it set")
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package calendar
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/export"
+)
+
+// Handler exposes calendar documents and feed links over HTTP.
+type Handler struct {
+	logger  logger.Logger
+	service Service
+}
+
+// NewHandler returns a Handler that uses the given service.
+func NewHandler(logger logger.Logger, service Service) *Handler {
+	return &Handler{logger: logger, service: service}
+}
+
+// EventCalendar handles GET /events/{eventId}/calendar.ics.
+//
+// EventCalendar godoc
+//
+//	@Summary		Event calendar file
+//	@Description	The event as an iCalendar file: one entry from start to end, or one entry per event day spanning its doors window. Times are UTC; the event's timezone is named in X-WR-TIMEZONE.
+//	@Tags			calendar
+//	@Produce		text/calendar
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/calendar.ics [get]
+func (h *Handler) EventCalendar(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		export.Error(w, r, errcode.InvalidID.WithMessage("invalid event id"))
+		return
+	}
+	cal, err := h.service.EventCalendar(r.Context(), eventID)
+	if err != nil {
+		export.Error(w, r, err)
+		return
+	}
+	h.write(w, r, cal, "event-"+eventID.String()+".ics")
+}
+
+// FeedLink handles GET /tenants/{tenantId}/calendar-feed.
+//
+// FeedLink godoc
+//
+//	@Summary		Tenant calendar feed link
+//	@Description	Returns the tenant's subscribable calendar feed of its events, for Google Calendar, Outlook or Apple Calendar. The link carries a token and needs no login; anyone holding it can read the tenant's event names and times.
+//	@Tags			calendar
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Success		200			{object}	calendar.FeedLink
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/calendar-feed [get]
+func (h *Handler) FeedLink(r *http.Request) (any, error) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "tenantId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid tenant id")
+	}
+	link, err := h.service.FeedLink(r.Context(), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(link), nil
+}
+
+// TenantFeed handles GET /tenants/{tenantId}/calendar.ics.
+//
+// TenantFeed godoc
+//
+//	@Summary		Tenant calendar feed
+//	@Description	Public, token-authenticated iCalendar feed of the tenant's events that ended no longer ago than the configured feed_past, one entry per event or event day. Get the link from the calendar-feed endpoint.
+//	@Tags			calendar
+//	@Produce		text/calendar
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Param			token		query		string	true	"Feed token from the feed link"
+//	@Success		200			{file}		file
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id"
+//	@Failure		404			{object}	problem.Problem	"Unknown tenant or wrong token"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/calendar.ics [get]
+func (h *Handler) TenantFeed(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "tenantId"))
+	if err != nil {
+		export.Error(w, r, errcode.InvalidID.WithMessage("invalid tenant id"))
+		return
+	}
+	cal, err := h.service.TenantFeed(r.Context(), tenantID, r.URL.Query().Get("token"))
+	if err != nil {
+		export.Error(w, r, err)
+		return
+	}
+	h.write(w, r, cal, "calendar.ics")
+}
+
+// write encodes cal into memory, so an encoding failure can still become an
+// error response, and sends it as filename.
+func (h *Handler) write(w http.ResponseWriter, r *http.Request, cal *Calendar, filename string) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, cal); err != nil {
+		h.logger.ErrorWithContext(r.Context(), "calendar encode failed", logger.F("error", err))
+		export.Error(w, r, errorz.Internal().WithMessage("failed to build calendar"))
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Disposition", `inline; filename="`+filename+`"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	_, _ = w.Write(buf.Bytes())
+}
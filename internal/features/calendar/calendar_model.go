@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message template variables of an event (Event.Variables), in the event's
+// timezone.
+const (
+	VarEventName     = "event_name"
+	VarEventTimezone = "event_timezone"
+	VarEventDate     = "event_date"  // e.g. Saturday, 2 May 2026
+	VarEventStart    = "event_start" // e.g. Saturday, 2 May 2026 19:00 WIB
+	VarEventEnd      = "event_end"
+)
+
+// Layouts of the event time variables.
+const (
+	dateLayout     = "Monday, 2 January 2006"
+	dateTimeLayout = "Monday, 2 January 2006 15:04 MST"
+)
+
+// Event is an event as calendars see it: one entry spanning start to end, or,
+// when it has days, one entry per day spanning its doors window.
+type Event struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	Name        string
+	Description *string
+	StartDate   time.Time
+	EndDate     time.Time
+	Timezone    string // IANA name
+	UpdatedAt   time.Time
+	Days        []Day // by date
+}
+
+// Day is one live day of a multi-day event.
+type Day struct {
+	ID           uuid.UUID
+	Date         string // YYYY-MM-DD in the event's timezone
+	Name         *string
+	DoorsOpenAt  time.Time
+	DoorsCloseAt time.Time
+	UpdatedAt    time.Time
+}
+
+// Location returns the event's timezone, or UTC if it doesn't load.
+func (e *Event) Location() *time.Location {
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Variables returns the event as message template variables, its times
+// rendered in the event's timezone.
+func (e *Event) Variables() map[string]string {
+	loc := e.Location()
+	return map[string]string{
+		VarEventName:     e.Name,
+		VarEventTimezone: loc.String(),
+		VarEventDate:     e.StartDate.In(loc).Format(dateLayout),
+		VarEventStart:    e.StartDate.In(loc).Format(dateTimeLayout),
+		VarEventEnd:      e.EndDate.In(loc).Format(dateTimeLayout),
+	}
+}
+
+// Calendar is an iCalendar (RFC 5545) document of events.
+type Calendar struct {
+	Name      string // X-WR-CALNAME, shown by subscribing clients
+	Timezone  string // X-WR-TIMEZONE; empty for calendars spanning zones
+	UIDDomain string // right-hand side of every entry's UID
+	Events    []*Event
+}
+
+// Attachment is a file to attach to a guest message.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// FeedLink is a tenant's subscribable calendar feed.
+//
+// swagger:model CalendarFeedLink
+type FeedLink struct {
+	URL       string `json:"url"`        // https://… for downloads
+	WebcalURL string `json:"webcal_url"` // webcal://… to subscribe in one click
+}
@@ -0,0 +1,144 @@
+package calendar
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/calendar/mock_store.go -package=mockcalendar github.com/biairmal/guest-management-be/internal/features/calendar Store
+
+// eventSelect reads the events columns scanEvent scans.
+const eventSelect = `SELECT id, tenant_id, name, description, start_date, end_date, timezone, updated_at
+	FROM events`
+
+// daySelect reads the event_days columns scanDays scans, the date as
+// YYYY-MM-DD; callers alias event_days as d.
+const daySelect = `SELECT d.id, d.event_id, to_char(d.day_date, 'YYYY-MM-DD'), d.name, d.doors_open_at,
+	d.doors_close_at, d.updated_at FROM event_days d`
+
+// Store reads the events and days calendars are built from.
+type Store interface {
+	// Event returns the live event with its live days, or
+	// repository.ErrNotFound.
+	Event(ctx context.Context, eventID uuid.UUID) (*Event, error)
+	// TenantName returns the live tenant's name, or repository.ErrNotFound.
+	TenantName(ctx context.Context, tenantID uuid.UUID) (string, error)
+	// TenantEvents returns the tenant's live events ending at or after since,
+	// by start date, with their live days.
+	TenantEvents(ctx context.Context, tenantID uuid.UUID, since time.Time) ([]*Event, error)
+}
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// Event implements Store.
+func (s *store) Event(ctx context.Context, eventID uuid.UUID) (*Event, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	ev, err := scanEvent(conn.QueryRowContext(ctx, eventSelect+" WHERE id = $1 AND deleted_at IS NULL", eventID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.days(ctx, " WHERE d.event_id = $1 AND d.deleted_at IS NULL ORDER BY d.day_date", eventID)
+	if err != nil {
+		return nil, err
+	}
+	ev.Days = days[ev.ID]
+	return ev, nil
+}
+
+// TenantName implements Store.
+func (s *store) TenantName(ctx context.Context, tenantID uuid.UUID) (string, error) {
+	var name string
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT name FROM tenants WHERE id = $1 AND deleted_at IS NULL", tenantID).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", repository.ErrNotFound
+	}
+	return name, err
+}
+
+// TenantEvents implements Store.
+func (s *store) TenantEvents(ctx context.Context, tenantID uuid.UUID, since time.Time) ([]*Event, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, eventSelect+`
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND end_date >= $2
+		ORDER BY start_date, id`, tenantID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	events := []*Event{}
+	for rows.Next() {
+		ev, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	days, err := s.days(ctx, `
+		JOIN events e ON e.id = d.event_id
+		WHERE e.tenant_id = $1 AND e.deleted_at IS NULL AND e.end_date >= $2 AND d.deleted_at IS NULL
+		ORDER BY d.day_date`, tenantID, since)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range events {
+		ev.Days = days[ev.ID]
+	}
+	return events, nil
+}
+
+// days runs daySelect with the given tail and groups the days by event.
+func (s *store) days(ctx context.Context, tail string, args ...any) (map[uuid.UUID][]Day, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, daySelect+tail, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := map[uuid.UUID][]Day{}
+	for rows.Next() {
+		var (
+			d       Day
+			eventID uuid.UUID
+		)
+		if err := rows.Scan(&d.ID, &eventID, &d.Date, &d.Name, &d.DoorsOpenAt, &d.DoorsCloseAt, &d.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out[eventID] = append(out[eventID], d)
+	}
+	return out, rows.Err()
+}
+
+// scanEvent scans one eventSelect row.
+func scanEvent(row interface{ Scan(dest ...any) error }) (*Event, error) {
+	var ev Event
+	if err := row.Scan(
+		&ev.ID, &ev.TenantID, &ev.Name, &ev.Description, &ev.StartDate, &ev.EndDate, &ev.Timezone, &ev.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &ev, nil
+}
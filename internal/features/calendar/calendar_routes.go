@@ -0,0 +1,20 @@
+package calendar
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitCalendarRoutes registers the event calendar file route on the given
+// router.
+func InitCalendarRoutes(r *chi.Mux, calendarH *Handler) {
+	r.Get("/api/v1/events/{eventId}/calendar.ics", calendarH.EventCalendar)
+}
+
+// InitFeedRoutes registers the tenant feed link route and the public,
+// token-authenticated tenant feed on the given router.
+func InitFeedRoutes(r *chi.Mux, calendarH *Handler) {
+	r.Get("/api/v1/tenants/{tenantId}/calendar-feed", problem.Handle(calendarH.FeedLink))
+	r.Get("/api/v1/tenants/{tenantId}/calendar.ics", calendarH.TenantFeed)
+}
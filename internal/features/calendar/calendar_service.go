@@ -0,0 +1,123 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/calendar/mock_service.go -package=mockcalendar github.com/biairmal/guest-management-be/internal/features/calendar Service
+
+// feedTokenContext separates feed token MACs from any other use of the secret.
+const feedTokenContext = "calendar-feed:"
+
+// Service builds iCalendar documents of events and tenants' feeds.
+type Service interface {
+	// EventCalendar returns the event as a calendar of one entry, or of one
+	// entry per day.
+	EventCalendar(ctx context.Context, eventID uuid.UUID) (*Calendar, error)
+	// Attachment returns the event's calendar as an .ics attachment for guest
+	// messages; invitations carry it when sent with the attach_ics option.
+	Attachment(ctx context.Context, eventID uuid.UUID) (*Attachment, error)
+	// FeedLink returns the link calendar apps subscribe to for the tenant's
+	// events.
+	FeedLink(ctx context.Context, tenantID uuid.UUID) (*FeedLink, error)
+	// TenantFeed returns the tenant's events that haven't ended more than
+	// FeedPast ago, if token is the tenant's feed token.
+	TenantFeed(ctx context.Context, tenantID uuid.UUID, token string) (*Calendar, error)
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger logger.Logger
+	store  Store
+	cfg    ServiceConfig
+	now    func() time.Time
+}
+
+// NewService returns a Service reading events from store.
+func NewService(logger logger.Logger, store Store, cfg ServiceConfig) Service {
+	return &serviceImpl{logger: logger, store: store, cfg: cfg, now: time.Now}
+}
+
+// EventCalendar implements Service.
+func (s *serviceImpl) EventCalendar(ctx context.Context, eventID uuid.UUID) (*Calendar, error) {
+	ev, err := s.store.Event(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return &Calendar{
+		Name: ev.Name, Timezone: ev.Location().String(), UIDDomain: s.cfg.UIDDomain, Events: []*Event{ev},
+	}, nil
+}
+
+// Attachment implements Service.
+func (s *serviceImpl) Attachment(ctx context.Context, eventID uuid.UUID) (*Attachment, error) {
+	cal, err := s.EventCalendar(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := Encode(&buf, cal); err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return &Attachment{Filename: "invite.ics", ContentType: ContentType, Content: buf.Bytes()}, nil
+}
+
+// FeedLink implements Service.
+func (s *serviceImpl) FeedLink(ctx context.Context, tenantID uuid.UUID) (*FeedLink, error) {
+	if _, err := s.store.TenantName(ctx, tenantID); err != nil {
+		return nil, s.translate(ctx, "tenant", err, logger.F("tenant_id", tenantID))
+	}
+	link := strings.TrimRight(s.cfg.FeedBaseURL, "/") + "/api/v1/tenants/" + tenantID.String() +
+		"/calendar.ics?token=" + s.feedToken(tenantID)
+	_, rest, _ := strings.Cut(link, "://")
+	return &FeedLink{URL: link, WebcalURL: "webcal://" + rest}, nil
+}
+
+// TenantFeed implements Service. A wrong token reads as an unknown tenant, so
+// the feed reveals nothing without one.
+func (s *serviceImpl) TenantFeed(ctx context.Context, tenantID uuid.UUID, token string) (*Calendar, error) {
+	if !hmac.Equal([]byte(token), []byte(s.feedToken(tenantID))) {
+		return nil, errcode.CalendarFeedNotFound.New()
+	}
+	name, err := s.store.TenantName(ctx, tenantID)
+	if err != nil {
+		return nil, s.translate(ctx, "calendar feed", err, logger.F("tenant_id", tenantID))
+	}
+	events, err := s.store.TenantEvents(ctx, tenantID, s.now().Add(-s.cfg.FeedPast))
+	if err != nil {
+		return nil, s.translate(ctx, "calendar feed", err, logger.F("tenant_id", tenantID))
+	}
+	return &Calendar{Name: name, UIDDomain: s.cfg.UIDDomain, Events: events}, nil
+}
+
+// feedToken returns the tenant's feed token: the base64url HMAC-SHA256 of its
+// id. It never expires; rotating FeedSecret revokes every token.
+func (s *serviceImpl) feedToken(tenantID uuid.UUID) string {
+	h := hmac.New(sha256.New, []byte(s.cfg.FeedSecret))
+	h.Write([]byte(feedTokenContext + tenantID.String()))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// translate maps a store error to errorz: repository.ErrNotFound → 404 for
+// subject, anything else is logged and becomes a 500.
+func (s *serviceImpl) translate(ctx context.Context, subject string, err error, fields ...logger.Field) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errorz.NotFound().WithMessage(subject + " not found")
+	}
+	s.logger.ErrorWithContext(ctx, "calendar build failed", append(fields, logger.F("error", err))...)
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to build calendar")
+}
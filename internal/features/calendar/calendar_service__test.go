@@ -0,0 +1,176 @@
+package calendar_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+	mockcalendar "github.com/biairmal/guest-management-be/mocks/calendar"
+)
+
+var testConfig = calendar.ServiceConfig{
+	UIDDomain: "gm.test", FeedSecret: strings.Repeat("s", 32), FeedBaseURL: "https://api.example.com/",
+	FeedPast: 24 * time.Hour,
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func newService(t *testing.T, cfg calendar.ServiceConfig) (calendar.Service, *mockcalendar.MockStore) {
+	store := mockcalendar.NewMockStore(gomock.NewController(t))
+	return calendar.NewService(logger.NewNoOp(), store, cfg), store
+}
+
+func TestService_EventCalendar(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name     string
+		event    *calendar.Event
+		err      error
+		wantTZ   string
+		wantCode string
+	}{
+		{name: "event's timezone", event: &calendar.Event{ID: eventID, Name: "Gala", Timezone: "Asia/Jakarta"},
+			wantTZ: "Asia/Jakarta"},
+		{name: "unloadable timezone falls back to UTC", event: &calendar.Event{ID: eventID, Timezone: "Mars/Olympus"},
+			wantTZ: "UTC"},
+		{name: "missing event", err: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "store failure", err: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t, testConfig)
+			store.EXPECT().Event(gomock.Any(), eventID).Return(tt.event, tt.err)
+			cal, err := svc.EventCalendar(context.Background(), eventID)
+			assertErrorzCode(t, err, tt.wantCode)
+			if err != nil {
+				return
+			}
+			if cal.Timezone != tt.wantTZ || cal.UIDDomain != "gm.test" || len(cal.Events) != 1 {
+				t.Errorf("calendar = %+v, want timezone %s with the one event", cal, tt.wantTZ)
+			}
+		})
+	}
+}
+
+func TestService_Attachment(t *testing.T) {
+	eventID := uuid.New()
+	svc, store := newService(t, testConfig)
+	store.EXPECT().Event(gomock.Any(), eventID).Return(&calendar.Event{
+		ID: eventID, Name: "Gala", Timezone: "UTC",
+		StartDate: time.Date(2026, 5, 2, 19, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 5, 2, 23, 0, 0, 0, time.UTC),
+	}, nil)
+	att, err := svc.Attachment(context.Background(), eventID)
+	assertErrorzCode(t, err, "")
+	if att.Filename != "invite.ics" || att.ContentType != calendar.ContentType {
+		t.Errorf("attachment = %s (%s), want invite.ics (%s)", att.Filename, att.ContentType, calendar.ContentType)
+	}
+	if !strings.Contains(string(att.Content), "UID:"+eventID.String()+"@gm.test\r\n") {
+		t.Errorf("attachment lacks the event entry:\n%s", att.Content)
+	}
+}
+
+func TestService_TenantFeed(t *testing.T) {
+	tenantID := uuid.New()
+	svc, store := newService(t, testConfig)
+	store.EXPECT().TenantName(gomock.Any(), tenantID).Return("Acme", nil)
+	link, err := svc.FeedLink(context.Background(), tenantID)
+	assertErrorzCode(t, err, "")
+	u, err := url.Parse(link.URL)
+	if err != nil {
+		t.Fatalf("feed url %q: %v", link.URL, err)
+	}
+	if u.Host != "api.example.com" || u.Path != "/api/v1/tenants/"+tenantID.String()+"/calendar.ics" {
+		t.Errorf("feed url = %s", link.URL)
+	}
+	if link.WebcalURL != "webcal://"+strings.TrimPrefix(link.URL, "https://") {
+		t.Errorf("webcal url = %s, want the feed url on webcal://", link.WebcalURL)
+	}
+	token := u.Query().Get("token")
+
+	otherSecret := testConfig
+	otherSecret.FeedSecret = strings.Repeat("x", 32)
+	tests := []struct {
+		name     string
+		cfg      calendar.ServiceConfig
+		tenantID uuid.UUID
+		token    string
+		mock     func(store *mockcalendar.MockStore)
+		wantCode string
+	}{
+		{
+			name: "valid token", cfg: testConfig, tenantID: tenantID, token: token,
+			mock: func(store *mockcalendar.MockStore) {
+				store.EXPECT().TenantName(gomock.Any(), tenantID).Return("Acme", nil)
+				store.EXPECT().TenantEvents(gomock.Any(), tenantID, gomock.Any()).
+					Return([]*calendar.Event{{ID: uuid.New()}}, nil)
+			},
+		},
+		{name: "missing token", cfg: testConfig, tenantID: tenantID, wantCode: errorz.CodeNotFound},
+		{name: "token of another tenant", cfg: testConfig, tenantID: uuid.New(), token: token,
+			wantCode: errorz.CodeNotFound},
+		{name: "rotated secret", cfg: otherSecret, tenantID: tenantID, token: token, wantCode: errorz.CodeNotFound},
+		{
+			name: "deleted tenant", cfg: testConfig, tenantID: tenantID, token: token,
+			mock: func(store *mockcalendar.MockStore) {
+				store.EXPECT().TenantName(gomock.Any(), tenantID).Return("", repository.ErrNotFound)
+			},
+			wantCode: errorz.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t, tt.cfg)
+			if tt.mock != nil {
+				tt.mock(store)
+			}
+			cal, err := svc.TenantFeed(context.Background(), tt.tenantID, tt.token)
+			assertErrorzCode(t, err, tt.wantCode)
+			if err == nil && (cal.Name != "Acme" || cal.Timezone != "" || len(cal.Events) != 1) {
+				t.Errorf("feed = %+v, want Acme's one event and no calendar timezone", cal)
+			}
+		})
+	}
+}
+
+func TestEvent_Variables(t *testing.T) {
+	ev := &calendar.Event{
+		Name: "Gala", Timezone: "Asia/Jakarta",
+		StartDate: time.Date(2026, 5, 2, 17, 30, 0, 0, time.UTC), EndDate: time.Date(2026, 5, 2, 20, 0, 0, 0, time.UTC),
+	}
+	want := map[string]string{
+		calendar.VarEventName:     "Gala",
+		calendar.VarEventTimezone: "Asia/Jakarta",
+		calendar.VarEventDate:     "Sunday, 3 May 2026",
+		calendar.VarEventStart:    "Sunday, 3 May 2026 00:30 WIB",
+		calendar.VarEventEnd:      "Sunday, 3 May 2026 03:00 WIB",
+	}
+	got := ev.Variables()
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q, want %q", k, got[k], v)
+		}
+	}
+}
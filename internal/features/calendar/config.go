@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// minSecretLength is the shortest accepted feed token signing secret, in bytes.
+const minSecretLength = 32
+
+// Config aggregates the calendar feature's own configuration, one field per
+// layer (app.calendar.<layer> in config.yaml). The public tenant feed routes
+// are only registered when FeedEnabled.
+type Config struct {
+	FeedEnabled bool          `mapstructure:"feed_enabled"`
+	Service     ServiceConfig `mapstructure:"service"`
+}
+
+// ServiceConfig holds config for the calendar feature's service layer.
+type ServiceConfig struct {
+	// UIDDomain is the right-hand side of every calendar entry's UID
+	// (<id>@<uid_domain>); changing it makes subscribers see new entries.
+	UIDDomain string `mapstructure:"uid_domain"`
+	// FeedSecret signs the tenant feed tokens. Rotating it invalidates every
+	// subscription handed out so far.
+	FeedSecret string `mapstructure:"feed_secret"`
+	// FeedBaseURL is the public origin of this API; feed links are built on it.
+	FeedBaseURL string `mapstructure:"feed_base_url"`
+	// FeedPast is how long after they end events stay in the tenant feed.
+	FeedPast time.Duration `mapstructure:"feed_past"`
+}
+
+// DefaultConfig returns the calendar feature config with its defaults. The
+// tenant feed is disabled until a secret is configured.
+func DefaultConfig() Config {
+	return Config{Service: ServiceConfig{
+		UIDDomain:   "guest-management",
+		FeedBaseURL: "http://localhost:8080",
+		FeedPast:    90 * 24 * time.Hour,
+	}}
+}
+
+// Validate validates the calendar feature configuration.
+func (c *Config) Validate() error {
+	if c.Service.UIDDomain == "" {
+		return errorz.Internal().WithMessage("calendar: service.uid_domain is required")
+	}
+	if !c.FeedEnabled {
+		return nil
+	}
+	if len(c.Service.FeedSecret) < minSecretLength {
+		return errorz.Internal().WithMessage("calendar: service.feed_secret must be at least 32 bytes when feeds are enabled")
+	}
+	if u, err := url.Parse(c.Service.FeedBaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return errorz.Internal().WithMessage("calendar: service.feed_base_url must be an absolute URL")
+	}
+	if c.Service.FeedPast < 0 {
+		return errorz.Internal().WithMessage("calendar: service.feed_past must not be negative")
+	}
+	return nil
+}
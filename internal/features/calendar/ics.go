@@ -0,0 +1,111 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of an encoded Calendar.
+const ContentType = "text/calendar; charset=utf-8"
+
+// prodID identifies this application as the calendar's producer.
+const prodID = "-//guest-management//calendar//EN"
+
+// icsTime is the UTC DATE-TIME form: 20260502T120000Z.
+const icsTime = "20060102T150405Z"
+
+// maxLineOctets is the longest content line before folding (RFC 5545 §3.1).
+const maxLineOctets = 75
+
+// Encode writes cal as an iCalendar document. Times are written in UTC, which
+// every client converts to its viewer's zone, so no VTIMEZONE is needed; the
+// event's own zone is named in X-WR-TIMEZONE for single-zone calendars.
+func Encode(w io.Writer, cal *Calendar) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escape(cal.Name))
+	}
+	if cal.Timezone != "" {
+		e.line("X-WR-TIMEZONE", cal.Timezone)
+	}
+	for _, ev := range cal.Events {
+		if len(ev.Days) == 0 {
+			e.event(cal.UIDDomain, ev.ID.String(), ev.Name, ev.Description, ev.StartDate, ev.EndDate, ev.UpdatedAt)
+			continue
+		}
+		for _, d := range ev.Days {
+			label := d.Date
+			if d.Name != nil {
+				label = *d.Name
+			}
+			e.event(cal.UIDDomain, d.ID.String(), ev.Name+" – "+label, ev.Description,
+				d.DoorsOpenAt, d.DoorsCloseAt, d.UpdatedAt)
+		}
+	}
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// encoder writes folded content lines, remembering the first write error.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// event writes one VEVENT. DTSTAMP is the row's last change, so encoding the
+// same data twice gives the same document.
+func (e *encoder) event(domain, id, summary string, description *string, start, end, updated time.Time) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", id+"@"+domain)
+	e.line("DTSTAMP", updated.UTC().Format(icsTime))
+	e.line("LAST-MODIFIED", updated.UTC().Format(icsTime))
+	e.line("DTSTART", start.UTC().Format(icsTime))
+	e.line("DTEND", end.UTC().Format(icsTime))
+	e.line("SUMMARY", escape(summary))
+	if description != nil && *description != "" {
+		e.line("DESCRIPTION", escape(*description))
+	}
+	e.line("STATUS", "CONFIRMED")
+	e.line("END", "VEVENT")
+}
+
+// line writes "name:value" folded to maxLineOctets per line, never splitting
+// a UTF-8 sequence; continuation lines start with a space.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	s := name + ":" + value
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		if _, e.err = e.w.WriteString(s[:cut] + "\r\n "); e.err != nil {
+			return
+		}
+		s = s[cut:]
+		limit = maxLineOctets - 1 // the leading space counts
+	}
+	_, e.err = e.w.WriteString(s + "\r\n")
+}
+
+// textEscaper escapes TEXT values (RFC 5545 §3.3.11).
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,141 @@
+package calendar_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/features/calendar"
+)
+
+// unfold joins folded content lines back into whole lines.
+func unfold(doc string) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(doc, "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+func TestEncode(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	updated := time.Date(2026, 4, 1, 8, 0, 0, 0, time.UTC)
+	desc := "Dress code: batik; bring your ticket, please\nSee you there"
+	day2 := "Reception"
+	single := &calendar.Event{
+		ID: uuid.MustParse("11111111-1111-1111-1111-111111111111"), Name: "Gala, 2026", Description: &desc,
+		StartDate: time.Date(2026, 5, 2, 19, 0, 0, 0, jakarta), EndDate: time.Date(2026, 5, 2, 23, 0, 0, 0, jakarta),
+		Timezone: "Asia/Jakarta", UpdatedAt: updated,
+	}
+	multi := &calendar.Event{
+		ID: uuid.New(), Name: "Wedding", Timezone: "Asia/Jakarta", UpdatedAt: updated,
+		Days: []calendar.Day{
+			{
+				ID: uuid.MustParse("22222222-2222-2222-2222-222222222222"), Date: "2026-05-02",
+				DoorsOpenAt:  time.Date(2026, 5, 2, 8, 0, 0, 0, jakarta),
+				DoorsCloseAt: time.Date(2026, 5, 2, 11, 0, 0, 0, jakarta), UpdatedAt: updated,
+			},
+			{
+				ID: uuid.MustParse("33333333-3333-3333-3333-333333333333"), Date: "2026-05-03", Name: &day2,
+				DoorsOpenAt:  time.Date(2026, 5, 3, 18, 0, 0, 0, jakarta),
+				DoorsCloseAt: time.Date(2026, 5, 3, 22, 0, 0, 0, jakarta), UpdatedAt: updated,
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		cal       *calendar.Calendar
+		wantLines []string
+		absent    []string
+		vevents   int
+	}{
+		{
+			name: "single event in UTC with escaped text",
+			cal: &calendar.Calendar{
+				Name: "Gala", Timezone: "Asia/Jakarta", UIDDomain: "gm.test", Events: []*calendar.Event{single},
+			},
+			wantLines: []string{
+				"BEGIN:VCALENDAR", "VERSION:2.0", "METHOD:PUBLISH", "X-WR-CALNAME:Gala", "X-WR-TIMEZONE:Asia/Jakarta",
+				"UID:11111111-1111-1111-1111-111111111111@gm.test",
+				"DTSTAMP:20260401T080000Z", "DTSTART:20260502T120000Z", "DTEND:20260502T160000Z",
+				`SUMMARY:Gala\, 2026`,
+				`DESCRIPTION:Dress code: batik\; bring your ticket\, please\nSee you there`,
+				"END:VCALENDAR",
+			},
+			vevents: 1,
+		},
+		{
+			name: "one entry per day spanning its doors window",
+			cal:  &calendar.Calendar{UIDDomain: "gm.test", Events: []*calendar.Event{multi}},
+			wantLines: []string{
+				"UID:22222222-2222-2222-2222-222222222222@gm.test", "SUMMARY:Wedding – 2026-05-02",
+				"DTSTART:20260502T010000Z", "DTEND:20260502T040000Z",
+				"UID:33333333-3333-3333-3333-333333333333@gm.test", "SUMMARY:Wedding – Reception",
+				"DTSTART:20260503T110000Z", "DTEND:20260503T150000Z",
+			},
+			absent:  []string{"X-WR-TIMEZONE", "X-WR-CALNAME", "DESCRIPTION", "UID:" + multi.ID.String()},
+			vevents: 2,
+		},
+		{
+			name:    "empty calendar",
+			cal:     &calendar.Calendar{UIDDomain: "gm.test"},
+			vevents: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := calendar.Encode(&buf, tt.cal); err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			doc := buf.String()
+			lines := unfold(doc)
+			have := map[string]bool{}
+			for _, l := range lines {
+				have[l] = true
+			}
+			for _, want := range tt.wantLines {
+				if !have[want] {
+					t.Errorf("missing line %q in:\n%s", want, doc)
+				}
+			}
+			for _, a := range tt.absent {
+				if strings.Contains(doc, a) {
+					t.Errorf("document contains %q", a)
+				}
+			}
+			if got := strings.Count(doc, "BEGIN:VEVENT\r\n"); got != tt.vevents {
+				t.Errorf("VEVENTs = %d, want %d", got, tt.vevents)
+			}
+			if lines[0] != "BEGIN:VCALENDAR" || lines[len(lines)-1] != "END:VCALENDAR" {
+				t.Errorf("document isn't wrapped in VCALENDAR:\n%s", doc)
+			}
+		})
+	}
+}
+
+func TestEncode_Folding(t *testing.T) {
+	name := strings.Repeat("é", 100) // 200 octets
+	var buf bytes.Buffer
+	cal := &calendar.Calendar{Name: name, UIDDomain: "gm.test"}
+	if err := calendar.Encode(&buf, cal); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(l) > 75 {
+			t.Errorf("line of %d octets: %q", len(l), l)
+		}
+		if !utf8.ValidString(l) {
+			t.Errorf("fold split a character: %q", l)
+		}
+	}
+	want := "X-WR-CALNAME:" + name
+	found := false
+	for _, l := range unfold(buf.String()) {
+		found = found || l == want
+	}
+	if !found {
+		t.Errorf("unfolded document lacks %q", want)
+	}
+}
@@ -0,0 +1,325 @@
+package devices
+
+import (
+	"encoding/json"
+	"net/http"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// shiftListConfig declares the allow-listed sort/filter fields for shifts.
+var shiftListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"started_at"},
+	AllowedFilterFields: []string{"device_id", "user_id"},
+}
+
+// Handler exposes HTTP handlers for scanner devices and operator shifts.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+}
+
+// NewHandler returns a Handler that uses the given service and validator.
+func NewHandler(service Service, validator validation.Validator) *Handler {
+	return &Handler{service: service, validator: validator}
+}
+
+// List handles GET /events/{eventId}/devices.
+//
+// List godoc
+//
+//	@Summary		List devices
+//	@Description	The event's scanner devices by name, each with the user of its open shift. Credentials are never returned here, only their prefix.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{array}		devices.Device
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated"
+//	@Failure		403		{object}	problem.Problem	"Missing manage_devices"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices [get]
+func (h *Handler) List(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	devices, err := h.service.List(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(devices), nil
+}
+
+// Create handles POST /events/{eventId}/devices.
+//
+// Create godoc
+//
+//	@Summary		Register device
+//	@Description	Registers a scanner device at a gate, optionally limited to one workflow step, and issues its credential. The credential is shown only in this response; the device sends it in the X-Device-Credential header.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string				true	"Event UUID"
+//	@Param			body	body		devices.DeviceInput	true	"Device"
+//	@Success		201		{object}	devices.Credentialed
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body, or step not in the event"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated"
+//	@Failure		403		{object}	problem.Problem	"Missing manage_devices"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		409		{object}	problem.Problem	"Device name taken"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices [post]
+func (h *Handler) Create(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	body, err := h.decode(r)
+	if err != nil {
+		return nil, err
+	}
+	d, err := h.service.Create(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(d), nil
+}
+
+// Update handles PUT /events/{eventId}/devices/{deviceId}.
+//
+// Update godoc
+//
+//	@Summary		Replace device
+//	@Description	Replaces the device's name, gate, step and active flag. An inactive device can't authorize scans or start shifts.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string				true	"Event UUID"
+//	@Param			deviceId	path		string				true	"Device UUID"
+//	@Param			body		body		devices.DeviceInput	true	"Device"
+//	@Success		200			{object}	devices.Device
+//	@Failure		400			{object}	problem.Problem	"Invalid id or body, or step not in the event"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Missing manage_devices"
+//	@Failure		404			{object}	problem.Problem	"Device not found"
+//	@Failure		409			{object}	problem.Problem	"Device name taken"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices/{deviceId} [put]
+func (h *Handler) Update(r *http.Request) (any, error) {
+	eventID, deviceID, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	body, err := h.decode(r)
+	if err != nil {
+		return nil, err
+	}
+	d, err := h.service.Update(r.Context(), eventID, deviceID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(d), nil
+}
+
+// Delete handles DELETE /events/{eventId}/devices/{deviceId}.
+//
+// Delete godoc
+//
+//	@Summary		Remove device
+//	@Description	Removes the device and ends its open shift. Its credential stops working; its scans keep their device id.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Param			eventId		path	string	true	"Event UUID"
+//	@Param			deviceId	path	string	true	"Device UUID"
+//	@Success		204
+//	@Failure		400	{object}	problem.Problem	"Invalid id"
+//	@Failure		401	{object}	problem.Problem	"Not authenticated"
+//	@Failure		403	{object}	problem.Problem	"Missing manage_devices"
+//	@Failure		404	{object}	problem.Problem	"Device not found"
+//	@Failure		500	{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices/{deviceId} [delete]
+func (h *Handler) Delete(r *http.Request) (any, error) {
+	eventID, deviceID, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Delete(r.Context(), eventID, deviceID); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// RotateCredential handles POST /events/{eventId}/devices/{deviceId}/credential.
+//
+// RotateCredential godoc
+//
+//	@Summary		Rotate device credential
+//	@Description	Issues the device a new credential, shown only in this response. The old credential stops working at once.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			deviceId	path		string	true	"Device UUID"
+//	@Success		200			{object}	devices.Credentialed
+//	@Failure		400			{object}	problem.Problem	"Invalid id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Missing manage_devices"
+//	@Failure		404			{object}	problem.Problem	"Device not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices/{deviceId}/credential [post]
+func (h *Handler) RotateCredential(r *http.Request) (any, error) {
+	eventID, deviceID, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	d, err := h.service.RotateCredential(r.Context(), eventID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(d), nil
+}
+
+// StartShift handles POST /events/{eventId}/devices/{deviceId}/shift.
+//
+// StartShift godoc
+//
+//	@Summary		Start shift
+//	@Description	Checks the logged-in staff member in as the device's operator. A device has one operator at a time and an operator works one device at a time.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			deviceId	path		string	true	"Device UUID"
+//	@Success		201			{object}	devices.Shift
+//	@Failure		400			{object}	problem.Problem	"Invalid id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Missing check_in, or not on the event's staff"
+//	@Failure		404			{object}	problem.Problem	"Device not found"
+//	@Failure		409			{object}	problem.Problem	"Device inactive, or device or operator already on a shift"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices/{deviceId}/shift [post]
+func (h *Handler) StartShift(r *http.Request) (any, error) {
+	eventID, deviceID, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	sh, err := h.service.StartShift(r.Context(), eventID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(sh), nil
+}
+
+// EndShift handles DELETE /events/{eventId}/devices/{deviceId}/shift.
+//
+// EndShift godoc
+//
+//	@Summary		End shift
+//	@Description	Checks the logged-in user out of their open shift on the device.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			deviceId	path		string	true	"Device UUID"
+//	@Success		200			{object}	devices.Shift
+//	@Failure		400			{object}	problem.Problem	"Invalid id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Missing check_in"
+//	@Failure		404			{object}	problem.Problem	"Device not found or no open shift on it"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/devices/{deviceId}/shift [delete]
+func (h *Handler) EndShift(r *http.Request) (any, error) {
+	eventID, deviceID, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	sh, err := h.service.EndShift(r.Context(), eventID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(sh), nil
+}
+
+// Shifts handles GET /events/{eventId}/shifts.
+//
+// Shifts godoc
+//
+//	@Summary		List shifts
+//	@Description	The event's operator shifts (paginated, filtered, sorted; default latest first), open ones without ended_at.
+//	@Tags			devices
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			page		query		int		false	"Page number (1-based)"
+//	@Param			size		query		int		false	"Page size (max 100)"
+//	@Param			sort		query		string	false	"Sort spec field,DIRECTION (repeatable): started_at"
+//	@Param			device_id	query		string	false	"Filter by device UUID"
+//	@Param			user_id		query		string	false	"Filter by operator UUID"
+//	@Success		200			{object}	dto.PageResponse[devices.Shift]
+//	@Failure		400			{object}	problem.Problem	"Invalid event id or query"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Missing manage_devices"
+//	@Failure		404			{object}	problem.Problem	"Event not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/shifts [get]
+func (h *Handler) Shifts(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	params, err := query.ParseListParams(r.URL.Query(), shiftListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	page, err := h.service.Shifts(r.Context(), eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(page), nil
+}
+
+// decode reads and validates a device body.
+func (h *Handler) decode(r *http.Request) (DeviceInput, error) {
+	var body DeviceInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return body, err
+	}
+	return body, nil
+}
+
+// parseIDs parses the eventId and deviceId path parameters.
+func parseIDs(r *http.Request) (eventID, deviceID uuid.UUID, err error) {
+	if eventID, err = parseID(r, "eventId", "event"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if deviceID, err = parseID(r, "deviceId", "device"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return eventID, deviceID, nil
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
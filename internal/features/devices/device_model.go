@@ -0,0 +1,61 @@
+package devices
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HeaderCredential is the request header scanner devices send their
+// credential in.
+const HeaderCredential = "X-Device-Credential"
+
+// credentialPrefix starts every device credential, so leaked ones are easy to
+// recognise.
+const credentialPrefix = "gmd_"
+
+// Device represents a row in the scanner_devices table: a scanner registered
+// to an event at a gate. A device with WorkflowStepID set may only scan for
+// that step; without one it may scan for any step of the event.
+//
+// swagger:model ScannerDevice
+type Device struct {
+	ID               uuid.UUID  `json:"id"`
+	EventID          uuid.UUID  `json:"event_id"`
+	Name             string     `json:"name"`
+	Gate             *string    `json:"gate,omitempty"`
+	WorkflowStepID   *uuid.UUID `json:"workflow_step_id"`
+	CredentialPrefix string     `json:"credential_prefix"` // first characters, to tell credentials apart
+	Active           bool       `json:"active"`
+	LastSeenAt       *time.Time `json:"last_seen_at,omitempty"`
+	Operator         *uuid.UUID `json:"operator_user_id,omitempty"` // user of the open shift
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Allows reports whether the device may scan for the workflow step.
+func (d *Device) Allows(stepID uuid.UUID) bool {
+	return d.Active && (d.WorkflowStepID == nil || *d.WorkflowStepID == stepID)
+}
+
+// Credentialed is a device with its credential, returned only when the
+// credential is issued; it can't be read back later.
+//
+// swagger:model ScannerDeviceCredential
+type Credentialed struct {
+	Device
+	Credential string `json:"credential"`
+}
+
+// Shift represents a row in the operator_shifts table: a staff user working
+// a device. EndedAt is nil while the shift is open.
+//
+// swagger:model OperatorShift
+type Shift struct {
+	ID        uuid.UUID  `json:"id"`
+	EventID   uuid.UUID  `json:"event_id"`
+	DeviceID  uuid.UUID  `json:"device_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
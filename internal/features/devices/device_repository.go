@@ -0,0 +1,314 @@
+package devices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/devices/mock_store.go -package=mockdevices github.com/biairmal/guest-management-be/internal/features/devices Store
+
+// uniqueViolation is PostgreSQL's SQLSTATE for a unique index conflict.
+const uniqueViolation = "23505"
+
+// deviceSelect reads the scanner_devices columns scanDevice scans, with the
+// user of the device's open shift; callers alias scanner_devices as d.
+const deviceSelect = `SELECT d.id, d.event_id, d.name, d.gate, d.workflow_step_id, d.credential_prefix, d.active,
+	d.last_seen_at, (SELECT o.user_id FROM operator_shifts o WHERE o.device_id = d.id AND o.ended_at IS NULL),
+	d.created_at, d.updated_at FROM scanner_devices d`
+
+// shiftSelect reads the operator_shifts columns scanShift scans.
+const shiftSelect = "SELECT id, event_id, device_id, user_id, started_at, ended_at FROM operator_shifts"
+
+// shiftColumns maps the shift list's allow-listed filter/sort fields to SQL.
+var shiftColumns = map[string]string{
+	"device_id":  "device_id",
+	"user_id":    "user_id",
+	"started_at": "started_at",
+}
+
+// Store holds the scanner device and operator shift queries.
+type Store interface {
+	// EventTenant returns the tenant of the live event, or
+	// repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// StepInEvent reports whether the workflow step is a live step of the
+	// event.
+	StepInEvent(ctx context.Context, eventID, stepID uuid.UUID) (bool, error)
+	// OnStaff reports whether the user is on the event's staff.
+	OnStaff(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+	// Devices returns the event's live devices by name.
+	Devices(ctx context.Context, eventID uuid.UUID) ([]*Device, error)
+	// Device returns a live device of the event, or repository.ErrNotFound.
+	Device(ctx context.Context, eventID, deviceID uuid.UUID) (*Device, error)
+	// ByCredential returns the live device whose credential hashes to hash,
+	// or repository.ErrNotFound.
+	ByCredential(ctx context.Context, hash string) (*Device, error)
+	// Insert inserts a device with its credential hash, setting its
+	// timestamps. A live device of the event with the same name is
+	// repository.ErrAlreadyExists.
+	Insert(ctx context.Context, d *Device, hash string) error
+	// Update saves a device's name, gate, step and active flag. It returns
+	// repository.ErrNotFound unless the device is a live device of its event,
+	// and repository.ErrAlreadyExists when the name is taken.
+	Update(ctx context.Context, d *Device) error
+	// SetCredential replaces the device's credential. It returns
+	// repository.ErrNotFound unless the device is a live device of the event.
+	SetCredential(ctx context.Context, eventID, deviceID uuid.UUID, hash, prefix string) error
+	// Delete soft-deletes a device and ends its open shift. It returns
+	// repository.ErrNotFound unless the device is a live device of the event.
+	Delete(ctx context.Context, eventID, deviceID uuid.UUID) error
+	// Touch records that the device was seen at at.
+	Touch(ctx context.Context, deviceID uuid.UUID, at time.Time) error
+	// StartShift inserts an open shift, setting its id and start. It returns
+	// repository.ErrAlreadyExists when the device or the user already has one.
+	StartShift(ctx context.Context, s *Shift) error
+	// EndShift ends the user's open shift on the device and returns it, or
+	// repository.ErrNotFound when there is none.
+	EndShift(ctx context.Context, deviceID, userID uuid.UUID) (*Shift, error)
+	// Shifts returns one page of the event's shifts, latest first by default,
+	// and their total.
+	Shifts(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*Shift, int64, error)
+}
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// EventTenant implements Store.
+func (s *store) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// StepInEvent implements Store.
+func (s *store) StepInEvent(ctx context.Context, eventID, stepID uuid.UUID) (bool, error) {
+	var ok bool
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM workflow_steps
+		WHERE id = $2 AND event_id = $1 AND deleted_at IS NULL)`, eventID, stepID).Scan(&ok)
+	return ok, err
+}
+
+// OnStaff implements Store.
+func (s *store) OnStaff(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	var ok bool
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM event_staff_assignments
+		WHERE event_id = $1 AND user_id = $2 AND deleted_at IS NULL)`, eventID, userID).Scan(&ok)
+	return ok, err
+}
+
+// Devices implements Store.
+func (s *store) Devices(ctx context.Context, eventID uuid.UUID) ([]*Device, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx,
+		deviceSelect+" WHERE d.event_id = $1 AND d.deleted_at IS NULL ORDER BY d.name", eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	devices := []*Device{}
+	for rows.Next() {
+		d, err := scanDevice(rows)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, d)
+	}
+	return devices, rows.Err()
+}
+
+// Device implements Store.
+func (s *store) Device(ctx context.Context, eventID, deviceID uuid.UUID) (*Device, error) {
+	return s.device(ctx, " WHERE d.id = $1 AND d.event_id = $2 AND d.deleted_at IS NULL", deviceID, eventID)
+}
+
+// ByCredential implements Store.
+func (s *store) ByCredential(ctx context.Context, hash string) (*Device, error) {
+	return s.device(ctx, " WHERE d.credential_hash = $1 AND d.deleted_at IS NULL", hash)
+}
+
+// device reads one device matching where, mapping no row to
+// repository.ErrNotFound.
+func (s *store) device(ctx context.Context, where string, args ...any) (*Device, error) {
+	d, err := scanDevice(corerepository.Conn(ctx, s.db).QueryRowContext(ctx, deviceSelect+where, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return d, err
+}
+
+// Insert implements Store.
+func (s *store) Insert(ctx context.Context, d *Device, hash string) error {
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		INSERT INTO scanner_devices (event_id, name, gate, workflow_step_id, credential_hash, credential_prefix, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at`,
+		d.EventID, d.Name, d.Gate, d.WorkflowStepID, hash, d.CredentialPrefix, d.Active,
+	).Scan(&d.ID, &d.CreatedAt, &d.UpdatedAt)
+	return conflict(err)
+}
+
+// Update implements Store.
+func (s *store) Update(ctx context.Context, d *Device) error {
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		UPDATE scanner_devices SET name = $3, gate = $4, workflow_step_id = $5, active = $6, updated_at = now()
+		WHERE id = $1 AND event_id = $2 AND deleted_at IS NULL
+		RETURNING updated_at`,
+		d.ID, d.EventID, d.Name, d.Gate, d.WorkflowStepID, d.Active,
+	).Scan(&d.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return conflict(err)
+}
+
+// SetCredential implements Store.
+func (s *store) SetCredential(ctx context.Context, eventID, deviceID uuid.UUID, hash, prefix string) error {
+	return s.exec(ctx, `UPDATE scanner_devices SET credential_hash = $3, credential_prefix = $4, updated_at = now()
+		WHERE id = $1 AND event_id = $2 AND deleted_at IS NULL`, deviceID, eventID, hash, prefix)
+}
+
+// Delete implements Store.
+func (s *store) Delete(ctx context.Context, eventID, deviceID uuid.UUID) error {
+	if err := s.exec(ctx, `UPDATE scanner_devices SET deleted_at = now(), updated_at = now()
+		WHERE id = $1 AND event_id = $2 AND deleted_at IS NULL`, deviceID, eventID); err != nil {
+		return err
+	}
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE operator_shifts SET ended_at = now() WHERE device_id = $1 AND ended_at IS NULL", deviceID)
+	return err
+}
+
+// Touch implements Store.
+func (s *store) Touch(ctx context.Context, deviceID uuid.UUID, at time.Time) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE scanner_devices SET last_seen_at = $2 WHERE id = $1", deviceID, at)
+	return err
+}
+
+// StartShift implements Store.
+func (s *store) StartShift(ctx context.Context, sh *Shift) error {
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		INSERT INTO operator_shifts (event_id, device_id, user_id) VALUES ($1, $2, $3)
+		RETURNING id, started_at`, sh.EventID, sh.DeviceID, sh.UserID,
+	).Scan(&sh.ID, &sh.StartedAt)
+	return conflict(err)
+}
+
+// EndShift implements Store.
+func (s *store) EndShift(ctx context.Context, deviceID, userID uuid.UUID) (*Shift, error) {
+	sh, err := scanShift(corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		UPDATE operator_shifts SET ended_at = now()
+		WHERE device_id = $1 AND user_id = $2 AND ended_at IS NULL
+		RETURNING id, event_id, device_id, user_id, started_at, ended_at`, deviceID, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return sh, err
+}
+
+// Shifts implements Store.
+func (s *store) Shifts(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*Shift, int64, error) {
+	clauses := query.ToSQL(params, shiftColumns, 2)
+	where := " WHERE " + strings.Join(append([]string{"event_id = $1"}, clauses.Where...), " AND ")
+	args := append([]any{eventID}, clauses.Args...)
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, "SELECT count(*) FROM operator_shifts"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := "started_at DESC, id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", id"
+	}
+	n := len(args)
+	args = append(args, params.Size, (params.Page-1)*params.Size)
+	rows, err := conn.QueryContext(ctx,
+		shiftSelect+where+fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, n+1, n+2), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var shifts []*Shift
+	for rows.Next() {
+		sh, err := scanShift(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		shifts = append(shifts, sh)
+	}
+	return shifts, total, rows.Err()
+}
+
+// exec runs a single-row write, mapping no row changed to
+// repository.ErrNotFound.
+func (s *store) exec(ctx context.Context, q string, args ...any) error {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// conflict maps a unique index violation to repository.ErrAlreadyExists.
+func conflict(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return repository.ErrAlreadyExists
+	}
+	return err
+}
+
+// scanDevice scans one deviceSelect row.
+func scanDevice(row interface{ Scan(dest ...any) error }) (*Device, error) {
+	var d Device
+	if err := row.Scan(
+		&d.ID, &d.EventID, &d.Name, &d.Gate, &d.WorkflowStepID, &d.CredentialPrefix, &d.Active, &d.LastSeenAt,
+		&d.Operator, &d.CreatedAt, &d.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// scanShift scans one shiftSelect row.
+func scanShift(row interface{ Scan(dest ...any) error }) (*Shift, error) {
+	var sh Shift
+	if err := row.Scan(&sh.ID, &sh.EventID, &sh.DeviceID, &sh.UserID, &sh.StartedAt, &sh.EndedAt); err != nil {
+		return nil, err
+	}
+	return &sh, nil
+}
@@ -0,0 +1,31 @@
+package devices
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitDeviceRoutes registers the scanner device and operator shift routes on
+// the given router. Managing devices and reading shifts needs
+// auth.ManageDevices; starting and ending a shift needs auth.CheckIn. The
+// service checks the caller belongs to the event's tenant.
+func InitDeviceRoutes(r *chi.Mux, deviceH *Handler) {
+	r.Route("/api/v1/events/{eventId}/devices", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(auth.Require(auth.ManageDevices))
+			r.Get("/", problem.Handle(deviceH.List))
+			r.Post("/", problem.Handle(deviceH.Create))
+			r.Put("/{deviceId}", problem.Handle(deviceH.Update))
+			r.Delete("/{deviceId}", problem.Handle(deviceH.Delete))
+			r.Post("/{deviceId}/credential", problem.Handle(deviceH.RotateCredential))
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(auth.Require(auth.CheckIn))
+			r.Post("/{deviceId}/shift", problem.Handle(deviceH.StartShift))
+			r.Delete("/{deviceId}/shift", problem.Handle(deviceH.EndShift))
+		})
+	})
+	r.With(auth.Require(auth.ManageDevices)).Get("/api/v1/events/{eventId}/shifts", problem.Handle(deviceH.Shifts))
+}
@@ -0,0 +1,68 @@
+package devices_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
+	mockdevices "github.com/biairmal/guest-management-be/mocks/devices"
+	mockvalidation "github.com/biairmal/guest-management-be/mocks/validation"
+)
+
+func TestInitDeviceRoutes_Guards(t *testing.T) {
+	event := "/api/v1/events/" + uuid.NewString()
+	device := event + "/devices/" + uuid.NewString()
+	routes := []struct {
+		method, path, perm string
+	}{
+		{http.MethodGet, event + "/devices", auth.ManageDevices},
+		{http.MethodPost, event + "/devices", auth.ManageDevices},
+		{http.MethodPut, device, auth.ManageDevices},
+		{http.MethodDelete, device, auth.ManageDevices},
+		{http.MethodPost, device + "/credential", auth.ManageDevices},
+		{http.MethodGet, event + "/shifts", auth.ManageDevices},
+		{http.MethodPost, device + "/shift", auth.CheckIn},
+		{http.MethodDelete, device + "/shift", auth.CheckIn},
+	}
+	for _, rt := range routes {
+		other := auth.CheckIn
+		if rt.perm == auth.CheckIn {
+			other = auth.ManageDevices
+		}
+		callers := []struct {
+			name       string
+			perms      []string
+			wantStatus int
+		}{
+			{name: "anonymous", wantStatus: http.StatusUnauthorized},
+			{name: "missing " + rt.perm, perms: []string{other}, wantStatus: http.StatusForbidden},
+		}
+		for _, c := range callers {
+			t.Run(c.name+" "+rt.method+" "+rt.path, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				// The service must never be reached.
+				h := devices.NewHandler(mockdevices.NewMockService(ctrl), mockvalidation.NewMockValidator(ctrl))
+				r := chi.NewRouter()
+				devices.InitDeviceRoutes(r, h)
+
+				ctx := context.Background()
+				if c.perms != nil {
+					ctx = ctxkit.WithPermissions(ctxkit.WithTenantID(ctx, uuid.NewString()), c.perms)
+				}
+				rec := httptest.NewRecorder()
+				r.ServeHTTP(rec, httptest.NewRequest(rt.method, rt.path, nil).WithContext(ctx))
+				if rec.Code != c.wantStatus {
+					t.Errorf("status = %d, want %d", rec.Code, c.wantStatus)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,378 @@
+package devices
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/devices/mock_service.go -package=mockdevices github.com/biairmal/guest-management-be/internal/features/devices Service
+
+// prefixLen is how many leading characters of a credential are kept readable.
+const prefixLen = 12
+
+// Service manages an event's scanner devices and their operators' shifts.
+type Service interface {
+	// List returns the event's devices.
+	List(ctx context.Context, eventID uuid.UUID) ([]*Device, error)
+	// Create registers a device and issues its credential.
+	Create(ctx context.Context, eventID uuid.UUID, in DeviceInput) (*Credentialed, error)
+	// Update replaces a device's name, gate, step and active flag.
+	Update(ctx context.Context, eventID, deviceID uuid.UUID, in DeviceInput) (*Device, error)
+	// Delete removes a device, ending its open shift.
+	Delete(ctx context.Context, eventID, deviceID uuid.UUID) error
+	// RotateCredential issues the device a new credential; the old one stops
+	// working at once.
+	RotateCredential(ctx context.Context, eventID, deviceID uuid.UUID) (*Credentialed, error)
+	// Authorize resolves a device credential for a scan at the event's step,
+	// records that the device was seen and returns it with the user of its
+	// open shift.
+	Authorize(ctx context.Context, credential string, eventID, stepID uuid.UUID) (*Device, error)
+	// StartShift opens a shift of the logged-in user on the device.
+	StartShift(ctx context.Context, eventID, deviceID uuid.UUID) (*Shift, error)
+	// EndShift ends the logged-in user's open shift on the device.
+	EndShift(ctx context.Context, eventID, deviceID uuid.UUID) (*Shift, error)
+	// Shifts returns a page of the event's shifts.
+	Shifts(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*common.PageResponse[Shift], error)
+}
+
+// DeviceInput is the body of a device create or replace. Active defaults to
+// true.
+//
+// swagger:model ScannerDeviceInput
+type DeviceInput struct {
+	Name           string     `json:"name" validate:"required,max=100"`
+	Gate           *string    `json:"gate,omitempty" validate:"omitempty,max=100"`
+	WorkflowStepID *uuid.UUID `json:"workflow_step_id,omitempty"`
+	Active         *bool      `json:"active,omitempty"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	store  Store
+	now    func() time.Time
+}
+
+// NewService returns a Service with the given dependencies.
+func NewService(logger logger.Logger, tx transaction.TxManager, store Store) Service {
+	return &serviceImpl{logger: logger, tx: tx, store: store, now: time.Now}
+}
+
+// List implements Service.
+func (s *serviceImpl) List(ctx context.Context, eventID uuid.UUID) ([]*Device, error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	devices, err := s.store.Devices(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "devices read failed", eventID, err)
+	}
+	return devices, nil
+}
+
+// Create implements Service.
+func (s *serviceImpl) Create(ctx context.Context, eventID uuid.UUID, in DeviceInput) (*Credentialed, error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	if err := s.checkStep(ctx, eventID, in.WorkflowStepID); err != nil {
+		return nil, err
+	}
+	credential, hash, err := newCredential()
+	if err != nil {
+		return nil, s.translate(ctx, "device credential generation failed", eventID, err)
+	}
+	d := Device{EventID: eventID, CredentialPrefix: credential[:prefixLen]}
+	in.apply(&d)
+	if err := s.store.Insert(ctx, &d, hash); err != nil {
+		return nil, s.translate(ctx, "device insert failed", eventID, err)
+	}
+	s.logger.InfoWithContext(ctx, "device registered", logger.F("event_id", eventID), logger.F("device_id", d.ID))
+	return &Credentialed{Device: d, Credential: credential}, nil
+}
+
+// Update implements Service.
+func (s *serviceImpl) Update(ctx context.Context, eventID, deviceID uuid.UUID, in DeviceInput) (*Device, error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	if err := s.checkStep(ctx, eventID, in.WorkflowStepID); err != nil {
+		return nil, err
+	}
+	var d *Device
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if d, err = s.device(ctx, eventID, deviceID); err != nil {
+			return err
+		}
+		in.apply(d)
+		err = s.store.Update(ctx, d)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.DeviceNotFound.New()
+		}
+		if err != nil {
+			return s.translate(ctx, "device update failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Delete implements Service.
+func (s *serviceImpl) Delete(ctx context.Context, eventID, deviceID uuid.UUID) error {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return err
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.store.Delete(ctx, eventID, deviceID)
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.DeviceNotFound.New()
+	}
+	if err != nil {
+		return s.translate(ctx, "device delete failed", eventID, err)
+	}
+	s.logger.InfoWithContext(ctx, "device removed", logger.F("event_id", eventID), logger.F("device_id", deviceID))
+	return nil
+}
+
+// RotateCredential implements Service.
+func (s *serviceImpl) RotateCredential(ctx context.Context, eventID, deviceID uuid.UUID) (*Credentialed, error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	credential, hash, err := newCredential()
+	if err != nil {
+		return nil, s.translate(ctx, "device credential generation failed", eventID, err)
+	}
+	var d *Device
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		err := s.store.SetCredential(ctx, eventID, deviceID, hash, credential[:prefixLen])
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.DeviceNotFound.New()
+		}
+		if err != nil {
+			return s.translate(ctx, "device credential update failed", eventID, err)
+		}
+		d, err = s.device(ctx, eventID, deviceID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "device credential rotated", logger.F("event_id", eventID),
+		logger.F("device_id", deviceID))
+	return &Credentialed{Device: *d, Credential: credential}, nil
+}
+
+// Authorize implements Service. An unknown credential is a 401; a known
+// device that is inactive, registered to another event or limited to another
+// step is a 403. Each refusal is counted as a rejected scan by reason.
+func (s *serviceImpl) Authorize(ctx context.Context, credential string, eventID, stepID uuid.UUID) (*Device, error) {
+	if credential == "" {
+		metrics.ScanRejected("device_credential_missing")
+		return nil, errcode.DeviceCredentialRequired.New()
+	}
+	d, err := s.store.ByCredential(ctx, hashCredential(credential))
+	if errors.Is(err, repository.ErrNotFound) {
+		metrics.ScanRejected("device_unknown")
+		return nil, errcode.DeviceCredentialInvalid.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "device credential read failed", eventID, err)
+	}
+	switch {
+	case d.EventID != eventID:
+		metrics.ScanRejected("device_other_event")
+		return nil, errcode.ScanDeviceOtherEvent.New()
+	case !d.Active:
+		metrics.ScanRejected("device_inactive")
+		return nil, errcode.ScanDeviceInactive.New()
+	case !d.Allows(stepID):
+		metrics.ScanRejected("device_step_not_allowed")
+		return nil, errcode.ScanStepNotAllowed.New()
+	}
+	at := s.now()
+	if err := s.store.Touch(ctx, d.ID, at); err != nil {
+		return nil, s.translate(ctx, "device touch failed", eventID, err)
+	}
+	d.LastSeenAt = &at
+	return d, nil
+}
+
+// StartShift implements Service. Only the event's staff may operate its
+// devices, one device at a time, and a device has one operator at a time.
+func (s *serviceImpl) StartShift(ctx context.Context, eventID, deviceID uuid.UUID) (*Shift, error) {
+	userID, err := uuid.Parse(ctxkit.UserID(ctx))
+	if err != nil {
+		return nil, errcode.LoginRequired.New()
+	}
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	d, err := s.device(ctx, eventID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	onStaff, err := s.store.OnStaff(ctx, eventID, userID)
+	if err != nil {
+		return nil, s.translate(ctx, "device staff read failed", eventID, err)
+	}
+	if !onStaff {
+		return nil, errcode.DeviceStaffOnly.New()
+	}
+	if !d.Active {
+		return nil, errcode.DeviceInactive.New()
+	}
+	if d.Operator != nil {
+		return nil, errcode.DeviceAlreadyOperated.New()
+	}
+	sh := Shift{EventID: eventID, DeviceID: deviceID, UserID: userID}
+	err = s.store.StartShift(ctx, &sh)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, errcode.ShiftAlreadyOpen.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "shift start failed", eventID, err)
+	}
+	s.logger.InfoWithContext(ctx, "shift started", logger.F("event_id", eventID), logger.F("device_id", deviceID),
+		logger.F("user_id", userID))
+	return &sh, nil
+}
+
+// EndShift implements Service.
+func (s *serviceImpl) EndShift(ctx context.Context, eventID, deviceID uuid.UUID) (*Shift, error) {
+	userID, err := uuid.Parse(ctxkit.UserID(ctx))
+	if err != nil {
+		return nil, errcode.LoginRequired.New()
+	}
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	if _, err := s.device(ctx, eventID, deviceID); err != nil {
+		return nil, err
+	}
+	sh, err := s.store.EndShift(ctx, deviceID, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.ShiftNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "shift end failed", eventID, err)
+	}
+	s.logger.InfoWithContext(ctx, "shift ended", logger.F("event_id", eventID), logger.F("device_id", deviceID),
+		logger.F("user_id", userID))
+	return sh, nil
+}
+
+// Shifts implements Service.
+func (s *serviceImpl) Shifts(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[Shift], error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	items, total, err := s.store.Shifts(ctx, eventID, params)
+	if err != nil {
+		return nil, s.translate(ctx, "shifts read failed", eventID, err)
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// checkEvent returns 404 unless the event is live and belongs to the
+// caller's tenant.
+func (s *serviceImpl) checkEvent(ctx context.Context, eventID uuid.UUID) error {
+	tenantID, err := s.store.EventTenant(ctx, eventID)
+	if err != nil {
+		return s.translate(ctx, "device event read failed", eventID, err)
+	}
+	if !auth.InTenant(ctx, tenantID) {
+		return errcode.EventNotFound.New()
+	}
+	return nil
+}
+
+// checkStep rejects a step that isn't a live step of the event.
+func (s *serviceImpl) checkStep(ctx context.Context, eventID uuid.UUID, stepID *uuid.UUID) error {
+	if stepID == nil {
+		return nil
+	}
+	ok, err := s.store.StepInEvent(ctx, eventID, *stepID)
+	if err != nil {
+		return s.translate(ctx, "device step read failed", eventID, err)
+	}
+	if !ok {
+		return errorz.BadRequest().WithMessage("workflow step not found in this event")
+	}
+	return nil
+}
+
+// device reads a live device of the event, 404 when there is none.
+func (s *serviceImpl) device(ctx context.Context, eventID, deviceID uuid.UUID) (*Device, error) {
+	d, err := s.store.Device(ctx, eventID, deviceID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.DeviceNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "device read failed", eventID, err)
+	}
+	return d, nil
+}
+
+// translate maps a store error to 404 for a missing event or 409 for a taken
+// device name, or logs it as msg and wraps it as a 500.
+func (s *serviceImpl) translate(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return errcode.DeviceNameTaken.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to process devices")
+}
+
+// apply copies the input onto d.
+func (in DeviceInput) apply(d *Device) {
+	d.Name = in.Name
+	d.Gate = in.Gate
+	d.WorkflowStepID = in.WorkflowStepID
+	d.Active = in.Active == nil || *in.Active
+}
+
+// newCredential returns a fresh credential and its hash.
+func newCredential() (credential, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	credential = credentialPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return credential, hashCredential(credential), nil
+}
+
+// hashCredential returns the hex SHA-256 a credential is stored as.
+func hashCredential(credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,234 @@
+package devices_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/devices"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockdevices "github.com/biairmal/guest-management-be/mocks/devices"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+// inTenant returns a context of a caller authenticated for tenantID.
+func inTenant(tenantID uuid.UUID) context.Context {
+	return ctxkit.WithTenantID(context.Background(), tenantID.String())
+}
+
+func newService(t *testing.T) (devices.Service, *mockdevices.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdevices.NewMockStore(ctrl)
+	return devices.NewService(logger.NewNoOp(), inlineTx(ctrl), store), store
+}
+
+func TestDevice_Allows(t *testing.T) {
+	step, other := uuid.New(), uuid.New()
+	tests := []struct {
+		name   string
+		device devices.Device
+		want   bool
+	}{
+		{name: "any step", device: devices.Device{Active: true}, want: true},
+		{name: "its step", device: devices.Device{Active: true, WorkflowStepID: &step}, want: true},
+		{name: "another step", device: devices.Device{Active: true, WorkflowStepID: &other}},
+		{name: "inactive", device: devices.Device{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.device.Allows(step); got != tt.want {
+				t.Errorf("Allows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	tenantID, eventID, stepID := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name      string
+		stepID    *uuid.UUID
+		stepFound bool
+		insertErr error
+		wantCode  string
+	}{
+		{name: "any step"},
+		{name: "step of the event", stepID: &stepID, stepFound: true},
+		{name: "step of another event", stepID: &stepID, wantCode: errorz.CodeBadRequest},
+		{name: "name taken", insertErr: repository.ErrAlreadyExists, wantCode: errorz.CodeConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+			if tt.stepID != nil {
+				store.EXPECT().StepInEvent(gomock.Any(), eventID, *tt.stepID).Return(tt.stepFound, nil)
+			}
+			var hash string
+			if tt.stepID == nil || tt.stepFound {
+				store.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, d *devices.Device, h string) error {
+						d.ID, hash = uuid.New(), h
+						return tt.insertErr
+					})
+			}
+			d, err := svc.Create(inTenant(tenantID), eventID, devices.DeviceInput{
+				Name: "Gate A scanner", WorkflowStepID: tt.stepID,
+			})
+			assertErrorzCode(t, err, tt.wantCode)
+			if err != nil {
+				return
+			}
+			if !d.Active || !strings.HasPrefix(d.Credential, "gmd_") ||
+				!strings.HasPrefix(d.Credential, d.CredentialPrefix) {
+				t.Errorf("device = %+v, want an active device with a gmd_ credential and its prefix", d)
+			}
+			if hash == "" || strings.Contains(hash, d.Credential) {
+				t.Errorf("stored hash %q must not be the credential", hash)
+			}
+		})
+	}
+}
+
+func TestService_Authorize(t *testing.T) {
+	eventID, stepID := uuid.New(), uuid.New()
+	other := uuid.New()
+	tests := []struct {
+		name     string
+		device   *devices.Device
+		err      error
+		wantCode string
+	}{
+		{name: "active device of the event", device: &devices.Device{EventID: eventID, Active: true}},
+		{name: "unknown credential", err: repository.ErrNotFound, wantCode: errorz.CodeUnauthorized},
+		{name: "inactive", device: &devices.Device{EventID: eventID}, wantCode: errorz.CodeForbidden},
+		{name: "another event", device: &devices.Device{EventID: uuid.New(), Active: true},
+			wantCode: errorz.CodeForbidden},
+		{name: "another step", device: &devices.Device{EventID: eventID, Active: true, WorkflowStepID: &other},
+			wantCode: errorz.CodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().ByCredential(gomock.Any(), gomock.Any()).Return(tt.device, tt.err)
+			if tt.wantCode == "" {
+				store.EXPECT().Touch(gomock.Any(), tt.device.ID, gomock.Any()).Return(nil)
+			}
+			d, err := svc.Authorize(context.Background(), "gmd_secret", eventID, stepID)
+			assertErrorzCode(t, err, tt.wantCode)
+			if err == nil && d.LastSeenAt == nil {
+				t.Error("LastSeenAt not set")
+			}
+		})
+	}
+}
+
+func TestService_Authorize_MissingCredential(t *testing.T) {
+	svc, _ := newService(t)
+	_, err := svc.Authorize(context.Background(), "", uuid.New(), uuid.New())
+	assertErrorzCode(t, err, errorz.CodeUnauthorized)
+}
+
+func TestService_StartShift_RequiresLogin(t *testing.T) {
+	svc, _ := newService(t)
+	_, err := svc.StartShift(context.Background(), uuid.New(), uuid.New())
+	assertErrorzCode(t, err, errorz.CodeUnauthorized)
+}
+
+// TestService_StartShift opens shifts for the user auth.Middleware puts on
+// the context from an access token's sub.
+func TestService_StartShift(t *testing.T) {
+	tenantID, eventID, deviceID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name     string
+		device   *devices.Device
+		onStaff  bool
+		wantCode string
+	}{
+		{name: "staff on an idle device", device: &devices.Device{ID: deviceID, Active: true}, onStaff: true},
+		{name: "not on staff", device: &devices.Device{ID: deviceID, Active: true}, wantCode: errorz.CodeForbidden},
+		{name: "inactive device", device: &devices.Device{ID: deviceID}, onStaff: true, wantCode: errorz.CodeConflict},
+		{
+			name: "device already operated", device: &devices.Device{ID: deviceID, Active: true, Operator: &deviceID},
+			onStaff: true, wantCode: errorz.CodeConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+			store.EXPECT().Device(gomock.Any(), eventID, deviceID).Return(tt.device, nil)
+			store.EXPECT().OnStaff(gomock.Any(), eventID, userID).Return(tt.onStaff, nil)
+			if tt.wantCode == "" {
+				store.EXPECT().StartShift(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			sh, err := svc.StartShift(ctxkit.WithUserID(inTenant(tenantID), userID.String()), eventID, deviceID)
+			assertErrorzCode(t, err, tt.wantCode)
+			if err == nil && sh.UserID != userID {
+				t.Errorf("shift user = %s, want %s", sh.UserID, userID)
+			}
+		})
+	}
+}
+
+// TestService_OtherTenant hides another tenant's event behind a 404 before
+// any device is read or written.
+func TestService_OtherTenant(t *testing.T) {
+	eventID, deviceID := uuid.New(), uuid.New()
+	ctx := ctxkit.WithUserID(inTenant(uuid.New()), uuid.NewString())
+	calls := map[string]func(devices.Service) error{
+		"List": func(svc devices.Service) error { _, err := svc.List(ctx, eventID); return err },
+		"Create": func(svc devices.Service) error {
+			_, err := svc.Create(ctx, eventID, devices.DeviceInput{Name: "Gate A scanner"})
+			return err
+		},
+		"Update": func(svc devices.Service) error {
+			_, err := svc.Update(ctx, eventID, deviceID, devices.DeviceInput{Name: "Gate A scanner"})
+			return err
+		},
+		"Delete": func(svc devices.Service) error { return svc.Delete(ctx, eventID, deviceID) },
+		"RotateCredential": func(svc devices.Service) error {
+			_, err := svc.RotateCredential(ctx, eventID, deviceID)
+			return err
+		},
+		"StartShift": func(svc devices.Service) error { _, err := svc.StartShift(ctx, eventID, deviceID); return err },
+		"EndShift":   func(svc devices.Service) error { _, err := svc.EndShift(ctx, eventID, deviceID); return err },
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(uuid.New(), nil)
+			assertErrorzCode(t, call(svc), errorz.CodeNotFound)
+		})
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"encoding/json"
 	"net/http"
 
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
 	"github.com/biairmal/go-sdk/lib/errorz"
 	"github.com/biairmal/go-sdk/lib/httpkit/response"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/etag"
 	"github.com/biairmal/guest-management-be/internal/core/query"
 	"github.com/biairmal/guest-management-be/internal/core/validation"
 )
@@ -25,6 +29,7 @@ type CategoryHandler struct {
 var eventCategoryListConfig = query.ListParseConfig{
 	AllowedSortFields:   []string{"id", "source", "tenant_id", "name", "created_at", "updated_at"},
 	AllowedFilterFields: []string{"name", "source", "tenant_id"},
+	AllowIncludeDeleted: true,
 }
 
 // NewCategoryHandler returns a CategoryHandler that uses the given service and
@@ -40,7 +45,7 @@ func NewCategoryHandler(service CategoryService, validator validation.Validator)
 // List godoc
 //
 //	@Summary		List event categories
-//	@Description	Returns a paginated list of event categories. Query: page, size, sort=field,dir (repeatable), filter by allowed fields (name, source, tenant_id).
+//	@Description	Returns a paginated list of event categories. Query: page, size, sort=field,dir (repeatable), filter by allowed fields (name, source, tenant_id), include_deleted=true to also return soft-deleted rows.
 //	@Tags			event-categories
 //	@Accept			json
 //	@Produce		json
@@ -49,9 +54,10 @@ func NewCategoryHandler(service CategoryService, validator validation.Validator)
 //	@Param			sort	query		string	false	"Sort: field,dir (e.g. sort=name,ASC&sort=id,DESC)"
 //	@Param			name	query		string	false	"Filter by name (exact match)"
 //	@Param			source	query		string	false	"Filter by source (exact match)"
-//	@Success		200		{object}	common.PageResponse[events.EventCategory]
-//	@Failure		400		{object}	object	"Invalid query (e.g. invalid sort field)"
-//	@Failure		500		{object}	object	"Internal server error"
+//	@Param			include_deleted	query	bool	false	"Also return soft-deleted categories (admin views)"
+//	@Success		200		{object}	dto.PageResponse[events.EventCategory]
+//	@Failure		400		{object}	problem.Problem	"Invalid query (e.g. invalid sort field)"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
 //	@Router			/api/v1/event-categories [get]
 func (h *CategoryHandler) List(r *http.Request) (any, error) {
 	params, err := query.ParseListParams(r.URL.Query(), eventCategoryListConfig)
@@ -74,22 +80,26 @@ func (h *CategoryHandler) List(r *http.Request) (any, error) {
 //	@Tags			event-categories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		string	true	"Event category UUID"
-//	@Success		200	{object}	events.EventCategory
-//	@Failure		400	{object}	object	"Invalid ID format"
-//	@Failure		404	{object}	object	"Event category not found"
-//	@Failure		500	{object}	object	"Internal server error"
+//	@Param			id				path		string	true	"Event category UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; 304 when unchanged"
+//	@Success		200				{object}	events.EventCategory
+//	@Header			200				{string}	ETag	"Version token for If-Match / If-None-Match"
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	problem.Problem	"Invalid ID format"
+//	@Failure		404				{object}	problem.Problem	"Event category not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
 //	@Router			/api/v1/event-categories/{id} [get]
 func (h *CategoryHandler) GetByID(r *http.Request) (any, error) {
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return nil, errorz.BadRequest().WithMessage("invalid event category id")
+		return nil, errcode.InvalidID.WithMessage("invalid event category id")
 	}
 	entity, err := h.service.GetByID(r.Context(), id)
 	if err != nil {
 		return nil, err
 	}
+	etag.Set(r.Context(), entity.UpdatedAt)
 	return response.OK(entity), nil
 }
 
@@ -102,17 +112,19 @@ func (h *CategoryHandler) GetByID(r *http.Request) (any, error) {
 //	@Tags			event-categories
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		events.CreateInput	true	"Event category payload"
+//	@Param			Idempotency-Key	header		string				false	"Client-chosen key; retries with the same key and body replay the first response"
+//	@Param			body			body		events.CreateInput	true	"Event category payload"
 //	@Success		201		{object}	events.EventCategory
-//	@Failure		400		{object}	object	"Invalid request body or validation error"
-//	@Failure		409		{object}	object	"Conflict (e.g. already exists)"
-//	@Failure		422		{object}	object	"Unprocessable entity"
-//	@Failure		500		{object}	object	"Internal server error"
+//	@Header			201		{string}	Idempotent-Replayed	"true when the response is a replay"
+//	@Failure		400		{object}	problem.Problem	"Invalid request body or validation error"
+//	@Failure		409		{object}	problem.Problem	"Conflict (e.g. already exists, or same Idempotency-Key still in progress)"
+//	@Failure		422		{object}	problem.Problem	"Unprocessable entity, or Idempotency-Key reused with a different body"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
 //	@Router			/api/v1/event-categories [post]
 func (h *CategoryHandler) Create(r *http.Request) (any, error) {
 	var body CreateInput
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return nil, errorz.BadRequest().WithMessage("invalid request body")
+		return nil, errcode.InvalidRequestBody.New()
 	}
 	if err := h.validator.Struct(body); err != nil {
 		return nil, err
@@ -121,6 +133,7 @@ func (h *CategoryHandler) Create(r *http.Request) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	etag.Set(r.Context(), entity.UpdatedAt)
 	return response.Created(entity), nil
 }
 
@@ -129,34 +142,38 @@ func (h *CategoryHandler) Create(r *http.Request) (any, error) {
 // Update godoc
 //
 //	@Summary		Update event category
-//	@Description	Updates an existing event category by ID. Only provided fields are applied (partial update).
+//	@Description	Updates an existing event category by ID. Only provided fields are applied (partial update). Send the ETag from the last read as If-Match to reject concurrent edits with 412.
 //	@Tags			event-categories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id		path		string				true	"Event category UUID"
-//	@Param			body	body		events.UpdateInput	true	"Fields to update"
-//	@Success		200		{object}	events.EventCategory
-//	@Failure		400		{object}	object	"Invalid ID or request body"
-//	@Failure		404		{object}	object	"Event category not found"
-//	@Failure		500		{object}	object	"Internal server error"
+//	@Param			id			path		string				true	"Event category UUID"
+//	@Param			If-Match	header		string				false	"ETag from the last read; 412 when the category changed since"
+//	@Param			body		body		events.UpdateInput	true	"Fields to update"
+//	@Success		200			{object}	events.EventCategory
+//	@Header			200			{string}	ETag	"New version token"
+//	@Failure		400			{object}	problem.Problem	"Invalid ID or request body"
+//	@Failure		404			{object}	problem.Problem	"Event category not found"
+//	@Failure		412			{object}	problem.Problem	"If-Match does not match the current version"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
 //	@Router			/api/v1/event-categories/{id} [put]
 func (h *CategoryHandler) Update(r *http.Request) (any, error) {
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return nil, errorz.BadRequest().WithMessage("invalid event category id")
+		return nil, errcode.InvalidID.WithMessage("invalid event category id")
 	}
 	var body UpdateInput
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return nil, errorz.BadRequest().WithMessage("invalid request body")
+		return nil, errcode.InvalidRequestBody.New()
 	}
 	if err := h.validator.Struct(body); err != nil {
 		return nil, err
 	}
-	entity, err := h.service.Update(r.Context(), id, body)
+	entity, err := h.service.Update(r.Context(), id, body, etag.IfMatch(r))
 	if err != nil {
 		return nil, err
 	}
+	etag.Set(r.Context(), entity.UpdatedAt)
 	return response.OK(entity), nil
 }
 
@@ -171,18 +188,48 @@ func (h *CategoryHandler) Update(r *http.Request) (any, error) {
 //	@Produce		json
 //	@Param			id	path		string	true	"Event category UUID"
 //	@Success		204	"No content"
-//	@Failure		400	{object}	object	"Invalid ID format"
-//	@Failure		404	{object}	object	"Event category not found"
-//	@Failure		500	{object}	object	"Internal server error"
+//	@Failure		400	{object}	problem.Problem	"Invalid ID format"
+//	@Failure		404	{object}	problem.Problem	"Event category not found"
+//	@Failure		500	{object}	problem.Problem	"Internal server error"
 //	@Router			/api/v1/event-categories/{id} [delete]
 func (h *CategoryHandler) Delete(r *http.Request) (any, error) {
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return nil, errorz.BadRequest().WithMessage("invalid event category id")
+		return nil, errcode.InvalidID.WithMessage("invalid event category id")
 	}
 	if err := h.service.Delete(r.Context(), id); err != nil {
 		return nil, err
 	}
 	return response.NoContent(), nil
 }
+
+// Restore handles POST /event-categories/{id}/restore.
+//
+// Restore godoc
+//
+//	@Summary		Restore event category
+//	@Description	Undoes a soft delete of an event category by ID.
+//	@Tags			event-categories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Event category UUID"
+//	@Success		200	{object}	events.EventCategory
+//	@Failure		400	{object}	problem.Problem	"Invalid ID format"
+//	@Failure		404	{object}	problem.Problem	"Deleted event category not found"
+//	@Failure		409	{object}	problem.Problem	"Conflicts with an existing event category"
+//	@Failure		500	{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/event-categories/{id}/restore [post]
+func (h *CategoryHandler) Restore(r *http.Request) (any, error) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event category id")
+	}
+	entity, err := h.service.Restore(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.OK(entity), nil
+}
@@ -2,7 +2,6 @@ package events
 
 import (
 	"github.com/biairmal/go-sdk/lib/logger"
-	"github.com/biairmal/go-sdk/lib/repository"
 	"github.com/biairmal/go-sdk/lib/sqlkit"
 	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
 	"github.com/google/uuid"
@@ -15,11 +14,12 @@ var eventCategoryColumns = []string{
 	"id", "source", "tenant_id", "name", "created_at", "updated_at", "deleted_at",
 }
 
-// NewCategoryRepository returns a soft-delete-aware repository for event categories.
+// NewCategoryRepository returns a soft-delete-aware repository for event categories,
+// including Restore/Purge for soft-deleted rows.
 // TID is uuid.UUID — kept typed all the way through the service layer.
 func NewCategoryRepository(
 	log logger.Logger, db *sqlkit.DB, cacheOpts corerepository.CacheOptions,
-) repository.Repository[EventCategory, uuid.UUID] {
+) corerepository.Repository[EventCategory, uuid.UUID] {
 	return corerepository.NewRepository[EventCategory, uuid.UUID](
 		log, db, eventCategoriesTable, eventCategoryColumns, cacheOpts,
 	)
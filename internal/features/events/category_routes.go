@@ -1,17 +1,19 @@
 package events
 
 import (
-	"github.com/biairmal/go-sdk/lib/httpkit/handler"
 	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
 )
 
 // InitCategoryRoutes registers event category routes on the given router.
 func InitCategoryRoutes(r *chi.Mux, categoryH *CategoryHandler) {
 	r.Route("/api/v1/event-categories", func(r chi.Router) {
-		r.Get("/", handler.Handle(categoryH.List))
-		r.Get("/{id}", handler.Handle(categoryH.GetByID))
-		r.Post("/", handler.Handle(categoryH.Create))
-		r.Put("/{id}", handler.Handle(categoryH.Update))
-		r.Delete("/{id}", handler.Handle(categoryH.Delete))
+		r.Get("/", problem.Handle(categoryH.List))
+		r.Get("/{id}", problem.Handle(categoryH.GetByID))
+		r.Post("/", problem.Handle(categoryH.Create))
+		r.Put("/{id}", problem.Handle(categoryH.Update))
+		r.Delete("/{id}", problem.Handle(categoryH.Delete))
+		r.Post("/{id}/restore", problem.Handle(categoryH.Restore))
 	})
 }
@@ -10,7 +10,10 @@ import (
 	"github.com/biairmal/go-sdk/lib/repository"
 	"github.com/google/uuid"
 
+	"github.com/biairmal/guest-management-be/internal/core/audit"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
 	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
 )
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/events/mock_service.go -package=mockevents github.com/biairmal/guest-management-be/internal/features/events CategoryService
@@ -19,21 +22,22 @@ import (
 type CategoryService interface {
 	Create(ctx context.Context, in CreateInput) (*EventCategory, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*EventCategory, error)
-	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (*EventCategory, error)
+	Update(ctx context.Context, id uuid.UUID, in UpdateInput, ifMatch string) (*EventCategory, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) (*EventCategory, error)
 	List(ctx context.Context, params *query.ListParams) (*common.PageResponse[EventCategory], error)
 }
 
 // categoryServiceImpl is the concrete implementation of CategoryService.
 type categoryServiceImpl struct {
-	repo   repository.Repository[EventCategory, uuid.UUID]
+	repo   corerepository.Repository[EventCategory, uuid.UUID]
 	logger logger.Logger
 }
 
 // NewCategoryService returns a CategoryService with the given dependencies.
 func NewCategoryService(
 	logger logger.Logger,
-	repo repository.Repository[EventCategory, uuid.UUID],
+	repo corerepository.Repository[EventCategory, uuid.UUID],
 ) CategoryService {
 	return &categoryServiceImpl{logger: logger, repo: repo}
 }
@@ -75,10 +79,10 @@ func (s *categoryServiceImpl) Create(ctx context.Context, in CreateInput) (*Even
 
 	if err := s.repo.Create(ctx, entity); err != nil {
 		if errors.Is(err, repository.ErrAlreadyExists) {
-			return nil, errorz.Conflict().WithMessage("event category already exists")
+			return nil, errcode.EventCategoryExists.New()
 		}
 		if errors.Is(err, repository.ErrInvalidEntity) {
-			return nil, errorz.UnprocessableEntity().WithMessage("invalid event category data")
+			return nil, errcode.UnprocessableEntity.WithMessage("invalid event category data")
 		}
 		s.logger.ErrorWithContext(ctx, "event category create failed", logger.F("error", err))
 		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to create event category")
@@ -93,7 +97,7 @@ func (s *categoryServiceImpl) GetByID(ctx context.Context, id uuid.UUID) (*Event
 	entity, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, errorz.NotFound().WithMessage("event category not found")
+			return nil, errcode.EventCategoryNotFound.New()
 		}
 		s.logger.ErrorWithContext(ctx, "event category get failed", logger.F("id", id), logger.F("error", err))
 		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get event category")
@@ -102,12 +106,16 @@ func (s *categoryServiceImpl) GetByID(ctx context.Context, id uuid.UUID) (*Event
 }
 
 // Update updates an event category. Only non-nil fields in UpdateInput are applied.
-// The updated_at field is set by the AuditableRepository.
-func (s *categoryServiceImpl) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (*EventCategory, error) {
+// The updated_at field is set by the AuditableRepository. When ifMatch (the request's
+// If-Match, "" for none) no longer matches the stored version, it fails with
+// errorz.PreconditionFailed.
+func (s *categoryServiceImpl) Update(
+	ctx context.Context, id uuid.UUID, in UpdateInput, ifMatch string,
+) (*EventCategory, error) {
 	entity, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, errorz.NotFound().WithMessage("event category not found")
+			return nil, errcode.EventCategoryNotFound.New()
 		}
 		s.logger.ErrorWithContext(ctx, "event category get for update failed", logger.F("id", id), logger.F("error", err))
 		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get event category")
@@ -123,9 +131,12 @@ func (s *categoryServiceImpl) Update(ctx context.Context, id uuid.UUID, in Updat
 		entity.Name = *in.Name
 	}
 
-	if err := s.repo.Update(ctx, id, entity); err != nil {
+	if err := s.repo.UpdateIfMatch(ctx, id, entity, ifMatch); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, errorz.NotFound().WithMessage("event category not found")
+			return nil, errcode.EventCategoryNotFound.New()
+		}
+		if errors.Is(err, corerepository.ErrVersionMismatch) {
+			return nil, errcode.VersionMismatch.WithMessage("event category was modified by someone else")
 		}
 		s.logger.ErrorWithContext(ctx, "event category update failed", logger.F("id", id), logger.F("error", err))
 		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update event category")
@@ -140,7 +151,7 @@ func (s *categoryServiceImpl) Update(ctx context.Context, id uuid.UUID, in Updat
 func (s *categoryServiceImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := s.repo.Delete(ctx, id); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return errorz.NotFound().WithMessage("event category not found")
+			return errcode.EventCategoryNotFound.New()
 		}
 		s.logger.ErrorWithContext(ctx, "event category delete failed", logger.F("id", id), logger.F("error", err))
 		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to delete event category")
@@ -149,11 +160,33 @@ func (s *categoryServiceImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore undoes a soft-delete and returns the restored event category. A category
+// that is missing or not deleted maps to errorz.NotFound; a unique-key collision with
+// a live row (repository.ErrAlreadyExists) maps to errorz.Conflict.
+func (s *categoryServiceImpl) Restore(ctx context.Context, id uuid.UUID) (*EventCategory, error) {
+	if err := s.repo.Restore(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventCategoryNotFound.WithMessage("deleted event category not found")
+		}
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, errcode.EventCategoryExists.WithMessage("event category conflicts with an existing one")
+		}
+		s.logger.ErrorWithContext(ctx, "event category restore failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to restore event category")
+	}
+	s.logger.InfoWithContext(ctx, "event category restored", logger.F("id", id))
+	return s.GetByID(ctx, id)
+}
+
 // List returns event categories with filter, sort, and pagination from query.ListParams.
+// Soft-deleted categories are included only when params.IncludeDeleted is set.
 func (s *categoryServiceImpl) List(
 	ctx context.Context, params *query.ListParams,
 ) (*common.PageResponse[EventCategory], error) {
 	opts := listParamsToListOptions(params)
+	if params != nil && params.IncludeDeleted {
+		ctx = audit.IncludeDeleted(ctx)
+	}
 	items, total, err := s.repo.List(ctx, opts)
 	if err != nil {
 		s.logger.ErrorWithContext(ctx, "event category list failed", logger.F("error", err))
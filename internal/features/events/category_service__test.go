@@ -8,12 +8,13 @@ import (
 
 	"github.com/biairmal/go-sdk/lib/errorz"
 	"github.com/biairmal/go-sdk/lib/logger"
-	mockrepository "github.com/biairmal/go-sdk/mocks/repository"
 	"github.com/biairmal/go-sdk/lib/repository"
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
 
 	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	mockcorerepository "github.com/biairmal/guest-management-be/mocks/core/repository"
 )
 
 // assertErrorzCode fails unless err carries the wanted errorz code (or is nil when want == "").
@@ -87,7 +88,7 @@ func TestCategoryService_Create(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			repo := mockrepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
+			repo := mockcorerepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
 			if tt.expects {
 				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.repoErr)
 			}
@@ -117,7 +118,7 @@ func TestCategoryService_GetByID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			repo := mockrepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
+			repo := mockcorerepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
 			repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(tt.repoRes, tt.repoErr)
 
 			svc := NewCategoryService(logger.NewNoOp(), repo)
@@ -159,6 +160,15 @@ func TestCategoryService_Update(t *testing.T) {
 			updateErr:  repository.ErrNotFound,
 			wantErr:    errorz.CodeNotFound,
 		},
+		{
+			name:       "version mismatch maps to 412",
+			in:         UpdateInput{Name: ptrString("y")},
+			expectsGet: true,
+			getRes:     &EventCategory{Name: "x"},
+			expectsSet: true,
+			updateErr:  corerepository.ErrVersionMismatch,
+			wantErr:    errorz.CodePreconditionFailed,
+		},
 		{
 			name:       "happy path partial update",
 			in:         UpdateInput{Name: ptrString("y")},
@@ -171,16 +181,16 @@ func TestCategoryService_Update(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			repo := mockrepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
+			repo := mockcorerepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
 			if tt.expectsGet {
 				repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(tt.getRes, tt.getErr)
 			}
 			if tt.expectsSet {
-				repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.updateErr)
+				repo.EXPECT().UpdateIfMatch(gomock.Any(), gomock.Any(), gomock.Any(), `"1"`).Return(tt.updateErr)
 			}
 
 			svc := NewCategoryService(logger.NewNoOp(), repo)
-			got, err := svc.Update(context.Background(), uuid.New(), tt.in)
+			got, err := svc.Update(context.Background(), uuid.New(), tt.in, `"1"`)
 			assertErrorzCode(t, err, tt.wantErr)
 			if tt.wantErr == "" && got.Name != "y" {
 				t.Errorf("Name = %q, want %q", got.Name, "y")
@@ -203,7 +213,7 @@ func TestCategoryService_Delete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			repo := mockrepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
+			repo := mockcorerepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
 			repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.repoErr)
 
 			svc := NewCategoryService(logger.NewNoOp(), repo)
@@ -213,6 +223,38 @@ func TestCategoryService_Delete(t *testing.T) {
 	}
 }
 
+func TestCategoryService_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		restoreErr error
+		expectsGet bool
+		wantErr    string
+	}{
+		{name: "not deleted maps to 404", restoreErr: repository.ErrNotFound, wantErr: errorz.CodeNotFound},
+		{name: "unique-key collision maps to 409", restoreErr: repository.ErrAlreadyExists, wantErr: errorz.CodeConflict},
+		{name: "unexpected error maps to 500", restoreErr: errors.New("boom"), wantErr: errorz.CodeInternal},
+		{name: "happy path returns the restored entity", expectsGet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
+			repo.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(tt.restoreErr)
+			if tt.expectsGet {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&EventCategory{Name: "x"}, nil)
+			}
+
+			svc := NewCategoryService(logger.NewNoOp(), repo)
+			got, err := svc.Restore(context.Background(), uuid.New())
+			assertErrorzCode(t, err, tt.wantErr)
+			if tt.wantErr == "" && got == nil {
+				t.Fatal("expected non-nil entity on success")
+			}
+		})
+	}
+}
+
 func TestCategoryService_List(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -227,7 +269,7 @@ func TestCategoryService_List(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			repo := mockrepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
+			repo := mockcorerepository.NewMockRepository[EventCategory, uuid.UUID](ctrl)
 			repo.EXPECT().
 				List(gomock.Any(), gomock.Any()).
 				Return([]*EventCategory{{Name: "x"}}, int64(1), tt.repoErr)
@@ -0,0 +1,277 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// DayHandler exposes HTTP handlers for event days, workflow step day scopes
+// and the event timezone.
+type DayHandler struct {
+	service   DayService
+	validator validation.Validator
+}
+
+// NewDayHandler returns a DayHandler that uses the given service and
+// validator.
+func NewDayHandler(service DayService, validator validation.Validator) *DayHandler {
+	return &DayHandler{service: service, validator: validator}
+}
+
+// List handles GET /events/{eventId}/days.
+//
+// List godoc
+//
+//	@Summary		List event days
+//	@Description	Returns the event's days by date, each with its doors window.
+//	@Tags			event-days
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{array}		events.EventDay
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/days [get]
+func (h *DayHandler) List(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	days, err := h.service.List(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(days), nil
+}
+
+// Today handles GET /events/{eventId}/days/today.
+//
+// Today godoc
+//
+//	@Summary		Current event day
+//	@Description	Returns the event day at the given instant (default now): the day whose doors are open, else the day whose date it is. Doors windows running past midnight keep their day until they close.
+//	@Tags			event-days
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			at		query		string	false	"RFC 3339 instant (default now)"
+//	@Success		200		{object}	events.EventDay
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or instant"
+//	@Failure		404		{object}	problem.Problem	"Event not found, or no event day at that instant"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/days/today [get]
+func (h *DayHandler) Today(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	at := time.Now()
+	if s := r.URL.Query().Get("at"); s != "" {
+		if at, err = time.Parse(time.RFC3339, s); err != nil {
+			return nil, errorz.BadRequest().WithMessage("at must be an RFC 3339 instant")
+		}
+	}
+	day, err := h.service.Today(r.Context(), eventID, at)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(day), nil
+}
+
+// Create handles POST /events/{eventId}/days.
+//
+// Create godoc
+//
+//	@Summary		Add event day
+//	@Description	Adds a day to the event. Dates are unique per event and doors windows may not overlap; the event becomes multi-day once it has two days.
+//	@Tags			event-days
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			body	body		events.CreateDayInput	true	"Date, optional name and doors window"
+//	@Success		201		{object}	events.EventDay
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body, doors close before they open, or open on another date"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		409		{object}	problem.Problem	"Date taken or doors window overlaps another day"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/days [post]
+func (h *DayHandler) Create(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body CreateDayInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	day, err := h.service.Create(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(day), nil
+}
+
+// Update handles PUT /events/{eventId}/days/{dayId}.
+//
+// Update godoc
+//
+//	@Summary		Update event day
+//	@Description	Updates a day's date, name or doors window; omitted fields are kept.
+//	@Tags			event-days
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			dayId	path		string					true	"Event day UUID"
+//	@Param			body	body		events.UpdateDayInput	true	"Fields to update"
+//	@Success		200		{object}	events.EventDay
+//	@Failure		400		{object}	problem.Problem	"Invalid ids or body, doors close before they open, or open on another date"
+//	@Failure		404		{object}	problem.Problem	"Event or day not found"
+//	@Failure		409		{object}	problem.Problem	"Date taken or doors window overlaps another day"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/days/{dayId} [put]
+func (h *DayHandler) Update(r *http.Request) (any, error) {
+	eventID, dayID, err := dayPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body UpdateDayInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	day, err := h.service.Update(r.Context(), eventID, dayID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(day), nil
+}
+
+// Delete handles DELETE /events/{eventId}/days/{dayId}.
+//
+// Delete godoc
+//
+//	@Summary		Delete event day
+//	@Description	Soft-deletes the day. Workflow steps scoped to it run on every day again; past scans are kept.
+//	@Tags			event-days
+//	@Param			eventId	path	string	true	"Event UUID"
+//	@Param			dayId	path	string	true	"Event day UUID"
+//	@Success		204		"No content"
+//	@Failure		400		{object}	problem.Problem	"Invalid ids"
+//	@Failure		404		{object}	problem.Problem	"Event or day not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/days/{dayId} [delete]
+func (h *DayHandler) Delete(r *http.Request) (any, error) {
+	eventID, dayID, err := dayPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Delete(r.Context(), eventID, dayID); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// ScopeStep handles PUT /events/{eventId}/workflow-steps/{stepId}/day.
+//
+// ScopeStep godoc
+//
+//	@Summary		Scope workflow step to a day
+//	@Description	Limits a workflow step to one day of the event, e.g. a day-2 workshop check-in; a null event_day_id runs the step on every day.
+//	@Tags			event-days
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			stepId	path		string					true	"Workflow step UUID"
+//	@Param			body	body		events.StepScopeInput	true	"Event day, or null for every day"
+//	@Success		200		{object}	events.StepScope
+//	@Failure		400		{object}	problem.Problem	"Invalid ids or body, or the day isn't a day of the event"
+//	@Failure		404		{object}	problem.Problem	"Event or workflow step not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/workflow-steps/{stepId}/day [put]
+func (h *DayHandler) ScopeStep(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	stepID, err := parseID(r, "stepId", "workflow step")
+	if err != nil {
+		return nil, err
+	}
+	var body StepScopeInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	scope, err := h.service.ScopeStep(r.Context(), eventID, stepID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(scope), nil
+}
+
+// SetTimezone handles PUT /events/{eventId}/timezone.
+//
+// SetTimezone godoc
+//
+//	@Summary		Set event timezone
+//	@Description	Sets the event's IANA timezone (e.g. Asia/Jakarta), in which its day dates, guest message times and calendar feeds are read. Events default to UTC. Fails while a day's doors would open on another date in the new zone.
+//	@Tags			event-days
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			body	body		events.TimezoneInput	true	"IANA timezone name"
+//	@Success		200		{object}	events.EventTimezone
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or unknown timezone"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		409		{object}	problem.Problem	"A day's doors would open on another date"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/timezone [put]
+func (h *DayHandler) SetTimezone(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body TimezoneInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	tz, err := h.service.SetTimezone(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(tz), nil
+}
+
+// decode decodes the request body into dst and validates it.
+func (h *DayHandler) decode(r *http.Request, dst any) error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return errcode.InvalidRequestBody.New()
+	}
+	return h.validator.Struct(dst)
+}
+
+// dayPathIDs parses the eventId and dayId path parameters.
+func dayPathIDs(r *http.Request) (eventID, dayID uuid.UUID, err error) {
+	if eventID, err = parseID(r, "eventId", "event"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if dayID, err = parseID(r, "dayId", "event day"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return eventID, dayID, nil
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
@@ -0,0 +1,129 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DateLayout is the layout of an event day's calendar date.
+const DateLayout = "2006-01-02"
+
+// RuleDailyReentry is the ticket_types.rules key (a boolean) that lets a
+// ticket pass single-entry steps again on each day of a multi-day event.
+const RuleDailyReentry = "daily_reentry"
+
+// errInvalidTimezone is returned by LoadTimezone for a name that isn't an
+// IANA timezone.
+var errInvalidTimezone = errors.New("not an IANA timezone")
+
+// LoadTimezone returns the location of an event timezone: an IANA name such
+// as "Asia/Jakarta" or "UTC". The empty name and "Local", which
+// time.LoadLocation accepts, are rejected since they don't name a zone.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, errInvalidTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errInvalidTimezone
+	}
+	return loc, nil
+}
+
+// EventDay represents a row in the event_days table: one day of a multi-day
+// event with its doors-open/doors-close window. Supports soft delete via
+// deleted_at.
+//
+// swagger:model EventDay
+type EventDay struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	EventID      uuid.UUID  `json:"event_id" db:"event_id"`
+	Date         string     `json:"date" db:"day_date"` // YYYY-MM-DD in the event's calendar
+	Name         *string    `json:"name,omitempty" db:"name"`
+	DoorsOpenAt  time.Time  `json:"doors_open_at" db:"doors_open_at"`
+	DoorsCloseAt time.Time  `json:"doors_close_at" db:"doors_close_at"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+// EventTimezone is an event's IANA timezone.
+//
+// swagger:model EventTimezone
+type EventTimezone struct {
+	EventID  uuid.UUID `json:"event_id"`
+	Timezone string    `json:"timezone"`
+}
+
+// OnDate reports whether the day's doors open on its own date in loc, the
+// event's timezone.
+func (d *EventDay) OnDate(loc *time.Location) bool {
+	return d.DoorsOpenAt.In(loc).Format(DateLayout) == d.Date
+}
+
+// Open reports whether the day's doors are open at t: doors_open_at ≤ t <
+// doors_close_at.
+func (d *EventDay) Open(t time.Time) bool {
+	return !t.Before(d.DoorsOpenAt) && t.Before(d.DoorsCloseAt)
+}
+
+// overlaps reports whether the doors windows of d and o intersect.
+func (d *EventDay) overlaps(o *EventDay) bool {
+	return d.DoorsOpenAt.Before(o.DoorsCloseAt) && o.DoorsOpenAt.Before(d.DoorsCloseAt)
+}
+
+// Schedule is an event's days, ordered by date.
+type Schedule []*EventDay
+
+// Today returns the event day at t: the day whose doors are open at t, else
+// the day whose date is t's calendar date in loc, else nil. A window running
+// past midnight thus keeps its day until the doors close.
+func (s Schedule) Today(t time.Time, loc *time.Location) *EventDay {
+	for _, d := range s {
+		if d.Open(t) {
+			return d
+		}
+	}
+	date := t.In(loc).Format(DateLayout)
+	for _, d := range s {
+		if d.Date == date {
+			return d
+		}
+	}
+	return nil
+}
+
+// EntrySince returns the earliest scan that counts against a new scan at a
+// single-entry step made on day: nil (every earlier scan) unless the ticket
+// type allows daily re-entry and the scan falls on an event day, in which case
+// scans before the day's doors opened are ignored.
+func EntrySince(day *EventDay, dailyReentry bool) *time.Time {
+	if day == nil || !dailyReentry {
+		return nil
+	}
+	since := day.DoorsOpenAt
+	return &since
+}
+
+// DailyReentry reports whether ticket type rules allow daily re-entry
+// (RuleDailyReentry set to true). Malformed rules allow nothing.
+func DailyReentry(rules json.RawMessage) bool {
+	var r map[string]any
+	if err := json.Unmarshal(rules, &r); err != nil {
+		return false
+	}
+	v, _ := r[RuleDailyReentry].(bool)
+	return v
+}
+
+// StepScope is a workflow step's day scope; a nil EventDayID runs the step
+// on every day.
+//
+// swagger:model StepScope
+type StepScope struct {
+	WorkflowStepID uuid.UUID  `json:"workflow_step_id"`
+	EventDayID     *uuid.UUID `json:"event_day_id"`
+}
@@ -0,0 +1,85 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/features/events"
+)
+
+// day returns an event day on date with doors open from open to close hours
+// (UTC, hours past midnight of date; close may exceed 24).
+func day(date string, open, close int) *events.EventDay {
+	midnight, _ := time.Parse(events.DateLayout, date)
+	return &events.EventDay{
+		ID:           uuid.New(),
+		Date:         date,
+		DoorsOpenAt:  midnight.Add(time.Duration(open) * time.Hour),
+		DoorsCloseAt: midnight.Add(time.Duration(close) * time.Hour),
+	}
+}
+
+func TestSchedule_Today(t *testing.T) {
+	day1 := day("2026-05-01", 18, 26) // doors close 02:00 on May 2
+	day2 := day("2026-05-02", 10, 20)
+	schedule := events.Schedule{day1, day2}
+	jakarta := time.FixedZone("WIB", 7*3600)
+
+	tests := []struct {
+		name string
+		at   string
+		loc  *time.Location
+		want *events.EventDay
+	}{
+		{name: "doors open", at: "2026-05-01T19:00:00Z", loc: time.UTC, want: day1},
+		{name: "past midnight before doors close", at: "2026-05-02T01:30:00Z", loc: time.UTC, want: day1},
+		{name: "before doors open on the date", at: "2026-05-02T08:00:00Z", loc: time.UTC, want: day2},
+		{name: "date in the event's zone", at: "2026-05-01T17:30:00Z", loc: jakarta, want: day2},
+		{name: "same instant in UTC", at: "2026-05-01T17:30:00Z", loc: time.UTC, want: day1},
+		{name: "before doors open, zone keeps date", at: "2026-05-01T04:00:00Z", loc: jakarta, want: day1},
+		{name: "no day", at: "2026-05-03T12:00:00Z", loc: time.UTC},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, _ := time.Parse(time.RFC3339, tt.at)
+			if got := schedule.Today(at, tt.loc); got != tt.want {
+				t.Errorf("Today(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntrySince(t *testing.T) {
+	d := day("2026-05-02", 10, 20)
+	if got := events.EntrySince(d, true); got == nil || !got.Equal(d.DoorsOpenAt) {
+		t.Errorf("daily re-entry: since = %v, want %v", got, d.DoorsOpenAt)
+	}
+	if got := events.EntrySince(d, false); got != nil {
+		t.Errorf("single entry: since = %v, want nil", got)
+	}
+	if got := events.EntrySince(nil, true); got != nil {
+		t.Errorf("outside event days: since = %v, want nil", got)
+	}
+}
+
+func TestDailyReentry(t *testing.T) {
+	tests := []struct {
+		rules string
+		want  bool
+	}{
+		{rules: `{"daily_reentry": true}`, want: true},
+		{rules: `{"daily_reentry": false}`},
+		{rules: `{"daily_reentry": "yes"}`},
+		{rules: `{}`},
+		{rules: `null`},
+		{rules: `not json`},
+	}
+	for _, tt := range tests {
+		if got := events.DailyReentry(json.RawMessage(tt.rules)); got != tt.want {
+			t.Errorf("DailyReentry(%s) = %v, want %v", tt.rules, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/events/mock_day_store.go -package=mockevents github.com/biairmal/guest-management-be/internal/features/events DayStore
+
+// daySelect reads the event_days columns Days scans, the date as YYYY-MM-DD.
+const daySelect = `SELECT id, event_id, to_char(day_date, 'YYYY-MM-DD'), name, doors_open_at, doors_close_at,
+	created_at, updated_at FROM event_days`
+
+// DayStore holds the event day and step scope queries. Methods that write
+// must run inside a transaction holding the event lock (LockEvent).
+type DayStore interface {
+	// Timezone returns the event's timezone, or repository.ErrNotFound unless
+	// the event is live.
+	Timezone(ctx context.Context, eventID uuid.UUID) (string, error)
+	// LockEvent locks the event row until the surrounding transaction ends,
+	// serialising changes to its days and timezone, and returns its timezone.
+	// It returns repository.ErrNotFound unless the event is live.
+	LockEvent(ctx context.Context, eventID uuid.UUID) (string, error)
+	// SetTimezone saves the event's timezone.
+	SetTimezone(ctx context.Context, eventID uuid.UUID, timezone string) error
+	// Days returns the event's live days by date.
+	Days(ctx context.Context, eventID uuid.UUID) (Schedule, error)
+	// Insert inserts a day.
+	Insert(ctx context.Context, d *EventDay) error
+	// Update saves a day's date, name and doors. It returns
+	// repository.ErrNotFound unless the day is a live day of its event.
+	Update(ctx context.Context, d *EventDay) error
+	// Delete soft-deletes a day and unscopes the workflow steps scoped to it.
+	// It returns repository.ErrNotFound unless the day is a live day of the
+	// event.
+	Delete(ctx context.Context, eventID, dayID uuid.UUID) error
+	// SyncMultiDay sets events.is_multi_day to whether the event has two or
+	// more live days.
+	SyncMultiDay(ctx context.Context, eventID uuid.UUID) error
+	// ScopeStep scopes a workflow step to a day, or to every day when dayID
+	// is nil. It returns repository.ErrNotFound unless the step is a live step
+	// of the event.
+	ScopeStep(ctx context.Context, eventID, stepID uuid.UUID, dayID *uuid.UUID) error
+}
+
+// dayStore implements DayStore on PostgreSQL.
+type dayStore struct {
+	db *sqlkit.DB
+}
+
+// NewDayStore returns a DayStore backed by db.
+func NewDayStore(db *sqlkit.DB) DayStore {
+	return &dayStore{db: db}
+}
+
+// Timezone implements DayStore.
+func (s *dayStore) Timezone(ctx context.Context, eventID uuid.UUID) (string, error) {
+	return s.timezone(ctx, "SELECT timezone FROM events WHERE id = $1 AND deleted_at IS NULL", eventID)
+}
+
+// LockEvent implements DayStore.
+func (s *dayStore) LockEvent(ctx context.Context, eventID uuid.UUID) (string, error) {
+	return s.timezone(ctx, "SELECT timezone FROM events WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", eventID)
+}
+
+// timezone runs an event timezone lookup, mapping no row to
+// repository.ErrNotFound.
+func (s *dayStore) timezone(ctx context.Context, q string, eventID uuid.UUID) (string, error) {
+	var tz string
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, q, eventID).Scan(&tz)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", repository.ErrNotFound
+	}
+	return tz, err
+}
+
+// SetTimezone implements DayStore.
+func (s *dayStore) SetTimezone(ctx context.Context, eventID uuid.UUID, timezone string) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE events SET timezone = $2, updated_at = now() WHERE id = $1", eventID, timezone)
+	return err
+}
+
+// Days implements DayStore.
+func (s *dayStore) Days(ctx context.Context, eventID uuid.UUID) (Schedule, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx,
+		daySelect+" WHERE event_id = $1 AND deleted_at IS NULL ORDER BY day_date", eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := Schedule{}
+	for rows.Next() {
+		var d EventDay
+		if err := rows.Scan(
+			&d.ID, &d.EventID, &d.Date, &d.Name, &d.DoorsOpenAt, &d.DoorsCloseAt, &d.CreatedAt, &d.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, &d)
+	}
+	return out, rows.Err()
+}
+
+// Insert implements DayStore.
+func (s *dayStore) Insert(ctx context.Context, d *EventDay) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `INSERT INTO event_days
+			(id, event_id, day_date, name, doors_open_at, doors_close_at)
+		VALUES ($1, $2, $3::date, $4, $5, $6)
+		RETURNING created_at, updated_at`,
+		d.ID, d.EventID, d.Date, d.Name, d.DoorsOpenAt, d.DoorsCloseAt,
+	).Scan(&d.CreatedAt, &d.UpdatedAt)
+}
+
+// Update implements DayStore.
+func (s *dayStore) Update(ctx context.Context, d *EventDay) error {
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `UPDATE event_days
+		SET day_date = $3::date, name = $4, doors_open_at = $5, doors_close_at = $6, updated_at = now()
+		WHERE id = $1 AND event_id = $2 AND deleted_at IS NULL
+		RETURNING updated_at`,
+		d.ID, d.EventID, d.Date, d.Name, d.DoorsOpenAt, d.DoorsCloseAt,
+	).Scan(&d.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// Delete implements DayStore. The two statements must run in one
+// transaction; callers go through TxManager.
+func (s *dayStore) Delete(ctx context.Context, eventID, dayID uuid.UUID) error {
+	conn := corerepository.Conn(ctx, s.db)
+	res, err := conn.ExecContext(ctx, `UPDATE event_days SET deleted_at = now(), updated_at = now()
+		WHERE id = $1 AND event_id = $2 AND deleted_at IS NULL`, dayID, eventID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	_, err = conn.ExecContext(ctx,
+		"UPDATE workflow_steps SET event_day_id = NULL, updated_at = now() WHERE event_day_id = $1", dayID)
+	return err
+}
+
+// SyncMultiDay implements DayStore.
+func (s *dayStore) SyncMultiDay(ctx context.Context, eventID uuid.UUID) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE events
+		SET is_multi_day = (SELECT count(*) >= 2 FROM event_days d WHERE d.event_id = $1 AND d.deleted_at IS NULL),
+			updated_at = now()
+		WHERE id = $1`, eventID)
+	return err
+}
+
+// ScopeStep implements DayStore.
+func (s *dayStore) ScopeStep(ctx context.Context, eventID, stepID uuid.UUID, dayID *uuid.UUID) error {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE workflow_steps
+		SET event_day_id = $3, updated_at = now()
+		WHERE id = $2 AND event_id = $1 AND deleted_at IS NULL`, eventID, stepID, dayID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package events
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitDayRoutes registers event day, workflow step day scope and event
+// timezone routes on the given router.
+func InitDayRoutes(r *chi.Mux, dayH *DayHandler) {
+	r.Route("/api/v1/events/{eventId}/days", func(r chi.Router) {
+		r.Get("/", problem.Handle(dayH.List))
+		r.Get("/today", problem.Handle(dayH.Today))
+		r.Post("/", problem.Handle(dayH.Create))
+		r.Put("/{dayId}", problem.Handle(dayH.Update))
+		r.Delete("/{dayId}", problem.Handle(dayH.Delete))
+	})
+	r.Put("/api/v1/events/{eventId}/workflow-steps/{stepId}/day", problem.Handle(dayH.ScopeStep))
+	r.Put("/api/v1/events/{eventId}/timezone", problem.Handle(dayH.SetTimezone))
+}
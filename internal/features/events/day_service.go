@@ -0,0 +1,355 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/events/mock_day_service.go -package=mockevents github.com/biairmal/guest-management-be/internal/features/events DayService
+
+// DayService manages the days of multi-day events, the day scope of their
+// workflow steps and the event timezone the days' dates are read in. Days of
+// one event have distinct dates and non-overlapping doors windows, and their
+// doors open on their own date; events.is_multi_day follows the number of
+// days.
+type DayService interface {
+	List(ctx context.Context, eventID uuid.UUID) (Schedule, error)
+	Create(ctx context.Context, eventID uuid.UUID, in CreateDayInput) (*EventDay, error)
+	Update(ctx context.Context, eventID, id uuid.UUID, in UpdateDayInput) (*EventDay, error)
+	// Delete soft-deletes the day; steps scoped to it run every day again.
+	Delete(ctx context.Context, eventID, id uuid.UUID) error
+	// Today returns the event day at at (see Schedule.Today), reading dates
+	// in the event's timezone.
+	Today(ctx context.Context, eventID uuid.UUID, at time.Time) (*EventDay, error)
+	// SetTimezone changes the event's timezone. It fails if a day's doors
+	// would no longer open on its date.
+	SetTimezone(ctx context.Context, eventID uuid.UUID, in TimezoneInput) (*EventTimezone, error)
+	// ScopeStep scopes a workflow step to one of the event's days, or to every
+	// day.
+	ScopeStep(ctx context.Context, eventID, stepID uuid.UUID, in StepScopeInput) (*StepScope, error)
+}
+
+// CreateDayInput is the input for adding a day to an event.
+//
+// swagger:model CreateDayInput
+type CreateDayInput struct {
+	Date         string    `json:"date"           validate:"required,datetime=2006-01-02"`
+	Name         *string   `json:"name,omitempty" validate:"omitempty,max=255"`
+	DoorsOpenAt  time.Time `json:"doors_open_at"  validate:"required"`
+	DoorsCloseAt time.Time `json:"doors_close_at" validate:"required"`
+}
+
+// UpdateDayInput is the input for updating an event day. Only non-nil fields
+// are applied.
+//
+// swagger:model UpdateDayInput
+type UpdateDayInput struct {
+	Date         *string    `json:"date,omitempty"           validate:"omitempty,datetime=2006-01-02"`
+	Name         *string    `json:"name,omitempty"           validate:"omitempty,max=255"`
+	DoorsOpenAt  *time.Time `json:"doors_open_at,omitempty"`
+	DoorsCloseAt *time.Time `json:"doors_close_at,omitempty"`
+}
+
+// StepScopeInput scopes a workflow step to a day; a null event_day_id runs
+// it on every day.
+//
+// swagger:model StepScopeInput
+type StepScopeInput struct {
+	EventDayID *uuid.UUID `json:"event_day_id"`
+}
+
+// TimezoneInput is the input for setting an event's timezone.
+//
+// swagger:model TimezoneInput
+type TimezoneInput struct {
+	Timezone string `json:"timezone" validate:"required,timezone"` // IANA name, e.g. Asia/Jakarta
+}
+
+// dayServiceImpl is the concrete implementation of DayService.
+type dayServiceImpl struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	store  DayStore
+}
+
+// NewDayService returns a DayService with the given dependencies.
+func NewDayService(logger logger.Logger, tx transaction.TxManager, store DayStore) DayService {
+	return &dayServiceImpl{logger: logger, tx: tx, store: store}
+}
+
+// List implements DayService.
+func (s *dayServiceImpl) List(ctx context.Context, eventID uuid.UUID) (Schedule, error) {
+	if _, err := s.store.Timezone(ctx, eventID); err != nil {
+		return nil, s.translate(ctx, "event lookup failed", eventID, err)
+	}
+	days, err := s.store.Days(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "event day list failed", eventID, err)
+	}
+	return days, nil
+}
+
+// Create implements DayService.
+func (s *dayServiceImpl) Create(ctx context.Context, eventID uuid.UUID, in CreateDayInput) (*EventDay, error) {
+	d := &EventDay{
+		ID:           uuid.New(),
+		EventID:      eventID,
+		Date:         in.Date,
+		Name:         in.Name,
+		DoorsOpenAt:  in.DoorsOpenAt,
+		DoorsCloseAt: in.DoorsCloseAt,
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		days, loc, err := s.lockDays(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if err := checkDay(d, days, loc); err != nil {
+			return err
+		}
+		if err := s.store.Insert(ctx, d); err != nil {
+			return s.translate(ctx, "event day create failed", eventID, err)
+		}
+		if err := s.store.SyncMultiDay(ctx, eventID); err != nil {
+			return s.translate(ctx, "event day create failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "event day created", logger.F("id", d.ID), logger.F("event_id", eventID))
+	return d, nil
+}
+
+// Update implements DayService.
+func (s *dayServiceImpl) Update(
+	ctx context.Context, eventID, id uuid.UUID, in UpdateDayInput,
+) (*EventDay, error) {
+	var d *EventDay
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		days, loc, err := s.lockDays(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		for _, day := range days {
+			if day.ID == id {
+				d = day
+			}
+		}
+		if d == nil {
+			return errcode.EventDayNotFound.New()
+		}
+		if in.Date != nil {
+			d.Date = *in.Date
+		}
+		if in.Name != nil {
+			d.Name = in.Name
+		}
+		if in.DoorsOpenAt != nil {
+			d.DoorsOpenAt = *in.DoorsOpenAt
+		}
+		if in.DoorsCloseAt != nil {
+			d.DoorsCloseAt = *in.DoorsCloseAt
+		}
+		if err := checkDay(d, days, loc); err != nil {
+			return err
+		}
+		if err := s.store.Update(ctx, d); err != nil {
+			return s.translate(ctx, "event day update failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "event day updated", logger.F("id", id), logger.F("event_id", eventID))
+	return d, nil
+}
+
+// Delete implements DayService.
+func (s *dayServiceImpl) Delete(ctx context.Context, eventID, id uuid.UUID) error {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.store.LockEvent(ctx, eventID); err != nil {
+			return s.translate(ctx, "event lock failed", eventID, err)
+		}
+		if err := s.store.Delete(ctx, eventID, id); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return errcode.EventDayNotFound.New()
+			}
+			return s.translate(ctx, "event day delete failed", eventID, err)
+		}
+		if err := s.store.SyncMultiDay(ctx, eventID); err != nil {
+			return s.translate(ctx, "event day delete failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.InfoWithContext(ctx, "event day deleted", logger.F("id", id), logger.F("event_id", eventID))
+	return nil
+}
+
+// Today implements DayService.
+func (s *dayServiceImpl) Today(ctx context.Context, eventID uuid.UUID, at time.Time) (*EventDay, error) {
+	tz, err := s.store.Timezone(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "event lookup failed", eventID, err)
+	}
+	loc, err := s.location(ctx, eventID, tz)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.store.Days(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "event day list failed", eventID, err)
+	}
+	d := days.Today(at, loc)
+	if d == nil {
+		return nil, errcode.EventDayNotFound.WithMessage("no event day at that time")
+	}
+	return d, nil
+}
+
+// ScopeStep implements DayService.
+func (s *dayServiceImpl) ScopeStep(
+	ctx context.Context, eventID, stepID uuid.UUID, in StepScopeInput,
+) (*StepScope, error) {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		days, _, err := s.lockDays(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if in.EventDayID != nil && !days.has(*in.EventDayID) {
+			return errorz.BadRequest().WithMessage("event_day_id is not a day of the event")
+		}
+		if err := s.store.ScopeStep(ctx, eventID, stepID, in.EventDayID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return errcode.WorkflowStepNotFound.New()
+			}
+			return s.translate(ctx, "workflow step scope failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "workflow step scoped",
+		logger.F("workflow_step_id", stepID), logger.F("event_day_id", in.EventDayID))
+	return &StepScope{WorkflowStepID: stepID, EventDayID: in.EventDayID}, nil
+}
+
+// SetTimezone implements DayService.
+func (s *dayServiceImpl) SetTimezone(
+	ctx context.Context, eventID uuid.UUID, in TimezoneInput,
+) (*EventTimezone, error) {
+	loc, err := LoadTimezone(in.Timezone)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("timezone must be an IANA timezone such as Asia/Jakarta")
+	}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		days, _, err := s.lockDays(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		for _, d := range days {
+			if !d.OnDate(loc) {
+				return errorz.Conflict().WithMessage("in " + in.Timezone + " the doors of the day on " + d.Date +
+					" open on another date; move the day first")
+			}
+		}
+		if err := s.store.SetTimezone(ctx, eventID, in.Timezone); err != nil {
+			return s.translate(ctx, "event timezone update failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "event timezone set",
+		logger.F("event_id", eventID), logger.F("timezone", in.Timezone))
+	return &EventTimezone{EventID: eventID, Timezone: in.Timezone}, nil
+}
+
+// lockDays takes the event lock and returns the event's days and timezone.
+func (s *dayServiceImpl) lockDays(ctx context.Context, eventID uuid.UUID) (Schedule, *time.Location, error) {
+	tz, err := s.store.LockEvent(ctx, eventID)
+	if err != nil {
+		return nil, nil, s.translate(ctx, "event lock failed", eventID, err)
+	}
+	loc, err := s.location(ctx, eventID, tz)
+	if err != nil {
+		return nil, nil, err
+	}
+	days, err := s.store.Days(ctx, eventID)
+	if err != nil {
+		return nil, nil, s.translate(ctx, "event day list failed", eventID, err)
+	}
+	return days, loc, nil
+}
+
+// location loads a stored event timezone; one that no longer loads (a
+// tzdata change) is logged and reported as 500.
+func (s *dayServiceImpl) location(ctx context.Context, eventID uuid.UUID, tz string) (*time.Location, error) {
+	loc, err := LoadTimezone(tz)
+	if err != nil {
+		return nil, s.translate(ctx, "event timezone invalid", eventID, fmt.Errorf("timezone %q: %w", tz, err))
+	}
+	return loc, nil
+}
+
+// translate maps a missing event to 404 and logs anything else as msg,
+// reporting it as 500.
+func (s *dayServiceImpl) translate(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update event days")
+}
+
+// checkDay rejects a day whose doors close before they open or don't open on
+// its date in loc, or that shares its date or overlaps its doors window with
+// another of days.
+func checkDay(d *EventDay, days Schedule, loc *time.Location) error {
+	if !d.DoorsCloseAt.After(d.DoorsOpenAt) {
+		return errorz.BadRequest().WithMessage("doors_close_at must be after doors_open_at")
+	}
+	if !d.OnDate(loc) {
+		return errorz.BadRequest().WithMessage("doors_open_at must fall on date in the event's timezone (" +
+			loc.String() + ")")
+	}
+	for _, o := range days {
+		if o.ID == d.ID {
+			continue
+		}
+		if o.Date == d.Date {
+			return errorz.Conflict().WithMessage("the event already has a day on " + d.Date)
+		}
+		if d.overlaps(o) {
+			return errorz.Conflict().WithMessage("doors window overlaps the day on " + o.Date)
+		}
+	}
+	return nil
+}
+
+// has reports whether the schedule holds the day id.
+func (s Schedule) has(id uuid.UUID) bool {
+	for _, d := range s {
+		if d.ID == id {
+			return true
+		}
+	}
+	return false
+}
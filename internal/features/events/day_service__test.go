@@ -0,0 +1,298 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/events"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockevents "github.com/biairmal/guest-management-be/mocks/events"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// assertCode fails unless err carries want, or is nil when want is empty.
+func assertCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var e *errorz.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *errorz.Error, got %T: %v", err, err)
+	}
+	if e.Code != want {
+		t.Errorf("code = %q, want %q", e.Code, want)
+	}
+}
+
+func TestDayService_Create(t *testing.T) {
+	eventID := uuid.New()
+	existing := day("2026-05-01", 18, 26)
+	window := func(date string, open, close int) events.CreateDayInput {
+		d := day(date, open, close)
+		return events.CreateDayInput{Date: d.Date, DoorsOpenAt: d.DoorsOpenAt, DoorsCloseAt: d.DoorsCloseAt}
+	}
+
+	tests := []struct {
+		name     string
+		in       events.CreateDayInput
+		lockErr  error
+		wantCode string
+	}{
+		{name: "second day", in: window("2026-05-02", 10, 20)},
+		{name: "doors close before they open", in: window("2026-05-02", 20, 10), wantCode: errorz.CodeBadRequest},
+		{name: "date taken", in: window("2026-05-01", 8, 12), wantCode: errorz.CodeConflict},
+		{
+			name: "doors open on the next date", in: window("2026-05-02", 25, 30),
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "window overlaps the previous night", in: window("2026-05-02", 1, 12),
+			wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "event not found", in: window("2026-05-02", 10, 20), lockErr: repository.ErrNotFound,
+			wantCode: errorz.CodeNotFound,
+		},
+		{
+			name: "lock failure", in: window("2026-05-02", 10, 20), lockErr: errors.New("boom"),
+			wantCode: errorz.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockevents.NewMockDayStore(ctrl)
+			store.EXPECT().LockEvent(gomock.Any(), eventID).Return("UTC", tt.lockErr)
+			store.EXPECT().Days(gomock.Any(), eventID).Return(events.Schedule{existing}, nil).MaxTimes(1)
+			inserted := false
+			store.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, d *events.EventDay) error {
+					inserted = d.EventID == eventID && d.Date == tt.in.Date
+					return nil
+				}).MaxTimes(1)
+			store.EXPECT().SyncMultiDay(gomock.Any(), eventID).Return(nil).MaxTimes(1)
+
+			svc := events.NewDayService(logger.NewNoOp(), inlineTx(ctrl), store)
+			_, err := svc.Create(context.Background(), eventID, tt.in)
+			assertCode(t, err, tt.wantCode)
+			if inserted != (tt.wantCode == "") {
+				t.Errorf("inserted = %v, want %v", inserted, tt.wantCode == "")
+			}
+		})
+	}
+}
+
+func TestDayService_Update(t *testing.T) {
+	eventID := uuid.New()
+	day1, day2 := day("2026-05-01", 18, 26), day("2026-05-02", 10, 20)
+	date := func(s string) *string { return &s }
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name     string
+		id       uuid.UUID
+		in       events.UpdateDayInput
+		wantCode string
+	}{
+		{name: "rename keeps its own date and window", id: day2.ID, in: events.UpdateDayInput{Name: date("Finals")}},
+		{
+			name: "move to a free date with its doors", id: day2.ID,
+			in: events.UpdateDayInput{
+				Date:         date("2026-05-03"),
+				DoorsOpenAt:  at(day2.DoorsOpenAt.Add(24 * time.Hour)),
+				DoorsCloseAt: at(day2.DoorsCloseAt.Add(24 * time.Hour)),
+			},
+		},
+		{
+			name: "move the date alone", id: day2.ID, in: events.UpdateDayInput{Date: date("2026-05-03")},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "extend into the next day's window", id: day1.ID,
+			in:       events.UpdateDayInput{DoorsCloseAt: at(day2.DoorsOpenAt.Add(time.Hour))},
+			wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "close before open", id: day2.ID, in: events.UpdateDayInput{DoorsCloseAt: at(day2.DoorsOpenAt)},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{name: "unknown day", id: uuid.New(), wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockevents.NewMockDayStore(ctrl)
+			store.EXPECT().LockEvent(gomock.Any(), eventID).Return("UTC", nil)
+			a, b := *day1, *day2
+			store.EXPECT().Days(gomock.Any(), eventID).Return(events.Schedule{&a, &b}, nil)
+			saved := false
+			store.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, *events.EventDay) error {
+				saved = true
+				return nil
+			}).MaxTimes(1)
+
+			svc := events.NewDayService(logger.NewNoOp(), inlineTx(ctrl), store)
+			_, err := svc.Update(context.Background(), eventID, tt.id, tt.in)
+			assertCode(t, err, tt.wantCode)
+			if saved != (tt.wantCode == "") {
+				t.Errorf("saved = %v, want %v", saved, tt.wantCode == "")
+			}
+		})
+	}
+}
+
+func TestDayService_Delete(t *testing.T) {
+	eventID, dayID := uuid.New(), uuid.New()
+	tests := []struct {
+		name      string
+		deleteErr error
+		wantCode  string
+	}{
+		{name: "deletes and resyncs multi-day"},
+		{name: "unknown day", deleteErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "store failure", deleteErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockevents.NewMockDayStore(ctrl)
+			store.EXPECT().LockEvent(gomock.Any(), eventID).Return("UTC", nil)
+			store.EXPECT().Delete(gomock.Any(), eventID, dayID).Return(tt.deleteErr)
+			if tt.wantCode == "" {
+				store.EXPECT().SyncMultiDay(gomock.Any(), eventID).Return(nil)
+			}
+
+			svc := events.NewDayService(logger.NewNoOp(), inlineTx(ctrl), store)
+			assertCode(t, svc.Delete(context.Background(), eventID, dayID), tt.wantCode)
+		})
+	}
+}
+
+func TestDayService_Today(t *testing.T) {
+	eventID := uuid.New()
+	d := day("2026-05-01", 18, 26)
+	tests := []struct {
+		name     string
+		tz       string
+		at       time.Time
+		wantCode string
+	}{
+		{name: "night session past midnight", tz: "UTC", at: d.DoorsCloseAt.Add(-time.Minute)},
+		{name: "date in UTC", tz: "UTC", at: d.DoorsOpenAt.Add(-17 * time.Hour)},
+		{
+			name: "date in the event's timezone", tz: "America/New_York", at: d.DoorsOpenAt.Add(-17 * time.Hour),
+			wantCode: errorz.CodeNotFound,
+		},
+		{name: "no day", tz: "UTC", at: d.DoorsOpenAt.Add(72 * time.Hour), wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockevents.NewMockDayStore(ctrl)
+			store.EXPECT().Timezone(gomock.Any(), eventID).Return(tt.tz, nil)
+			store.EXPECT().Days(gomock.Any(), eventID).Return(events.Schedule{d}, nil)
+
+			svc := events.NewDayService(logger.NewNoOp(), inlineTx(ctrl), store)
+			got, err := svc.Today(context.Background(), eventID, tt.at)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && got != d {
+				t.Errorf("Today = %v, want %v", got, d)
+			}
+		})
+	}
+}
+
+func TestDayService_ScopeStep(t *testing.T) {
+	eventID, stepID := uuid.New(), uuid.New()
+	d := day("2026-05-02", 10, 20)
+	other := uuid.New()
+
+	tests := []struct {
+		name     string
+		dayID    *uuid.UUID
+		scopeErr error
+		wantCode string
+	}{
+		{name: "scope to a day", dayID: &d.ID},
+		{name: "every day"},
+		{name: "day of another event", dayID: &other, wantCode: errorz.CodeBadRequest},
+		{name: "unknown step", dayID: &d.ID, scopeErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockevents.NewMockDayStore(ctrl)
+			store.EXPECT().LockEvent(gomock.Any(), eventID).Return("UTC", nil)
+			store.EXPECT().Days(gomock.Any(), eventID).Return(events.Schedule{d}, nil)
+			store.EXPECT().ScopeStep(gomock.Any(), eventID, stepID, tt.dayID).Return(tt.scopeErr).MaxTimes(1)
+
+			svc := events.NewDayService(logger.NewNoOp(), inlineTx(ctrl), store)
+			got, err := svc.ScopeStep(context.Background(), eventID, stepID, events.StepScopeInput{EventDayID: tt.dayID})
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && (got.WorkflowStepID != stepID || got.EventDayID != tt.dayID) {
+				t.Errorf("scope = %+v", got)
+			}
+		})
+	}
+}
+
+func TestDayService_SetTimezone(t *testing.T) {
+	eventID := uuid.New()
+	evening := day("2026-05-01", 18, 26) // 18:00 UTC is 01:00 next day in Asia/Jakarta
+
+	tests := []struct {
+		name     string
+		tz       string
+		days     events.Schedule
+		wantCode string
+	}{
+		{name: "no days", tz: "Asia/Jakarta"},
+		{name: "days keep their dates", tz: "Europe/London", days: events.Schedule{evening}},
+		{
+			name: "a day moves to another date", tz: "Asia/Jakarta", days: events.Schedule{evening},
+			wantCode: errorz.CodeConflict,
+		},
+		{name: "unknown zone", tz: "Mars/Olympus", wantCode: errorz.CodeBadRequest},
+		{name: "local zone", tz: "Local", wantCode: errorz.CodeBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockevents.NewMockDayStore(ctrl)
+			store.EXPECT().LockEvent(gomock.Any(), eventID).Return("UTC", nil).MaxTimes(1)
+			store.EXPECT().Days(gomock.Any(), eventID).Return(tt.days, nil).MaxTimes(1)
+			saved := ""
+			store.EXPECT().SetTimezone(gomock.Any(), eventID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, tz string) error {
+					saved = tz
+					return nil
+				}).MaxTimes(1)
+
+			svc := events.NewDayService(logger.NewNoOp(), inlineTx(ctrl), store)
+			_, err := svc.SetTimezone(context.Background(), eventID, events.TimezoneInput{Timezone: tt.tz})
+			assertCode(t, err, tt.wantCode)
+			if want := map[bool]string{true: tt.tz}[tt.wantCode == ""]; saved != want {
+				t.Errorf("saved timezone = %q, want %q", saved, want)
+			}
+		})
+	}
+}
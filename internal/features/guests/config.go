@@ -0,0 +1,82 @@
+package guests
+
+import (
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// Config aggregates the guests feature's own configuration, one field per
+// layer (app.guests.<layer> in config.yaml).
+type Config struct {
+	Service ServiceConfig `mapstructure:"service"`
+	Handler HandlerConfig `mapstructure:"handler"`
+}
+
+// ServiceConfig holds config for the guests feature's service layer.
+type ServiceConfig struct {
+	Import ImportConfig `mapstructure:"import"`
+}
+
+// ImportConfig tunes the background guest import.
+type ImportConfig struct {
+	Workers   int `mapstructure:"workers"`    // imports processed concurrently
+	BatchSize int `mapstructure:"batch_size"` // guests per INSERT statement
+	MaxRows   int `mapstructure:"max_rows"`   // data rows accepted per file
+	// StaleAfter is how long a queued or running import may go without
+	// progress before a starting instance takes its worker for dead and marks
+	// it failed. A running import records progress after every batch.
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+}
+
+// HandlerConfig holds config for the guests feature's HTTP layer.
+type HandlerConfig struct {
+	MaxUploadBytes int64 `mapstructure:"max_upload_bytes"` // multipart import upload limit
+}
+
+// DefaultConfig returns the guests feature config with its defaults.
+func DefaultConfig() Config {
+	return Config{
+		Service: ServiceConfig{Import: ImportConfig{Workers: 2, BatchSize: 500, MaxRows: 50000, StaleAfter: 10 * time.Minute}},
+		Handler: HandlerConfig{MaxUploadBytes: 10 << 20},
+	}
+}
+
+// Validate validates the guests feature configuration.
+func (c *Config) Validate() error {
+	if err := c.Service.Validate(); err != nil {
+		return err
+	}
+	return c.Handler.Validate()
+}
+
+// Validate validates the guests feature's service-layer configuration.
+func (c *ServiceConfig) Validate() error {
+	return c.Import.Validate()
+}
+
+// Validate validates the import settings.
+func (c *ImportConfig) Validate() error {
+	if c.Workers < 1 {
+		return errorz.Internal().WithMessage("guests: import.workers must be at least 1")
+	}
+	// 7 bind parameters per guest; PostgreSQL allows 65535 per statement.
+	if c.BatchSize < 1 || c.BatchSize > 9000 {
+		return errorz.Internal().WithMessage("guests: import.batch_size must be between 1 and 9000")
+	}
+	if c.MaxRows < 1 {
+		return errorz.Internal().WithMessage("guests: import.max_rows must be at least 1")
+	}
+	if c.StaleAfter <= 0 {
+		return errorz.Internal().WithMessage("guests: import.stale_after must be positive")
+	}
+	return nil
+}
+
+// Validate validates the guests feature's handler-layer configuration.
+func (c *HandlerConfig) Validate() error {
+	if c.MaxUploadBytes <= 0 {
+		return errorz.Internal().WithMessage("guests: handler.max_upload_bytes must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package guests
+
+import (
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/export"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/tabular"
+)
+
+// ExportHandler exposes the guest list export over HTTP.
+type ExportHandler struct {
+	logger  logger.Logger
+	service ExportService
+}
+
+// NewExportHandler returns an ExportHandler that uses the given service.
+func NewExportHandler(logger logger.Logger, service ExportService) *ExportHandler {
+	return &ExportHandler{logger: logger, service: service}
+}
+
+// Export handles GET /events/{eventId}/guests/export.
+//
+// Export godoc
+//
+//	@Summary		Export guests
+//	@Description	Streams the event's guest list, with RSVP and ticket status and one column per custom field, as CSV, XLSX or JSON Lines. Accepts the guest list's filters and sorts, including cf.<key>=<value> custom field filters.
+//	@Tags			guests
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Produce		application/x-ndjson
+//	@Param			eventId			path		string	true	"Event UUID"
+//	@Param			format			query		string	false	"csv (default), xlsx or ndjson"
+//	@Param			sort			query		string	false	"Sort spec field,DIRECTION (repeatable)"
+//	@Param			name			query		string	false	"Filter by name"
+//	@Param			email			query		string	false	"Filter by email"
+//	@Param			rsvp_status		query		string	false	"Filter by RSVP status"
+//	@Param			ticket_status	query		string	false	"Filter by ticket status"
+//	@Param			group_id		query		string	false	"Filter by guest group UUID"
+//	@Success		200				{file}		file
+//	@Failure		400				{object}	problem.Problem	"Invalid event id, format or query"
+//	@Failure		404				{object}	problem.Problem	"Event not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/export [get]
+func (h *ExportHandler) Export(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		export.Error(w, r, errcode.InvalidID.WithMessage("invalid event id"))
+		return
+	}
+	name := r.URL.Query().Get("format")
+	if name == "" {
+		name = string(tabular.FormatCSV)
+	}
+	format, err := tabular.ParseExportFormat(name)
+	if err != nil {
+		export.Error(w, r, errorz.BadRequest().WithMessage("format must be csv, xlsx or ndjson"))
+		return
+	}
+	params, err := query.ParseListParams(r.URL.Query(), guestListConfig)
+	if err != nil {
+		export.Error(w, r, errorz.BadRequest().WithMessage(err.Error()))
+		return
+	}
+
+	header, src, err := h.service.Export(r.Context(), eventID, params)
+	if err != nil {
+		export.Error(w, r, err)
+		return
+	}
+	export.Write(w, r, h.logger, format, "guests-"+eventID.String(), header, src)
+}
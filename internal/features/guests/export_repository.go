@@ -0,0 +1,68 @@
+package guests
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_export_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ExportStore
+
+// GuestExportRow is one guest as exported: the guest plus its ticket's status.
+type GuestExportRow struct {
+	ID           uuid.UUID
+	Name         string
+	Email        string
+	Phone        *string
+	RSVPStatus   string
+	TicketStatus *string // nil when the guest has no live ticket
+	CustomFields CustomFields
+	CreatedAt    time.Time
+}
+
+// ExportStore reads guests for export.
+type ExportStore interface {
+	// StreamGuests calls fn for each live guest of the event matching params'
+	// filters (custom field filters resolved against schema), in params' sort
+	// order (then by id), reading through a server-side cursor.
+	StreamGuests(
+		ctx context.Context, eventID uuid.UUID, params *query.ListParams, schema Schema, fn func(*GuestExportRow) error,
+	) error
+}
+
+// exportStore implements ExportStore on PostgreSQL.
+type exportStore struct {
+	db *sqlkit.DB
+}
+
+// NewExportStore returns an ExportStore backed by db.
+func NewExportStore(db *sqlkit.DB) ExportStore {
+	return &exportStore{db: db}
+}
+
+// StreamGuests implements ExportStore.
+func (s *exportStore) StreamGuests(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams, schema Schema, fn func(*GuestExportRow) error,
+) error {
+	where, args, order := guestListSQL(eventID, params, schema)
+	q := `SELECT g.id, g.name, g.email, g.phone, g.rsvp_status, t.status, g.custom_fields, g.created_at
+		FROM guests g
+		LEFT JOIN tickets t ON t.id = g.ticket_id AND t.deleted_at IS NULL
+		WHERE ` + where + " ORDER BY " + order
+
+	return corerepository.Stream(ctx, s.db, corerepository.DefaultFetchSize, q, args, func(rows *sql.Rows) error {
+		var row GuestExportRow
+		if err := rows.Scan(
+			&row.ID, &row.Name, &row.Email, &row.Phone, &row.RSVPStatus, &row.TicketStatus, &row.CustomFields, &row.CreatedAt,
+		); err != nil {
+			return err
+		}
+		return fn(&row)
+	})
+}
@@ -0,0 +1,8 @@
+package guests
+
+import "github.com/go-chi/chi/v5"
+
+// InitExportRoutes registers the guest list export route on the given router.
+func InitExportRoutes(r *chi.Mux, exportH *ExportHandler) {
+	r.Get("/api/v1/events/{eventId}/guests/export", exportH.Export)
+}
@@ -0,0 +1,104 @@
+package guests
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/export"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_export_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ExportService
+
+// guestListConfig is the guest list's filter/sort allow-list, shared by every
+// endpoint that lists guests so they accept the same query. Custom fields are
+// filtered as cf.<key>=<value>, checked against the event's schema.
+var guestListConfig = query.ListParseConfig{
+	AllowedSortFields:     []string{"name", "email", "rsvp_status", "ticket_status", "created_at"},
+	AllowedFilterFields:   []string{"name", "email", "rsvp_status", "ticket_status", "group_id"},
+	AllowedFilterPrefixes: []string{customFieldFilterPrefix},
+}
+
+// guestExportHeader is the header row of a guest export, before the event's
+// custom field keys.
+var guestExportHeader = []string{"id", "name", "email", "phone", "rsvp_status", "ticket_status", "created_at"}
+
+// ExportService exports an event's guest list.
+type ExportService interface {
+	// Export checks the event and params and returns the export's header (the
+	// built-in columns, then one column per custom field key) and a source
+	// that emits each matching guest as a row under it. An error returned by
+	// the source's emit is returned unchanged.
+	Export(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]string, export.Source, error)
+}
+
+// exportServiceImpl is the concrete implementation of ExportService.
+type exportServiceImpl struct {
+	logger logger.Logger
+	store  ExportStore
+	fields FieldStore
+}
+
+// NewExportService returns an ExportService that reads guests from store and
+// the event's custom fields from fields.
+func NewExportService(logger logger.Logger, store ExportStore, fields FieldStore) ExportService {
+	return &exportServiceImpl{logger: logger, store: store, fields: fields}
+}
+
+// Export implements ExportService.
+func (s *exportServiceImpl) Export(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) ([]string, export.Source, error) {
+	schema, err := loadSchema(ctx, s.logger, s.fields, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if params != nil {
+		if err := checkGuestFilters(schema, params.Filters); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	header := slices.Clone(guestExportHeader)
+	for _, f := range schema {
+		header = append(header, f.Key)
+	}
+	src := func(ctx context.Context, emit func([]string) error) error {
+		var emitErr error
+		err := s.store.StreamGuests(ctx, eventID, params, schema, func(g *GuestExportRow) error {
+			emitErr = emit(guestExportRecord(g, schema))
+			return emitErr
+		})
+		if err == nil || emitErr != nil {
+			return err
+		}
+		s.logger.ErrorWithContext(ctx, "guest export failed", logger.F("event_id", eventID), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to export guests")
+	}
+	return header, src, nil
+}
+
+// guestExportRecord formats g as a row under the header built from schema.
+func guestExportRecord(g *GuestExportRow, schema Schema) []string {
+	row := []string{
+		g.ID.String(), g.Name, g.Email, deref(g.Phone), g.RSVPStatus, deref(g.TicketStatus),
+		g.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, f := range schema {
+		row = append(row, formatFieldValue(g.CustomFields[f.Key]))
+	}
+	return row
+}
+
+// deref returns *s, or "" when s is nil.
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,119 @@
+package guests_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+)
+
+func TestExportService_Export(t *testing.T) {
+	eventID := uuid.New()
+	guestID := uuid.New()
+	phone, ticket := "+62811", "used"
+	created := time.Date(2026, 5, 1, 9, 30, 0, 0, time.FixedZone("WIB", 7*3600))
+	schema := guests.Schema{
+		{Key: "table", Type: guests.FieldTypeNumber},
+		{Key: "vip", Type: guests.FieldTypeBoolean},
+	}
+	stored := []*guests.GuestExportRow{
+		{
+			ID: guestID, Name: "Ann", Email: "ann@x.io", Phone: &phone, RSVPStatus: guests.RSVPConfirmed,
+			TicketStatus: &ticket, CustomFields: guests.CustomFields{"table": 7.0, "vip": true}, CreatedAt: created,
+		},
+		{ID: guestID, Name: "Bob", Email: "bob@x.io", RSVPStatus: guests.RSVPNone, CreatedAt: created},
+	}
+	emitFailure := errors.New("client gone")
+
+	tests := []struct {
+		name       string
+		filters    map[string]string
+		lookup     error
+		streamErr  error
+		emitErr    error
+		wantHeader []string
+		wantRows   [][]string
+		wantCode   string // from Export
+		wantSrc    string // from the source
+		wantErr    error
+	}{
+		{
+			name:    "rows are formatted under the header with custom field columns",
+			filters: map[string]string{"rsvp_status": "confirmed", "cf.vip": "true"},
+			wantHeader: []string{
+				"id", "name", "email", "phone", "rsvp_status", "ticket_status", "created_at", "table", "vip",
+			},
+			wantRows: [][]string{
+				{guestID.String(), "Ann", "ann@x.io", "+62811", "confirmed", "used", "2026-05-01T02:30:00Z", "7", "true"},
+				{guestID.String(), "Bob", "bob@x.io", "", "none", "", "2026-05-01T02:30:00Z", "", ""},
+			},
+		},
+		{name: "unknown custom field filter", filters: map[string]string{"cf.seat": "1"}, wantCode: errorz.CodeBadRequest},
+		{name: "mistyped custom field filter", filters: map[string]string{"cf.table": "x"}, wantCode: errorz.CodeBadRequest},
+		{name: "event not found", lookup: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "event lookup failure", lookup: errors.New("boom"), wantCode: errorz.CodeInternal},
+		{name: "stream failure", streamErr: errors.New("boom"), wantSrc: errorz.CodeInternal},
+		{name: "emit failure is returned unchanged", emitErr: emitFailure, wantErr: emitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockguests.NewMockExportStore(ctrl)
+			fields := mockguests.NewMockFieldStore(ctrl)
+			params := &query.ListParams{Filters: tt.filters}
+			fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(schema, tt.lookup)
+			store.EXPECT().StreamGuests(gomock.Any(), eventID, params, schema, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, _ *query.ListParams, _ guests.Schema, fn func(*guests.GuestExportRow) error) error {
+					if tt.streamErr != nil {
+						return tt.streamErr
+					}
+					for _, g := range stored {
+						if err := fn(g); err != nil {
+							return err
+						}
+					}
+					return nil
+				}).MaxTimes(1)
+
+			svc := guests.NewExportService(logger.NewNoOp(), store, fields)
+			header, src, err := svc.Export(context.Background(), eventID, params)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				return
+			}
+			if tt.wantHeader != nil && !slices.Equal(header, tt.wantHeader) {
+				t.Errorf("header = %v, want %v", header, tt.wantHeader)
+			}
+
+			var got [][]string
+			err = src(context.Background(), func(row []string) error {
+				if tt.emitErr != nil {
+					return tt.emitErr
+				}
+				got = append(got, row)
+				return nil
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			assertErrorzCode(t, err, tt.wantSrc)
+			if !slices.EqualFunc(got, tt.wantRows, slices.Equal) {
+				t.Errorf("rows = %v, want %v", got, tt.wantRows)
+			}
+		})
+	}
+}
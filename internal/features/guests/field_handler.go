@@ -0,0 +1,251 @@
+package guests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/etag"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// FieldHandler exposes HTTP handlers for custom guest field definitions.
+type FieldHandler struct {
+	service   FieldService
+	validator validation.Validator
+}
+
+// NewFieldHandler returns a FieldHandler that uses the given service and validator.
+func NewFieldHandler(service FieldService, validator validation.Validator) *FieldHandler {
+	return &FieldHandler{service: service, validator: validator}
+}
+
+// ListTenant handles GET /tenants/{tenantId}/guest-fields.
+//
+// ListTenant godoc
+//
+//	@Summary		List tenant guest fields
+//	@Description	Returns the custom guest fields every event of the tenant inherits, ordered by position then key.
+//	@Tags			guest-fields
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Success		200			{array}		guests.FieldDefinition
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/guest-fields [get]
+func (h *FieldHandler) ListTenant(r *http.Request) (any, error) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "tenantId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid tenant id")
+	}
+	fields, err := h.service.TenantFields(r.Context(), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(fields), nil
+}
+
+// CreateTenant handles POST /tenants/{tenantId}/guest-fields.
+//
+// CreateTenant godoc
+//
+//	@Summary		Create tenant guest field
+//	@Description	Adds a custom guest field to every event of the tenant. Type is text, number, boolean, date or select; select fields need options, text fields may set a pattern (RE2). Key and type cannot change later.
+//	@Tags			guest-fields
+//	@Accept			json
+//	@Produce		json
+//	@Param			tenantId	path		string					true	"Tenant UUID"
+//	@Param			body		body		guests.CreateFieldInput	true	"Field definition"
+//	@Success		201			{object}	guests.FieldDefinition
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id, body or definition"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		409			{object}	problem.Problem	"A tenant field with this key already exists"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/guest-fields [post]
+func (h *FieldHandler) CreateTenant(r *http.Request) (any, error) {
+	tenantID, err := uuid.Parse(chi.URLParam(r, "tenantId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid tenant id")
+	}
+	body, err := h.decodeCreate(r)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := h.service.CreateForTenant(r.Context(), tenantID, body)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.Created(entity), nil
+}
+
+// ListEvent handles GET /events/{eventId}/guest-fields.
+//
+// ListEvent godoc
+//
+//	@Summary		List event guest fields
+//	@Description	Returns the event's effective custom guest fields: the tenant's fields overlaid by the event's own (event_id set), ordered by position then key.
+//	@Tags			guest-fields
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{array}		guests.FieldDefinition
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-fields [get]
+func (h *FieldHandler) ListEvent(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	schema, err := h.service.EventSchema(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(schema), nil
+}
+
+// CreateEvent handles POST /events/{eventId}/guest-fields.
+//
+// CreateEvent godoc
+//
+//	@Summary		Create event guest field
+//	@Description	Adds a custom guest field to one event. A tenant field with the same key is overridden for this event.
+//	@Tags			guest-fields
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			body	body		guests.CreateFieldInput	true	"Field definition"
+//	@Success		201		{object}	guests.FieldDefinition
+//	@Failure		400		{object}	problem.Problem	"Invalid event id, body or definition"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		409		{object}	problem.Problem	"An event field with this key already exists"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-fields [post]
+func (h *FieldHandler) CreateEvent(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	body, err := h.decodeCreate(r)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := h.service.CreateForEvent(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.Created(entity), nil
+}
+
+// GetByID handles GET /guest-fields/{fieldId}.
+//
+// GetByID godoc
+//
+//	@Summary		Get guest field
+//	@Description	Returns a custom guest field definition by UUID.
+//	@Tags			guest-fields
+//	@Produce		json
+//	@Param			fieldId			path		string	true	"Guest field UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; 304 when unchanged"
+//	@Success		200				{object}	guests.FieldDefinition
+//	@Header			200				{string}	ETag	"Version token for If-Match / If-None-Match"
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	problem.Problem	"Invalid field id"
+//	@Failure		404				{object}	problem.Problem	"Guest field not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/guest-fields/{fieldId} [get]
+func (h *FieldHandler) GetByID(r *http.Request) (any, error) {
+	id, err := uuid.Parse(chi.URLParam(r, "fieldId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid guest field id")
+	}
+	entity, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.OK(entity), nil
+}
+
+// Update handles PUT /guest-fields/{fieldId}.
+//
+// Update godoc
+//
+//	@Summary		Update guest field
+//	@Description	Updates a custom guest field's label, required flag, options, pattern or position (partial update). Existing guest values are rechecked on each guest's next write. Send the ETag from the last read as If-Match to reject concurrent edits with 412.
+//	@Tags			guest-fields
+//	@Accept			json
+//	@Produce		json
+//	@Param			fieldId		path		string					true	"Guest field UUID"
+//	@Param			If-Match	header		string					false	"ETag from the last read; 412 when the field changed since"
+//	@Param			body		body		guests.UpdateFieldInput	true	"Fields to update"
+//	@Success		200			{object}	guests.FieldDefinition
+//	@Header			200			{string}	ETag	"New version token"
+//	@Failure		400			{object}	problem.Problem	"Invalid field id, body or definition"
+//	@Failure		404			{object}	problem.Problem	"Guest field not found"
+//	@Failure		412			{object}	problem.Problem	"If-Match does not match the current version"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/guest-fields/{fieldId} [put]
+func (h *FieldHandler) Update(r *http.Request) (any, error) {
+	id, err := uuid.Parse(chi.URLParam(r, "fieldId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid guest field id")
+	}
+	var body UpdateFieldInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	entity, err := h.service.Update(r.Context(), id, body, etag.IfMatch(r))
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.OK(entity), nil
+}
+
+// Delete handles DELETE /guest-fields/{fieldId}.
+//
+// Delete godoc
+//
+//	@Summary		Delete guest field
+//	@Description	Soft-deletes a custom guest field. Stored guest values are kept but no longer validated or exported.
+//	@Tags			guest-fields
+//	@Produce		json
+//	@Param			fieldId	path		string	true	"Guest field UUID"
+//	@Success		204		"No content"
+//	@Failure		400		{object}	problem.Problem	"Invalid field id"
+//	@Failure		404		{object}	problem.Problem	"Guest field not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/guest-fields/{fieldId} [delete]
+func (h *FieldHandler) Delete(r *http.Request) (any, error) {
+	id, err := uuid.Parse(chi.URLParam(r, "fieldId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid guest field id")
+	}
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// decodeCreate decodes and validates a CreateFieldInput body.
+func (h *FieldHandler) decodeCreate(r *http.Request) (CreateFieldInput, error) {
+	var body CreateFieldInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return body, err
+	}
+	return body, nil
+}
@@ -0,0 +1,115 @@
+package guests
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// FieldTypeText is a free-text custom field, optionally constrained by a pattern.
+	FieldTypeText = "text"
+	// FieldTypeNumber is a numeric custom field, stored as a JSON number.
+	FieldTypeNumber = "number"
+	// FieldTypeBoolean is a true/false custom field.
+	FieldTypeBoolean = "boolean"
+	// FieldTypeDate is a calendar date custom field, stored as "YYYY-MM-DD".
+	FieldTypeDate = "date"
+	// FieldTypeSelect is a custom field whose value must be one of its options.
+	FieldTypeSelect = "select"
+)
+
+// FieldDefinition represents a row in the guest_field_definitions table: one
+// custom guest field. A tenant-level field (EventID nil) applies to every
+// event of the tenant; an event-level field applies to one event and
+// overrides a tenant-level field with the same key. GuestEditable fields may
+// also be set by the guest through the public RSVP portal.
+//
+// swagger:model FieldDefinition
+type FieldDefinition struct {
+	ID            uuid.UUID    `json:"id" db:"id"`
+	TenantID      uuid.UUID    `json:"tenant_id" db:"tenant_id"`
+	EventID       *uuid.UUID   `json:"event_id,omitempty" db:"event_id"`
+	Key           string       `json:"key" db:"key"`
+	Label         string       `json:"label" db:"label"`
+	Type          string       `json:"type" db:"type"`
+	Required      bool         `json:"required" db:"required"`
+	Options       FieldOptions `json:"options,omitempty" db:"options"`
+	Pattern       *string      `json:"pattern,omitempty" db:"pattern"`
+	GuestEditable bool         `json:"guest_editable" db:"guest_editable"`
+	Position      int          `json:"position" db:"position"`
+	CreatedAt     time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at" db:"updated_at"`
+	DeletedAt     *time.Time   `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+// TableName returns the database table name.
+func (FieldDefinition) TableName() string {
+	return "guest_field_definitions"
+}
+
+// FieldOptions are a select field's allowed values, stored as a JSONB array
+// in guest_field_definitions.options.
+type FieldOptions []string
+
+// Value implements driver.Valuer.
+func (o FieldOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+func (o *FieldOptions) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, o)
+	case string:
+		return json.Unmarshal([]byte(v), o)
+	default:
+		return errors.New("guests: unsupported options type")
+	}
+}
+
+// CustomFields are a guest's custom field values keyed by field key, stored
+// as a JSONB object in guests.custom_fields. Values are typed as JSON: string
+// (text, date, select), float64 (number) or bool (boolean).
+type CustomFields map[string]any
+
+// Value implements driver.Valuer.
+func (c CustomFields) Value() (driver.Value, error) {
+	if c == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+func (c *CustomFields) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return errors.New("guests: unsupported custom_fields type")
+	}
+}
@@ -0,0 +1,136 @@
+package guests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_field_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests FieldStore
+
+const guestFieldDefinitionsTable = "guest_field_definitions"
+
+// guestFieldDefinitionColumns are the columns selected on reads (GetByID, List).
+var guestFieldDefinitionColumns = []string{
+	"id", "tenant_id", "event_id", "key", "label", "type", "required", "options", "pattern",
+	"guest_editable", "position", "created_at", "updated_at", "deleted_at",
+}
+
+// NewFieldRepository returns a soft-delete-aware repository for custom guest
+// field definitions. Definitions are read through FieldStore's merged schema
+// query on every guest write, so the repository itself is not cached.
+func NewFieldRepository(log logger.Logger, db *sqlkit.DB) corerepository.Repository[FieldDefinition, uuid.UUID] {
+	return corerepository.NewRepository[FieldDefinition, uuid.UUID](
+		log, db, guestFieldDefinitionsTable, guestFieldDefinitionColumns, corerepository.CacheOptions{},
+	)
+}
+
+// FieldStore holds the hand-written queries over custom field definitions:
+// owner lookups and the tenant/event merge that yields an event's Schema.
+type FieldStore interface {
+	// TenantExists returns repository.ErrNotFound unless the tenant is live.
+	TenantExists(ctx context.Context, tenantID uuid.UUID) error
+	// EventTenant returns the tenant owning a live event, or repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// TenantFields returns the tenant-level fields, ordered by position then key.
+	TenantFields(ctx context.Context, tenantID uuid.UUID) ([]FieldDefinition, error)
+	// EventSchema returns the event's effective schema: its tenant's fields
+	// overlaid by its own, an event field replacing a tenant field with the
+	// same key. It returns repository.ErrNotFound unless the event is live.
+	EventSchema(ctx context.Context, eventID uuid.UUID) (Schema, error)
+}
+
+// fieldStore implements FieldStore on PostgreSQL.
+type fieldStore struct {
+	db *sqlkit.DB
+}
+
+// NewFieldStore returns a FieldStore backed by db.
+func NewFieldStore(db *sqlkit.DB) FieldStore {
+	return &fieldStore{db: db}
+}
+
+// fieldSelect selects guestFieldDefinitionColumns in the order query scans them.
+const fieldSelect = `SELECT f.id, f.tenant_id, f.event_id, f.key, f.label, f.type, f.required, f.options,
+	f.pattern, f.guest_editable, f.position, f.created_at, f.updated_at, f.deleted_at
+	FROM guest_field_definitions f`
+
+// TenantExists implements FieldStore.
+func (s *fieldStore) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT 1 FROM tenants WHERE id = $1 AND deleted_at IS NULL", tenantID,
+	).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// EventTenant implements FieldStore.
+func (s *fieldStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// TenantFields implements FieldStore.
+func (s *fieldStore) TenantFields(ctx context.Context, tenantID uuid.UUID) ([]FieldDefinition, error) {
+	return s.query(ctx, fieldSelect+`
+		WHERE f.tenant_id = $1 AND f.event_id IS NULL AND f.deleted_at IS NULL
+		ORDER BY f.position, f.key`, tenantID)
+}
+
+// EventSchema implements FieldStore.
+func (s *fieldStore) EventSchema(ctx context.Context, eventID uuid.UUID) (Schema, error) {
+	tenantID, err := s.EventTenant(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	// DISTINCT ON keeps the first row per key; event rows sort before tenant rows.
+	fields, err := s.query(ctx, `SELECT * FROM (
+		SELECT DISTINCT ON (f.key) f.id, f.tenant_id, f.event_id, f.key, f.label, f.type, f.required,
+			f.options, f.pattern, f.guest_editable, f.position, f.created_at, f.updated_at, f.deleted_at
+		FROM guest_field_definitions f
+		WHERE f.tenant_id = $1 AND (f.event_id IS NULL OR f.event_id = $2) AND f.deleted_at IS NULL
+		ORDER BY f.key, f.event_id NULLS LAST
+	) merged ORDER BY position, key`, tenantID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return Schema(fields), nil
+}
+
+// query runs a definitions query selecting guestFieldDefinitionColumns.
+func (s *fieldStore) query(ctx context.Context, q string, args ...any) ([]FieldDefinition, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var fields []FieldDefinition
+	for rows.Next() {
+		var f FieldDefinition
+		if err := rows.Scan(
+			&f.ID, &f.TenantID, &f.EventID, &f.Key, &f.Label, &f.Type, &f.Required, &f.Options,
+			&f.Pattern, &f.GuestEditable, &f.Position, &f.CreatedAt, &f.UpdatedAt, &f.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, rows.Err()
+}
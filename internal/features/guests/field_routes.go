@@ -0,0 +1,21 @@
+package guests
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitFieldRoutes registers custom guest field routes on the given router:
+// tenant-level and event-level collections, and per-field routes by id.
+func InitFieldRoutes(r *chi.Mux, fieldH *FieldHandler) {
+	r.Get("/api/v1/tenants/{tenantId}/guest-fields", problem.Handle(fieldH.ListTenant))
+	r.Post("/api/v1/tenants/{tenantId}/guest-fields", problem.Handle(fieldH.CreateTenant))
+	r.Get("/api/v1/events/{eventId}/guest-fields", problem.Handle(fieldH.ListEvent))
+	r.Post("/api/v1/events/{eventId}/guest-fields", problem.Handle(fieldH.CreateEvent))
+	r.Route("/api/v1/guest-fields", func(r chi.Router) {
+		r.Get("/{fieldId}", problem.Handle(fieldH.GetByID))
+		r.Put("/{fieldId}", problem.Handle(fieldH.Update))
+		r.Delete("/{fieldId}", problem.Handle(fieldH.Delete))
+	})
+}
@@ -0,0 +1,335 @@
+package guests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+)
+
+const (
+	// customFieldFilterPrefix marks a list/export filter on a custom field:
+	// cf.<key>=<value>.
+	customFieldFilterPrefix = "cf."
+	// maxTextFieldLength caps a text custom field value, in characters.
+	maxTextFieldLength = 1000
+	// dateFieldLayout is the storage and input format of date custom fields.
+	dateFieldLayout = "2006-01-02"
+)
+
+// fieldKeyPattern is what a custom field key must look like: it doubles as a
+// JSON key, a spreadsheet header and a query parameter suffix.
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// reservedFieldKeys are guest columns and export headers a custom field key
+// may not shadow.
+var reservedFieldKeys = map[string]bool{
+	"id": true, "event_id": true, "name": true, "email": true, "phone": true,
+	"rsvp_status": true, "ticket_id": true, "ticket_status": true,
+	"created_at": true, "updated_at": true, "deleted_at": true,
+}
+
+// Schema is the effective set of custom fields of an event: its tenant's
+// fields overlaid with its own, ordered by position then key.
+type Schema []FieldDefinition
+
+// fieldErrors lists the problems found in a set of custom field values, one
+// entry per field, in key order.
+type fieldErrors []string
+
+// Error implements error.
+func (e fieldErrors) Error() string {
+	return strings.Join(e, "; ")
+}
+
+// find returns the field with the given key, or nil.
+func (s Schema) find(key string) *FieldDefinition {
+	for i := range s {
+		if s[i].Key == key {
+			return &s[i]
+		}
+	}
+	return nil
+}
+
+// Check validates values against s: every key must be a field of s, every
+// value must fit its field and every required field must be present. A nil
+// value counts as absent. It returns the values normalised for storage.
+func (s Schema) Check(values CustomFields) (CustomFields, error) {
+	var problems fieldErrors
+	out := make(CustomFields, len(values))
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		v := values[k]
+		if v == nil {
+			continue
+		}
+		f := s.find(k)
+		if f == nil {
+			problems = append(problems, fmt.Sprintf("%s: unknown custom field", k))
+			continue
+		}
+		norm, err := f.coerce(v)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", k, err))
+			continue
+		}
+		out[k] = norm
+	}
+	for _, f := range s {
+		if _, ok := out[f.Key]; !ok && f.Required && values[f.Key] == nil {
+			problems = append(problems, fmt.Sprintf("%s: is required", f.Key))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+	return out, nil
+}
+
+// Parse converts textual values (spreadsheet cells) keyed by field key to
+// typed values and checks them like Check. Blank cells count as absent.
+func (s Schema) Parse(cells map[string]string) (CustomFields, error) {
+	var problems fieldErrors
+	values := make(CustomFields, len(cells))
+	for k, raw := range cells {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		f := s.find(k)
+		if f == nil {
+			problems = append(problems, fmt.Sprintf("%s: unknown custom field", k))
+			continue
+		}
+		v, err := f.parse(raw)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", k, err))
+			continue
+		}
+		values[k] = v
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return nil, problems
+	}
+	return s.Check(values)
+}
+
+// CheckFilters validates the cf.<key> filters among filters: the key must be
+// a field of s and the value must parse as that field's type.
+func (s Schema) CheckFilters(filters map[string]string) error {
+	var problems fieldErrors
+	for name, raw := range filters {
+		key, ok := strings.CutPrefix(name, customFieldFilterPrefix)
+		if !ok {
+			continue
+		}
+		f := s.find(key)
+		if f == nil {
+			problems = append(problems, fmt.Sprintf("%s: unknown custom field", name))
+			continue
+		}
+		if _, err := f.parse(raw); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return problems
+	}
+	return nil
+}
+
+// filterSQL translates the cf.<key> filters among filters into JSONB
+// containment conditions on expr (e.g. "g.custom_fields"), served by the GIN
+// index. Placeholders start at $firstArg. Filters CheckFilters would reject
+// are skipped.
+func (s Schema) filterSQL(filters map[string]string, expr string, firstArg int) query.SQLClauses {
+	var c query.SQLClauses
+	names := make([]string, 0, len(filters))
+	for name := range filters {
+		if strings.HasPrefix(name, customFieldFilterPrefix) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		f := s.find(strings.TrimPrefix(name, customFieldFilterPrefix))
+		if f == nil {
+			continue
+		}
+		v, err := f.parse(filters[name])
+		if err != nil {
+			continue
+		}
+		doc, err := json.Marshal(map[string]any{f.Key: v})
+		if err != nil {
+			continue
+		}
+		c.Args = append(c.Args, string(doc))
+		c.Where = append(c.Where, fmt.Sprintf("%s @> $%d::jsonb", expr, firstArg+len(c.Args)-1))
+	}
+	return c
+}
+
+// coerce checks a JSON-decoded value against f and returns its stored form.
+func (f *FieldDefinition) coerce(v any) (any, error) {
+	switch f.Type {
+	case FieldTypeText:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("must be a string")
+		}
+		if utf8.RuneCountInString(s) > maxTextFieldLength {
+			return nil, fmt.Errorf("must be at most %d characters", maxTextFieldLength)
+		}
+		if f.Pattern != nil && *f.Pattern != "" {
+			re, err := regexp.Compile(*f.Pattern)
+			if err != nil {
+				return nil, errors.New("field has an invalid pattern")
+			}
+			if !re.MatchString(s) {
+				return nil, fmt.Errorf("must match %s", *f.Pattern)
+			}
+		}
+		return s, nil
+	case FieldTypeNumber:
+		var n float64
+		switch x := v.(type) {
+		case float64:
+			n = x
+		case int:
+			n = float64(x)
+		case int64:
+			n = float64(x)
+		case json.Number:
+			parsed, err := x.Float64()
+			if err != nil {
+				return nil, errors.New("must be a number")
+			}
+			n = parsed
+		default:
+			return nil, errors.New("must be a number")
+		}
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, errors.New("must be a finite number")
+		}
+		return n, nil
+	case FieldTypeBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, errors.New("must be true or false")
+		}
+		return b, nil
+	case FieldTypeDate:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("must be a date (YYYY-MM-DD)")
+		}
+		if _, err := time.Parse(dateFieldLayout, s); err != nil {
+			return nil, errors.New("must be a date (YYYY-MM-DD)")
+		}
+		return s, nil
+	case FieldTypeSelect:
+		s, ok := v.(string)
+		if !ok || !slices.Contains(f.Options, s) {
+			return nil, fmt.Errorf("must be one of %s", strings.Join(f.Options, ", "))
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("field has unknown type %q", f.Type)
+	}
+}
+
+// parse converts a textual value to f's type and checks it.
+func (f *FieldDefinition) parse(raw string) (any, error) {
+	switch f.Type {
+	case FieldTypeNumber:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, errors.New("must be a number")
+		}
+		return f.coerce(n)
+	case FieldTypeBoolean:
+		// Spreadsheets commonly say yes/no; accept those besides strconv's forms.
+		switch strings.ToLower(raw) {
+		case "yes", "y":
+			return true, nil
+		case "no", "n":
+			return false, nil
+		}
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.New("must be true or false")
+		}
+		return b, nil
+	default:
+		return f.coerce(raw)
+	}
+}
+
+// formatFieldValue renders a stored custom field value as text for exports;
+// "" when absent.
+func formatFieldValue(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	default:
+		b, _ := json.Marshal(x)
+		return string(b)
+	}
+}
+
+// validateDefinition checks a field definition's own shape: key syntax,
+// options only (and always) on select fields, a compilable pattern only on
+// text fields.
+func validateDefinition(f *FieldDefinition) error {
+	if !fieldKeyPattern.MatchString(f.Key) {
+		return errors.New("key must start with a lowercase letter and contain only lowercase letters, digits and underscores (max 63)")
+	}
+	if reservedFieldKeys[f.Key] {
+		return fmt.Errorf("key %q is reserved for a built-in guest field", f.Key)
+	}
+	if f.Type == FieldTypeSelect {
+		if len(f.Options) == 0 {
+			return errors.New("select fields need at least one option")
+		}
+		seen := make(map[string]bool, len(f.Options))
+		for _, o := range f.Options {
+			if seen[o] {
+				return fmt.Errorf("option %q is listed twice", o)
+			}
+			seen[o] = true
+		}
+	} else if len(f.Options) > 0 {
+		return errors.New("options are only allowed on select fields")
+	}
+	if f.Pattern != nil && *f.Pattern != "" {
+		if f.Type != FieldTypeText {
+			return errors.New("pattern is only allowed on text fields")
+		}
+		if _, err := regexp.Compile(*f.Pattern); err != nil {
+			return fmt.Errorf("pattern does not compile: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package guests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testSchema() Schema {
+	pattern := `^[A-Z]{2}\d+$`
+	return Schema{
+		{Key: "seat", Type: FieldTypeText, Pattern: &pattern},
+		{Key: "table", Type: FieldTypeNumber, Required: true},
+		{Key: "vip", Type: FieldTypeBoolean},
+		{Key: "arrival", Type: FieldTypeDate},
+		{Key: "meal", Type: FieldTypeSelect, Options: FieldOptions{"meat", "fish", "vegan"}},
+	}
+}
+
+func TestSchema_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  CustomFields
+		want    CustomFields
+		wantErr []string // substrings of the error, one per problem
+	}{
+		{
+			name:   "valid values of every type",
+			values: CustomFields{"seat": "AB12", "table": 4.0, "vip": true, "arrival": "2026-05-01", "meal": "fish"},
+			want:   CustomFields{"seat": "AB12", "table": 4.0, "vip": true, "arrival": "2026-05-01", "meal": "fish"},
+		},
+		{
+			name:   "null counts as absent",
+			values: CustomFields{"table": 1.0, "vip": nil},
+			want:   CustomFields{"table": 1.0},
+		},
+		{name: "missing required field", values: CustomFields{}, wantErr: []string{"table: is required"}},
+		{
+			name:    "required field set to null",
+			values:  CustomFields{"table": nil},
+			wantErr: []string{"table: is required"},
+		},
+		{
+			name:    "unknown key",
+			values:  CustomFields{"table": 1.0, "shoe_size": 42.0},
+			wantErr: []string{"shoe_size: unknown custom field"},
+		},
+		{
+			name: "every mistyped value is reported",
+			values: CustomFields{
+				"seat": "ab", "table": "four", "vip": "yes", "arrival": "01/05/2026", "meal": "pasta",
+			},
+			wantErr: []string{
+				"seat: must match", "table: must be a number", "vip: must be true or false",
+				"arrival: must be a date", "meal: must be one of meat, fish, vegan",
+			},
+		},
+		{
+			name:    "text too long",
+			values:  CustomFields{"table": 1.0, "seat": strings.Repeat("A", maxTextFieldLength+1)},
+			wantErr: []string{"seat: must be at most"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testSchema().Check(tt.values)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Check() error = %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Check() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Check() error = nil, want %v", tt.wantErr)
+			}
+			for _, w := range tt.wantErr {
+				if !strings.Contains(err.Error(), w) {
+					t.Errorf("error %q does not mention %q", err, w)
+				}
+			}
+		})
+	}
+}
+
+func TestSchema_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		cells   map[string]string
+		want    CustomFields
+		wantErr bool
+	}{
+		{
+			name:  "cells are typed",
+			cells: map[string]string{"table": " 12.5 ", "vip": "Yes", "meal": "vegan", "seat": ""},
+			want:  CustomFields{"table": 12.5, "vip": true, "meal": "vegan"},
+		},
+		{name: "boolean no", cells: map[string]string{"table": "1", "vip": "no"}, want: CustomFields{"table": 1.0, "vip": false}},
+		{name: "blank required cell", cells: map[string]string{"table": " "}, wantErr: true},
+		{name: "number does not parse", cells: map[string]string{"table": "12a"}, wantErr: true},
+		{name: "infinite number", cells: map[string]string{"table": "Inf"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testSchema().Parse(tt.cells)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchema_Filters(t *testing.T) {
+	schema := testSchema()
+	filters := map[string]string{"name": "Ann", "cf.vip": "true", "cf.table": "3"}
+	if err := schema.CheckFilters(filters); err != nil {
+		t.Fatalf("CheckFilters() error = %v", err)
+	}
+
+	got := schema.filterSQL(filters, "g.custom_fields", 4)
+	wantWhere := []string{"g.custom_fields @> $4::jsonb", "g.custom_fields @> $5::jsonb"}
+	wantArgs := []any{`{"table":3}`, `{"vip":true}`}
+	if !reflect.DeepEqual(got.Where, wantWhere) || !reflect.DeepEqual(got.Args, wantArgs) {
+		t.Errorf("filterSQL() = %v %v, want %v %v", got.Where, got.Args, wantWhere, wantArgs)
+	}
+
+	for _, bad := range []map[string]string{{"cf.shoe_size": "42"}, {"cf.meal": "pasta"}, {"cf.arrival": "soon"}} {
+		if err := schema.CheckFilters(bad); err == nil {
+			t.Errorf("CheckFilters(%v) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestValidateDefinition(t *testing.T) {
+	pattern := func(p string) *string { return &p }
+	tests := []struct {
+		name    string
+		def     FieldDefinition
+		wantErr bool
+	}{
+		{name: "text with pattern", def: FieldDefinition{Key: "seat_no", Type: FieldTypeText, Pattern: pattern(`^\d+$`)}},
+		{name: "select with options", def: FieldDefinition{Key: "meal", Type: FieldTypeSelect, Options: FieldOptions{"a", "b"}}},
+		{name: "key with uppercase", def: FieldDefinition{Key: "Seat", Type: FieldTypeText}, wantErr: true},
+		{name: "key starting with a digit", def: FieldDefinition{Key: "1st", Type: FieldTypeText}, wantErr: true},
+		{name: "reserved key", def: FieldDefinition{Key: "email", Type: FieldTypeText}, wantErr: true},
+		{name: "select without options", def: FieldDefinition{Key: "meal", Type: FieldTypeSelect}, wantErr: true},
+		{name: "duplicate option", def: FieldDefinition{Key: "meal", Type: FieldTypeSelect, Options: FieldOptions{"a", "a"}}, wantErr: true},
+		{name: "options on a number", def: FieldDefinition{Key: "n", Type: FieldTypeNumber, Options: FieldOptions{"1"}}, wantErr: true},
+		{name: "pattern on a date", def: FieldDefinition{Key: "d", Type: FieldTypeDate, Pattern: pattern(`.`)}, wantErr: true},
+		{name: "pattern does not compile", def: FieldDefinition{Key: "s", Type: FieldTypeText, Pattern: pattern(`(`)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDefinition(&tt.def)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateDefinition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
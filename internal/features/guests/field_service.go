@@ -0,0 +1,258 @@
+package guests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_field_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests FieldService
+
+// FieldService manages custom guest field definitions.
+type FieldService interface {
+	// CreateForTenant adds a field every event of the tenant inherits.
+	CreateForTenant(ctx context.Context, tenantID uuid.UUID, in CreateFieldInput) (*FieldDefinition, error)
+	// CreateForEvent adds a field to one event, overriding a tenant field with the same key.
+	CreateForEvent(ctx context.Context, eventID uuid.UUID, in CreateFieldInput) (*FieldDefinition, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*FieldDefinition, error)
+	Update(ctx context.Context, id uuid.UUID, in UpdateFieldInput, ifMatch string) (*FieldDefinition, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// TenantFields lists the tenant-level fields.
+	TenantFields(ctx context.Context, tenantID uuid.UUID) ([]FieldDefinition, error)
+	// EventSchema lists the fields that apply to the event's guests.
+	EventSchema(ctx context.Context, eventID uuid.UUID) (Schema, error)
+}
+
+// CreateFieldInput is the input for creating a custom guest field. Key and
+// Type are fixed once created: stored values are keyed and typed by them.
+//
+// swagger:model CreateFieldInput
+type CreateFieldInput struct {
+	Key      string   `json:"key"                validate:"required,max=63"`
+	Label    string   `json:"label"              validate:"required,max=255"`
+	Type     string   `json:"type"               validate:"required,oneof=text number boolean date select"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"  validate:"omitempty,max=100,dive,required,max=255"`
+	Pattern  *string  `json:"pattern,omitempty"  validate:"omitempty,max=500"`
+	Position int      `json:"position"           validate:"min=0"`
+	// GuestEditable lets guests set the field through the public RSVP portal.
+	GuestEditable bool `json:"guest_editable"`
+}
+
+// UpdateFieldInput is the input for updating a custom guest field. Only
+// non-nil fields are applied; an empty Pattern clears it.
+//
+// swagger:model UpdateFieldInput
+type UpdateFieldInput struct {
+	Label    *string  `json:"label,omitempty"    validate:"omitempty,min=1,max=255"`
+	Required *bool    `json:"required,omitempty"`
+	Options  []string `json:"options,omitempty"  validate:"omitempty,max=100,dive,required,max=255"`
+	Pattern  *string  `json:"pattern,omitempty"  validate:"omitempty,max=500"`
+	Position *int     `json:"position,omitempty" validate:"omitempty,min=0"`
+	// GuestEditable lets guests set the field through the public RSVP portal.
+	GuestEditable *bool `json:"guest_editable,omitempty"`
+}
+
+// fieldServiceImpl is the concrete implementation of FieldService.
+type fieldServiceImpl struct {
+	logger logger.Logger
+	repo   corerepository.Repository[FieldDefinition, uuid.UUID]
+	store  FieldStore
+}
+
+// NewFieldService returns a FieldService with the given dependencies.
+func NewFieldService(
+	logger logger.Logger,
+	repo corerepository.Repository[FieldDefinition, uuid.UUID],
+	store FieldStore,
+) FieldService {
+	return &fieldServiceImpl{logger: logger, repo: repo, store: store}
+}
+
+// CreateForTenant implements FieldService.
+func (s *fieldServiceImpl) CreateForTenant(
+	ctx context.Context, tenantID uuid.UUID, in CreateFieldInput,
+) (*FieldDefinition, error) {
+	if err := s.store.TenantExists(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.TenantNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest field tenant lookup failed", logger.F("tenant_id", tenantID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to create guest field")
+	}
+	return s.create(ctx, tenantID, nil, in)
+}
+
+// CreateForEvent implements FieldService.
+func (s *fieldServiceImpl) CreateForEvent(
+	ctx context.Context, eventID uuid.UUID, in CreateFieldInput,
+) (*FieldDefinition, error) {
+	tenantID, err := s.store.EventTenant(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest field event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to create guest field")
+	}
+	return s.create(ctx, tenantID, &eventID, in)
+}
+
+// create validates and stores a new definition owned by tenantID and, when
+// set, eventID.
+func (s *fieldServiceImpl) create(
+	ctx context.Context, tenantID uuid.UUID, eventID *uuid.UUID, in CreateFieldInput,
+) (*FieldDefinition, error) {
+	entity := &FieldDefinition{
+		ID:            uuid.New(),
+		TenantID:      tenantID,
+		EventID:       eventID,
+		Key:           in.Key,
+		Label:         in.Label,
+		Type:          in.Type,
+		Required:      in.Required,
+		Options:       in.Options,
+		Pattern:       in.Pattern,
+		Position:      in.Position,
+		GuestEditable: in.GuestEditable,
+	}
+	if err := validateDefinition(entity); err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+
+	if err := s.repo.Create(ctx, entity); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, errcode.GuestFieldKeyTaken.New()
+		}
+		if errors.Is(err, repository.ErrInvalidEntity) {
+			return nil, errcode.UnprocessableEntity.WithMessage("invalid guest field data")
+		}
+		s.logger.ErrorWithContext(ctx, "guest field create failed", logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to create guest field")
+	}
+
+	s.logger.InfoWithContext(ctx, "guest field created", logger.F("id", entity.ID), logger.F("key", entity.Key))
+	return entity, nil
+}
+
+// GetByID implements FieldService.
+func (s *fieldServiceImpl) GetByID(ctx context.Context, id uuid.UUID) (*FieldDefinition, error) {
+	entity, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.GuestFieldNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest field get failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get guest field")
+	}
+	return entity, nil
+}
+
+// Update implements FieldService. Existing guest values are not rechecked:
+// a tightened field (now required, fewer options, a stricter pattern) applies
+// from each guest's next write. A non-empty ifMatch (the request's If-Match)
+// makes the write conditional on the field's version.
+func (s *fieldServiceImpl) Update(
+	ctx context.Context, id uuid.UUID, in UpdateFieldInput, ifMatch string,
+) (*FieldDefinition, error) {
+	entity, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Label != nil {
+		entity.Label = *in.Label
+	}
+	if in.Required != nil {
+		entity.Required = *in.Required
+	}
+	if in.Options != nil {
+		entity.Options = in.Options
+	}
+	if in.Pattern != nil {
+		entity.Pattern = in.Pattern
+		if *in.Pattern == "" {
+			entity.Pattern = nil
+		}
+	}
+	if in.Position != nil {
+		entity.Position = *in.Position
+	}
+	if in.GuestEditable != nil {
+		entity.GuestEditable = *in.GuestEditable
+	}
+	if err := validateDefinition(entity); err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+
+	if err := s.repo.UpdateIfMatch(ctx, id, entity, ifMatch); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.GuestFieldNotFound.New()
+		}
+		if errors.Is(err, corerepository.ErrVersionMismatch) {
+			return nil, errcode.VersionMismatch.WithMessage("guest field was modified by someone else")
+		}
+		s.logger.ErrorWithContext(ctx, "guest field update failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update guest field")
+	}
+
+	s.logger.InfoWithContext(ctx, "guest field updated", logger.F("id", id))
+	return entity, nil
+}
+
+// Delete implements FieldService. Guests keep their stored values; they are
+// no longer exported and are dropped on the guest's next write.
+func (s *fieldServiceImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.GuestFieldNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest field delete failed", logger.F("id", id), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to delete guest field")
+	}
+	s.logger.InfoWithContext(ctx, "guest field deleted", logger.F("id", id))
+	return nil
+}
+
+// TenantFields implements FieldService.
+func (s *fieldServiceImpl) TenantFields(ctx context.Context, tenantID uuid.UUID) ([]FieldDefinition, error) {
+	if err := s.store.TenantExists(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.TenantNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest field tenant lookup failed", logger.F("tenant_id", tenantID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to list guest fields")
+	}
+	fields, err := s.store.TenantFields(ctx, tenantID)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "guest field list failed", logger.F("tenant_id", tenantID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to list guest fields")
+	}
+	return fields, nil
+}
+
+// EventSchema implements FieldService.
+func (s *fieldServiceImpl) EventSchema(ctx context.Context, eventID uuid.UUID) (Schema, error) {
+	return loadSchema(ctx, s.logger, s.store, eventID)
+}
+
+// loadSchema returns the event's effective schema, mapping a missing event
+// to 404. Every guest read or write path that needs the schema goes through it.
+func loadSchema(ctx context.Context, log logger.Logger, store FieldStore, eventID uuid.UUID) (Schema, error) {
+	schema, err := store.EventSchema(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventNotFound.New()
+		}
+		log.ErrorWithContext(ctx, "guest field schema load failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to load guest fields")
+	}
+	return schema, nil
+}
@@ -0,0 +1,156 @@
+package guests_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	mockcorerepository "github.com/biairmal/guest-management-be/mocks/core/repository"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+)
+
+func TestFieldService_CreateForEvent(t *testing.T) {
+	eventID, tenantID := uuid.New(), uuid.New()
+	valid := guests.CreateFieldInput{Key: "meal", Label: "Meal", Type: guests.FieldTypeSelect, Options: []string{"meat", "fish"}}
+
+	tests := []struct {
+		name      string
+		in        guests.CreateFieldInput
+		lookupErr error
+		createErr error
+		wantCode  string
+	}{
+		{name: "created", in: valid},
+		{name: "event not found", in: valid, lookupErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "event lookup failure", in: valid, lookupErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+		{
+			name:     "invalid definition",
+			in:       guests.CreateFieldInput{Key: "meal", Label: "Meal", Type: guests.FieldTypeSelect},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{name: "duplicate key", in: valid, createErr: repository.ErrAlreadyExists, wantCode: errorz.CodeConflict},
+		{name: "create failure", in: valid, createErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.FieldDefinition, uuid.UUID](ctrl)
+			store := mockguests.NewMockFieldStore(ctrl)
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, tt.lookupErr)
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, f *guests.FieldDefinition) error {
+					if f.TenantID != tenantID || f.EventID == nil || *f.EventID != eventID {
+						t.Errorf("owner = %v/%v, want %v/%v", f.TenantID, f.EventID, tenantID, eventID)
+					}
+					return tt.createErr
+				}).MaxTimes(1)
+
+			svc := guests.NewFieldService(logger.NewNoOp(), repo, store)
+			_, err := svc.CreateForEvent(context.Background(), eventID, tt.in)
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestFieldService_CreateForTenant(t *testing.T) {
+	tenantID := uuid.New()
+	in := guests.CreateFieldInput{Key: "vip", Label: "VIP", Type: guests.FieldTypeBoolean}
+
+	tests := []struct {
+		name      string
+		lookupErr error
+		wantCode  string
+	}{
+		{name: "created"},
+		{name: "tenant not found", lookupErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.FieldDefinition, uuid.UUID](ctrl)
+			store := mockguests.NewMockFieldStore(ctrl)
+			store.EXPECT().TenantExists(gomock.Any(), tenantID).Return(tt.lookupErr)
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, f *guests.FieldDefinition) error {
+					if f.TenantID != tenantID || f.EventID != nil {
+						t.Errorf("owner = %v/%v, want tenant-level %v", f.TenantID, f.EventID, tenantID)
+					}
+					return nil
+				}).MaxTimes(1)
+
+			svc := guests.NewFieldService(logger.NewNoOp(), repo, store)
+			_, err := svc.CreateForTenant(context.Background(), tenantID, in)
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestFieldService_Update(t *testing.T) {
+	id := uuid.New()
+	empty, label := "", "Seat"
+	tests := []struct {
+		name      string
+		in        guests.UpdateFieldInput
+		getErr    error
+		updateErr error
+		wantCode  string
+	}{
+		{name: "label updated and pattern cleared", in: guests.UpdateFieldInput{Label: &label, Pattern: &empty}},
+		{name: "not found", getErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{
+			name:     "options on a text field",
+			in:       guests.UpdateFieldInput{Options: []string{"a"}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{name: "version mismatch", updateErr: corerepository.ErrVersionMismatch, wantCode: errorz.CodePreconditionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.FieldDefinition, uuid.UUID](ctrl)
+			pattern := `^\d+$`
+			stored := &guests.FieldDefinition{ID: id, Key: "seat", Label: "Seat no", Type: guests.FieldTypeText, Pattern: &pattern}
+			repo.EXPECT().GetByID(gomock.Any(), id).Return(stored, tt.getErr)
+			repo.EXPECT().UpdateIfMatch(gomock.Any(), id, gomock.Any(), `"1"`).Return(tt.updateErr).MaxTimes(1)
+
+			svc := guests.NewFieldService(logger.NewNoOp(), repo, mockguests.NewMockFieldStore(ctrl))
+			got, err := svc.Update(context.Background(), id, tt.in, `"1"`)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && (got.Label != label || got.Pattern != nil) {
+				t.Errorf("updated = %q pattern %v, want %q and no pattern", got.Label, got.Pattern, label)
+			}
+		})
+	}
+}
+
+func TestFieldService_EventSchema(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name     string
+		storeErr error
+		wantCode string
+	}{
+		{name: "found"},
+		{name: "event not found", storeErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "store failure", storeErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockguests.NewMockFieldStore(ctrl)
+			store.EXPECT().EventSchema(gomock.Any(), eventID).Return(guests.Schema{}, tt.storeErr)
+
+			svc := guests.NewFieldService(logger.NewNoOp(), nil, store)
+			_, err := svc.EventSchema(context.Background(), eventID)
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
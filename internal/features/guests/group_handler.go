@@ -0,0 +1,389 @@
+package guests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/etag"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// groupListConfig is the guest group list's filter/sort allow-list.
+var groupListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"name", "created_at"},
+	AllowedFilterFields: []string{"name"},
+}
+
+// GroupHandler exposes HTTP handlers for an event's guest groups.
+type GroupHandler struct {
+	service   GroupService
+	validator validation.Validator
+}
+
+// NewGroupHandler returns a GroupHandler that uses the given service and validator.
+func NewGroupHandler(service GroupService, validator validation.Validator) *GroupHandler {
+	return &GroupHandler{service: service, validator: validator}
+}
+
+// List handles GET /events/{eventId}/guest-groups.
+//
+// List godoc
+//
+//	@Summary		List guest groups
+//	@Description	Returns a paginated list of the event's guest groups with member, plus-one and confirmed counts. Query: page, size, sort=field,dir (name, created_at), equality filter on name.
+//	@Tags			guest-groups
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			page	query		int		false	"Page number (1-based)"
+//	@Param			size	query		int		false	"Page size (default 20, max 100)"
+//	@Param			sort	query		string	false	"Sort spec field,DIRECTION (repeatable)"
+//	@Param			name	query		string	false	"Filter by name"
+//	@Success		200		{object}	dto.PageResponse[guests.GroupSummary]
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or query"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups [get]
+func (h *GroupHandler) List(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	params, err := query.ParseListParams(r.URL.Query(), groupListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	result, err := h.service.List(r.Context(), eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(result), nil
+}
+
+// Create handles POST /events/{eventId}/guest-groups.
+//
+// Create godoc
+//
+//	@Summary		Create guest group
+//	@Description	Groups guests of the event under a primary contact, who receives the group's invitation. The primary contact and members must be guests of the event that belong to no group yet.
+//	@Tags			guest-groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId			path		string					true	"Event UUID"
+//	@Param			Idempotency-Key	header		string					false	"Client-chosen key; retries with the same key and body replay the first response"
+//	@Param			body			body		guests.CreateGroupInput	true	"Group payload"
+//	@Success		201				{object}	guests.GroupDetail
+//	@Failure		400				{object}	problem.Problem	"Invalid event id or body, or a member is not a guest of the event"
+//	@Failure		404				{object}	problem.Problem	"Event not found"
+//	@Failure		409				{object}	problem.Problem	"A member already belongs to a group"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups [post]
+func (h *GroupHandler) Create(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	var body CreateGroupInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	group, err := h.service.Create(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), group.UpdatedAt)
+	return response.Created(group), nil
+}
+
+// GetByID handles GET /events/{eventId}/guest-groups/{groupId}.
+//
+// GetByID godoc
+//
+//	@Summary		Get guest group
+//	@Description	Returns a guest group with its members, primary contact first and plus-ones last.
+//	@Tags			guest-groups
+//	@Produce		json
+//	@Param			eventId			path		string	true	"Event UUID"
+//	@Param			groupId			path		string	true	"Guest group UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; 304 when unchanged"
+//	@Success		200				{object}	guests.GroupDetail
+//	@Header			200				{string}	ETag	"Version token for If-Match / If-None-Match"
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	problem.Problem	"Invalid event or group id"
+//	@Failure		404				{object}	problem.Problem	"Guest group not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId} [get]
+func (h *GroupHandler) GetByID(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	group, err := h.service.GetByID(r.Context(), eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), group.UpdatedAt)
+	return response.OK(group), nil
+}
+
+// Update handles PUT /events/{eventId}/guest-groups/{groupId}.
+//
+// Update godoc
+//
+//	@Summary		Update guest group
+//	@Description	Updates a guest group (partial update). The primary contact must be a member who is not a plus-one; the plus-one allowance can't drop below the plus-ones already named. Send the ETag from the last read as If-Match to reject concurrent edits with 412.
+//	@Tags			guest-groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string					true	"Event UUID"
+//	@Param			groupId		path		string					true	"Guest group UUID"
+//	@Param			If-Match	header		string					false	"ETag from the last read; 412 when the group changed since"
+//	@Param			body		body		guests.UpdateGroupInput	true	"Fields to update"
+//	@Success		200			{object}	guests.GroupDetail
+//	@Header			200			{string}	ETag	"New version token"
+//	@Failure		400			{object}	problem.Problem	"Invalid ids or body, or the primary contact is not an invited member"
+//	@Failure		404			{object}	problem.Problem	"Guest group not found"
+//	@Failure		409			{object}	problem.Problem	"More plus-ones are named than the new allowance"
+//	@Failure		412			{object}	problem.Problem	"If-Match does not match the current version"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId} [put]
+func (h *GroupHandler) Update(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body UpdateGroupInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	group, err := h.service.Update(r.Context(), eventID, id, body, etag.IfMatch(r))
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), group.UpdatedAt)
+	return response.OK(group), nil
+}
+
+// Delete handles DELETE /events/{eventId}/guest-groups/{groupId}.
+//
+// Delete godoc
+//
+//	@Summary		Delete guest group
+//	@Description	Disbands a guest group: invited members stay on the guest list ungrouped, plus-ones are deleted and their tickets invalidated.
+//	@Tags			guest-groups
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			groupId	path		string	true	"Guest group UUID"
+//	@Success		204		"No content"
+//	@Failure		400		{object}	problem.Problem	"Invalid event or group id"
+//	@Failure		404		{object}	problem.Problem	"Guest group not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId} [delete]
+func (h *GroupHandler) Delete(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Delete(r.Context(), eventID, id); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// AddMembers handles POST /events/{eventId}/guest-groups/{groupId}/members.
+//
+// AddMembers godoc
+//
+//	@Summary		Add guest group members
+//	@Description	Moves guests of the event that belong to no group into the group.
+//	@Tags			guest-groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string						true	"Event UUID"
+//	@Param			groupId	path		string						true	"Guest group UUID"
+//	@Param			body	body		guests.GroupMembersInput	true	"Guests to add"
+//	@Success		200		{object}	guests.GroupDetail
+//	@Failure		400		{object}	problem.Problem	"Invalid ids or body, or a guest is not a guest of the event"
+//	@Failure		404		{object}	problem.Problem	"Guest group not found"
+//	@Failure		409		{object}	problem.Problem	"A guest already belongs to a group"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId}/members [post]
+func (h *GroupHandler) AddMembers(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body GroupMembersInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	group, err := h.service.AddMembers(r.Context(), eventID, id, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(group), nil
+}
+
+// RemoveMember handles DELETE /events/{eventId}/guest-groups/{groupId}/members/{guestId}.
+//
+// RemoveMember godoc
+//
+//	@Summary		Remove guest group member
+//	@Description	Takes a guest out of the group. An invited guest stays on the guest list ungrouped; a plus-one is deleted and their ticket invalidated. The primary contact can only be removed after another member takes over.
+//	@Tags			guest-groups
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			groupId	path		string	true	"Guest group UUID"
+//	@Param			guestId	path		string	true	"Guest UUID"
+//	@Success		200		{object}	guests.GroupDetail
+//	@Failure		400		{object}	problem.Problem	"Invalid event, group or guest id"
+//	@Failure		404		{object}	problem.Problem	"Guest group not found or guest not a member"
+//	@Failure		409		{object}	problem.Problem	"The guest is the primary contact"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId}/members/{guestId} [delete]
+func (h *GroupHandler) RemoveMember(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	guestID, err := uuid.Parse(chi.URLParam(r, "guestId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid guest id")
+	}
+	group, err := h.service.RemoveMember(r.Context(), eventID, id, guestID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(group), nil
+}
+
+// AddPlusOne handles POST /events/{eventId}/guest-groups/{groupId}/plus-ones.
+//
+// AddPlusOne godoc
+//
+//	@Summary		Name a plus-one
+//	@Description	Adds a named plus-one to the group, within its plus-one allowance. The plus-one takes the primary contact's RSVP status and, when the primary contact holds a ticket, gets their own ticket of the same type linked to the group. custom_fields is checked against the event's guest fields.
+//	@Tags			guest-groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId			path		string				true	"Event UUID"
+//	@Param			groupId			path		string				true	"Guest group UUID"
+//	@Param			Idempotency-Key	header		string				false	"Client-chosen key; retries with the same key and body replay the first response"
+//	@Param			body			body		guests.PlusOneInput	true	"Plus-one payload"
+//	@Success		201				{object}	guests.Guest
+//	@Failure		400				{object}	problem.Problem	"Invalid ids, body or custom fields"
+//	@Failure		404				{object}	problem.Problem	"Guest group not found"
+//	@Failure		409				{object}	problem.Problem	"Allowance used up, or a guest with this email already exists in the event"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId}/plus-ones [post]
+func (h *GroupHandler) AddPlusOne(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body PlusOneInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	guest, err := h.service.AddPlusOne(r.Context(), eventID, id, body)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), guest.UpdatedAt)
+	return response.Created(guest), nil
+}
+
+// RSVP handles POST /events/{eventId}/guest-groups/{groupId}/rsvp.
+//
+// RSVP godoc
+//
+//	@Summary		Record a group RSVP
+//	@Description	Sets the RSVP status of every member of the group, or only of guest_ids when given (each must be a member).
+//	@Tags			guest-groups
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			groupId	path		string					true	"Guest group UUID"
+//	@Param			body	body		guests.GroupRSVPInput	true	"RSVP answer"
+//	@Success		200		{object}	guests.GroupDetail
+//	@Failure		400		{object}	problem.Problem	"Invalid ids or body, or a guest is not a member"
+//	@Failure		404		{object}	problem.Problem	"Guest group not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guest-groups/{groupId}/rsvp [post]
+func (h *GroupHandler) RSVP(r *http.Request) (any, error) {
+	eventID, id, err := groupPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body GroupRSVPInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	group, err := h.service.RSVP(r.Context(), eventID, id, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(group), nil
+}
+
+// InvitationRecipients handles GET /events/{eventId}/invitation-recipients.
+//
+// InvitationRecipients godoc
+//
+//	@Summary		Resolve invitation recipients
+//	@Description	Resolves the event's guest list to the invitations to send: one per guest group, addressed to its primary contact and covering every member, and one per ungrouped guest.
+//	@Tags			guest-groups
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{array}		guests.InvitationRecipient
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/invitation-recipients [get]
+func (h *GroupHandler) InvitationRecipients(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	recipients, err := h.service.InvitationRecipients(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(recipients), nil
+}
+
+// groupPathIDs parses the eventId and groupId path parameters.
+func groupPathIDs(r *http.Request) (eventID, groupID uuid.UUID, err error) {
+	eventID, err = uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	groupID, err = uuid.Parse(chi.URLParam(r, "groupId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errcode.InvalidID.WithMessage("invalid guest group id")
+	}
+	return eventID, groupID, nil
+}
@@ -0,0 +1,62 @@
+package guests
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GuestGroup represents a row in the guest_groups table: guests invited
+// together (a couple, a family) under one primary contact, who may bring up to
+// PlusOnesAllowed companions named later.
+// Supports soft delete via deleted_at. Uses db tags for reflection-based scanning.
+//
+// swagger:model GuestGroup
+type GuestGroup struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	EventID         uuid.UUID  `json:"event_id" db:"event_id"`
+	Name            string     `json:"name" db:"name"`
+	PrimaryGuestID  *uuid.UUID `json:"primary_guest_id,omitempty" db:"primary_guest_id"`
+	PlusOnesAllowed int        `json:"plus_ones_allowed" db:"plus_ones_allowed"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+// TableName returns the database table name.
+func (GuestGroup) TableName() string {
+	return "guest_groups"
+}
+
+// GroupDetail is a group with its live members, primary contact first.
+//
+// swagger:model GroupDetail
+type GroupDetail struct {
+	GuestGroup
+	PlusOnesNamed int      `json:"plus_ones_named"`
+	Members       []*Guest `json:"members"`
+}
+
+// GroupSummary is a group as listed for an event, with member counts.
+//
+// swagger:model GroupSummary
+type GroupSummary struct {
+	GuestGroup
+	MemberCount    int `json:"member_count"`
+	PlusOnesNamed  int `json:"plus_ones_named"`
+	ConfirmedCount int `json:"confirmed_count"`
+}
+
+// InvitationRecipient is one invitation to send for an event: a group's
+// primary contact on behalf of every member, or an ungrouped guest alone.
+//
+// swagger:model InvitationRecipient
+type InvitationRecipient struct {
+	GuestID  uuid.UUID   `json:"guest_id"`
+	GroupID  *uuid.UUID  `json:"group_id,omitempty"`
+	Name     string      `json:"name"`
+	Email    string      `json:"email"`
+	Phone    *string     `json:"phone,omitempty"`
+	GuestIDs []uuid.UUID `json:"guest_ids"` // every guest the message covers, recipient first
+	Names    []string    `json:"names"`     // their names, in the same order
+}
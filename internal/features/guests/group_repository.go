@@ -0,0 +1,337 @@
+package guests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_group_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GroupStore
+
+const guestGroupsTable = "guest_groups"
+
+// guestGroupColumns are the columns selected on reads (GetByID, List).
+var guestGroupColumns = []string{
+	"id", "event_id", "name", "primary_guest_id", "plus_ones_allowed",
+	"created_at", "updated_at", "deleted_at",
+}
+
+// groupListColumns maps the group list's allow-listed filter/sort fields to
+// SQL expressions over guest_groups gg.
+var groupListColumns = map[string]string{
+	"name":       "gg.name",
+	"created_at": "gg.created_at",
+}
+
+// NewGroupRepository returns a soft-delete-aware repository for guest groups.
+func NewGroupRepository(log logger.Logger, db *sqlkit.DB) corerepository.Repository[GuestGroup, uuid.UUID] {
+	return corerepository.NewRepository[GuestGroup, uuid.UUID](
+		log, db, guestGroupsTable, guestGroupColumns, corerepository.CacheOptions{},
+	)
+}
+
+// GroupStore holds the set-based queries over a group's members and the
+// per-group invitation list.
+type GroupStore interface {
+	// EventExists returns repository.ErrNotFound unless the event is live.
+	EventExists(ctx context.Context, eventID uuid.UUID) error
+	// EventGuests returns the event's live guests among ids, in no particular order.
+	EventGuests(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID) ([]*Guest, error)
+	// Members returns the group's live members: primary contact first, then
+	// invited guests, then plus-ones, each by creation time.
+	Members(ctx context.Context, groupID uuid.UUID) ([]*Guest, error)
+	// Assign moves the live, ungrouped guests among guestIDs into the group and
+	// returns how many it moved.
+	Assign(ctx context.Context, groupID uuid.UUID, guestIDs []uuid.UUID) (int64, error)
+	// Release takes the group's members among guestIDs (all of them when nil)
+	// out of the group. Invited members become ungrouped guests; plus-ones only
+	// exist through the group, so they are soft-deleted and their ids returned
+	// for their tickets to be withdrawn.
+	Release(ctx context.Context, groupID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error)
+	// LockPlusOnes locks the group row until the surrounding transaction ends
+	// and returns its plus-one allowance and number of live plus-ones, so
+	// concurrent additions can't overshoot the allowance. It returns
+	// repository.ErrNotFound unless the group is live.
+	LockPlusOnes(ctx context.Context, groupID uuid.UUID) (allowed, named int, err error)
+	// SetRSVP sets the RSVP status of the guests.
+	SetRSVP(ctx context.Context, guestIDs []uuid.UUID, status string) error
+	// TicketType returns the ticket type of the guest's live ticket, or nil
+	// when the guest holds none.
+	TicketType(ctx context.Context, guestID uuid.UUID) (*uuid.UUID, error)
+	// ListGroups returns a page of the event's live groups with member counts,
+	// in params' sort order (then by id), and the total number of matches.
+	ListGroups(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*GroupSummary, int64, error)
+	// InvitationRecipients returns one recipient per group (its primary
+	// contact, or its first member while the primary is gone) and one per
+	// ungrouped guest, among the event's live guests, oldest first.
+	InvitationRecipients(ctx context.Context, eventID uuid.UUID) ([]*InvitationRecipient, error)
+}
+
+// groupStore implements GroupStore on PostgreSQL.
+type groupStore struct {
+	db *sqlkit.DB
+}
+
+// NewGroupStore returns a GroupStore backed by db.
+func NewGroupStore(db *sqlkit.DB) GroupStore {
+	return &groupStore{db: db}
+}
+
+// guestSelect selects guestColumns from guests g in the order groupStore.guests scans them.
+const guestSelect = `SELECT g.id, g.event_id, g.name, g.email, g.phone, g.rsvp_status, g.ticket_id, g.group_id,
+	g.is_plus_one, g.custom_fields, g.created_at, g.updated_at, g.deleted_at
+	FROM guests g`
+
+// EventExists implements GroupStore.
+func (s *groupStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT 1 FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// EventGuests implements GroupStore.
+func (s *groupStore) EventGuests(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID) ([]*Guest, error) {
+	return s.guests(ctx, guestSelect+`
+		WHERE g.event_id = $1 AND g.id = ANY($2::uuid[]) AND g.deleted_at IS NULL`,
+		eventID, uuidArray(ids))
+}
+
+// Members implements GroupStore.
+func (s *groupStore) Members(ctx context.Context, groupID uuid.UUID) ([]*Guest, error) {
+	return s.guests(ctx, guestSelect+`
+		JOIN guest_groups gg ON gg.id = g.group_id
+		WHERE g.group_id = $1 AND g.deleted_at IS NULL
+		ORDER BY (g.id = gg.primary_guest_id) IS TRUE DESC, g.is_plus_one, g.created_at, g.id`, groupID)
+}
+
+// Assign implements GroupStore.
+func (s *groupStore) Assign(ctx context.Context, groupID uuid.UUID, guestIDs []uuid.UUID) (int64, error) {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		`UPDATE guests SET group_id = $1, updated_at = now()
+		WHERE id = ANY($2::uuid[]) AND group_id IS NULL AND deleted_at IS NULL`,
+		groupID, uuidArray(guestIDs))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// Release implements GroupStore. The two statements must run in one
+// transaction; callers go through TxManager.
+func (s *groupStore) Release(ctx context.Context, groupID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	scope := "g.group_id = $1 AND g.deleted_at IS NULL"
+	args := []any{groupID}
+	if guestIDs != nil {
+		scope += " AND g.id = ANY($2::uuid[])"
+		args = append(args, uuidArray(guestIDs))
+	}
+
+	rows, err := conn.QueryContext(ctx, `UPDATE guests g SET deleted_at = now(), updated_at = now()
+		WHERE g.is_plus_one AND `+scope+` RETURNING g.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var plusOnes []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		plusOnes = append(plusOnes, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	_, err = conn.ExecContext(ctx, `UPDATE guests g SET group_id = NULL, updated_at = now()
+		WHERE `+scope, args...)
+	return plusOnes, err
+}
+
+// LockPlusOnes implements GroupStore.
+func (s *groupStore) LockPlusOnes(ctx context.Context, groupID uuid.UUID) (allowed, named int, err error) {
+	conn := corerepository.Conn(ctx, s.db)
+	err = conn.QueryRowContext(ctx,
+		"SELECT plus_ones_allowed FROM guest_groups WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", groupID,
+	).Scan(&allowed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, repository.ErrNotFound
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	err = conn.QueryRowContext(ctx,
+		"SELECT count(*) FROM guests WHERE group_id = $1 AND is_plus_one AND deleted_at IS NULL", groupID,
+	).Scan(&named)
+	return allowed, named, err
+}
+
+// SetRSVP implements GroupStore.
+func (s *groupStore) SetRSVP(ctx context.Context, guestIDs []uuid.UUID, status string) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE guests SET rsvp_status = $1, updated_at = now() WHERE id = ANY($2::uuid[]) AND deleted_at IS NULL",
+		status, uuidArray(guestIDs))
+	return err
+}
+
+// TicketType implements GroupStore.
+func (s *groupStore) TicketType(ctx context.Context, guestID uuid.UUID) (*uuid.UUID, error) {
+	var typeID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT t.ticket_type_id
+		FROM guests g JOIN tickets t ON t.id = g.ticket_id AND t.deleted_at IS NULL AND t.status <> 'invalidated'
+		WHERE g.id = $1`, guestID,
+	).Scan(&typeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &typeID, nil
+}
+
+// ListGroups implements GroupStore.
+func (s *groupStore) ListGroups(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) ([]*GroupSummary, int64, error) {
+	clauses := query.ToSQL(params, groupListColumns, 2)
+	where := strings.Join(append([]string{"gg.event_id = $1", "gg.deleted_at IS NULL"}, clauses.Where...), " AND ")
+	args := append([]any{eventID}, clauses.Args...)
+	order := "gg.id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", gg.id"
+	}
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	if err := conn.QueryRowContext(ctx,
+		"SELECT count(*) FROM guest_groups gg WHERE "+where, args...,
+	).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	q := `SELECT gg.id, gg.event_id, gg.name, gg.primary_guest_id, gg.plus_ones_allowed,
+			gg.created_at, gg.updated_at, gg.deleted_at,
+			count(g.id), count(g.id) FILTER (WHERE g.is_plus_one),
+			count(g.id) FILTER (WHERE g.rsvp_status = 'confirmed')
+		FROM guest_groups gg
+		LEFT JOIN guests g ON g.group_id = gg.id AND g.deleted_at IS NULL
+		WHERE ` + where + `
+		GROUP BY gg.id` +
+		fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, len(args)+1, len(args)+2)
+	rows, err := conn.QueryContext(ctx, q, append(args, params.Size, (params.Page-1)*params.Size)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*GroupSummary
+	for rows.Next() {
+		var g GroupSummary
+		if err := rows.Scan(
+			&g.ID, &g.EventID, &g.Name, &g.PrimaryGuestID, &g.PlusOnesAllowed,
+			&g.CreatedAt, &g.UpdatedAt, &g.DeletedAt,
+			&g.MemberCount, &g.PlusOnesNamed, &g.ConfirmedCount,
+		); err != nil {
+			return nil, 0, err
+		}
+		items = append(items, &g)
+	}
+	return items, total, rows.Err()
+}
+
+// InvitationRecipients implements GroupStore. Members of a deleted group count
+// as ungrouped.
+func (s *groupStore) InvitationRecipients(ctx context.Context, eventID uuid.UUID) ([]*InvitationRecipient, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `WITH m AS (
+			SELECT g.id, g.name, g.email, g.phone, g.created_at, gg.id AS group_id,
+				COALESCE(gg.id, g.id) AS unit,
+				row_number() OVER (
+					PARTITION BY COALESCE(gg.id, g.id)
+					ORDER BY (g.id = gg.primary_guest_id) IS TRUE DESC, g.is_plus_one, g.created_at, g.id
+				) AS pos
+			FROM guests g
+			LEFT JOIN guest_groups gg ON gg.id = g.group_id AND gg.deleted_at IS NULL
+			WHERE g.event_id = $1 AND g.deleted_at IS NULL
+		)
+		SELECT r.id, r.group_id, r.name, r.email, r.phone,
+			array_agg(o.id::text ORDER BY o.pos), array_agg(o.name ORDER BY o.pos)
+		FROM m r JOIN m o ON o.unit = r.unit
+		WHERE r.pos = 1
+		GROUP BY r.id, r.group_id, r.name, r.email, r.phone, r.created_at
+		ORDER BY r.created_at, r.id`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []*InvitationRecipient
+	for rows.Next() {
+		var (
+			r     InvitationRecipient
+			ids   pq.StringArray
+			names pq.StringArray
+		)
+		if err := rows.Scan(&r.GuestID, &r.GroupID, &r.Name, &r.Email, &r.Phone, &ids, &names); err != nil {
+			return nil, err
+		}
+		r.GuestIDs = make([]uuid.UUID, len(ids))
+		for i, id := range ids {
+			if r.GuestIDs[i], err = uuid.Parse(id); err != nil {
+				return nil, err
+			}
+		}
+		r.Names = names
+		out = append(out, &r)
+	}
+	return out, rows.Err()
+}
+
+// guests runs a query selecting guestSelect's columns.
+func (s *groupStore) guests(ctx context.Context, q string, args ...any) ([]*Guest, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []*Guest
+	for rows.Next() {
+		var g Guest
+		if err := rows.Scan(
+			&g.ID, &g.EventID, &g.Name, &g.Email, &g.Phone, &g.RSVPStatus, &g.TicketID, &g.GroupID,
+			&g.IsPlusOne, &g.CustomFields, &g.CreatedAt, &g.UpdatedAt, &g.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, &g)
+	}
+	return out, rows.Err()
+}
+
+// uuidArray binds ids as a PostgreSQL text array, cast to uuid[] in SQL.
+func uuidArray(ids []uuid.UUID) any {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return pq.StringArray(s)
+}
@@ -0,0 +1,24 @@
+package guests
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitGroupRoutes registers guest group routes on the given router, plus the
+// event's per-group invitation recipient list.
+func InitGroupRoutes(r *chi.Mux, groupH *GroupHandler) {
+	r.Route("/api/v1/events/{eventId}/guest-groups", func(r chi.Router) {
+		r.Get("/", problem.Handle(groupH.List))
+		r.Post("/", problem.Handle(groupH.Create))
+		r.Get("/{groupId}", problem.Handle(groupH.GetByID))
+		r.Put("/{groupId}", problem.Handle(groupH.Update))
+		r.Delete("/{groupId}", problem.Handle(groupH.Delete))
+		r.Post("/{groupId}/members", problem.Handle(groupH.AddMembers))
+		r.Delete("/{groupId}/members/{guestId}", problem.Handle(groupH.RemoveMember))
+		r.Post("/{groupId}/plus-ones", problem.Handle(groupH.AddPlusOne))
+		r.Post("/{groupId}/rsvp", problem.Handle(groupH.RSVP))
+	})
+	r.Get("/api/v1/events/{eventId}/invitation-recipients", problem.Handle(groupH.InvitationRecipients))
+}
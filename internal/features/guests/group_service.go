@@ -0,0 +1,578 @@
+package guests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_group_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GroupService
+
+// GroupService manages guest groups: households invited together under one
+// primary contact, their plus-ones and their shared RSVP.
+type GroupService interface {
+	Create(ctx context.Context, eventID uuid.UUID, in CreateGroupInput) (*GroupDetail, error)
+	GetByID(ctx context.Context, eventID, id uuid.UUID) (*GroupDetail, error)
+	Update(ctx context.Context, eventID, id uuid.UUID, in UpdateGroupInput, ifMatch string) (*GroupDetail, error)
+	// Delete disbands the group: members become ungrouped, plus-ones are
+	// deleted and their tickets withdrawn.
+	Delete(ctx context.Context, eventID, id uuid.UUID) error
+	List(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*common.PageResponse[GroupSummary], error)
+	// AddMembers moves ungrouped guests of the event into the group.
+	AddMembers(ctx context.Context, eventID, id uuid.UUID, in GroupMembersInput) (*GroupDetail, error)
+	// RemoveMember takes a guest out of the group; a plus-one is deleted.
+	RemoveMember(ctx context.Context, eventID, id, guestID uuid.UUID) (*GroupDetail, error)
+	// AddPlusOne names one of the group's plus-ones, issuing them a ticket
+	// linked to the group (or waitlisting them when capacity is full) when the
+	// primary contact holds one.
+	AddPlusOne(ctx context.Context, eventID, id uuid.UUID, in PlusOneInput) (*Guest, error)
+	// RSVP records one answer for the whole group or for some of its members.
+	// Declining withdraws the members' tickets and waitlist places.
+	RSVP(ctx context.Context, eventID, id uuid.UUID, in GroupRSVPInput) (*GroupDetail, error)
+	// InvitationRecipients resolves the event's guest list to one invitation
+	// per group and one per ungrouped guest.
+	InvitationRecipients(ctx context.Context, eventID uuid.UUID) ([]*InvitationRecipient, error)
+}
+
+// CreateGroupInput is the input for creating a guest group. The primary
+// contact and members must be ungrouped guests of the event.
+//
+// swagger:model CreateGroupInput
+type CreateGroupInput struct {
+	Name            string      `json:"name"                 validate:"required,max=255"`
+	PrimaryGuestID  uuid.UUID   `json:"primary_guest_id"     validate:"required"`
+	MemberIDs       []uuid.UUID `json:"member_ids,omitempty" validate:"omitempty,max=100"`
+	PlusOnesAllowed int         `json:"plus_ones_allowed"    validate:"min=0,max=50"`
+}
+
+// UpdateGroupInput is the input for updating a guest group. Only non-nil
+// fields are applied.
+//
+// swagger:model UpdateGroupInput
+type UpdateGroupInput struct {
+	Name            *string    `json:"name,omitempty"              validate:"omitempty,min=1,max=255"`
+	PrimaryGuestID  *uuid.UUID `json:"primary_guest_id,omitempty"`
+	PlusOnesAllowed *int       `json:"plus_ones_allowed,omitempty" validate:"omitempty,min=0,max=50"`
+}
+
+// GroupMembersInput lists guests to add to a group.
+//
+// swagger:model GroupMembersInput
+type GroupMembersInput struct {
+	GuestIDs []uuid.UUID `json:"guest_ids" validate:"required,min=1,max=100"`
+}
+
+// PlusOneInput is the input for naming a plus-one.
+//
+// swagger:model PlusOneInput
+type PlusOneInput struct {
+	Name         string       `json:"name"                    validate:"required,max=255"`
+	Email        string       `json:"email"                   validate:"required,email,max=320"`
+	Phone        *string      `json:"phone,omitempty"         validate:"omitempty,max=32"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+}
+
+// GroupRSVPInput is an RSVP answer for a group. Without GuestIDs it applies to
+// every member.
+//
+// swagger:model GroupRSVPInput
+type GroupRSVPInput struct {
+	Status   string      `json:"status"              validate:"required,oneof=none invited confirmed declined"`
+	GuestIDs []uuid.UUID `json:"guest_ids,omitempty" validate:"omitempty,max=100"`
+}
+
+// groupServiceImpl is the concrete implementation of GroupService.
+type groupServiceImpl struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	repo   corerepository.Repository[GuestGroup, uuid.UUID]
+	guests corerepository.Repository[Guest, uuid.UUID]
+	store  GroupStore
+	fields FieldStore
+	issuer tickets.Issuer
+	hooks  webhooks.Publisher
+}
+
+// NewGroupService returns a GroupService with the given dependencies.
+func NewGroupService(
+	logger logger.Logger,
+	tx transaction.TxManager,
+	repo corerepository.Repository[GuestGroup, uuid.UUID],
+	guests corerepository.Repository[Guest, uuid.UUID],
+	store GroupStore,
+	fields FieldStore,
+	issuer tickets.Issuer,
+	hooks webhooks.Publisher,
+) GroupService {
+	return &groupServiceImpl{
+		logger: logger, tx: tx, repo: repo, guests: guests, store: store, fields: fields, issuer: issuer,
+		hooks: hooks,
+	}
+}
+
+// Create implements GroupService.
+func (s *groupServiceImpl) Create(ctx context.Context, eventID uuid.UUID, in CreateGroupInput) (*GroupDetail, error) {
+	if err := s.eventExists(ctx, eventID); err != nil {
+		return nil, err
+	}
+	group := &GuestGroup{
+		ID:              uuid.New(),
+		EventID:         eventID,
+		Name:            in.Name,
+		PrimaryGuestID:  &in.PrimaryGuestID,
+		PlusOnesAllowed: in.PlusOnesAllowed,
+	}
+	ids := uniqueIDs(append([]uuid.UUID{in.PrimaryGuestID}, in.MemberIDs...))
+
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, group); err != nil {
+			return err
+		}
+		return s.assign(ctx, eventID, group.ID, ids)
+	})
+	if err != nil {
+		return nil, s.writeError(ctx, "guest group create failed", group.ID, err)
+	}
+
+	s.logger.InfoWithContext(ctx, "guest group created", logger.F("id", group.ID), logger.F("event_id", eventID))
+	return s.detail(ctx, group)
+}
+
+// GetByID implements GroupService. A group of another event is reported as
+// not found.
+func (s *groupServiceImpl) GetByID(ctx context.Context, eventID, id uuid.UUID) (*GroupDetail, error) {
+	group, err := s.load(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.detail(ctx, group)
+}
+
+// Update implements GroupService. The primary contact must be an invited
+// member, and the allowance can't drop below the plus-ones already named. A
+// non-empty ifMatch (the request's If-Match) makes the write conditional on
+// the group's version.
+func (s *groupServiceImpl) Update(
+	ctx context.Context, eventID, id uuid.UUID, in UpdateGroupInput, ifMatch string,
+) (*GroupDetail, error) {
+	group, err := s.load(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	if in.Name != nil {
+		group.Name = *in.Name
+	}
+	if in.PrimaryGuestID != nil {
+		members, err := s.members(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		m := findGuest(members, *in.PrimaryGuestID)
+		if m == nil || m.IsPlusOne {
+			return nil, errorz.BadRequest().WithMessage("the primary contact must be an invited member of the group")
+		}
+		group.PrimaryGuestID = in.PrimaryGuestID
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if in.PlusOnesAllowed != nil {
+			_, named, err := s.store.LockPlusOnes(ctx, id)
+			if err != nil {
+				return err
+			}
+			if *in.PlusOnesAllowed < named {
+				return errorz.Conflict().WithMessage(
+					fmt.Sprintf("%d plus-ones are already named; remove some before lowering the allowance", named))
+			}
+			group.PlusOnesAllowed = *in.PlusOnesAllowed
+		}
+		return s.repo.UpdateIfMatch(ctx, id, group, ifMatch)
+	})
+	if err != nil {
+		return nil, s.writeError(ctx, "guest group update failed", id, err)
+	}
+
+	s.logger.InfoWithContext(ctx, "guest group updated", logger.F("id", id))
+	return s.detail(ctx, group)
+}
+
+// Delete implements GroupService.
+func (s *groupServiceImpl) Delete(ctx context.Context, eventID, id uuid.UUID) error {
+	if _, err := s.load(ctx, eventID, id); err != nil {
+		return err
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.release(ctx, eventID, id, nil); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, id)
+	})
+	if err != nil {
+		return s.writeError(ctx, "guest group delete failed", id, err)
+	}
+	s.logger.InfoWithContext(ctx, "guest group deleted", logger.F("id", id))
+	return nil
+}
+
+// List implements GroupService.
+func (s *groupServiceImpl) List(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[GroupSummary], error) {
+	if err := s.eventExists(ctx, eventID); err != nil {
+		return nil, err
+	}
+	items, total, err := s.store.ListGroups(ctx, eventID, params)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "guest group list failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to list guest groups")
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// AddMembers implements GroupService.
+func (s *groupServiceImpl) AddMembers(
+	ctx context.Context, eventID, id uuid.UUID, in GroupMembersInput,
+) (*GroupDetail, error) {
+	group, err := s.load(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.assign(ctx, eventID, id, uniqueIDs(in.GuestIDs))
+	})
+	if err != nil {
+		return nil, s.writeError(ctx, "guest group add members failed", id, err)
+	}
+	s.logger.InfoWithContext(ctx, "guest group members added", logger.F("id", id), logger.F("count", len(in.GuestIDs)))
+	return s.detail(ctx, group)
+}
+
+// RemoveMember implements GroupService. The primary contact can't be removed
+// until another member takes over.
+func (s *groupServiceImpl) RemoveMember(ctx context.Context, eventID, id, guestID uuid.UUID) (*GroupDetail, error) {
+	group, err := s.load(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	if group.PrimaryGuestID != nil && *group.PrimaryGuestID == guestID {
+		return nil, errcode.GroupPrimaryContactRequired.New()
+	}
+	members, err := s.members(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if findGuest(members, guestID) == nil {
+		return nil, errcode.GuestNotInGroup.New()
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return s.release(ctx, eventID, id, []uuid.UUID{guestID})
+	})
+	if err != nil {
+		return nil, s.writeError(ctx, "guest group remove member failed", id, err)
+	}
+	s.logger.InfoWithContext(ctx, "guest group member removed", logger.F("id", id), logger.F("guest_id", guestID))
+	return s.detail(ctx, group)
+}
+
+// AddPlusOne implements GroupService. The plus-one starts with the primary
+// contact's RSVP status and, when the primary contact holds a live ticket,
+// asks for a ticket of the same type linked to the group.
+func (s *groupServiceImpl) AddPlusOne(ctx context.Context, eventID, id uuid.UUID, in PlusOneInput) (*Guest, error) {
+	group, err := s.load(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := loadSchema(ctx, s.logger, s.fields, eventID)
+	if err != nil {
+		return nil, err
+	}
+	custom, err := schema.Check(in.CustomFields)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("invalid custom fields: " + err.Error())
+	}
+	guest := &Guest{
+		ID:           uuid.New(),
+		EventID:      eventID,
+		Name:         in.Name,
+		Email:        in.Email,
+		Phone:        in.Phone,
+		RSVPStatus:   RSVPNone,
+		GroupID:      &group.ID,
+		IsPlusOne:    true,
+		CustomFields: custom,
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		allowed, named, err := s.store.LockPlusOnes(ctx, id)
+		if err != nil {
+			return err
+		}
+		if named >= allowed {
+			return errorz.Conflict().WithMessage(fmt.Sprintf("the group's %d plus-ones are all named", allowed))
+		}
+		var primary *Guest
+		if group.PrimaryGuestID != nil {
+			primary, err = s.guests.GetByID(ctx, *group.PrimaryGuestID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return err
+			}
+		}
+		if primary != nil {
+			guest.RSVPStatus = primary.RSVPStatus
+		}
+		if err := s.guests.Create(ctx, guest); err != nil {
+			return err
+		}
+		if err := s.hooks.Publish(ctx, eventID, webhooks.GuestCreated, guest); err != nil {
+			return err
+		}
+		if primary == nil {
+			return nil
+		}
+		typeID, err := s.store.TicketType(ctx, primary.ID)
+		if err != nil || typeID == nil {
+			return err
+		}
+		res, err := s.issuer.Issue(ctx, tickets.IssueRequest{
+			EventID: eventID, GuestID: guest.ID, TicketTypeID: *typeID, GroupID: &group.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if res.Ticket != nil {
+			guest.TicketID = &res.Ticket.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, s.writeError(ctx, "guest group add plus-one failed", id, err)
+	}
+
+	s.logger.InfoWithContext(ctx, "plus-one added",
+		logger.F("id", guest.ID), logger.F("group_id", id), logger.F("ticketed", guest.TicketID != nil))
+	return guest, nil
+}
+
+// RSVP implements GroupService.
+func (s *groupServiceImpl) RSVP(ctx context.Context, eventID, id uuid.UUID, in GroupRSVPInput) (*GroupDetail, error) {
+	group, err := s.load(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.members(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]uuid.UUID, 0, len(members))
+	if len(in.GuestIDs) == 0 {
+		for _, m := range members {
+			targets = append(targets, m.ID)
+		}
+	} else {
+		for _, gid := range uniqueIDs(in.GuestIDs) {
+			if findGuest(members, gid) == nil {
+				return nil, errorz.BadRequest().WithMessage(fmt.Sprintf("guest %s is not a member of the group", gid))
+			}
+			targets = append(targets, gid)
+		}
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.store.SetRSVP(ctx, targets, in.Status); err != nil {
+			return err
+		}
+		if err := s.publishRSVP(ctx, eventID, members, targets, in.Status); err != nil {
+			return err
+		}
+		if in.Status != RSVPDeclined {
+			return nil
+		}
+		return s.issuer.Withdraw(ctx, eventID, targets)
+	})
+	if err != nil {
+		return nil, s.writeError(ctx, "guest group rsvp failed", id, err)
+	}
+	s.logger.InfoWithContext(ctx, "guest group rsvp recorded",
+		logger.F("id", id), logger.F("status", in.Status), logger.F("count", len(targets)))
+	return s.detail(ctx, group)
+}
+
+// InvitationRecipients implements GroupService.
+func (s *groupServiceImpl) InvitationRecipients(
+	ctx context.Context, eventID uuid.UUID,
+) ([]*InvitationRecipient, error) {
+	if err := s.eventExists(ctx, eventID); err != nil {
+		return nil, err
+	}
+	out, err := s.store.InvitationRecipients(ctx, eventID)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "invitation recipients failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to resolve invitation recipients")
+	}
+	return out, nil
+}
+
+// assign moves guestIDs into the group. They must be live, ungrouped guests
+// of the event; a guest grouped concurrently surfaces as a conflict.
+func (s *groupServiceImpl) assign(ctx context.Context, eventID, groupID uuid.UUID, guestIDs []uuid.UUID) error {
+	found, err := s.store.EventGuests(ctx, eventID, guestIDs)
+	if err != nil {
+		return err
+	}
+	for _, gid := range guestIDs {
+		g := findGuest(found, gid)
+		if g == nil {
+			return errorz.BadRequest().WithMessage(fmt.Sprintf("guest %s is not a guest of the event", gid))
+		}
+		if g.GroupID != nil {
+			return errorz.Conflict().WithMessage(fmt.Sprintf("guest %s already belongs to a group", gid))
+		}
+	}
+	moved, err := s.store.Assign(ctx, groupID, guestIDs)
+	if err != nil {
+		return err
+	}
+	if moved != int64(len(guestIDs)) {
+		return errcode.GroupMembershipChanged.New()
+	}
+	return nil
+}
+
+// release takes guestIDs (all members when nil) out of the group and
+// withdraws the tickets of the plus-ones it deletes.
+func (s *groupServiceImpl) release(ctx context.Context, eventID, groupID uuid.UUID, guestIDs []uuid.UUID) error {
+	plusOnes, err := s.store.Release(ctx, groupID, guestIDs)
+	if err != nil {
+		return err
+	}
+	return s.issuer.Withdraw(ctx, eventID, plusOnes)
+}
+
+// load returns the live group, reporting a group of another event as not found.
+func (s *groupServiceImpl) load(ctx context.Context, eventID, id uuid.UUID) (*GuestGroup, error) {
+	group, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.GuestGroupNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest group get failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get guest group")
+	}
+	if group.EventID != eventID {
+		return nil, errcode.GuestGroupNotFound.New()
+	}
+	return group, nil
+}
+
+// publishRSVP publishes guest.rsvp_changed for each target whose status
+// changed to status.
+func (s *groupServiceImpl) publishRSVP(
+	ctx context.Context, eventID uuid.UUID, members []*Guest, targets []uuid.UUID, status string,
+) error {
+	for _, gid := range targets {
+		m := findGuest(members, gid)
+		if m == nil || m.RSVPStatus == status {
+			continue
+		}
+		saved := *m
+		saved.RSVPStatus = status
+		change := RSVPChange{Guest: &saved, PreviousStatus: m.RSVPStatus}
+		if err := s.hooks.Publish(ctx, eventID, webhooks.GuestRSVPChanged, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// members returns the group's live members, mapping failures to 500.
+func (s *groupServiceImpl) members(ctx context.Context, id uuid.UUID) ([]*Guest, error) {
+	members, err := s.store.Members(ctx, id)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "guest group members failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get guest group members")
+	}
+	return members, nil
+}
+
+// detail returns group with its current members.
+func (s *groupServiceImpl) detail(ctx context.Context, group *GuestGroup) (*GroupDetail, error) {
+	members, err := s.members(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+	d := &GroupDetail{GuestGroup: *group, Members: members}
+	for _, m := range members {
+		if m.IsPlusOne {
+			d.PlusOnesNamed++
+		}
+	}
+	return d, nil
+}
+
+// eventExists maps a missing event to 404.
+func (s *groupServiceImpl) eventExists(ctx context.Context, eventID uuid.UUID) error {
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get event")
+	}
+	return nil
+}
+
+// writeError translates the error of a group write transaction: errorz errors
+// raised inside pass through, repository sentinels map to their status, the
+// rest is logged as msg and reported as 500.
+func (s *groupServiceImpl) writeError(ctx context.Context, msg string, id uuid.UUID, err error) error {
+	var ez *errorz.Error
+	switch {
+	case errors.As(err, &ez):
+		return ez
+	case errors.Is(err, repository.ErrNotFound):
+		return errcode.GuestGroupNotFound.New()
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return errcode.GuestEmailTaken.New()
+	case errors.Is(err, corerepository.ErrVersionMismatch):
+		return errcode.VersionMismatch.WithMessage("guest group was modified by someone else")
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("id", id), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update guest group")
+}
+
+// findGuest returns the guest with the given id among guests, or nil.
+func findGuest(guests []*Guest, id uuid.UUID) *Guest {
+	for _, g := range guests {
+		if g.ID == id {
+			return g
+		}
+	}
+	return nil
+}
+
+// uniqueIDs returns ids without repeats, in first-seen order.
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,364 @@
+package guests_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mockcorerepository "github.com/biairmal/guest-management-be/mocks/core/repository"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+// groupMocks bundles the group service's dependencies; the transaction
+// manager runs its callback inline.
+type groupMocks struct {
+	groups *mockcorerepository.MockRepository[guests.GuestGroup, uuid.UUID]
+	guests *mockcorerepository.MockRepository[guests.Guest, uuid.UUID]
+	store  *mockguests.MockGroupStore
+	fields *mockguests.MockFieldStore
+	issuer *mocktickets.MockIssuer
+	hooks  *mockwebhooks.MockPublisher
+	svc    guests.GroupService
+}
+
+func newGroupMocks(t *testing.T) *groupMocks {
+	ctrl := gomock.NewController(t)
+	m := &groupMocks{
+		groups: mockcorerepository.NewMockRepository[guests.GuestGroup, uuid.UUID](ctrl),
+		guests: mockcorerepository.NewMockRepository[guests.Guest, uuid.UUID](ctrl),
+		store:  mockguests.NewMockGroupStore(ctrl),
+		fields: mockguests.NewMockFieldStore(ctrl),
+		issuer: mocktickets.NewMockIssuer(ctrl),
+		hooks:  mockwebhooks.NewMockPublisher(ctrl),
+	}
+	m.svc = guests.NewGroupService(
+		logger.NewNoOp(), inlineTx(ctrl), m.groups, m.guests, m.store, m.fields, m.issuer, m.hooks)
+	return m
+}
+
+func TestGroupService_Create(t *testing.T) {
+	eventID, primaryID, memberID, otherGroup := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	in := guests.CreateGroupInput{
+		Name: "The Smiths", PrimaryGuestID: primaryID, MemberIDs: []uuid.UUID{memberID, primaryID}, PlusOnesAllowed: 2,
+	}
+
+	tests := []struct {
+		name     string
+		eventErr error
+		found    []*guests.Guest
+		moved    int64
+		wantCode string
+	}{
+		{
+			name:  "created with the primary contact as a member",
+			found: []*guests.Guest{{ID: primaryID}, {ID: memberID}},
+			moved: 2,
+		},
+		{name: "event not found", eventErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{
+			name:     "member is not a guest of the event",
+			found:    []*guests.Guest{{ID: primaryID}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:     "member already in a group",
+			found:    []*guests.Guest{{ID: primaryID}, {ID: memberID, GroupID: &otherGroup}},
+			wantCode: errorz.CodeConflict,
+		},
+		{
+			name:     "member grouped concurrently",
+			found:    []*guests.Guest{{ID: primaryID}, {ID: memberID}},
+			moved:    1,
+			wantCode: errorz.CodeConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newGroupMocks(t)
+			m.store.EXPECT().EventExists(gomock.Any(), eventID).Return(tt.eventErr)
+			m.groups.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(1)
+			m.store.EXPECT().EventGuests(gomock.Any(), eventID, []uuid.UUID{primaryID, memberID}).
+				Return(tt.found, nil).MaxTimes(1)
+			m.store.EXPECT().Assign(gomock.Any(), gomock.Any(), []uuid.UUID{primaryID, memberID}).
+				Return(tt.moved, nil).MaxTimes(1)
+			m.store.EXPECT().Members(gomock.Any(), gomock.Any()).Return(tt.found, nil).MaxTimes(1)
+
+			got, err := m.svc.Create(context.Background(), eventID, in)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && (*got.PrimaryGuestID != primaryID || len(got.Members) != 2) {
+				t.Errorf("group = %+v, want primary %v and 2 members", got, primaryID)
+			}
+		})
+	}
+}
+
+func TestGroupService_AddPlusOne(t *testing.T) {
+	eventID, groupID, primaryID, typeID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	in := guests.PlusOneInput{Name: "Sam", Email: "sam@x.io", CustomFields: guests.CustomFields{"table": 2.0}}
+	issued := &tickets.IssueResult{Outcome: tickets.OutcomeIssued, Ticket: &tickets.Ticket{ID: uuid.New()}}
+	waitlisted := &tickets.IssueResult{Outcome: tickets.OutcomeWaitlisted, Waitlist: &tickets.WaitlistEntry{}}
+
+	tests := []struct {
+		name        string
+		allowed     int
+		named       int
+		primaryErr  error
+		createErr   error
+		ticketType  *uuid.UUID
+		issue       *tickets.IssueResult
+		issueErr    error
+		wantRSVP    string
+		wantTicket  bool
+		wantCode    string
+		wantIssuing bool
+	}{
+		{
+			name: "named with a companion ticket", allowed: 2, named: 1, ticketType: &typeID, issue: issued,
+			wantRSVP: guests.RSVPConfirmed, wantTicket: true, wantIssuing: true,
+		},
+		{
+			name: "waitlisted while the event is full", allowed: 1, ticketType: &typeID, issue: waitlisted,
+			wantRSVP: guests.RSVPConfirmed, wantIssuing: true,
+		},
+		{
+			name: "ticket type full and waitlist refused", allowed: 1, ticketType: &typeID,
+			issueErr: errorz.Conflict(), wantCode: errorz.CodeConflict, wantIssuing: true,
+		},
+		{name: "primary contact holds no ticket", allowed: 1, wantRSVP: guests.RSVPConfirmed},
+		{
+			name: "primary contact deleted", allowed: 1, primaryErr: repository.ErrNotFound, wantRSVP: guests.RSVPNone,
+		},
+		{name: "allowance used up", allowed: 2, named: 2, wantCode: errorz.CodeConflict},
+		{name: "no plus-ones allowed", wantCode: errorz.CodeConflict},
+		{
+			name: "email taken in the event", allowed: 1, createErr: repository.ErrAlreadyExists,
+			wantCode: errorz.CodeConflict,
+		},
+		{name: "create failure", allowed: 1, createErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newGroupMocks(t)
+			m.groups.EXPECT().GetByID(gomock.Any(), groupID).Return(
+				&guests.GuestGroup{ID: groupID, EventID: eventID, PrimaryGuestID: &primaryID, PlusOnesAllowed: tt.allowed}, nil)
+			m.fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(guestSchema(), nil)
+			m.store.EXPECT().LockPlusOnes(gomock.Any(), groupID).Return(tt.allowed, tt.named, nil)
+			var primary *guests.Guest
+			if tt.primaryErr == nil {
+				primary = &guests.Guest{ID: primaryID, RSVPStatus: guests.RSVPConfirmed}
+			}
+			m.guests.EXPECT().GetByID(gomock.Any(), primaryID).Return(primary, tt.primaryErr).MaxTimes(1)
+			m.guests.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, g *guests.Guest) error {
+					if !g.IsPlusOne || g.GroupID == nil || *g.GroupID != groupID {
+						t.Errorf("guest = %+v, want a plus-one of group %v", g, groupID)
+					}
+					return tt.createErr
+				}).MaxTimes(1)
+			m.hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.GuestCreated, gomock.Any()).Return(nil).MaxTimes(1)
+			m.store.EXPECT().TicketType(gomock.Any(), primaryID).Return(tt.ticketType, nil).MaxTimes(1)
+			issuing := false
+			m.issuer.EXPECT().Issue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req tickets.IssueRequest) (*tickets.IssueResult, error) {
+					issuing = true
+					if req.TicketTypeID != typeID || req.GroupID == nil || *req.GroupID != groupID {
+						t.Errorf("issue request = %+v, want type %v in group %v", req, typeID, groupID)
+					}
+					return tt.issue, tt.issueErr
+				}).MaxTimes(1)
+
+			got, err := m.svc.AddPlusOne(context.Background(), eventID, groupID, in)
+			assertErrorzCode(t, err, tt.wantCode)
+			if issuing != tt.wantIssuing {
+				t.Errorf("ticket requested = %v, want %v", issuing, tt.wantIssuing)
+			}
+			if tt.wantCode != "" {
+				return
+			}
+			if got.RSVPStatus != tt.wantRSVP {
+				t.Errorf("rsvp = %q, want %q", got.RSVPStatus, tt.wantRSVP)
+			}
+			if (got.TicketID != nil) != tt.wantTicket {
+				t.Errorf("ticket = %v, want ticket %v", got.TicketID, tt.wantTicket)
+			}
+		})
+	}
+}
+
+func TestGroupService_Update(t *testing.T) {
+	eventID, groupID, primaryID, memberID, plusOneID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	members := []*guests.Guest{{ID: primaryID}, {ID: memberID}, {ID: plusOneID, IsPlusOne: true}}
+	allow := func(n int) *int { return &n }
+
+	tests := []struct {
+		name      string
+		in        guests.UpdateGroupInput
+		updateErr error
+		wantCode  string
+	}{
+		{name: "primary contact handed over", in: guests.UpdateGroupInput{PrimaryGuestID: &memberID}},
+		{name: "allowance lowered to what is named", in: guests.UpdateGroupInput{PlusOnesAllowed: allow(1)}},
+		{
+			name:     "allowance below the plus-ones named",
+			in:       guests.UpdateGroupInput{PlusOnesAllowed: allow(0)},
+			wantCode: errorz.CodeConflict,
+		},
+		{
+			name:     "plus-one as primary contact",
+			in:       guests.UpdateGroupInput{PrimaryGuestID: &plusOneID},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:      "version mismatch",
+			in:        guests.UpdateGroupInput{PrimaryGuestID: &memberID},
+			updateErr: corerepository.ErrVersionMismatch,
+			wantCode:  errorz.CodePreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newGroupMocks(t)
+			m.groups.EXPECT().GetByID(gomock.Any(), groupID).Return(
+				&guests.GuestGroup{ID: groupID, EventID: eventID, PrimaryGuestID: &primaryID, PlusOnesAllowed: 3}, nil)
+			m.store.EXPECT().Members(gomock.Any(), groupID).Return(members, nil).AnyTimes()
+			m.store.EXPECT().LockPlusOnes(gomock.Any(), groupID).Return(3, 1, nil).MaxTimes(1)
+			m.groups.EXPECT().UpdateIfMatch(gomock.Any(), groupID, gomock.Any(), `"1"`).Return(tt.updateErr).MaxTimes(1)
+
+			got, err := m.svc.Update(context.Background(), eventID, groupID, tt.in, `"1"`)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && got.PlusOnesNamed != 1 {
+				t.Errorf("plus-ones named = %d, want 1", got.PlusOnesNamed)
+			}
+		})
+	}
+}
+
+func TestGroupService_RSVP(t *testing.T) {
+	eventID, groupID, a, b, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	members := []*guests.Guest{{ID: a}, {ID: b, IsPlusOne: true}}
+
+	tests := []struct {
+		name         string
+		status       string
+		guestIDs     []uuid.UUID
+		wantTargets  []uuid.UUID
+		wantWithdraw bool
+		wantCode     string
+	}{
+		{name: "whole group declines", status: guests.RSVPDeclined, wantTargets: []uuid.UUID{a, b}, wantWithdraw: true},
+		{
+			name: "one member declines", status: guests.RSVPDeclined, guestIDs: []uuid.UUID{b, b},
+			wantTargets: []uuid.UUID{b}, wantWithdraw: true,
+		},
+		{name: "whole group confirms", status: guests.RSVPConfirmed, wantTargets: []uuid.UUID{a, b}},
+		{
+			name: "not a member", status: guests.RSVPDeclined, guestIDs: []uuid.UUID{a, outsider},
+			wantCode: errorz.CodeBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newGroupMocks(t)
+			m.groups.EXPECT().GetByID(gomock.Any(), groupID).Return(&guests.GuestGroup{ID: groupID, EventID: eventID}, nil)
+			m.store.EXPECT().Members(gomock.Any(), groupID).Return(members, nil).AnyTimes()
+			var targets []uuid.UUID
+			m.store.EXPECT().SetRSVP(gomock.Any(), gomock.Any(), tt.status).DoAndReturn(
+				func(_ context.Context, ids []uuid.UUID, _ string) error {
+					targets = ids
+					return nil
+				}).MaxTimes(1)
+			var withdrawn []uuid.UUID
+			m.issuer.EXPECT().Withdraw(gomock.Any(), eventID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, ids []uuid.UUID) error {
+					withdrawn = ids
+					return nil
+				}).MaxTimes(1)
+			var published []uuid.UUID
+			m.hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.GuestRSVPChanged, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, _ string, data any) error {
+					change := data.(guests.RSVPChange)
+					if change.Guest.RSVPStatus != tt.status {
+						t.Errorf("published rsvp = %q, want %q", change.Guest.RSVPStatus, tt.status)
+					}
+					published = append(published, change.Guest.ID)
+					return nil
+				}).AnyTimes()
+
+			_, err := m.svc.RSVP(context.Background(), eventID, groupID,
+				guests.GroupRSVPInput{Status: tt.status, GuestIDs: tt.guestIDs})
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				return
+			}
+			if !reflect.DeepEqual(targets, tt.wantTargets) {
+				t.Errorf("rsvp targets = %v, want %v", targets, tt.wantTargets)
+			}
+			if (withdrawn != nil) != tt.wantWithdraw || (tt.wantWithdraw && !reflect.DeepEqual(withdrawn, targets)) {
+				t.Errorf("withdrawn = %v, want withdraw %v of the targets", withdrawn, tt.wantWithdraw)
+			}
+			if !reflect.DeepEqual(published, tt.wantTargets) {
+				t.Errorf("published = %v, want %v", published, tt.wantTargets)
+			}
+		})
+	}
+}
+
+func TestGroupService_RemoveMember(t *testing.T) {
+	eventID, groupID, primaryID, memberID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	plusOneID := uuid.New()
+
+	tests := []struct {
+		name        string
+		guestID     uuid.UUID
+		groupEvent  uuid.UUID
+		plusOnes    []uuid.UUID
+		wantRelease bool
+		wantCode    string
+	}{
+		{name: "member removed", guestID: memberID, wantRelease: true},
+		{name: "plus-one removed", guestID: plusOneID, plusOnes: []uuid.UUID{plusOneID}, wantRelease: true},
+		{name: "primary contact", guestID: primaryID, wantCode: errorz.CodeConflict},
+		{name: "not a member", guestID: uuid.New(), wantCode: errorz.CodeNotFound},
+		{name: "group of another event", guestID: memberID, groupEvent: uuid.New(), wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newGroupMocks(t)
+			owner := eventID
+			if tt.groupEvent != uuid.Nil {
+				owner = tt.groupEvent
+			}
+			m.groups.EXPECT().GetByID(gomock.Any(), groupID).Return(
+				&guests.GuestGroup{ID: groupID, EventID: owner, PrimaryGuestID: &primaryID}, nil)
+			m.store.EXPECT().Members(gomock.Any(), groupID).
+				Return([]*guests.Guest{{ID: primaryID}, {ID: memberID}, {ID: plusOneID, IsPlusOne: true}}, nil).AnyTimes()
+			released := false
+			m.store.EXPECT().Release(gomock.Any(), groupID, []uuid.UUID{tt.guestID}).DoAndReturn(
+				func(context.Context, uuid.UUID, []uuid.UUID) ([]uuid.UUID, error) {
+					released = true
+					return tt.plusOnes, nil
+				}).MaxTimes(1)
+			m.issuer.EXPECT().Withdraw(gomock.Any(), eventID, tt.plusOnes).Return(nil).MaxTimes(1)
+
+			_, err := m.svc.RemoveMember(context.Background(), eventID, groupID, tt.guestID)
+			assertErrorzCode(t, err, tt.wantCode)
+			if released != tt.wantRelease {
+				t.Errorf("released = %v, want %v", released, tt.wantRelease)
+			}
+		})
+	}
+}
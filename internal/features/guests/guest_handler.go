@@ -0,0 +1,216 @@
+package guests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/etag"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// GuestHandler exposes HTTP handlers for an event's guests.
+type GuestHandler struct {
+	service   GuestService
+	validator validation.Validator
+}
+
+// NewGuestHandler returns a GuestHandler that uses the given service and validator.
+func NewGuestHandler(service GuestService, validator validation.Validator) *GuestHandler {
+	return &GuestHandler{service: service, validator: validator}
+}
+
+// List handles GET /events/{eventId}/guests.
+//
+// List godoc
+//
+//	@Summary		List guests
+//	@Description	Returns a paginated list of the event's guests. Query: page, size, sort=field,dir (name, email, rsvp_status, ticket_status, created_at), equality filters on name, email, rsvp_status, ticket_status, group_id, and cf.<key>=<value> on custom fields (value parsed as the field's type).
+//	@Tags			guests
+//	@Produce		json
+//	@Param			eventId			path		string	true	"Event UUID"
+//	@Param			page			query		int		false	"Page number (1-based)"
+//	@Param			size			query		int		false	"Page size (default 20, max 100)"
+//	@Param			sort			query		string	false	"Sort spec field,DIRECTION (repeatable)"
+//	@Param			name			query		string	false	"Filter by name"
+//	@Param			email			query		string	false	"Filter by email"
+//	@Param			rsvp_status		query		string	false	"Filter by RSVP status"
+//	@Param			ticket_status	query		string	false	"Filter by ticket status"
+//	@Param			group_id		query		string	false	"Filter by guest group UUID"
+//	@Success		200				{object}	dto.PageResponse[guests.Guest]
+//	@Failure		400				{object}	problem.Problem	"Invalid event id or query"
+//	@Failure		404				{object}	problem.Problem	"Event not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests [get]
+func (h *GuestHandler) List(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	params, err := query.ParseListParams(r.URL.Query(), guestListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	result, err := h.service.List(r.Context(), eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(result), nil
+}
+
+// GetByID handles GET /events/{eventId}/guests/{guestId}.
+//
+// GetByID godoc
+//
+//	@Summary		Get guest
+//	@Description	Returns one guest of the event, with its custom field values.
+//	@Tags			guests
+//	@Produce		json
+//	@Param			eventId			path		string	true	"Event UUID"
+//	@Param			guestId			path		string	true	"Guest UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; 304 when unchanged"
+//	@Success		200				{object}	guests.Guest
+//	@Header			200				{string}	ETag	"Version token for If-Match / If-None-Match"
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	problem.Problem	"Invalid event or guest id"
+//	@Failure		404				{object}	problem.Problem	"Guest not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/{guestId} [get]
+func (h *GuestHandler) GetByID(r *http.Request) (any, error) {
+	eventID, id, err := guestPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := h.service.GetByID(r.Context(), eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.OK(entity), nil
+}
+
+// Create handles POST /events/{eventId}/guests.
+//
+// Create godoc
+//
+//	@Summary		Create guest
+//	@Description	Adds a guest to the event. custom_fields is checked against the event's guest fields: unknown keys, wrongly typed values and missing required fields are rejected.
+//	@Tags			guests
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId			path		string					true	"Event UUID"
+//	@Param			Idempotency-Key	header		string					false	"Client-chosen key; retries with the same key and body replay the first response"
+//	@Param			body			body		guests.CreateGuestInput	true	"Guest payload"
+//	@Success		201				{object}	guests.Guest
+//	@Failure		400				{object}	problem.Problem	"Invalid event id, body or custom fields"
+//	@Failure		404				{object}	problem.Problem	"Event not found"
+//	@Failure		409				{object}	problem.Problem	"A guest with this email already exists in the event"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests [post]
+func (h *GuestHandler) Create(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	var body CreateGuestInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	entity, err := h.service.Create(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.Created(entity), nil
+}
+
+// Update handles PUT /events/{eventId}/guests/{guestId}.
+//
+// Update godoc
+//
+//	@Summary		Update guest
+//	@Description	Updates a guest (partial update). custom_fields is merged into the stored values (null removes a value) and the result is checked against the event's guest fields. Send the ETag from the last read as If-Match to reject concurrent edits with 412.
+//	@Tags			guests
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string					true	"Event UUID"
+//	@Param			guestId		path		string					true	"Guest UUID"
+//	@Param			If-Match	header		string					false	"ETag from the last read; 412 when the guest changed since"
+//	@Param			body		body		guests.UpdateGuestInput	true	"Fields to update"
+//	@Success		200			{object}	guests.Guest
+//	@Header			200			{string}	ETag	"New version token"
+//	@Failure		400			{object}	problem.Problem	"Invalid ids, body or custom fields"
+//	@Failure		404			{object}	problem.Problem	"Guest not found"
+//	@Failure		409			{object}	problem.Problem	"A guest with this email already exists in the event"
+//	@Failure		412			{object}	problem.Problem	"If-Match does not match the current version"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/{guestId} [put]
+func (h *GuestHandler) Update(r *http.Request) (any, error) {
+	eventID, id, err := guestPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body UpdateGuestInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	entity, err := h.service.Update(r.Context(), eventID, id, body, etag.IfMatch(r))
+	if err != nil {
+		return nil, err
+	}
+	etag.Set(r.Context(), entity.UpdatedAt)
+	return response.OK(entity), nil
+}
+
+// Delete handles DELETE /events/{eventId}/guests/{guestId}.
+//
+// Delete godoc
+//
+//	@Summary		Delete guest
+//	@Description	Soft-deletes a guest of the event.
+//	@Tags			guests
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			guestId	path		string	true	"Guest UUID"
+//	@Success		204		"No content"
+//	@Failure		400		{object}	problem.Problem	"Invalid event or guest id"
+//	@Failure		404		{object}	problem.Problem	"Guest not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/{guestId} [delete]
+func (h *GuestHandler) Delete(r *http.Request) (any, error) {
+	eventID, id, err := guestPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Delete(r.Context(), eventID, id); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// guestPathIDs parses the eventId and guestId path parameters.
+func guestPathIDs(r *http.Request) (eventID, guestID uuid.UUID, err error) {
+	eventID, err = uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	guestID, err = uuid.Parse(chi.URLParam(r, "guestId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errcode.InvalidID.WithMessage("invalid guest id")
+	}
+	return eventID, guestID, nil
+}
@@ -0,0 +1,55 @@
+package guests
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RSVPNone is the RSVP status of a guest who hasn't been invited yet.
+	RSVPNone = "none"
+	// RSVPPending is the RSVP status of a guest who registered themselves and
+	// awaits staff approval.
+	RSVPPending = "pending"
+	// RSVPInvited is the RSVP status of a guest who was sent an invitation.
+	RSVPInvited = "invited"
+	// RSVPConfirmed is the RSVP status of a guest who accepted.
+	RSVPConfirmed = "confirmed"
+	// RSVPDeclined is the RSVP status of a guest who declined.
+	RSVPDeclined = "declined"
+)
+
+// Guest represents a row in the guests table.
+// Supports soft delete via deleted_at. Uses db tags for reflection-based scanning.
+//
+// swagger:model Guest
+type Guest struct {
+	ID           uuid.UUID    `json:"id" db:"id"`
+	EventID      uuid.UUID    `json:"event_id" db:"event_id"`
+	Name         string       `json:"name" db:"name"`
+	Email        string       `json:"email" db:"email"`
+	Phone        *string      `json:"phone,omitempty" db:"phone"`
+	RSVPStatus   string       `json:"rsvp_status" db:"rsvp_status"`
+	TicketID     *uuid.UUID   `json:"ticket_id,omitempty" db:"ticket_id"`
+	GroupID      *uuid.UUID   `json:"group_id,omitempty" db:"group_id"`
+	IsPlusOne    bool         `json:"is_plus_one" db:"is_plus_one"`
+	CustomFields CustomFields `json:"custom_fields" db:"custom_fields"`
+	CreatedAt    time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at" db:"updated_at"`
+	DeletedAt    *time.Time   `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+// TableName returns the database table name.
+func (Guest) TableName() string {
+	return "guests"
+}
+
+// RSVPChange is the payload of a guest.rsvp_changed webhook: the guest as
+// saved and the RSVP status they had before.
+//
+// swagger:model GuestRSVPChange
+type RSVPChange struct {
+	Guest          *Guest `json:"guest"`
+	PreviousStatus string `json:"previous_rsvp_status"`
+}
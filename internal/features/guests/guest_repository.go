@@ -0,0 +1,122 @@
+package guests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_guest_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GuestStore
+
+const guestsTable = "guests"
+
+// guestColumns are the columns selected on reads (GetByID, List).
+var guestColumns = []string{
+	"id", "event_id", "name", "email", "phone", "rsvp_status", "ticket_id", "group_id", "is_plus_one",
+	"custom_fields", "created_at", "updated_at", "deleted_at",
+}
+
+// guestListColumns maps the guest list's allow-listed filter/sort fields to
+// the SQL expressions of the guest list and export queries, which join the
+// guest's live ticket as t.
+var guestListColumns = map[string]string{
+	"name":          "g.name",
+	"email":         "g.email",
+	"rsvp_status":   "g.rsvp_status",
+	"ticket_status": "t.status",
+	"group_id":      "g.group_id",
+	"created_at":    "g.created_at",
+}
+
+// NewGuestRepository returns a soft-delete-aware repository for guests. The
+// email uniqueness per event (ux_guests_event_email) surfaces as
+// repository.ErrAlreadyExists.
+func NewGuestRepository(log logger.Logger, db *sqlkit.DB) corerepository.Repository[Guest, uuid.UUID] {
+	return corerepository.NewRepository[Guest, uuid.UUID](
+		log, db, guestsTable, guestColumns, corerepository.CacheOptions{},
+	)
+}
+
+// GuestStore holds the guest list query, which filters on the guest's ticket
+// status and custom fields and so cannot go through repository.ListOptions.
+type GuestStore interface {
+	// ListGuests returns a page of the event's live guests matching params'
+	// filters (custom field filters resolved against schema), in params' sort
+	// order (then by id), and the total number of matches.
+	ListGuests(ctx context.Context, eventID uuid.UUID, params *query.ListParams, schema Schema) ([]*Guest, int64, error)
+}
+
+// guestStore implements GuestStore on PostgreSQL.
+type guestStore struct {
+	db *sqlkit.DB
+}
+
+// NewGuestStore returns a GuestStore backed by db.
+func NewGuestStore(db *sqlkit.DB) GuestStore {
+	return &guestStore{db: db}
+}
+
+// ListGuests implements GuestStore.
+func (s *guestStore) ListGuests(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams, schema Schema,
+) ([]*Guest, int64, error) {
+	where, args, order := guestListSQL(eventID, params, schema)
+	from := ` FROM guests g
+		LEFT JOIN tickets t ON t.id = g.ticket_id AND t.deleted_at IS NULL
+		WHERE ` + where
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, "SELECT count(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	q := `SELECT g.id, g.event_id, g.name, g.email, g.phone, g.rsvp_status, g.ticket_id, g.group_id, g.is_plus_one,
+		g.custom_fields, g.created_at, g.updated_at, g.deleted_at` + from +
+		fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, len(args)+1, len(args)+2)
+	rows, err := conn.QueryContext(ctx, q, append(args, params.Size, (params.Page-1)*params.Size)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*Guest
+	for rows.Next() {
+		var g Guest
+		if err := rows.Scan(
+			&g.ID, &g.EventID, &g.Name, &g.Email, &g.Phone, &g.RSVPStatus, &g.TicketID, &g.GroupID, &g.IsPlusOne,
+			&g.CustomFields, &g.CreatedAt, &g.UpdatedAt, &g.DeletedAt,
+		); err != nil {
+			return nil, 0, err
+		}
+		items = append(items, &g)
+	}
+	return items, total, rows.Err()
+}
+
+// guestListSQL builds the WHERE conditions, bind values and ORDER BY shared by
+// the guest list and export queries over guests g joined to tickets t. $1 is
+// the event id; built-in filters and sorts come from guestListColumns, custom
+// field filters from schema.
+func guestListSQL(eventID uuid.UUID, params *query.ListParams, schema Schema) (where string, args []any, order string) {
+	clauses := query.ToSQL(params, guestListColumns, 2)
+	conds := append([]string{"g.event_id = $1", "g.deleted_at IS NULL"}, clauses.Where...)
+	args = append([]any{eventID}, clauses.Args...)
+	if params != nil {
+		custom := schema.filterSQL(params.Filters, "g.custom_fields", len(args)+1)
+		conds = append(conds, custom.Where...)
+		args = append(args, custom.Args...)
+	}
+	order = "g.id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", g.id"
+	}
+	return strings.Join(conds, " AND "), args, order
+}
@@ -0,0 +1,18 @@
+package guests
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitGuestRoutes registers guest CRUD routes on the given router. They are
+// registered one by one rather than as a sub-router so they coexist with the
+// import and export routes under the same /guests prefix.
+func InitGuestRoutes(r *chi.Mux, guestH *GuestHandler) {
+	r.Get("/api/v1/events/{eventId}/guests", problem.Handle(guestH.List))
+	r.Post("/api/v1/events/{eventId}/guests", problem.Handle(guestH.Create))
+	r.Get("/api/v1/events/{eventId}/guests/{guestId}", problem.Handle(guestH.GetByID))
+	r.Put("/api/v1/events/{eventId}/guests/{guestId}", problem.Handle(guestH.Update))
+	r.Delete("/api/v1/events/{eventId}/guests/{guestId}", problem.Handle(guestH.Delete))
+}
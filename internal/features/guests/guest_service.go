@@ -0,0 +1,289 @@
+package guests
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_guest_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GuestService
+
+// GuestService defines the application-level operations for an event's guests.
+// Custom field values are checked against the event's schema on every write.
+// A guest who declines or is deleted gives up their ticket or waitlist place,
+// and the next waitlisted guest is promoted. Creating a guest and changing
+// their RSVP are published to the tenant's webhooks.
+type GuestService interface {
+	Create(ctx context.Context, eventID uuid.UUID, in CreateGuestInput) (*Guest, error)
+	GetByID(ctx context.Context, eventID, id uuid.UUID) (*Guest, error)
+	Update(ctx context.Context, eventID, id uuid.UUID, in UpdateGuestInput, ifMatch string) (*Guest, error)
+	Delete(ctx context.Context, eventID, id uuid.UUID) error
+	List(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*common.PageResponse[Guest], error)
+}
+
+// CreateGuestInput is the input for adding a guest to an event.
+//
+// swagger:model CreateGuestInput
+type CreateGuestInput struct {
+	Name         string       `json:"name"                    validate:"required,max=255"`
+	Email        string       `json:"email"                   validate:"required,email,max=320"`
+	Phone        *string      `json:"phone,omitempty"         validate:"omitempty,max=32"`
+	RSVPStatus   string       `json:"rsvp_status,omitempty"   validate:"omitempty,oneof=none invited confirmed declined"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+}
+
+// UpdateGuestInput is the input for updating a guest. Only non-nil fields are
+// applied. CustomFields is merged into the stored values: a key set to null
+// removes that value.
+//
+// swagger:model UpdateGuestInput
+type UpdateGuestInput struct {
+	Name         *string      `json:"name,omitempty"          validate:"omitempty,min=1,max=255"`
+	Email        *string      `json:"email,omitempty"         validate:"omitempty,email,max=320"`
+	Phone        *string      `json:"phone,omitempty"         validate:"omitempty,max=32"`
+	RSVPStatus   *string      `json:"rsvp_status,omitempty"   validate:"omitempty,oneof=none invited confirmed declined"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+}
+
+// guestServiceImpl is the concrete implementation of GuestService.
+type guestServiceImpl struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	repo   corerepository.Repository[Guest, uuid.UUID]
+	store  GuestStore
+	fields FieldStore
+	issuer tickets.Issuer
+	hooks  webhooks.Publisher
+}
+
+// NewGuestService returns a GuestService with the given dependencies.
+func NewGuestService(
+	logger logger.Logger,
+	tx transaction.TxManager,
+	repo corerepository.Repository[Guest, uuid.UUID],
+	store GuestStore,
+	fields FieldStore,
+	issuer tickets.Issuer,
+	hooks webhooks.Publisher,
+) GuestService {
+	return &guestServiceImpl{
+		logger: logger, tx: tx, repo: repo, store: store, fields: fields, issuer: issuer, hooks: hooks,
+	}
+}
+
+// Create implements GuestService.
+func (s *guestServiceImpl) Create(ctx context.Context, eventID uuid.UUID, in CreateGuestInput) (*Guest, error) {
+	schema, err := loadSchema(ctx, s.logger, s.fields, eventID)
+	if err != nil {
+		return nil, err
+	}
+	custom, err := schema.Check(in.CustomFields)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("invalid custom fields: " + err.Error())
+	}
+
+	entity := &Guest{
+		ID:           uuid.New(),
+		EventID:      eventID,
+		Name:         in.Name,
+		Email:        in.Email,
+		Phone:        in.Phone,
+		RSVPStatus:   in.RSVPStatus,
+		CustomFields: custom,
+	}
+	if entity.RSVPStatus == "" {
+		entity.RSVPStatus = RSVPNone
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, entity); err != nil {
+			return err
+		}
+		return s.hooks.Publish(ctx, eventID, webhooks.GuestCreated, entity)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, errcode.GuestEmailTaken.New()
+		}
+		if errors.Is(err, repository.ErrInvalidEntity) {
+			return nil, errcode.UnprocessableEntity.WithMessage("invalid guest data")
+		}
+		s.logger.ErrorWithContext(ctx, "guest create failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to create guest")
+	}
+
+	s.logger.InfoWithContext(ctx, "guest created", logger.F("id", entity.ID), logger.F("event_id", eventID))
+	return entity, nil
+}
+
+// GetByID implements GuestService. A guest of another event is reported as
+// not found.
+func (s *guestServiceImpl) GetByID(ctx context.Context, eventID, id uuid.UUID) (*Guest, error) {
+	entity, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.GuestNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest get failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get guest")
+	}
+	if entity.EventID != eventID {
+		return nil, errcode.GuestNotFound.New()
+	}
+	return entity, nil
+}
+
+// Update implements GuestService. The merged custom field values are checked
+// as a whole, so values stored before a field was tightened must be fixed in
+// the same update. Declining invalidates the guest's active ticket. A non-empty
+// ifMatch (the request's If-Match) makes the write conditional on the guest's
+// version.
+func (s *guestServiceImpl) Update(
+	ctx context.Context, eventID, id uuid.UUID, in UpdateGuestInput, ifMatch string,
+) (*Guest, error) {
+	entity, err := s.GetByID(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := loadSchema(ctx, s.logger, s.fields, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Name != nil {
+		entity.Name = *in.Name
+	}
+	if in.Email != nil {
+		entity.Email = *in.Email
+	}
+	if in.Phone != nil {
+		entity.Phone = in.Phone
+	}
+	previous := entity.RSVPStatus
+	declined := in.RSVPStatus != nil && *in.RSVPStatus == RSVPDeclined && entity.RSVPStatus != RSVPDeclined
+	if in.RSVPStatus != nil {
+		entity.RSVPStatus = *in.RSVPStatus
+	}
+	merged := make(CustomFields, len(entity.CustomFields)+len(in.CustomFields))
+	for k, v := range entity.CustomFields {
+		// Values of fields deleted since are dropped rather than rejected.
+		if schema.find(k) != nil {
+			merged[k] = v
+		}
+	}
+	maps.Copy(merged, in.CustomFields)
+	custom, err := schema.Check(merged)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("invalid custom fields: " + err.Error())
+	}
+	entity.CustomFields = custom
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateIfMatch(ctx, id, entity, ifMatch); err != nil {
+			return err
+		}
+		if entity.RSVPStatus != previous {
+			change := RSVPChange{Guest: entity, PreviousStatus: previous}
+			if err := s.hooks.Publish(ctx, eventID, webhooks.GuestRSVPChanged, change); err != nil {
+				return err
+			}
+		}
+		if !declined {
+			return nil
+		}
+		return s.issuer.Withdraw(ctx, eventID, []uuid.UUID{id})
+	})
+	if err != nil {
+		var ez *errorz.Error
+		if errors.As(err, &ez) {
+			return nil, ez
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.GuestNotFound.New()
+		}
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, errcode.GuestEmailTaken.New()
+		}
+		if errors.Is(err, corerepository.ErrVersionMismatch) {
+			return nil, errcode.VersionMismatch.WithMessage("guest was modified by someone else")
+		}
+		s.logger.ErrorWithContext(ctx, "guest update failed", logger.F("id", id), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update guest")
+	}
+
+	s.logger.InfoWithContext(ctx, "guest updated", logger.F("id", id))
+	return entity, nil
+}
+
+// Delete implements GuestService. The guest's active ticket is invalidated.
+func (s *guestServiceImpl) Delete(ctx context.Context, eventID, id uuid.UUID) error {
+	if _, err := s.GetByID(ctx, eventID, id); err != nil {
+		return err
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.issuer.Withdraw(ctx, eventID, []uuid.UUID{id}); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, id)
+	})
+	if err != nil {
+		var ez *errorz.Error
+		if errors.As(err, &ez) {
+			return ez
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.GuestNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest delete failed", logger.F("id", id), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to delete guest")
+	}
+	s.logger.InfoWithContext(ctx, "guest deleted", logger.F("id", id))
+	return nil
+}
+
+// List implements GuestService. cf.<key> filters must name a field of the
+// event's schema and carry a value of its type; group_id must be a UUID.
+func (s *guestServiceImpl) List(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[Guest], error) {
+	schema, err := loadSchema(ctx, s.logger, s.fields, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGuestFilters(schema, params.Filters); err != nil {
+		return nil, err
+	}
+	items, total, err := s.store.ListGuests(ctx, eventID, params, schema)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "guest list failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to list guests")
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// checkGuestFilters rejects guest list filters the SQL can't bind: a group_id
+// that is not a UUID, and cf.<key> filters schema does not accept.
+func checkGuestFilters(schema Schema, filters map[string]string) error {
+	if v, ok := filters["group_id"]; ok {
+		if _, err := uuid.Parse(v); err != nil {
+			return errorz.BadRequest().WithMessage("group_id must be a UUID")
+		}
+	}
+	if err := schema.CheckFilters(filters); err != nil {
+		return errorz.BadRequest().WithMessage(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package guests_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mockcorerepository "github.com/biairmal/guest-management-be/mocks/core/repository"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+// guestSchema is the custom field schema the guest service tests run against.
+func guestSchema() guests.Schema {
+	return guests.Schema{
+		{Key: "table", Type: guests.FieldTypeNumber, Required: true},
+		{Key: "vip", Type: guests.FieldTypeBoolean},
+	}
+}
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+func TestGuestService_Create(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name      string
+		custom    guests.CustomFields
+		schemaErr error
+		createErr error
+		wantCode  string
+	}{
+		{name: "created", custom: guests.CustomFields{"table": 3.0, "vip": true}},
+		{name: "event not found", schemaErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "required custom field missing", custom: guests.CustomFields{"vip": true}, wantCode: errorz.CodeBadRequest},
+		{name: "custom field of the wrong type", custom: guests.CustomFields{"table": "three"}, wantCode: errorz.CodeBadRequest},
+		{
+			name:      "email taken in the event",
+			custom:    guests.CustomFields{"table": 3.0},
+			createErr: repository.ErrAlreadyExists,
+			wantCode:  errorz.CodeConflict,
+		},
+		{
+			name:      "create failure",
+			custom:    guests.CustomFields{"table": 3.0},
+			createErr: errors.New("boom"),
+			wantCode:  errorz.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.Guest, uuid.UUID](ctrl)
+			fields := mockguests.NewMockFieldStore(ctrl)
+			fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(guestSchema(), tt.schemaErr)
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, g *guests.Guest) error {
+					if g.EventID != eventID || g.RSVPStatus != guests.RSVPNone {
+						t.Errorf("guest = %+v, want event %v and rsvp none", g, eventID)
+					}
+					return tt.createErr
+				}).MaxTimes(1)
+			hooks := mockwebhooks.NewMockPublisher(ctrl)
+			published := 0
+			if tt.wantCode == "" {
+				published = 1
+			}
+			hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.GuestCreated, gomock.Any()).Return(nil).Times(published)
+
+			svc := guests.NewGuestService(
+				logger.NewNoOp(), inlineTx(ctrl), repo, mockguests.NewMockGuestStore(ctrl), fields, nil, hooks)
+			_, err := svc.Create(context.Background(), eventID, guests.CreateGuestInput{
+				Name: "Ann", Email: "ann@x.io", CustomFields: tt.custom,
+			})
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestGuestService_Update(t *testing.T) {
+	eventID, id := uuid.New(), uuid.New()
+	declined := guests.RSVPDeclined
+	tests := []struct {
+		name         string
+		stored       guests.CustomFields
+		storedRSVP   string
+		in           guests.CustomFields
+		rsvp         *string
+		storedIn     uuid.UUID // event of the stored guest
+		updateErr    error
+		wantCustom   guests.CustomFields
+		wantWithdraw bool
+		wantCode     string
+	}{
+		{
+			name:         "declining withdraws the ticket",
+			stored:       guests.CustomFields{"table": 1.0},
+			storedRSVP:   guests.RSVPConfirmed,
+			rsvp:         &declined,
+			wantCustom:   guests.CustomFields{"table": 1.0},
+			wantWithdraw: true,
+		},
+		{
+			name:       "declining again withdraws nothing",
+			stored:     guests.CustomFields{"table": 1.0},
+			storedRSVP: guests.RSVPDeclined,
+			rsvp:       &declined,
+			wantCustom: guests.CustomFields{"table": 1.0},
+		},
+		{
+			name:       "custom fields are merged",
+			stored:     guests.CustomFields{"table": 1.0, "vip": true},
+			in:         guests.CustomFields{"table": 2.0},
+			wantCustom: guests.CustomFields{"table": 2.0, "vip": true},
+		},
+		{
+			name:       "null removes a value and deleted fields are dropped",
+			stored:     guests.CustomFields{"table": 1.0, "vip": true, "retired": "x"},
+			in:         guests.CustomFields{"vip": nil},
+			wantCustom: guests.CustomFields{"table": 1.0},
+		},
+		{
+			name:     "removing a required value",
+			stored:   guests.CustomFields{"table": 1.0},
+			in:       guests.CustomFields{"table": nil},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{name: "guest of another event", storedIn: uuid.New(), wantCode: errorz.CodeNotFound},
+		{
+			name:      "email taken in the event",
+			stored:    guests.CustomFields{"table": 1.0},
+			updateErr: repository.ErrAlreadyExists,
+			wantCode:  errorz.CodeConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.Guest, uuid.UUID](ctrl)
+			fields := mockguests.NewMockFieldStore(ctrl)
+			owner := eventID
+			if tt.storedIn != uuid.Nil {
+				owner = tt.storedIn
+			}
+			repo.EXPECT().GetByID(gomock.Any(), id).Return(
+				&guests.Guest{ID: id, EventID: owner, RSVPStatus: tt.storedRSVP, CustomFields: tt.stored}, nil)
+			fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(guestSchema(), nil).MaxTimes(1)
+			repo.EXPECT().UpdateIfMatch(gomock.Any(), id, gomock.Any(), "").Return(tt.updateErr).MaxTimes(1)
+			issuer := mocktickets.NewMockIssuer(ctrl)
+			withdrawn := false
+			issuer.EXPECT().Withdraw(gomock.Any(), eventID, []uuid.UUID{id}).DoAndReturn(
+				func(context.Context, uuid.UUID, []uuid.UUID) error {
+					withdrawn = true
+					return nil
+				}).MaxTimes(1)
+
+			hooks := mockwebhooks.NewMockPublisher(ctrl)
+			published := 0
+			if tt.wantCode == "" && tt.rsvp != nil && *tt.rsvp != tt.storedRSVP {
+				published = 1
+			}
+			hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.GuestRSVPChanged, gomock.Any()).
+				Return(nil).Times(published)
+
+			svc := guests.NewGuestService(
+				logger.NewNoOp(), inlineTx(ctrl), repo, mockguests.NewMockGuestStore(ctrl), fields, issuer, hooks)
+			got, err := svc.Update(context.Background(), eventID, id,
+				guests.UpdateGuestInput{RSVPStatus: tt.rsvp, CustomFields: tt.in}, "")
+			assertErrorzCode(t, err, tt.wantCode)
+			if withdrawn != tt.wantWithdraw {
+				t.Errorf("withdrawn = %v, want %v", withdrawn, tt.wantWithdraw)
+			}
+			if tt.wantCode == "" && !reflect.DeepEqual(got.CustomFields, tt.wantCustom) {
+				t.Errorf("custom fields = %v, want %v", got.CustomFields, tt.wantCustom)
+			}
+		})
+	}
+}
+
+func TestGuestService_Delete(t *testing.T) {
+	eventID, id := uuid.New(), uuid.New()
+	tests := []struct {
+		name        string
+		withdrawErr error
+		deleteErr   error
+		wantDelete  bool
+		wantCode    string
+	}{
+		{name: "ticket withdrawn and guest deleted", wantDelete: true},
+		{name: "withdraw failure keeps the guest", withdrawErr: errorz.Internal(), wantCode: errorz.CodeInternal},
+		{name: "deleted meanwhile", deleteErr: repository.ErrNotFound, wantDelete: true, wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.Guest, uuid.UUID](ctrl)
+			issuer := mocktickets.NewMockIssuer(ctrl)
+			repo.EXPECT().GetByID(gomock.Any(), id).Return(&guests.Guest{ID: id, EventID: eventID}, nil)
+			issuer.EXPECT().Withdraw(gomock.Any(), eventID, []uuid.UUID{id}).Return(tt.withdrawErr)
+			deleted := false
+			repo.EXPECT().Delete(gomock.Any(), id).DoAndReturn(func(context.Context, uuid.UUID) error {
+				deleted = true
+				return tt.deleteErr
+			}).MaxTimes(1)
+
+			svc := guests.NewGuestService(logger.NewNoOp(), inlineTx(ctrl), repo, nil, nil, issuer, nil)
+			err := svc.Delete(context.Background(), eventID, id)
+			assertErrorzCode(t, err, tt.wantCode)
+			if deleted != tt.wantDelete {
+				t.Errorf("deleted = %v, want %v", deleted, tt.wantDelete)
+			}
+		})
+	}
+}
+
+func TestGuestService_List(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name     string
+		filters  map[string]string
+		listErr  error
+		wantCode string
+	}{
+		{name: "listed", filters: map[string]string{"cf.vip": "true"}},
+		{name: "unknown custom field filter", filters: map[string]string{"cf.seat": "A1"}, wantCode: errorz.CodeBadRequest},
+		{name: "list failure", listErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockguests.NewMockGuestStore(ctrl)
+			fields := mockguests.NewMockFieldStore(ctrl)
+			params := &query.ListParams{Filters: tt.filters}
+			params.Page, params.Size = 1, 20
+			fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(guestSchema(), nil)
+			store.EXPECT().ListGuests(gomock.Any(), eventID, params, guestSchema()).
+				Return([]*guests.Guest{{ID: uuid.New()}}, int64(1), tt.listErr).MaxTimes(1)
+
+			svc := guests.NewGuestService(logger.NewNoOp(), nil, nil, store, fields, nil, nil)
+			page, err := svc.List(context.Background(), eventID, params)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && page.Total != 1 {
+				t.Errorf("total = %d, want 1", page.Total)
+			}
+		})
+	}
+}
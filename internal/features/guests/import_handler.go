@@ -0,0 +1,177 @@
+package guests
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// ImportHandler exposes HTTP handlers for bulk guest imports.
+type ImportHandler struct {
+	service   ImportService
+	validator validation.Validator
+	cfg       HandlerConfig
+}
+
+// NewImportHandler returns an ImportHandler that uses the given service and
+// validator. cfg.MaxUploadBytes caps the multipart body (see InitImportRoutes).
+func NewImportHandler(service ImportService, validator validation.Validator, cfg HandlerConfig) *ImportHandler {
+	return &ImportHandler{service: service, validator: validator, cfg: cfg}
+}
+
+// Start handles POST /events/{eventId}/guests/imports.
+//
+// Start godoc
+//
+//	@Summary		Import guests
+//	@Description	Uploads a CSV or XLSX guest list (multipart field "file") and starts a background import. Columns are mapped by the "mapping" field (JSON ColumnMapping), else the tenant's saved mapping, else headers named name/email/phone; custom fields are read from the headers given in mapping.custom_fields, else from headers equal to their keys. save_mapping=true stores the given mapping for the tenant. Rows are validated (custom field cells against the event's guest fields) and deduplicated by email within the event; poll the returned import for progress.
+//	@Tags			guest-imports
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			eventId			path		string	true	"Event UUID"
+//	@Param			file			formData	file	true	"Guest list (.csv or .xlsx)"
+//	@Param			mapping			formData	string	false	"JSON column mapping, e.g. {\"name\":\"Full Name\",\"email\":\"E-mail\"}"
+//	@Param			save_mapping	formData	bool	false	"Save mapping as the tenant's default"
+//	@Success		201				{object}	guests.GuestImport
+//	@Failure		400				{object}	problem.Problem	"Invalid or oversized upload, file type, mapping or header"
+//	@Failure		404				{object}	problem.Problem	"Event not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Failure		503				{object}	problem.Problem	"Import workers unavailable"
+//	@Router			/api/v1/events/{eventId}/guests/imports [post]
+func (h *ImportHandler) Start(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, errorz.BadRequest().WithMessage(fmt.Sprintf("upload exceeds %d bytes", h.cfg.MaxUploadBytes))
+		}
+		return nil, errorz.BadRequest().WithMessage(`multipart field "file" is required`)
+	}
+	defer func() { _ = file.Close() }()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("invalid upload")
+	}
+
+	in := StartImportInput{Filename: header.Filename, Data: data}
+	if raw := r.FormValue("mapping"); raw != "" {
+		var mapping ColumnMapping
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			return nil, errorz.BadRequest().WithMessage("invalid mapping")
+		}
+		if err := h.validator.Struct(mapping); err != nil {
+			return nil, err
+		}
+		in.Mapping = &mapping
+	}
+	if raw := r.FormValue("save_mapping"); raw != "" {
+		if in.SaveMapping, err = strconv.ParseBool(raw); err != nil {
+			return nil, errorz.BadRequest().WithMessage("invalid save_mapping value")
+		}
+		if in.SaveMapping && in.Mapping == nil {
+			return nil, errorz.BadRequest().WithMessage("save_mapping requires mapping")
+		}
+	}
+
+	job, err := h.service.Start(r.Context(), eventID, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(job), nil
+}
+
+// Get handles GET /events/{eventId}/guests/imports/{importId}.
+//
+// Get godoc
+//
+//	@Summary		Get guest import status
+//	@Description	Returns an import's status (queued, running, completed, failed) and row counts.
+//	@Tags			guest-imports
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			importId	path		string	true	"Import UUID"
+//	@Success		200			{object}	guests.GuestImport
+//	@Failure		400			{object}	problem.Problem	"Invalid ID format"
+//	@Failure		404			{object}	problem.Problem	"Guest import not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/imports/{importId} [get]
+func (h *ImportHandler) Get(r *http.Request) (any, error) {
+	job, err := h.getFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(job), nil
+}
+
+// ErrorReport handles GET /events/{eventId}/guests/imports/{importId}/errors.
+// It answers with a CSV download rather than the JSON envelope, so it is a
+// plain http.HandlerFunc; errors still go through problem.Write.
+//
+// ErrorReport godoc
+//
+//	@Summary		Download guest import error report
+//	@Description	CSV of every rejected row: row number in the uploaded sheet, email and reason. Header only while the import is queued or running.
+//	@Tags			guest-imports
+//	@Produce		text/csv
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			importId	path		string	true	"Import UUID"
+//	@Success		200			{file}		file	"CSV error report"
+//	@Failure		400			{object}	problem.Problem	"Invalid ID format"
+//	@Failure		404			{object}	problem.Problem	"Guest import not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/imports/{importId}/errors [get]
+func (h *ImportHandler) ErrorReport(w http.ResponseWriter, r *http.Request) {
+	job, err := h.getFromPath(r)
+	if err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+	name := strings.TrimSuffix(job.Filename, "."+job.Format) + "-errors.csv"
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+strings.ReplaceAll(name, `"`, "")+`"`)
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"row", "email", "reason"})
+	for _, e := range job.RowErrors {
+		_ = cw.Write([]string{strconv.Itoa(e.Row), e.Email, e.Reason})
+	}
+	cw.Flush()
+}
+
+// getFromPath loads the import named by the eventId/importId path parameters.
+func (h *ImportHandler) getFromPath(r *http.Request) (*GuestImport, error) {
+	eventID, importID, err := importPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.service.Get(r.Context(), eventID, importID)
+}
+
+// importPathIDs parses the eventId and importId path parameters.
+func importPathIDs(r *http.Request) (eventID, importID uuid.UUID, err error) {
+	if eventID, err = uuid.Parse(chi.URLParam(r, "eventId")); err != nil {
+		return eventID, importID, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	if importID, err = uuid.Parse(chi.URLParam(r, "importId")); err != nil {
+		return eventID, importID, errcode.InvalidID.WithMessage("invalid guest import id")
+	}
+	return eventID, importID, nil
+}
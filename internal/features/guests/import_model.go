@@ -0,0 +1,108 @@
+package guests
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ImportStatusQueued is an import waiting for a background worker.
+	ImportStatusQueued = "queued"
+	// ImportStatusRunning is an import being processed.
+	ImportStatusRunning = "running"
+	// ImportStatusCompleted is an import that processed every row; some rows may
+	// still have been rejected (see RejectedRows and the error report).
+	ImportStatusCompleted = "completed"
+	// ImportStatusFailed is an import aborted by an unexpected error.
+	ImportStatusFailed = "failed"
+)
+
+// ColumnMapping maps guest fields to spreadsheet header names. Header
+// matching is case-insensitive. Phone is optional. CustomFields maps custom
+// field keys to headers; a field not listed is read from a header equal to
+// its key, when present.
+//
+// swagger:model ColumnMapping
+type ColumnMapping struct {
+	Name         string            `json:"name"                    validate:"required"`
+	Email        string            `json:"email"                   validate:"required"`
+	Phone        string            `json:"phone,omitempty"`
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+}
+
+// defaultColumnMapping is used when neither the request nor the tenant
+// provides one: headers named after the fields themselves.
+var defaultColumnMapping = ColumnMapping{Name: "name", Email: "email", Phone: "phone"}
+
+// ImportRowError describes one rejected row. Row is the 1-based row number in
+// the uploaded sheet, header included, so it matches what the user sees.
+//
+// swagger:model ImportRowError
+type ImportRowError struct {
+	Row    int    `json:"row"`
+	Email  string `json:"email,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// ImportRowErrors is stored as JSONB in guest_imports.row_errors.
+type ImportRowErrors []ImportRowError
+
+// Value implements driver.Valuer.
+func (e ImportRowErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+func (e *ImportRowErrors) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	default:
+		return errors.New("guests: unsupported row_errors type")
+	}
+}
+
+// GuestImport represents a row in the guest_imports table: one uploaded file
+// and the progress of the background job importing it. Rejected rows are
+// served separately as a CSV error report rather than inlined here.
+//
+// swagger:model GuestImport
+type GuestImport struct {
+	ID           uuid.UUID       `json:"id" db:"id"`
+	EventID      uuid.UUID       `json:"event_id" db:"event_id"`
+	TenantID     uuid.UUID       `json:"tenant_id" db:"tenant_id"`
+	Filename     string          `json:"filename" db:"filename"`
+	Format       string          `json:"format" db:"format"`
+	Status       string          `json:"status" db:"status"`
+	TotalRows    int             `json:"total_rows" db:"total_rows"`
+	ImportedRows int             `json:"imported_rows" db:"imported_rows"`
+	RejectedRows int             `json:"rejected_rows" db:"rejected_rows"`
+	RowErrors    ImportRowErrors `json:"-" db:"row_errors"`
+	Failure      *string         `json:"failure,omitempty" db:"failure"`
+	StartedAt    *time.Time      `json:"started_at,omitempty" db:"started_at"`
+	FinishedAt   *time.Time      `json:"finished_at,omitempty" db:"finished_at"`
+	CreatedAt    time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at" db:"updated_at"`
+	DeletedAt    *time.Time      `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+// TableName returns the database table name.
+func (GuestImport) TableName() string {
+	return "guest_imports"
+}
@@ -0,0 +1,182 @@
+package guests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_import_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ImportStore
+
+const guestImportsTable = "guest_imports"
+
+// guestImportColumns are the columns selected on reads (GetByID, List).
+var guestImportColumns = []string{
+	"id", "event_id", "tenant_id", "filename", "format", "status",
+	"total_rows", "imported_rows", "rejected_rows", "row_errors", "failure",
+	"started_at", "finished_at", "created_at", "updated_at", "deleted_at",
+}
+
+// NewImportRepository returns a soft-delete-aware repository for guest import
+// jobs. Jobs are written by the background worker while clients poll them, so
+// they are never cached.
+func NewImportRepository(log logger.Logger, db *sqlkit.DB) corerepository.Repository[GuestImport, uuid.UUID] {
+	return corerepository.NewRepository[GuestImport, uuid.UUID](
+		log, db, guestImportsTable, guestImportColumns, corerepository.CacheOptions{},
+	)
+}
+
+// ImportStore holds the hand-written queries an import needs beyond generic
+// CRUD: set-based reads and the batched insert.
+type ImportStore interface {
+	// EventTenant returns the tenant owning a live event, or repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// ExistingEmails returns the lower-cased emails of the event's live guests.
+	ExistingEmails(ctx context.Context, eventID uuid.UUID) (map[string]struct{}, error)
+	// InsertGuests inserts guests in one statement, skipping any whose email
+	// already exists in the event, and returns the lower-cased emails inserted.
+	InsertGuests(ctx context.Context, guests []*Guest) (map[string]struct{}, error)
+	// Mapping returns the tenant's saved column mapping, or nil when none is saved.
+	Mapping(ctx context.Context, tenantID uuid.UUID) (*ColumnMapping, error)
+	// SaveMapping stores m as the tenant's column mapping.
+	SaveMapping(ctx context.Context, tenantID uuid.UUID, m ColumnMapping) error
+	// FailStale marks queued and running imports last updated before before
+	// as failed with the given reason, and returns how many it marked.
+	FailStale(ctx context.Context, before time.Time, failure string) (int64, error)
+}
+
+// importStore implements ImportStore on PostgreSQL.
+type importStore struct {
+	db *sqlkit.DB
+}
+
+// NewImportStore returns an ImportStore backed by db.
+func NewImportStore(db *sqlkit.DB) ImportStore {
+	return &importStore{db: db}
+}
+
+// EventTenant implements ImportStore.
+func (s *importStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// ExistingEmails implements ImportStore.
+func (s *importStore) ExistingEmails(ctx context.Context, eventID uuid.UUID) (map[string]struct{}, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx,
+		"SELECT lower(email) FROM guests WHERE event_id = $1 AND deleted_at IS NULL", eventID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	emails := make(map[string]struct{})
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		emails[email] = struct{}{}
+	}
+	return emails, rows.Err()
+}
+
+// InsertGuests implements ImportStore. The ON CONFLICT target is the partial
+// unique index ux_guests_event_email, so a guest added concurrently (by hand
+// or by another import) is skipped instead of failing the whole batch.
+func (s *importStore) InsertGuests(ctx context.Context, guests []*Guest) (map[string]struct{}, error) {
+	inserted := make(map[string]struct{}, len(guests))
+	if len(guests) == 0 {
+		return inserted, nil
+	}
+
+	const cols = 7
+	values := make([]string, len(guests))
+	args := make([]any, 0, len(guests)*cols)
+	for i, g := range guests {
+		n := i * cols
+		values[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5, n+6, n+7)
+		args = append(args, g.ID, g.EventID, g.Name, g.Email, g.Phone, g.RSVPStatus, g.CustomFields)
+	}
+	query := "INSERT INTO guests (id, event_id, name, email, phone, rsvp_status, custom_fields) VALUES " +
+		strings.Join(values, ", ") +
+		" ON CONFLICT (event_id, lower(email)) WHERE deleted_at IS NULL DO NOTHING RETURNING lower(email)"
+
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		inserted[email] = struct{}{}
+	}
+	return inserted, rows.Err()
+}
+
+// Mapping implements ImportStore.
+func (s *importStore) Mapping(ctx context.Context, tenantID uuid.UUID) (*ColumnMapping, error) {
+	var raw []byte
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT mapping FROM guest_import_mappings WHERE tenant_id = $1", tenantID,
+	).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m ColumnMapping
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// SaveMapping implements ImportStore.
+func (s *importStore) SaveMapping(ctx context.Context, tenantID uuid.UUID, m ColumnMapping) error {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		`INSERT INTO guest_import_mappings (tenant_id, mapping) VALUES ($1, $2)
+		 ON CONFLICT (tenant_id) DO UPDATE SET mapping = EXCLUDED.mapping, updated_at = now()`,
+		tenantID, string(raw),
+	)
+	return err
+}
+
+// FailStale implements ImportStore.
+func (s *importStore) FailStale(ctx context.Context, before time.Time, failure string) (int64, error) {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		`UPDATE guest_imports SET status = $1, failure = $2, finished_at = now(), updated_at = now()
+		 WHERE status IN ($3, $4) AND updated_at < $5 AND deleted_at IS NULL`,
+		ImportStatusFailed, failure, ImportStatusQueued, ImportStatusRunning, before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
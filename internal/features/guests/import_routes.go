@@ -0,0 +1,18 @@
+package guests
+
+import (
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitImportRoutes registers guest import routes on the given router. Uploads
+// are capped at the handler's MaxUploadBytes before the multipart form is parsed.
+func InitImportRoutes(r *chi.Mux, importH *ImportHandler) {
+	r.Route("/api/v1/events/{eventId}/guests/imports", func(r chi.Router) {
+		r.With(chiMiddleware.RequestSize(importH.cfg.MaxUploadBytes)).Post("/", problem.Handle(importH.Start))
+		r.Get("/{importId}", problem.Handle(importH.Get))
+		r.Get("/{importId}/errors", importH.ErrorReport)
+	})
+}
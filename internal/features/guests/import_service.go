@@ -0,0 +1,414 @@
+package guests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/background"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/core/tabular"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/guests/mock_import_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ImportService
+
+// ImportService runs bulk guest imports from uploaded spreadsheets.
+type ImportService interface {
+	// Start validates the upload's shape (format, header, size) synchronously,
+	// records a queued import and hands the rows to a background worker.
+	Start(ctx context.Context, eventID uuid.UUID, in StartImportInput) (*GuestImport, error)
+	// Get returns an import of the given event, including its row errors.
+	Get(ctx context.Context, eventID, importID uuid.UUID) (*GuestImport, error)
+	// FailInterrupted marks imports whose worker died with its instance
+	// (queued or running without progress for ImportConfig.StaleAfter) as
+	// failed, so clients polling them stop waiting. Call it on startup.
+	FailInterrupted(ctx context.Context) error
+}
+
+// StartImportInput is an uploaded guest list.
+type StartImportInput struct {
+	Filename string
+	Data     []byte
+	// Mapping overrides the tenant's saved mapping for this import; nil uses
+	// the saved one, or header names equal to the field names.
+	Mapping *ColumnMapping
+	// SaveMapping stores Mapping as the tenant's mapping for later imports.
+	SaveMapping bool
+}
+
+// ImportRow is one spreadsheet row mapped onto guest fields; its tags are
+// what every row is validated against.
+type ImportRow struct {
+	Name  string `json:"name"            validate:"required,max=255"`
+	Email string `json:"email"           validate:"required,email,max=320"`
+	Phone string `json:"phone,omitempty" validate:"omitempty,max=32"`
+}
+
+// interruptedFailure is recorded on imports whose worker stopped mid-way;
+// the rows must be uploaded again.
+const interruptedFailure = "import interrupted by a server restart; upload the file again"
+
+// importServiceImpl is the concrete implementation of ImportService.
+type importServiceImpl struct {
+	logger    logger.Logger
+	validator validation.Validator
+	repo      corerepository.Repository[GuestImport, uuid.UUID]
+	store     ImportStore
+	fields    FieldStore
+	runner    *background.Runner
+	cfg       ImportConfig
+}
+
+// NewImportService returns an ImportService that processes imports on runner.
+func NewImportService(
+	logger logger.Logger,
+	validator validation.Validator,
+	repo corerepository.Repository[GuestImport, uuid.UUID],
+	store ImportStore,
+	fields FieldStore,
+	runner *background.Runner,
+	cfg ImportConfig,
+) ImportService {
+	return &importServiceImpl{
+		logger: logger, validator: validator, repo: repo, store: store, fields: fields, runner: runner, cfg: cfg,
+	}
+}
+
+// columnIndexes are the positions of the mapped columns in the header; phone
+// is -1 when not mapped. custom holds the position of each custom field found
+// in the header, by key.
+type columnIndexes struct {
+	name, email, phone int
+	custom             map[string]int
+}
+
+// numberedRow is a non-blank data row with its 1-based sheet row number.
+type numberedRow struct {
+	number int
+	cells  []string
+}
+
+// Start implements ImportService.
+func (s *importServiceImpl) Start(ctx context.Context, eventID uuid.UUID, in StartImportInput) (*GuestImport, error) {
+	format, err := tabular.FormatFromFilename(in.Filename)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("unsupported file type; upload a .csv or .xlsx file")
+	}
+
+	tenantID, err := s.store.EventTenant(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest import event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to start guest import")
+	}
+
+	sheet, err := tabular.ReadAll(format, in.Data)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("file could not be read as " + string(format))
+	}
+	if len(sheet) == 0 {
+		return nil, errorz.BadRequest().WithMessage("file is empty")
+	}
+	rows := dataRows(sheet)
+	if len(rows) > s.cfg.MaxRows {
+		return nil, errorz.BadRequest().WithMessage(fmt.Sprintf("file has %d rows; at most %d are allowed", len(rows), s.cfg.MaxRows))
+	}
+
+	mapping, err := s.resolveMapping(ctx, tenantID, in)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := loadSchema(ctx, s.logger, s.fields, eventID)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := mapping.indexes(sheet[0], schema)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+
+	job := &GuestImport{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		TenantID:  tenantID,
+		Filename:  in.Filename,
+		Format:    string(format),
+		Status:    ImportStatusQueued,
+		TotalRows: len(rows),
+	}
+	if err := s.repo.Create(ctx, job); err != nil {
+		s.logger.ErrorWithContext(ctx, "guest import create failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to start guest import")
+	}
+
+	queued := *job
+	if err := s.runner.Go(ctx, "guest-import", func(jobCtx context.Context) {
+		s.process(jobCtx, job, rows, cols, schema)
+	}); err != nil {
+		s.fail(ctx, job, err)
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeServiceUnavailable).WithMessage("guest imports are unavailable, try again later")
+	}
+
+	s.logger.InfoWithContext(ctx, "guest import queued",
+		logger.F("id", job.ID), logger.F("event_id", eventID), logger.F("rows", len(rows)))
+	return &queued, nil
+}
+
+// Get implements ImportService.
+func (s *importServiceImpl) Get(ctx context.Context, eventID, importID uuid.UUID) (*GuestImport, error) {
+	job, err := s.repo.GetByID(ctx, importID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.GuestImportNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "guest import get failed", logger.F("id", importID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get guest import")
+	}
+	if job.EventID != eventID {
+		return nil, errcode.GuestImportNotFound.New()
+	}
+	return job, nil
+}
+
+// FailInterrupted implements ImportService. The file is not kept once the
+// upload request ends, so an interrupted import cannot be resumed; the guests
+// inserted before the interruption stay, and ImportedRows says how many.
+func (s *importServiceImpl) FailInterrupted(ctx context.Context) error {
+	n, err := s.store.FailStale(ctx, time.Now().Add(-s.cfg.StaleAfter), interruptedFailure)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "guest import sweep failed", logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to fail interrupted guest imports")
+	}
+	if n > 0 {
+		s.logger.WarnWithContext(ctx, "interrupted guest imports marked failed", logger.F("count", n))
+	}
+	return nil
+}
+
+// resolveMapping picks the request's mapping, else the tenant's saved one,
+// else the default, and saves the request's mapping when asked to.
+func (s *importServiceImpl) resolveMapping(
+	ctx context.Context, tenantID uuid.UUID, in StartImportInput,
+) (ColumnMapping, error) {
+	if in.Mapping != nil {
+		if in.SaveMapping {
+			if err := s.store.SaveMapping(ctx, tenantID, *in.Mapping); err != nil {
+				s.logger.ErrorWithContext(ctx, "guest import mapping save failed",
+					logger.F("tenant_id", tenantID), logger.F("error", err))
+				return ColumnMapping{}, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to save column mapping")
+			}
+		}
+		return *in.Mapping, nil
+	}
+	saved, err := s.store.Mapping(ctx, tenantID)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "guest import mapping load failed",
+			logger.F("tenant_id", tenantID), logger.F("error", err))
+		return ColumnMapping{}, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to load column mapping")
+	}
+	if saved != nil {
+		return *saved, nil
+	}
+	return defaultColumnMapping, nil
+}
+
+// process validates, dedupes and inserts rows in batches, then records the
+// outcome on job. Custom field cells are checked against schema, the event's
+// fields when the import started. It runs on the background runner.
+func (s *importServiceImpl) process(
+	ctx context.Context, job *GuestImport, rows []numberedRow, cols columnIndexes, schema Schema,
+) {
+	startedAt := time.Now()
+	job.Status = ImportStatusRunning
+	job.StartedAt = &startedAt
+	if err := s.repo.Update(ctx, job.ID, job); err != nil {
+		s.fail(ctx, job, err)
+		return
+	}
+
+	seen, err := s.store.ExistingEmails(ctx, job.EventID)
+	if err != nil {
+		s.fail(ctx, job, err)
+		return
+	}
+
+	var rejected ImportRowErrors
+	batch := make([]*Guest, 0, s.cfg.BatchSize)
+	batchRows := make([]int, 0, s.cfg.BatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		inserted, err := s.store.InsertGuests(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for i, g := range batch {
+			if _, ok := inserted[strings.ToLower(g.Email)]; ok {
+				job.ImportedRows++
+				continue
+			}
+			rejected = append(rejected, ImportRowError{
+				Row: batchRows[i], Email: g.Email, Reason: "a guest with this email already exists in the event",
+			})
+		}
+		batch, batchRows = batch[:0], batchRows[:0]
+		// Record progress: it is what tells FailInterrupted the job is alive.
+		job.RejectedRows = len(rejected)
+		return s.repo.Update(ctx, job.ID, job)
+	}
+
+	for _, row := range rows {
+		in, cells := cols.row(row.cells)
+		if err := s.validator.Struct(in); err != nil {
+			rejected = append(rejected, ImportRowError{Row: row.number, Email: in.Email, Reason: err.Error()})
+			continue
+		}
+		custom, err := schema.Parse(cells)
+		if err != nil {
+			rejected = append(rejected, ImportRowError{Row: row.number, Email: in.Email, Reason: err.Error()})
+			continue
+		}
+		key := strings.ToLower(in.Email)
+		if _, dup := seen[key]; dup {
+			rejected = append(rejected, ImportRowError{
+				Row: row.number, Email: in.Email, Reason: "duplicate email in the event or earlier in the file",
+			})
+			continue
+		}
+		seen[key] = struct{}{}
+
+		guest := &Guest{
+			ID: uuid.New(), EventID: job.EventID, Name: in.Name, Email: in.Email, RSVPStatus: RSVPNone, CustomFields: custom,
+		}
+		if in.Phone != "" {
+			phone := in.Phone
+			guest.Phone = &phone
+		}
+		batch = append(batch, guest)
+		batchRows = append(batchRows, row.number)
+		if len(batch) == s.cfg.BatchSize {
+			if err := flush(); err != nil {
+				s.fail(ctx, job, err)
+				return
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		s.fail(ctx, job, err)
+		return
+	}
+
+	finishedAt := time.Now()
+	job.Status = ImportStatusCompleted
+	job.RejectedRows = len(rejected)
+	job.RowErrors = rejected
+	job.FinishedAt = &finishedAt
+	if err := s.repo.Update(ctx, job.ID, job); err != nil {
+		s.logger.ErrorWithContext(ctx, "guest import result save failed", logger.F("id", job.ID), logger.F("error", err))
+		return
+	}
+	s.logger.InfoWithContext(ctx, "guest import completed", logger.F("id", job.ID),
+		logger.F("imported", job.ImportedRows), logger.F("rejected", job.RejectedRows))
+}
+
+// fail records an unexpected error on job. Guests already inserted by earlier
+// batches stay; ImportedRows says how many.
+func (s *importServiceImpl) fail(ctx context.Context, job *GuestImport, cause error) {
+	s.logger.ErrorWithContext(ctx, "guest import failed", logger.F("id", job.ID), logger.F("error", cause))
+	finishedAt := time.Now()
+	failure := "import aborted by an internal error"
+	job.Status = ImportStatusFailed
+	job.Failure = &failure
+	job.FinishedAt = &finishedAt
+	if err := s.repo.Update(ctx, job.ID, job); err != nil {
+		s.logger.ErrorWithContext(ctx, "guest import failure save failed", logger.F("id", job.ID), logger.F("error", err))
+	}
+}
+
+// dataRows returns the rows below the header that have any content, numbered
+// as in the sheet (the header is row 1).
+func dataRows(sheet [][]string) []numberedRow {
+	rows := make([]numberedRow, 0, len(sheet))
+	for i, cells := range sheet[1:] {
+		if strings.Join(cells, "") == "" {
+			continue
+		}
+		rows = append(rows, numberedRow{number: i + 2, cells: cells})
+	}
+	return rows
+}
+
+// indexes locates the mapped columns in header (case-insensitive). A custom
+// field mapped explicitly, or a required one, must be present; other custom
+// fields are read only when a header matches their key.
+func (m ColumnMapping) indexes(header []string, schema Schema) (columnIndexes, error) {
+	find := func(name string) int {
+		for i, h := range header {
+			if strings.EqualFold(h, name) {
+				return i
+			}
+		}
+		return -1
+	}
+	cols := columnIndexes{name: find(m.Name), email: find(m.Email), phone: -1}
+	if cols.name < 0 {
+		return cols, fmt.Errorf("column %q (name) not found in header", m.Name)
+	}
+	if cols.email < 0 {
+		return cols, fmt.Errorf("column %q (email) not found in header", m.Email)
+	}
+	if m.Phone != "" {
+		// A mapped phone column that is missing is tolerated: phone is optional.
+		cols.phone = find(m.Phone)
+	}
+
+	for key := range m.CustomFields {
+		if schema.find(key) == nil {
+			return cols, fmt.Errorf("mapping names unknown custom field %q", key)
+		}
+	}
+	cols.custom = make(map[string]int, len(schema))
+	for _, f := range schema {
+		name, explicit := m.CustomFields[f.Key]
+		if !explicit {
+			name = f.Key
+		}
+		i := find(name)
+		if i < 0 {
+			if explicit || f.Required {
+				return cols, fmt.Errorf("column %q (custom field %s) not found in header", name, f.Key)
+			}
+			continue
+		}
+		cols.custom[f.Key] = i
+	}
+	return cols, nil
+}
+
+// row maps a row's cells onto guest fields and custom field cells by key;
+// short rows yield empty fields.
+func (c columnIndexes) row(cells []string) (ImportRow, map[string]string) {
+	cell := func(i int) string {
+		if i < 0 || i >= len(cells) {
+			return ""
+		}
+		return cells[i]
+	}
+	custom := make(map[string]string, len(c.custom))
+	for key, i := range c.custom {
+		custom[key] = cell(i)
+	}
+	return ImportRow{Name: cell(c.name), Email: cell(c.email), Phone: cell(c.phone)}, custom
+}
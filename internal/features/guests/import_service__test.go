@@ -0,0 +1,320 @@
+package guests_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/background"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	mockcorerepository "github.com/biairmal/guest-management-be/mocks/core/repository"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+	mockvalidation "github.com/biairmal/guest-management-be/mocks/validation"
+)
+
+// assertErrorzCode fails unless err carries the wanted errorz code (or is nil when want == "").
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var e *errorz.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *errorz.Error, got %T: %v", err, err)
+	}
+	if e.Code != want {
+		t.Errorf("code = %q, want %q", e.Code, want)
+	}
+}
+
+// emailValidator stands in for the tag-driven validator: an guests.ImportRow is
+// invalid when its email has no "@".
+func emailValidator(ctrl *gomock.Controller) *mockvalidation.MockValidator {
+	v := mockvalidation.NewMockValidator(ctrl)
+	v.EXPECT().Struct(gomock.Any()).DoAndReturn(func(x any) error {
+		if row, ok := x.(guests.ImportRow); ok && !strings.Contains(row.Email, "@") {
+			return errorz.BadRequest().WithMessage("email must be a valid email")
+		}
+		return nil
+	}).AnyTimes()
+	return v
+}
+
+func testImportConfig() guests.ImportConfig {
+	return guests.ImportConfig{Workers: 1, BatchSize: 2, MaxRows: 10, StaleAfter: 10 * time.Minute}
+}
+
+func TestImportService_Start(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name     string
+		in       guests.StartImportInput
+		tenantOK bool
+		lookup   error
+		schema   guests.Schema
+		wantErr  string
+	}{
+		{
+			name:    "unsupported file type",
+			in:      guests.StartImportInput{Filename: "guests.pdf"},
+			wantErr: errorz.CodeBadRequest,
+		},
+		{
+			name:    "event not found",
+			in:      guests.StartImportInput{Filename: "guests.csv", Data: []byte("name,email\n")},
+			lookup:  repository.ErrNotFound,
+			wantErr: errorz.CodeNotFound,
+		},
+		{
+			name:     "mapped column missing from header",
+			in:       guests.StartImportInput{Filename: "guests.csv", Data: []byte("full name,email\nAnn,a@x.io\n")},
+			tenantOK: true,
+			wantErr:  errorz.CodeBadRequest,
+		},
+		{
+			name:     "required custom field column missing from header",
+			in:       guests.StartImportInput{Filename: "guests.csv", Data: []byte("name,email\nAnn,a@x.io\n")},
+			tenantOK: true,
+			schema:   guests.Schema{{Key: "table", Type: guests.FieldTypeNumber, Required: true}},
+			wantErr:  errorz.CodeBadRequest,
+		},
+		{
+			name: "custom field mapped to a missing column",
+			in: guests.StartImportInput{
+				Filename: "guests.csv", Data: []byte("name,email\nAnn,a@x.io\n"),
+				Mapping: &guests.ColumnMapping{Name: "name", Email: "email", CustomFields: map[string]string{"vip": "VIP"}},
+			},
+			tenantOK: true,
+			schema:   guests.Schema{{Key: "vip", Type: guests.FieldTypeBoolean}},
+			wantErr:  errorz.CodeBadRequest,
+		},
+		{
+			name:     "too many rows",
+			in:       guests.StartImportInput{Filename: "guests.csv", Data: []byte("name,email\n" + strings.Repeat("a,a@x.io\n", 11))},
+			tenantOK: true,
+			wantErr:  errorz.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockguests.NewMockImportStore(ctrl)
+			fields := mockguests.NewMockFieldStore(ctrl)
+			repo := mockcorerepository.NewMockRepository[guests.GuestImport, uuid.UUID](ctrl)
+			if tt.lookup != nil || tt.tenantOK {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(uuid.New(), tt.lookup)
+			}
+			if tt.tenantOK {
+				store.EXPECT().Mapping(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+				fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(tt.schema, nil).AnyTimes()
+			}
+
+			runner := background.NewRunner(logger.NewNoOp(), 1)
+			svc := guests.NewImportService(
+				logger.NewNoOp(), emailValidator(ctrl), repo, store, fields, runner, testImportConfig(),
+			)
+			_, err := svc.Start(context.Background(), eventID, tt.in)
+			assertErrorzCode(t, err, tt.wantErr)
+			_ = runner.Shutdown(context.Background())
+		})
+	}
+}
+
+func TestImportService_StartProcessesRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockguests.NewMockImportStore(ctrl)
+	fields := mockguests.NewMockFieldStore(ctrl)
+	repo := mockcorerepository.NewMockRepository[guests.GuestImport, uuid.UUID](ctrl)
+	eventID, tenantID := uuid.New(), uuid.New()
+	mapping := guests.ColumnMapping{
+		Name: "Full Name", Email: "E-mail", Phone: "Mobile", CustomFields: map[string]string{"table": "Table No"},
+	}
+	schema := guests.Schema{
+		{Key: "table", Type: guests.FieldTypeNumber},
+		{Key: "vip", Type: guests.FieldTypeBoolean}, // read from the header named after its key
+	}
+
+	sheet := "Full Name,E-mail,Mobile,Table No,VIP\n" +
+		"Ann,ann@x.io,0812,7,yes\n" + // row 2: imported
+		"Bob,not-an-email,,,\n" + // row 3: invalid
+		",,,,\n" + // row 4: blank, skipped
+		"Ann Again,ANN@x.io,,,\n" + // row 5: duplicate in file
+		"Cid,cid@x.io,,,\n" + // row 6: already a guest
+		"Dee,dee@x.io,,,\n" + // row 7: inserted concurrently by someone else
+		"Eve,eve@x.io,,,\n" + // row 8: imported
+		"Fay,fay@x.io,,seven,\n" // row 9: table is not a number
+
+	store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+	fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(schema, nil)
+	store.EXPECT().SaveMapping(gomock.Any(), tenantID, mapping).Return(nil)
+	store.EXPECT().ExistingEmails(gomock.Any(), eventID).Return(map[string]struct{}{"cid@x.io": {}}, nil)
+	gomock.InOrder(
+		store.EXPECT().InsertGuests(gomock.Any(), gomock.Len(2)).DoAndReturn(
+			func(_ context.Context, batch []*guests.Guest) (map[string]struct{}, error) {
+				if batch[0].Phone == nil || *batch[0].Phone != "0812" || batch[0].RSVPStatus != guests.RSVPNone {
+					t.Errorf("first guest = %+v, want phone 0812 and rsvp none", batch[0])
+				}
+				if batch[0].CustomFields["table"] != 7.0 || batch[0].CustomFields["vip"] != true {
+					t.Errorf("first guest custom fields = %v, want table 7 and vip true", batch[0].CustomFields)
+				}
+				return map[string]struct{}{"ann@x.io": {}}, nil // dee lost the race
+			}),
+		store.EXPECT().InsertGuests(gomock.Any(), gomock.Len(1)).Return(map[string]struct{}{"eve@x.io": {}}, nil),
+	)
+
+	var final guests.GuestImport
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, job *guests.GuestImport) error {
+			final = *job
+			return nil
+		}).Times(4) // running, progress after each of the 2 batches, completed
+
+	runner := background.NewRunner(logger.NewNoOp(), 1)
+	svc := guests.NewImportService(logger.NewNoOp(), emailValidator(ctrl), repo, store, fields, runner, testImportConfig())
+	queued, err := svc.Start(context.Background(), eventID, guests.StartImportInput{
+		Filename: "guests.csv", Data: []byte(sheet), Mapping: &mapping, SaveMapping: true,
+	})
+	assertErrorzCode(t, err, "")
+	if queued.Status != guests.ImportStatusQueued || queued.TotalRows != 7 {
+		t.Errorf("queued = %s with %d rows, want queued with 7", queued.Status, queued.TotalRows)
+	}
+	if err := runner.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	if final.Status != guests.ImportStatusCompleted || final.ImportedRows != 2 || final.RejectedRows != 5 {
+		t.Fatalf("final = %s imported=%d rejected=%d, want completed 2/5",
+			final.Status, final.ImportedRows, final.RejectedRows)
+	}
+	wantRows := []int{3, 5, 6, 7, 9}
+	gotRows := make([]int, len(final.RowErrors))
+	for i, e := range final.RowErrors {
+		gotRows[i] = e.Row
+	}
+	if !sameInts(gotRows, wantRows) {
+		t.Errorf("rejected rows = %v, want %v", gotRows, wantRows)
+	}
+}
+
+func TestImportService_ProcessFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockguests.NewMockImportStore(ctrl)
+	fields := mockguests.NewMockFieldStore(ctrl)
+	repo := mockcorerepository.NewMockRepository[guests.GuestImport, uuid.UUID](ctrl)
+	eventID := uuid.New()
+
+	store.EXPECT().EventTenant(gomock.Any(), eventID).Return(uuid.New(), nil)
+	fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(nil, nil)
+	store.EXPECT().Mapping(gomock.Any(), gomock.Any()).Return(nil, nil)
+	store.EXPECT().ExistingEmails(gomock.Any(), eventID).Return(map[string]struct{}{}, nil)
+	store.EXPECT().InsertGuests(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	var final guests.GuestImport
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, job *guests.GuestImport) error {
+			final = *job
+			return nil
+		}).Times(2)
+
+	runner := background.NewRunner(logger.NewNoOp(), 1)
+	svc := guests.NewImportService(logger.NewNoOp(), emailValidator(ctrl), repo, store, fields, runner, testImportConfig())
+	_, err := svc.Start(context.Background(), eventID, guests.StartImportInput{
+		Filename: "guests.csv", Data: []byte("name,email\nAnn,ann@x.io\n"),
+	})
+	assertErrorzCode(t, err, "")
+	_ = runner.Shutdown(context.Background())
+
+	if final.Status != guests.ImportStatusFailed || final.Failure == nil {
+		t.Errorf("final status = %s, failure = %v; want failed with a reason", final.Status, final.Failure)
+	}
+}
+
+func TestImportService_FailInterrupted(t *testing.T) {
+	tests := []struct {
+		name     string
+		storeErr error
+		wantErr  string
+	}{
+		{name: "stale imports are failed"},
+		{name: "store error", storeErr: errors.New("db down"), wantErr: errorz.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockguests.NewMockImportStore(ctrl)
+			repo := mockcorerepository.NewMockRepository[guests.GuestImport, uuid.UUID](ctrl)
+			store.EXPECT().FailStale(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, before time.Time, failure string) (int64, error) {
+					// Only imports idle for StaleAfter (10m) are taken for dead.
+					if idle := time.Since(before); idle < 10*time.Minute || idle > 11*time.Minute {
+						t.Errorf("before = %s ago, want 10m", idle)
+					}
+					if failure == "" {
+						t.Error("failure reason is empty")
+					}
+					return 2, tt.storeErr
+				})
+
+			runner := background.NewRunner(logger.NewNoOp(), 1)
+			svc := guests.NewImportService(
+				logger.NewNoOp(), emailValidator(ctrl), repo, store, mockguests.NewMockFieldStore(ctrl), runner, testImportConfig(),
+			)
+			assertErrorzCode(t, svc.FailInterrupted(context.Background()), tt.wantErr)
+			_ = runner.Shutdown(context.Background())
+		})
+	}
+}
+
+func TestImportService_Get(t *testing.T) {
+	eventID, importID := uuid.New(), uuid.New()
+	tests := []struct {
+		name    string
+		job     *guests.GuestImport
+		repoErr error
+		wantErr string
+	}{
+		{name: "found", job: &guests.GuestImport{ID: importID, EventID: eventID}},
+		{name: "other event's import is hidden", job: &guests.GuestImport{ID: importID, EventID: uuid.New()}, wantErr: errorz.CodeNotFound},
+		{name: "not found", repoErr: repository.ErrNotFound, wantErr: errorz.CodeNotFound},
+		{name: "unexpected error", repoErr: errors.New("boom"), wantErr: errorz.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockcorerepository.NewMockRepository[guests.GuestImport, uuid.UUID](ctrl)
+			repo.EXPECT().GetByID(gomock.Any(), importID).Return(tt.job, tt.repoErr)
+
+			svc := guests.NewImportService(logger.NewNoOp(), nil, repo, nil, nil, nil, testImportConfig())
+			_, err := svc.Get(context.Background(), eventID, importID)
+			assertErrorzCode(t, err, tt.wantErr)
+		})
+	}
+}
+
+func sameInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
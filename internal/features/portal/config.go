@@ -0,0 +1,92 @@
+package portal
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
+)
+
+// minSecretLength is the shortest accepted token signing secret, in bytes.
+const minSecretLength = 32
+
+// Config aggregates the portal feature's own configuration, one field per
+// layer (app.portal.<layer> in config.yaml). The public routes are only
+// registered when Enabled.
+type Config struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Service ServiceConfig `mapstructure:"service"`
+	Handler HandlerConfig `mapstructure:"handler"`
+}
+
+// ServiceConfig holds config for the portal feature's service layer.
+type ServiceConfig struct {
+	// TokenSecret signs the magic-link tokens. Rotating it invalidates every
+	// link handed out so far.
+	TokenSecret string `mapstructure:"token_secret"`
+	// TokenTTL is how long a magic link stays valid.
+	TokenTTL time.Duration `mapstructure:"token_ttl"`
+	// BaseURL is the guest-facing portal page; the token is appended as the
+	// last path segment to build the link.
+	BaseURL string `mapstructure:"base_url"`
+}
+
+// HandlerConfig holds config for the portal feature's HTTP layer.
+type HandlerConfig struct {
+	// RateLimit caps requests per client across the token-scoped routes.
+	RateLimit ratelimit.Rule `mapstructure:"rate_limit"`
+	// LinkRateLimit caps magic-link requests per client; it is tighter since
+	// each accepted request may send a message.
+	LinkRateLimit ratelimit.Rule `mapstructure:"link_rate_limit"`
+}
+
+// DefaultConfig returns the portal feature config with its defaults. The
+// portal is disabled until a token secret is configured.
+func DefaultConfig() Config {
+	return Config{
+		Service: ServiceConfig{TokenTTL: 30 * 24 * time.Hour, BaseURL: "http://localhost:3000/rsvp"},
+		Handler: HandlerConfig{
+			RateLimit:     ratelimit.Rule{Requests: 60, Window: time.Minute},
+			LinkRateLimit: ratelimit.Rule{Requests: 5, Window: 15 * time.Minute},
+		},
+	}
+}
+
+// Validate validates the portal feature configuration. It is a no-op when
+// disabled.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if err := c.Service.Validate(); err != nil {
+		return err
+	}
+	return c.Handler.Validate()
+}
+
+// Validate validates the portal feature's service-layer configuration.
+func (c *ServiceConfig) Validate() error {
+	if len(c.TokenSecret) < minSecretLength {
+		return errorz.Internal().WithMessage("portal: service.token_secret must be at least 32 bytes when enabled")
+	}
+	if c.TokenTTL <= 0 {
+		return errorz.Internal().WithMessage("portal: service.token_ttl must be positive")
+	}
+	if u, err := url.Parse(c.BaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return errorz.Internal().WithMessage("portal: service.base_url must be an absolute URL")
+	}
+	return nil
+}
+
+// Validate validates the portal feature's handler-layer configuration.
+func (c *HandlerConfig) Validate() error {
+	if err := c.RateLimit.Validate(); err != nil {
+		return errorz.Internal().WithMessage("portal: handler.rate_limit: " + err.Error())
+	}
+	if err := c.LinkRateLimit.Validate(); err != nil {
+		return errorz.Internal().WithMessage("portal: handler.link_rate_limit: " + err.Error())
+	}
+	return nil
+}
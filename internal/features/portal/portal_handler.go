@@ -0,0 +1,221 @@
+package portal
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// Handler exposes HTTP handlers for the public RSVP portal and the staff
+// magic-link endpoint.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+	limit     func(http.Handler) http.Handler // rate limit of the token-scoped routes
+	linkLimit func(http.Handler) http.Handler // rate limit of magic-link requests
+}
+
+// NewHandler returns a Handler that uses the given service and validator and
+// rate limits the public routes through limiter as cfg says.
+func NewHandler(
+	log logger.Logger, service Service, validator validation.Validator, limiter ratelimit.Limiter, cfg HandlerConfig,
+) *Handler {
+	return &Handler{
+		service:   service,
+		validator: validator,
+		limit:     ratelimit.Middleware(log, limiter, "portal", cfg.RateLimit),
+		linkLimit: ratelimit.Middleware(log, limiter, "portal-link", cfg.LinkRateLimit),
+	}
+}
+
+// View handles GET /public/rsvp/{token}.
+//
+// View godoc
+//
+//	@Summary		RSVP portal page
+//	@Description	Returns the event summary with tenant branding, the guest's RSVP and the custom fields they may fill in. Needs no login: the magic-link token is the credential.
+//	@Tags			portal
+//	@Produce		json
+//	@Param			token	path		string	true	"Magic-link token"
+//	@Success		200		{object}	portal.View
+//	@Failure		401		{object}	problem.Problem	"Link invalid or expired"
+//	@Failure		429		{object}	problem.Problem	"Too many requests"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/public/rsvp/{token} [get]
+func (h *Handler) View(r *http.Request) (any, error) {
+	v, err := h.service.View(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(v), nil
+}
+
+// Respond handles POST /public/rsvp/{token}/response.
+//
+// Respond godoc
+//
+//	@Summary		Respond to invitation
+//	@Description	Records the guest's RSVP. Declining withdraws any ticket they hold and promotes the event's waitlist.
+//	@Tags			portal
+//	@Accept			json
+//	@Produce		json
+//	@Param			token	path		string				true	"Magic-link token"
+//	@Param			body	body		portal.RespondInput	true	"RSVP answer"
+//	@Success		200		{object}	portal.View
+//	@Failure		400		{object}	problem.Problem	"Invalid body"
+//	@Failure		401		{object}	problem.Problem	"Link invalid or expired"
+//	@Failure		429		{object}	problem.Problem	"Too many requests"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/public/rsvp/{token}/response [post]
+func (h *Handler) Respond(r *http.Request) (any, error) {
+	var body RespondInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	v, err := h.service.Respond(r.Context(), chi.URLParam(r, "token"), body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(v), nil
+}
+
+// UpdateFields handles PUT /public/rsvp/{token}/fields.
+//
+// UpdateFields godoc
+//
+//	@Summary		Update own custom fields
+//	@Description	Sets the guest's answers to guest-editable custom fields (merged; null removes a value). Any other field is rejected with 400.
+//	@Tags			portal
+//	@Accept			json
+//	@Produce		json
+//	@Param			token	path		string				true	"Magic-link token"
+//	@Param			body	body		portal.FieldsInput	true	"Custom field values"
+//	@Success		200		{object}	portal.View
+//	@Failure		400		{object}	problem.Problem	"Invalid body, a field that isn't guest-editable, or an invalid value"
+//	@Failure		401		{object}	problem.Problem	"Link invalid or expired"
+//	@Failure		429		{object}	problem.Problem	"Too many requests"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/public/rsvp/{token}/fields [put]
+func (h *Handler) UpdateFields(r *http.Request) (any, error) {
+	var body FieldsInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	v, err := h.service.UpdateFields(r.Context(), chi.URLParam(r, "token"), body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(v), nil
+}
+
+// Ticket handles GET /public/rsvp/{token}/ticket.
+//
+// Ticket godoc
+//
+//	@Summary		Own ticket
+//	@Description	Returns the guest's ticket and the QR code value to render for entry.
+//	@Tags			portal
+//	@Produce		json
+//	@Param			token	path		string	true	"Magic-link token"
+//	@Success		200		{object}	portal.TicketView
+//	@Failure		401		{object}	problem.Problem	"Link invalid or expired"
+//	@Failure		404		{object}	problem.Problem	"No ticket issued yet"
+//	@Failure		429		{object}	problem.Problem	"Too many requests"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/public/rsvp/{token}/ticket [get]
+func (h *Handler) Ticket(r *http.Request) (any, error) {
+	t, err := h.service.Ticket(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(t), nil
+}
+
+// RequestLink handles POST /public/events/{eventId}/rsvp-link.
+//
+// RequestLink godoc
+//
+//	@Summary		Request magic link
+//	@Description	Sends a magic link to the event's guest with the email. Always answers 204, whether or not such a guest exists.
+//	@Tags			portal
+//	@Accept			json
+//	@Param			eventId	path	string					true	"Event UUID"
+//	@Param			body	body	portal.RequestLinkInput	true	"Guest email"
+//	@Success		204		"A link is sent if the guest exists"
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body"
+//	@Failure		429		{object}	problem.Problem	"Too many requests"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/public/events/{eventId}/rsvp-link [post]
+func (h *Handler) RequestLink(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body RequestLinkInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	if err := h.service.RequestLink(r.Context(), eventID, body); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// Link handles GET /events/{eventId}/guests/{guestId}/rsvp-link.
+//
+// Link godoc
+//
+//	@Summary		Guest magic link
+//	@Description	Returns a fresh magic link into the RSVP portal for the guest, to share or embed in a message (template variables rsvp_token and rsvp_url). Requires manage_guests in the event's tenant.
+//	@Tags			portal
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			guestId	path		string	true	"Guest UUID"
+//	@Success		200		{object}	portal.Link
+//	@Failure		400		{object}	problem.Problem	"Invalid ids"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated"
+//	@Failure		403		{object}	problem.Problem	"Missing manage_guests"
+//	@Failure		404		{object}	problem.Problem	"Event (or another tenant's event) or guest not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/{guestId}/rsvp-link [get]
+func (h *Handler) Link(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	guestID, err := parseID(r, "guestId", "guest")
+	if err != nil {
+		return nil, err
+	}
+	link, err := h.service.Link(r.Context(), eventID, guestID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(link), nil
+}
+
+// decode decodes and validates the JSON body into dst.
+func (h *Handler) decode(r *http.Request, dst any) error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return errcode.InvalidRequestBody.New()
+	}
+	return h.validator.Struct(dst)
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
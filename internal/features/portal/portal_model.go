@@ -0,0 +1,87 @@
+package portal
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+)
+
+// Template variables a magic link fills in guest messages.
+const (
+	VarToken = "rsvp_token"
+	VarURL   = "rsvp_url"
+)
+
+// EventSummary is the public view of an event and its tenant's branding.
+//
+// swagger:model PortalEventSummary
+type EventSummary struct {
+	ID          uuid.UUID       `json:"id"`
+	Name        string          `json:"name"`
+	Description *string         `json:"description,omitempty"`
+	StartDate   time.Time       `json:"start_date"`
+	EndDate     time.Time       `json:"end_date"`
+	TenantName  string          `json:"tenant_name"`
+	Branding    json.RawMessage `json:"branding" swaggertype:"object"`
+}
+
+// GuestSummary is what a guest sees of their own record.
+//
+// swagger:model PortalGuestSummary
+type GuestSummary struct {
+	Name         string              `json:"name"`
+	RSVPStatus   string              `json:"rsvp_status"`
+	CustomFields guests.CustomFields `json:"custom_fields"`
+	HasTicket    bool                `json:"has_ticket"`
+}
+
+// EditableField describes a custom field the guest may fill in.
+//
+// swagger:model PortalEditableField
+type EditableField struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+	Pattern  *string  `json:"pattern,omitempty"`
+}
+
+// View is the portal page for one guest: the event, the guest's answers and
+// the fields they may change.
+//
+// swagger:model PortalView
+type View struct {
+	Event  *EventSummary   `json:"event"`
+	Guest  GuestSummary    `json:"guest"`
+	Fields []EditableField `json:"fields"`
+}
+
+// TicketView is the guest's ticket as shown on the portal. QRCode is the
+// value to encode in the QR image shown at the entrance.
+//
+// swagger:model PortalTicket
+type TicketView struct {
+	ID         uuid.UUID `json:"id"`
+	TicketType string    `json:"ticket_type"`
+	QRCode     string    `json:"qr_code"`
+	Status     string    `json:"status"`
+}
+
+// Link is a guest's magic link into the portal.
+//
+// swagger:model PortalLink
+type Link struct {
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Variables returns the link as message template variables (VarToken,
+// VarURL).
+func (l *Link) Variables() map[string]string {
+	return map[string]string{VarToken: l.Token, VarURL: l.URL}
+}
@@ -0,0 +1,37 @@
+package portal
+
+import (
+	"context"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/portal/mock_notifier.go -package=mockportal github.com/biairmal/guest-management-be/internal/features/portal Notifier
+
+// Notifier delivers magic links that guests asked for by email.
+type Notifier interface {
+	// LinkRequested sends the guest their magic link.
+	LinkRequested(ctx context.Context, eventID, guestID uuid.UUID, link *Link)
+}
+
+// logNotifier records requested links in the log until guest messaging
+// exists, counting each as a sent notification. The token itself is never
+// logged.
+type logNotifier struct {
+	logger logger.Logger
+}
+
+// NewLogNotifier returns a Notifier that logs each request.
+func NewLogNotifier(logger logger.Logger) Notifier {
+	return &logNotifier{logger: logger}
+}
+
+// LinkRequested implements Notifier.
+func (n *logNotifier) LinkRequested(ctx context.Context, eventID, guestID uuid.UUID, link *Link) {
+	metrics.MessageSent(metrics.ChannelNotification)
+	n.logger.InfoWithContext(ctx, "rsvp link requested",
+		logger.F("event_id", eventID), logger.F("guest_id", guestID), logger.F("expires_at", link.ExpiresAt))
+}
@@ -0,0 +1,106 @@
+package portal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/portal/mock_store.go -package=mockportal github.com/biairmal/guest-management-be/internal/features/portal Store
+
+// Store holds the portal's read queries. Guest writes go through the guests
+// feature so its field validation and ticket withdrawal apply unchanged.
+type Store interface {
+	// Event returns the live event with its tenant's name and branding, or
+	// repository.ErrNotFound.
+	Event(ctx context.Context, eventID uuid.UUID) (*EventSummary, error)
+	// EventTenant returns the tenant owning a live event, or
+	// repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// GuestByEmail returns the id of the event's live guest with the email
+	// (case-insensitive), or repository.ErrNotFound.
+	GuestByEmail(ctx context.Context, eventID uuid.UUID, email string) (uuid.UUID, error)
+	// Ticket returns the guest's current ticket, or repository.ErrNotFound
+	// when they hold none.
+	Ticket(ctx context.Context, guestID uuid.UUID) (*TicketView, error)
+}
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// Event implements Store.
+func (s *store) Event(ctx context.Context, eventID uuid.UUID) (*EventSummary, error) {
+	var e EventSummary
+	var branding []byte
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		SELECT e.id, e.name, e.description, e.start_date, e.end_date, t.name, COALESCE(t.branding, '{}')
+		FROM events e JOIN tenants t ON t.id = e.tenant_id AND t.deleted_at IS NULL
+		WHERE e.id = $1 AND e.deleted_at IS NULL`, eventID,
+	).Scan(&e.ID, &e.Name, &e.Description, &e.StartDate, &e.EndDate, &e.TenantName, &branding)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.Branding = branding
+	return &e, nil
+}
+
+// EventTenant implements Store.
+func (s *store) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// GuestByEmail implements Store.
+func (s *store) GuestByEmail(ctx context.Context, eventID uuid.UUID, email string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		SELECT id FROM guests
+		WHERE event_id = $1 AND lower(email) = lower($2) AND deleted_at IS NULL
+		ORDER BY created_at LIMIT 1`, eventID, email,
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return id, err
+}
+
+// Ticket implements Store.
+func (s *store) Ticket(ctx context.Context, guestID uuid.UUID) (*TicketView, error) {
+	var t TicketView
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		SELECT tk.id, tt.name, tk.qr_code, tk.status
+		FROM guests g
+		JOIN tickets tk ON tk.id = g.ticket_id AND tk.deleted_at IS NULL
+		JOIN ticket_types tt ON tt.id = tk.ticket_type_id
+		WHERE g.id = $1 AND g.deleted_at IS NULL`, guestID,
+	).Scan(&t.ID, &t.TicketType, &t.QRCode, &t.Status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,24 @@
+package portal
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitPortalRoutes registers the public, rate-limited RSVP portal routes and
+// the staff magic-link route on the given router. The magic link is a bearer
+// credential for the guest, so only staff holding auth.ManageGuests get one.
+func InitPortalRoutes(r *chi.Mux, portalH *Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(portalH.limit)
+		r.Get("/api/v1/public/rsvp/{token}", problem.Handle(portalH.View))
+		r.Post("/api/v1/public/rsvp/{token}/response", problem.Handle(portalH.Respond))
+		r.Put("/api/v1/public/rsvp/{token}/fields", problem.Handle(portalH.UpdateFields))
+		r.Get("/api/v1/public/rsvp/{token}/ticket", problem.Handle(portalH.Ticket))
+	})
+	r.With(portalH.linkLimit).Post("/api/v1/public/events/{eventId}/rsvp-link", problem.Handle(portalH.RequestLink))
+	r.With(auth.Require(auth.ManageGuests)).
+		Get("/api/v1/events/{eventId}/guests/{guestId}/rsvp-link", problem.Handle(portalH.Link))
+}
@@ -0,0 +1,273 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/portal/mock_service.go -package=mockportal github.com/biairmal/guest-management-be/internal/features/portal Service
+
+// Service is the guest-facing RSVP portal. Every method but RequestLink and
+// Link is scoped by a magic-link token and acts only on the guest it names.
+type Service interface {
+	// View returns the portal page of the token's guest.
+	View(ctx context.Context, token string) (*View, error)
+	// Respond records the guest's RSVP; declining withdraws their ticket.
+	Respond(ctx context.Context, token string, in RespondInput) (*View, error)
+	// UpdateFields sets the guest's guest-editable custom fields.
+	UpdateFields(ctx context.Context, token string, in FieldsInput) (*View, error)
+	// Ticket returns the guest's ticket and its QR code value.
+	Ticket(ctx context.Context, token string) (*TicketView, error)
+	// RequestLink sends a magic link to the event's guest with the email, if
+	// there is one. It succeeds either way so callers can't probe for guests.
+	RequestLink(ctx context.Context, eventID uuid.UUID, in RequestLinkInput) error
+	// Link returns a guest's magic link for staff to share or embed in a
+	// message. The caller must belong to the event's tenant; other tenants'
+	// events are reported as not found.
+	Link(ctx context.Context, eventID, guestID uuid.UUID) (*Link, error)
+}
+
+// RespondInput is a guest's RSVP answer.
+//
+// swagger:model PortalRespondInput
+type RespondInput struct {
+	RSVPStatus string `json:"rsvp_status" validate:"required,oneof=confirmed declined"`
+}
+
+// FieldsInput sets guest-editable custom fields. It is merged into the stored
+// values: a key set to null removes that value.
+//
+// swagger:model PortalFieldsInput
+type FieldsInput struct {
+	CustomFields guests.CustomFields `json:"custom_fields" validate:"required"`
+}
+
+// RequestLinkInput asks for a magic link to be sent to an email.
+//
+// swagger:model PortalRequestLinkInput
+type RequestLinkInput struct {
+	Email string `json:"email" validate:"required,email,max=320"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger  logger.Logger
+	store   Store
+	guests  guests.GuestService
+	fields  guests.FieldStore
+	notify  Notifier
+	signer  *Signer
+	baseURL string
+}
+
+// NewService returns a Service with the given dependencies. Tokens are signed
+// and expire as cfg says.
+func NewService(
+	logger logger.Logger, store Store, guestService guests.GuestService, fields guests.FieldStore,
+	notify Notifier, cfg ServiceConfig,
+) Service {
+	return &serviceImpl{
+		logger: logger, store: store, guests: guestService, fields: fields, notify: notify,
+		signer: NewSigner(cfg.TokenSecret, cfg.TokenTTL), baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+	}
+}
+
+// errLinkInvalid answers every token that doesn't lead to a live guest, so a
+// caller can't tell a forged token from a deleted guest.
+func errLinkInvalid() error {
+	return errcode.PortalLinkInvalid.New()
+}
+
+// View implements Service.
+func (s *serviceImpl) View(ctx context.Context, token string) (*View, error) {
+	claims, guest, err := s.authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, claims, guest)
+}
+
+// Respond implements Service.
+func (s *serviceImpl) Respond(ctx context.Context, token string, in RespondInput) (*View, error) {
+	claims, _, err := s.authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	guest, err := s.guests.Update(ctx, claims.EventID, claims.GuestID,
+		guests.UpdateGuestInput{RSVPStatus: &in.RSVPStatus}, "")
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "guest responded through portal",
+		logger.F("guest_id", guest.ID), logger.F("rsvp_status", guest.RSVPStatus))
+	return s.view(ctx, claims, guest)
+}
+
+// UpdateFields implements Service.
+func (s *serviceImpl) UpdateFields(ctx context.Context, token string, in FieldsInput) (*View, error) {
+	claims, _, err := s.authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := s.schema(ctx, claims.EventID)
+	if err != nil {
+		return nil, err
+	}
+	for key := range in.CustomFields {
+		if !editable(schema, key) {
+			return nil, errorz.BadRequest().WithMessage("field " + key + " cannot be changed")
+		}
+	}
+	guest, err := s.guests.Update(ctx, claims.EventID, claims.GuestID,
+		guests.UpdateGuestInput{CustomFields: in.CustomFields}, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, claims, guest)
+}
+
+// Ticket implements Service.
+func (s *serviceImpl) Ticket(ctx context.Context, token string) (*TicketView, error) {
+	claims, _, err := s.authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.store.Ticket(ctx, claims.GuestID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.TicketNotFound.WithMessage("no ticket has been issued to you yet")
+		}
+		return nil, s.fail(ctx, "portal ticket read failed", err)
+	}
+	return t, nil
+}
+
+// RequestLink implements Service.
+func (s *serviceImpl) RequestLink(ctx context.Context, eventID uuid.UUID, in RequestLinkInput) error {
+	guestID, err := s.store.GuestByEmail(ctx, eventID, in.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return s.fail(ctx, "portal guest lookup failed", err)
+	}
+	s.notify.LinkRequested(ctx, eventID, guestID, s.link(guestID, eventID))
+	return nil
+}
+
+// Link implements Service.
+func (s *serviceImpl) Link(ctx context.Context, eventID, guestID uuid.UUID) (*Link, error) {
+	tenantID, err := s.store.EventTenant(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "portal link event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to create the RSVP link")
+	}
+	if !auth.InTenant(ctx, tenantID) {
+		return nil, errcode.EventNotFound.New()
+	}
+	if _, err := s.guests.GetByID(ctx, eventID, guestID); err != nil {
+		return nil, err
+	}
+	return s.link(guestID, eventID), nil
+}
+
+// link signs a magic link for the guest of the event.
+func (s *serviceImpl) link(guestID, eventID uuid.UUID) *Link {
+	token, expires := s.signer.Sign(guestID, eventID)
+	return &Link{Token: token, URL: s.baseURL + "/" + token, ExpiresAt: expires}
+}
+
+// authorize verifies token and loads the guest it names.
+func (s *serviceImpl) authorize(ctx context.Context, token string) (Claims, *guests.Guest, error) {
+	claims, err := s.signer.Verify(token)
+	if err != nil {
+		return Claims{}, nil, errLinkInvalid()
+	}
+	guest, err := s.guests.GetByID(ctx, claims.EventID, claims.GuestID)
+	if err != nil {
+		var ez *errorz.Error
+		if errors.As(err, &ez) && ez.Code == errorz.CodeNotFound {
+			return Claims{}, nil, errLinkInvalid()
+		}
+		return Claims{}, nil, err
+	}
+	return claims, guest, nil
+}
+
+// view assembles the portal page. Only guest-editable custom fields are
+// shown; the rest are for staff.
+func (s *serviceImpl) view(ctx context.Context, claims Claims, guest *guests.Guest) (*View, error) {
+	event, err := s.store.Event(ctx, claims.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errLinkInvalid()
+		}
+		return nil, s.fail(ctx, "portal event read failed", err)
+	}
+	schema, err := s.schema(ctx, claims.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &View{
+		Event: event,
+		Guest: GuestSummary{
+			Name: guest.Name, RSVPStatus: guest.RSVPStatus, CustomFields: guests.CustomFields{},
+			HasTicket: guest.TicketID != nil,
+		},
+		Fields: []EditableField{},
+	}
+	for _, f := range schema {
+		if !f.GuestEditable {
+			continue
+		}
+		v.Fields = append(v.Fields, EditableField{
+			Key: f.Key, Label: f.Label, Type: f.Type, Required: f.Required, Options: f.Options, Pattern: f.Pattern,
+		})
+		if val, ok := guest.CustomFields[f.Key]; ok {
+			v.Guest.CustomFields[f.Key] = val
+		}
+	}
+	return v, nil
+}
+
+// schema loads the event's custom field schema.
+func (s *serviceImpl) schema(ctx context.Context, eventID uuid.UUID) (guests.Schema, error) {
+	schema, err := s.fields.EventSchema(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errLinkInvalid()
+		}
+		return nil, s.fail(ctx, "portal field schema read failed", err)
+	}
+	return schema, nil
+}
+
+// editable reports whether the schema lets guests set key.
+func editable(schema guests.Schema, key string) bool {
+	for _, f := range schema {
+		if f.Key == key {
+			return f.GuestEditable
+		}
+	}
+	return false
+}
+
+// fail logs err as msg and reports it as 500.
+func (s *serviceImpl) fail(ctx context.Context, msg string, err error) error {
+	s.logger.ErrorWithContext(ctx, msg, logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to load the RSVP portal")
+}
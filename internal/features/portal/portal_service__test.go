@@ -0,0 +1,239 @@
+package portal_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/portal"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+	mockportal "github.com/biairmal/guest-management-be/mocks/portal"
+)
+
+var testConfig = portal.ServiceConfig{
+	TokenSecret: strings.Repeat("s", 32), TokenTTL: time.Hour, BaseURL: "https://rsvp.example.com/r/",
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+type deps struct {
+	store  *mockportal.MockStore
+	guests *mockguests.MockGuestService
+	fields *mockguests.MockFieldStore
+	notify *mockportal.MockNotifier
+	svc    portal.Service
+}
+
+func newDeps(t *testing.T) *deps {
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		store:  mockportal.NewMockStore(ctrl),
+		guests: mockguests.NewMockGuestService(ctrl),
+		fields: mockguests.NewMockFieldStore(ctrl),
+		notify: mockportal.NewMockNotifier(ctrl),
+	}
+	d.svc = portal.NewService(logger.NewNoOp(), d.store, d.guests, d.fields, d.notify, testConfig)
+	return d
+}
+
+// staff returns a context authenticated for tenantID, as auth.Middleware
+// leaves it for a staff user.
+func staff(tenantID uuid.UUID) context.Context {
+	return ctxkit.WithTenantID(ctxkit.WithUserID(context.Background(), uuid.NewString()), tenantID.String())
+}
+
+// token returns a valid token for the guest, minted through the staff link.
+func (d *deps) token(t *testing.T, eventID, guestID uuid.UUID) string {
+	t.Helper()
+	tenantID := uuid.New()
+	d.store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+	d.guests.EXPECT().GetByID(gomock.Any(), eventID, guestID).Return(&guests.Guest{ID: guestID}, nil)
+	link, err := d.svc.Link(staff(tenantID), eventID, guestID)
+	if err != nil {
+		t.Fatalf("Link: %v", err)
+	}
+	if link.URL != "https://rsvp.example.com/r/"+link.Token {
+		t.Errorf("url = %q, want the base URL followed by the token", link.URL)
+	}
+	return link.Token
+}
+
+func TestService_Link(t *testing.T) {
+	eventID, guestID, tenantID := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		lookupErr error
+		guestErr  error
+		wantGuest bool
+		wantErr   string
+	}{
+		{name: "staff of the event's tenant", ctx: staff(tenantID), wantGuest: true},
+		{name: "staff of another tenant", ctx: staff(uuid.New()), wantErr: errorz.CodeNotFound},
+		{name: "anonymous caller", ctx: context.Background(), wantErr: errorz.CodeNotFound},
+		{name: "event not found", ctx: staff(tenantID), lookupErr: repository.ErrNotFound, wantErr: errorz.CodeNotFound},
+		{name: "event lookup fails", ctx: staff(tenantID), lookupErr: errors.New("db down"), wantErr: errorz.CodeInternal},
+		{
+			name: "guest not found", ctx: staff(tenantID), wantGuest: true,
+			guestErr: errorz.NotFound().WithMessage("guest not found"), wantErr: errorz.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeps(t)
+			d.store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, tt.lookupErr)
+			if tt.wantGuest {
+				d.guests.EXPECT().GetByID(gomock.Any(), eventID, guestID).Return(&guests.Guest{ID: guestID}, tt.guestErr)
+			}
+			link, err := d.svc.Link(tt.ctx, eventID, guestID)
+			assertErrorzCode(t, err, tt.wantErr)
+			if tt.wantErr == "" && link.Token == "" {
+				t.Error("link has no token")
+			}
+		})
+	}
+}
+
+func TestService_View(t *testing.T) {
+	eventID, guestID := uuid.New(), uuid.New()
+	schema := guests.Schema{
+		{Key: "diet", Type: guests.FieldTypeText, GuestEditable: true},
+		{Key: "vip_notes", Type: guests.FieldTypeText},
+	}
+
+	tests := []struct {
+		name     string
+		token    func(d *deps) string
+		guestErr error
+		wantCode string
+	}{
+		{name: "shows editable fields only", token: func(d *deps) string { return d.token(t, eventID, guestID) }},
+		{name: "forged token", token: func(*deps) string { return "forged.token" }, wantCode: errorz.CodeUnauthorized},
+		{
+			name:     "deleted guest looks like a bad link",
+			token:    func(d *deps) string { return d.token(t, eventID, guestID) },
+			guestErr: errorz.NotFound().WithMessage("guest not found"),
+			wantCode: errorz.CodeUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeps(t)
+			token := tt.token(d)
+			guest := &guests.Guest{
+				ID: guestID, EventID: eventID, Name: "Ann", RSVPStatus: guests.RSVPInvited,
+				CustomFields: guests.CustomFields{"diet": "vegan", "vip_notes": "owes us"},
+			}
+			d.guests.EXPECT().GetByID(gomock.Any(), eventID, guestID).Return(guest, tt.guestErr).MaxTimes(1)
+			d.store.EXPECT().Event(gomock.Any(), eventID).Return(&portal.EventSummary{ID: eventID}, nil).MaxTimes(1)
+			d.fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(schema, nil).MaxTimes(1)
+
+			v, err := d.svc.View(context.Background(), token)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				return
+			}
+			if len(v.Fields) != 1 || v.Fields[0].Key != "diet" {
+				t.Errorf("fields = %+v, want only diet", v.Fields)
+			}
+			if _, leaked := v.Guest.CustomFields["vip_notes"]; leaked || v.Guest.CustomFields["diet"] != "vegan" {
+				t.Errorf("custom fields = %v, want only diet", v.Guest.CustomFields)
+			}
+		})
+	}
+}
+
+func TestService_UpdateFields(t *testing.T) {
+	eventID, guestID := uuid.New(), uuid.New()
+	schema := guests.Schema{
+		{Key: "diet", Type: guests.FieldTypeText, GuestEditable: true},
+		{Key: "vip_notes", Type: guests.FieldTypeText},
+	}
+
+	tests := []struct {
+		name       string
+		fields     guests.CustomFields
+		wantUpdate bool
+		wantCode   string
+	}{
+		{name: "editable field updated", fields: guests.CustomFields{"diet": "vegan"}, wantUpdate: true},
+		{name: "staff-only field rejected", fields: guests.CustomFields{"vip_notes": "x"}, wantCode: errorz.CodeBadRequest},
+		{name: "unknown field rejected", fields: guests.CustomFields{"shoe_size": 9.0}, wantCode: errorz.CodeBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeps(t)
+			token := d.token(t, eventID, guestID)
+			guest := &guests.Guest{ID: guestID, EventID: eventID}
+			d.guests.EXPECT().GetByID(gomock.Any(), eventID, guestID).Return(guest, nil)
+			d.fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(schema, nil).AnyTimes()
+			updated := false
+			d.guests.EXPECT().Update(gomock.Any(), eventID, guestID, guests.UpdateGuestInput{CustomFields: tt.fields}, "").
+				DoAndReturn(func(context.Context, uuid.UUID, uuid.UUID, guests.UpdateGuestInput, string) (*guests.Guest, error) {
+					updated = true
+					return guest, nil
+				}).MaxTimes(1)
+			d.store.EXPECT().Event(gomock.Any(), eventID).Return(&portal.EventSummary{ID: eventID}, nil).MaxTimes(1)
+
+			_, err := d.svc.UpdateFields(context.Background(), token, portal.FieldsInput{CustomFields: tt.fields})
+			assertErrorzCode(t, err, tt.wantCode)
+			if updated != tt.wantUpdate {
+				t.Errorf("updated = %v, want %v", updated, tt.wantUpdate)
+			}
+		})
+	}
+}
+
+func TestService_RequestLink(t *testing.T) {
+	eventID, guestID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		lookupErr  error
+		wantNotify bool
+		wantCode   string
+	}{
+		{name: "known email gets a link", wantNotify: true},
+		{name: "unknown email answers the same", lookupErr: repository.ErrNotFound},
+		{name: "lookup failure", lookupErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeps(t)
+			d.store.EXPECT().GuestByEmail(gomock.Any(), eventID, "ann@x.io").Return(guestID, tt.lookupErr)
+			notified := false
+			d.notify.EXPECT().LinkRequested(gomock.Any(), eventID, guestID, gomock.Any()).Do(
+				func(context.Context, uuid.UUID, uuid.UUID, *portal.Link) { notified = true }).MaxTimes(1)
+
+			err := d.svc.RequestLink(context.Background(), eventID, portal.RequestLinkInput{Email: "ann@x.io"})
+			assertErrorzCode(t, err, tt.wantCode)
+			if notified != tt.wantNotify {
+				t.Errorf("notified = %v, want %v", notified, tt.wantNotify)
+			}
+		})
+	}
+}
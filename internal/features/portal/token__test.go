@@ -0,0 +1,49 @@
+package portal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	guestID, eventID := uuid.New(), uuid.New()
+	issued := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	signer := NewSigner(strings.Repeat("s", 32), time.Hour)
+	signer.now = func() time.Time { return issued }
+	token, expires := signer.Sign(guestID, eventID)
+
+	payload, mac, _ := strings.Cut(token, ".")
+	tests := []struct {
+		name    string
+		token   string
+		secret  string
+		at      time.Time
+		wantErr bool
+	}{
+		{name: "valid token", token: token, at: issued.Add(59 * time.Minute)},
+		{name: "expired token", token: token, at: expires, wantErr: true},
+		{name: "other secret", token: token, secret: strings.Repeat("x", 32), at: issued, wantErr: true},
+		{name: "tampered payload", token: payload[:len(payload)-2] + "AA." + mac, at: issued, wantErr: true},
+		{name: "missing signature", token: payload, at: issued, wantErr: true},
+		{name: "garbage", token: "not-a-token.!!", at: issued, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewSigner(strings.Repeat("s", 32), time.Hour)
+			if tt.secret != "" {
+				v = NewSigner(tt.secret, time.Hour)
+			}
+			v.now = func() time.Time { return tt.at }
+			c, err := v.Verify(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (c.GuestID != guestID || c.EventID != eventID || !c.ExpiresAt.Equal(expires)) {
+				t.Errorf("claims = %+v, want guest %v event %v expiring %v", c, guestID, eventID, expires)
+			}
+		})
+	}
+}
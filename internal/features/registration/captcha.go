@@ -0,0 +1,53 @@
+package registration
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/registration/mock_verifier.go -package=mockregistration github.com/biairmal/guest-management-be/internal/features/registration Verifier
+
+// ErrCaptchaRejected is returned by a Verifier for a missing or wrong token.
+var ErrCaptchaRejected = errors.New("captcha rejected")
+
+// Verifier checks the captcha token a registrant's browser obtained. A hosted
+// provider (hCaptcha, reCAPTCHA, Turnstile) plugs in here; any error other
+// than ErrCaptchaRejected is treated as the provider being unavailable.
+type Verifier interface {
+	// Verify returns nil when token proves a human at remoteIP.
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// NewVerifier returns the Verifier cfg selects.
+func NewVerifier(cfg CaptchaConfig) Verifier {
+	if cfg.Provider == CaptchaStatic {
+		return NewStaticVerifier(cfg.StaticToken)
+	}
+	return noopVerifier{}
+}
+
+// noopVerifier accepts every token.
+type noopVerifier struct{}
+
+// Verify implements Verifier.
+func (noopVerifier) Verify(context.Context, string, string) error { return nil }
+
+// staticVerifier accepts exactly one token, standing in for a provider in
+// local runs and tests.
+type staticVerifier struct {
+	token []byte
+}
+
+// NewStaticVerifier returns a Verifier that accepts only token.
+func NewStaticVerifier(token string) Verifier {
+	return &staticVerifier{token: []byte(token)}
+}
+
+// Verify implements Verifier.
+func (v *staticVerifier) Verify(_ context.Context, token, _ string) error {
+	if subtle.ConstantTimeCompare([]byte(token), v.token) != 1 {
+		return ErrCaptchaRejected
+	}
+	return nil
+}
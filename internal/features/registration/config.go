@@ -0,0 +1,85 @@
+package registration
+
+import (
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
+)
+
+// Captcha providers.
+const (
+	// CaptchaNone accepts every registration without a captcha.
+	CaptchaNone = "none"
+	// CaptchaStatic accepts one fixed token; for local runs and tests.
+	CaptchaStatic = "static"
+)
+
+// Config aggregates the registration feature's own configuration, one field
+// per layer (app.registration.<layer> in config.yaml).
+type Config struct {
+	Service ServiceConfig `mapstructure:"service"`
+	Handler HandlerConfig `mapstructure:"handler"`
+}
+
+// ServiceConfig holds config for the registration feature's service layer.
+type ServiceConfig struct {
+	Captcha CaptchaConfig `mapstructure:"captcha"`
+}
+
+// CaptchaConfig selects the captcha Verifier public registrations go through.
+type CaptchaConfig struct {
+	Provider    string `mapstructure:"provider"`     // none or static
+	StaticToken string `mapstructure:"static_token"` // the token the static provider accepts
+}
+
+// HandlerConfig holds config for the registration feature's HTTP layer.
+type HandlerConfig struct {
+	// RateLimit caps public registration requests per client.
+	RateLimit ratelimit.Rule `mapstructure:"rate_limit"`
+}
+
+// DefaultConfig returns the registration feature config with its defaults.
+func DefaultConfig() Config {
+	return Config{
+		Service: ServiceConfig{Captcha: CaptchaConfig{Provider: CaptchaNone}},
+		Handler: HandlerConfig{RateLimit: ratelimit.Rule{Requests: 10, Window: time.Minute}},
+	}
+}
+
+// Validate validates the registration feature configuration.
+func (c *Config) Validate() error {
+	if err := c.Service.Validate(); err != nil {
+		return err
+	}
+	return c.Handler.Validate()
+}
+
+// Validate validates the registration feature's service-layer configuration.
+func (c *ServiceConfig) Validate() error {
+	return c.Captcha.Validate()
+}
+
+// Validate validates the captcha settings.
+func (c *CaptchaConfig) Validate() error {
+	switch c.Provider {
+	case CaptchaNone:
+		return nil
+	case CaptchaStatic:
+		if c.StaticToken == "" {
+			return errorz.Internal().WithMessage("registration: captcha.static_token is required for the static provider")
+		}
+		return nil
+	default:
+		return errorz.Internal().WithMessage("registration: captcha.provider must be none or static")
+	}
+}
+
+// Validate validates the registration feature's handler-layer configuration.
+func (c *HandlerConfig) Validate() error {
+	if err := c.RateLimit.Validate(); err != nil {
+		return errorz.Internal().WithMessage("registration: handler.rate_limit: " + err.Error())
+	}
+	return nil
+}
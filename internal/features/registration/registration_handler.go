@@ -0,0 +1,249 @@
+package registration
+
+import (
+	"encoding/json"
+	"net/http"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/ratelimit"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// pendingListConfig declares the allow-listed sort/filter fields for the
+// approval queue.
+var pendingListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"name", "email", "registered_at"},
+	AllowedFilterFields: []string{"name", "email"},
+}
+
+// Handler exposes HTTP handlers for public registration and the staff
+// approval queue.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+	limit     func(http.Handler) http.Handler // rate limit of public registrations
+}
+
+// NewHandler returns a Handler that uses the given service and validator and
+// rate limits public registrations through limiter as cfg says.
+func NewHandler(
+	log logger.Logger, service Service, validator validation.Validator, limiter ratelimit.Limiter, cfg HandlerConfig,
+) *Handler {
+	return &Handler{
+		service:   service,
+		validator: validator,
+		limit:     ratelimit.Middleware(log, limiter, "registration", cfg.RateLimit),
+	}
+}
+
+// Availability handles GET /public/events/{eventId}/registration.
+//
+// Availability godoc
+//
+//	@Summary		Registration availability
+//	@Description	Whether the event takes public registrations now (state closed, upcoming, open or full), its window, whether approval is required and the places left under the cap.
+//	@Tags			registration
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{object}	registration.Availability
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/public/events/{eventId}/registration [get]
+func (h *Handler) Availability(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	a, err := h.service.Availability(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(a), nil
+}
+
+// Register handles POST /public/events/{eventId}/registrations.
+//
+// Register godoc
+//
+//	@Summary		Register for event
+//	@Description	Adds the registrant to the guest list: invited, or pending when the event requires approval. An email already on the list gets the same answer and changes nothing. Needs no login; rate limited per client and checked by the configured captcha.
+//	@Tags			registration
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string						true	"Event UUID"
+//	@Param			body	body		registration.RegisterInput	true	"Registrant"
+//	@Success		201		{object}	registration.Result
+//	@Failure		400		{object}	problem.Problem	"Invalid body, captcha failed, or a field guests can't set"
+//	@Failure		403		{object}	problem.Problem	"Registration not open"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		409		{object}	problem.Problem	"Registration full"
+//	@Failure		429		{object}	problem.Problem	"Too many requests"
+//	@Failure		503		{object}	problem.Problem	"Captcha provider unavailable"
+//	@Router			/api/v1/public/events/{eventId}/registrations [post]
+func (h *Handler) Register(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body RegisterInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Register(r.Context(), eventID, body, ratelimit.ClientIP(r))
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(res), nil
+}
+
+// Settings handles GET /events/{eventId}/registration.
+//
+// Settings godoc
+//
+//	@Summary		Registration settings
+//	@Description	Returns the event's registration settings and its current registrant count. Events never configured have registration disabled.
+//	@Tags			registration
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{object}	registration.Settings
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/registration [get]
+func (h *Handler) Settings(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	st, err := h.service.Settings(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(st), nil
+}
+
+// UpdateSettings handles PUT /events/{eventId}/registration.
+//
+// UpdateSettings godoc
+//
+//	@Summary		Update registration settings
+//	@Description	Replaces the event's registration settings: enabled, the opens_at/closes_at window, whether registrants need approval, and the cap on registrants. Lowering the cap below the current registrants removes none.
+//	@Tags			registration
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string							true	"Event UUID"
+//	@Param			body	body		registration.SettingsInput	true	"Settings"
+//	@Success		200		{object}	registration.Settings
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body, or closes_at not after opens_at"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/registration [put]
+func (h *Handler) UpdateSettings(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body SettingsInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	st, err := h.service.UpdateSettings(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(st), nil
+}
+
+// Pending handles GET /events/{eventId}/registrations/pending.
+//
+// Pending godoc
+//
+//	@Summary		Approval queue
+//	@Description	Registrants awaiting approval (paginated, filtered, sorted; default oldest first).
+//	@Tags			registration
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			page	query		int		false	"Page number (1-based)"
+//	@Param			size	query		int		false	"Page size (max 100)"
+//	@Param			sort	query		string	false	"Sort spec field,DIRECTION (repeatable): name, email, registered_at"
+//	@Param			name	query		string	false	"Filter by name"
+//	@Param			email	query		string	false	"Filter by email"
+//	@Success		200		{object}	dto.PageResponse[registration.Registrant]
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or query"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/registrations/pending [get]
+func (h *Handler) Pending(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	params, err := query.ParseListParams(r.URL.Query(), pendingListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	page, err := h.service.Pending(r.Context(), eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(page), nil
+}
+
+// Review handles POST /events/{eventId}/registrations/review.
+//
+// Review godoc
+//
+//	@Summary		Review registrants
+//	@Description	Approves (pending → invited) or rejects (removed from the guest list) up to 500 pending registrants at once. Ids that aren't pending registrants of the event are returned as skipped.
+//	@Tags			registration
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string						true	"Event UUID"
+//	@Param			body	body		registration.ReviewInput	true	"Action and guest ids"
+//	@Success		200		{object}	registration.ReviewResult
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/registrations/review [post]
+func (h *Handler) Review(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body ReviewInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Review(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(res), nil
+}
+
+// decode decodes and validates the JSON body into dst.
+func (h *Handler) decode(r *http.Request, dst any) error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return errcode.InvalidRequestBody.New()
+	}
+	return h.validator.Struct(dst)
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
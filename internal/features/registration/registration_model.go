@@ -0,0 +1,101 @@
+package registration
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+)
+
+// Registration window states, as shown to the public.
+const (
+	StateClosed   = "closed"   // registration disabled, or its window has passed
+	StateUpcoming = "upcoming" // enabled, opens later
+	StateOpen     = "open"
+	StateFull     = "full" // the cap is reached
+)
+
+// Review actions.
+const (
+	ActionApprove = "approve"
+	ActionReject  = "reject"
+)
+
+// Settings represents a row in the event_registration_settings table. An
+// event without a row has registration disabled.
+//
+// swagger:model RegistrationSettings
+type Settings struct {
+	EventID          uuid.UUID  `json:"event_id" db:"event_id"`
+	Enabled          bool       `json:"enabled" db:"enabled"`
+	OpensAt          *time.Time `json:"opens_at,omitempty" db:"opens_at"`
+	ClosesAt         *time.Time `json:"closes_at,omitempty" db:"closes_at"`
+	RequiresApproval bool       `json:"requires_approval" db:"requires_approval"`
+	Cap              *int       `json:"cap" db:"cap"` // most registrants; null = unlimited
+	Registered       int        `json:"registered"`   // live registrants, pending or approved
+	CreatedAt        *time.Time `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// TableName returns the database table name.
+func (Settings) TableName() string {
+	return "event_registration_settings"
+}
+
+// State returns the registration state at now.
+func (s *Settings) State(now time.Time) string {
+	switch {
+	case !s.Enabled, s.ClosesAt != nil && !now.Before(*s.ClosesAt):
+		return StateClosed
+	case s.OpensAt != nil && now.Before(*s.OpensAt):
+		return StateUpcoming
+	case s.Cap != nil && s.Registered >= *s.Cap:
+		return StateFull
+	default:
+		return StateOpen
+	}
+}
+
+// Availability is the public view of an event's registration.
+//
+// swagger:model RegistrationAvailability
+type Availability struct {
+	EventID          uuid.UUID  `json:"event_id"`
+	State            string     `json:"state"` // closed, upcoming, open or full
+	OpensAt          *time.Time `json:"opens_at,omitempty"`
+	ClosesAt         *time.Time `json:"closes_at,omitempty"`
+	RequiresApproval bool       `json:"requires_approval"`
+	SpotsLeft        *int       `json:"spots_left,omitempty"` // set when capped
+}
+
+// Result is what a registrant is told. Status is the RSVP status they got:
+// pending when the event requires approval, invited otherwise.
+//
+// swagger:model RegistrationResult
+type Result struct {
+	Status string `json:"status"`
+}
+
+// Registrant is a self-registered guest, as listed in the approval queue.
+//
+// swagger:model Registrant
+type Registrant struct {
+	GuestID      uuid.UUID           `json:"guest_id"`
+	Name         string              `json:"name"`
+	Email        string              `json:"email"`
+	Phone        *string             `json:"phone,omitempty"`
+	RSVPStatus   string              `json:"rsvp_status"`
+	CustomFields guests.CustomFields `json:"custom_fields"`
+	RegisteredAt time.Time           `json:"registered_at"`
+}
+
+// ReviewResult reports a bulk review: the registrants it applied to and the
+// ids it skipped because they weren't pending registrants of the event.
+//
+// swagger:model RegistrationReviewResult
+type ReviewResult struct {
+	Action   string      `json:"action"`
+	Reviewed []uuid.UUID `json:"reviewed"`
+	Skipped  []uuid.UUID `json:"skipped"`
+}
@@ -0,0 +1,207 @@
+package registration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/registration/mock_store.go -package=mockregistration github.com/biairmal/guest-management-be/internal/features/registration Store
+
+// Store holds the registration queries. Registrants are guests: they are
+// created through the guests feature and only marked and reviewed here.
+type Store interface {
+	// Settings returns the event's registration settings with its live
+	// registrant count; an event without settings gets disabled defaults. It
+	// returns repository.ErrNotFound unless the event is live.
+	Settings(ctx context.Context, eventID uuid.UUID) (*Settings, error)
+	// LockSettings is Settings taken under the event's row lock, serialising
+	// registrations so the cap holds under concurrency.
+	LockSettings(ctx context.Context, eventID uuid.UUID) (*Settings, error)
+	// SaveSettings inserts or replaces the event's settings, setting their
+	// timestamps.
+	SaveSettings(ctx context.Context, s *Settings) error
+	// MarkRegistered stamps the guest as self-registered at at.
+	MarkRegistered(ctx context.Context, guestID uuid.UUID, at time.Time) error
+	// Pending returns one page of the event's pending registrants, oldest
+	// first by default, and their total.
+	Pending(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*Registrant, int64, error)
+	// Approve turns the listed pending registrants of the event invited and
+	// returns the ids it changed.
+	Approve(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error)
+	// Reject soft-deletes the listed pending registrants of the event, freeing
+	// their place under the cap and their email, and returns the ids it
+	// changed.
+	Reject(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+// pendingColumns maps the approval queue's allow-listed filter/sort fields to
+// SQL.
+var pendingColumns = map[string]string{
+	"name":          "g.name",
+	"email":         "g.email",
+	"registered_at": "g.registered_at",
+}
+
+// pendingScope selects the pending registrants of the event in $1.
+const pendingScope = `g.event_id = $1 AND g.rsvp_status = 'pending'
+	AND g.registered_at IS NOT NULL AND g.deleted_at IS NULL`
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// Settings implements Store.
+func (s *store) Settings(ctx context.Context, eventID uuid.UUID) (*Settings, error) {
+	return s.settings(ctx, eventID, "")
+}
+
+// LockSettings implements Store.
+func (s *store) LockSettings(ctx context.Context, eventID uuid.UUID) (*Settings, error) {
+	return s.settings(ctx, eventID, " FOR UPDATE OF e")
+}
+
+// settings reads the event's settings, appending lock to the event query.
+func (s *store) settings(ctx context.Context, eventID uuid.UUID, lock string) (*Settings, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	st := Settings{EventID: eventID}
+	var enabled, approval sql.NullBool
+	err := conn.QueryRowContext(ctx, `
+		SELECT r.enabled, r.opens_at, r.closes_at, r.requires_approval, r.cap, r.created_at, r.updated_at
+		FROM events e LEFT JOIN event_registration_settings r ON r.event_id = e.id
+		WHERE e.id = $1 AND e.deleted_at IS NULL`+lock, eventID,
+	).Scan(&enabled, &st.OpensAt, &st.ClosesAt, &approval, &st.Cap, &st.CreatedAt, &st.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	st.Enabled, st.RequiresApproval = enabled.Bool, approval.Bool
+
+	err = conn.QueryRowContext(ctx, `SELECT count(*) FROM guests g
+		WHERE g.event_id = $1 AND g.registered_at IS NOT NULL AND g.deleted_at IS NULL`, eventID,
+	).Scan(&st.Registered)
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// SaveSettings implements Store.
+func (s *store) SaveSettings(ctx context.Context, st *Settings) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		INSERT INTO event_registration_settings (event_id, enabled, opens_at, closes_at, requires_approval, cap)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (event_id) DO UPDATE SET enabled = EXCLUDED.enabled, opens_at = EXCLUDED.opens_at,
+			closes_at = EXCLUDED.closes_at, requires_approval = EXCLUDED.requires_approval,
+			cap = EXCLUDED.cap, updated_at = now()
+		RETURNING created_at, updated_at`,
+		st.EventID, st.Enabled, st.OpensAt, st.ClosesAt, st.RequiresApproval, st.Cap,
+	).Scan(&st.CreatedAt, &st.UpdatedAt)
+}
+
+// MarkRegistered implements Store.
+func (s *store) MarkRegistered(ctx context.Context, guestID uuid.UUID, at time.Time) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE guests SET registered_at = $2 WHERE id = $1", guestID, at)
+	return err
+}
+
+// Pending implements Store.
+func (s *store) Pending(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) ([]*Registrant, int64, error) {
+	clauses := query.ToSQL(params, pendingColumns, 2)
+	from := " FROM guests g WHERE " + strings.Join(append([]string{pendingScope}, clauses.Where...), " AND ")
+	args := append([]any{eventID}, clauses.Args...)
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, "SELECT count(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := "g.registered_at, g.id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", g.id"
+	}
+	n := len(args)
+	args = append(args, params.Size, (params.Page-1)*params.Size)
+	rows, err := conn.QueryContext(ctx,
+		"SELECT g.id, g.name, g.email, g.phone, g.rsvp_status, g.custom_fields, g.registered_at"+from+
+			fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, n+1, n+2), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var registrants []*Registrant
+	for rows.Next() {
+		var r Registrant
+		if err := rows.Scan(
+			&r.GuestID, &r.Name, &r.Email, &r.Phone, &r.RSVPStatus, &r.CustomFields, &r.RegisteredAt,
+		); err != nil {
+			return nil, 0, err
+		}
+		registrants = append(registrants, &r)
+	}
+	return registrants, total, rows.Err()
+}
+
+// Approve implements Store.
+func (s *store) Approve(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return s.review(ctx, `UPDATE guests g SET rsvp_status = 'invited', updated_at = now()
+		WHERE `+pendingScope+` AND g.id = ANY($2::uuid[]) RETURNING g.id`, eventID, guestIDs)
+}
+
+// Reject implements Store.
+func (s *store) Reject(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return s.review(ctx, `UPDATE guests g SET deleted_at = now(), updated_at = now()
+		WHERE `+pendingScope+` AND g.id = ANY($2::uuid[]) RETURNING g.id`, eventID, guestIDs)
+}
+
+// review runs a review UPDATE returning the ids it changed.
+func (s *store) review(ctx context.Context, q string, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, q, eventID, uuidArray(guestIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// uuidArray binds ids as a PostgreSQL text array for ANY($n::uuid[]).
+func uuidArray(ids []uuid.UUID) any {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return pq.StringArray(s)
+}
@@ -0,0 +1,20 @@
+package registration
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitRegistrationRoutes registers the public registration routes, the
+// registration being rate limited, and the staff settings and approval queue
+// routes on the given router.
+func InitRegistrationRoutes(r *chi.Mux, registrationH *Handler) {
+	r.Get("/api/v1/public/events/{eventId}/registration", problem.Handle(registrationH.Availability))
+	r.With(registrationH.limit).
+		Post("/api/v1/public/events/{eventId}/registrations", problem.Handle(registrationH.Register))
+	r.Get("/api/v1/events/{eventId}/registration", problem.Handle(registrationH.Settings))
+	r.Put("/api/v1/events/{eventId}/registration", problem.Handle(registrationH.UpdateSettings))
+	r.Get("/api/v1/events/{eventId}/registrations/pending", problem.Handle(registrationH.Pending))
+	r.Post("/api/v1/events/{eventId}/registrations/review", problem.Handle(registrationH.Review))
+}
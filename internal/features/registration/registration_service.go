@@ -0,0 +1,284 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/registration/mock_service.go -package=mockregistration github.com/biairmal/guest-management-be/internal/features/registration Service
+
+// Service runs public self-registration for events and the staff approval
+// queue.
+type Service interface {
+	// Availability returns whether the event takes registrations right now.
+	Availability(ctx context.Context, eventID uuid.UUID) (*Availability, error)
+	// Register adds the registrant as a guest of the event, pending approval
+	// when the event requires it. remoteIP is passed to the captcha check.
+	Register(ctx context.Context, eventID uuid.UUID, in RegisterInput, remoteIP string) (*Result, error)
+	// Settings returns the event's registration settings.
+	Settings(ctx context.Context, eventID uuid.UUID) (*Settings, error)
+	// UpdateSettings replaces the event's registration settings.
+	UpdateSettings(ctx context.Context, eventID uuid.UUID, in SettingsInput) (*Settings, error)
+	// Pending returns a page of the event's registrants awaiting approval.
+	Pending(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*common.PageResponse[Registrant], error)
+	// Review approves or rejects pending registrants in bulk.
+	Review(ctx context.Context, eventID uuid.UUID, in ReviewInput) (*ReviewResult, error)
+}
+
+// RegisterInput is a public registration. CustomFields may only hold the
+// event's guest-editable fields.
+//
+// swagger:model RegisterInput
+type RegisterInput struct {
+	Name         string              `json:"name"                    validate:"required,max=255"`
+	Email        string              `json:"email"                   validate:"required,email,max=320"`
+	Phone        *string             `json:"phone,omitempty"         validate:"omitempty,max=32"`
+	CustomFields guests.CustomFields `json:"custom_fields,omitempty"`
+	CaptchaToken string              `json:"captcha_token,omitempty" validate:"max=4096"`
+}
+
+// SettingsInput replaces an event's registration settings. Omitted window
+// bounds and cap mean no bound and no cap.
+//
+// swagger:model RegistrationSettingsInput
+type SettingsInput struct {
+	Enabled          bool       `json:"enabled"`
+	OpensAt          *time.Time `json:"opens_at,omitempty"`
+	ClosesAt         *time.Time `json:"closes_at,omitempty"`
+	RequiresApproval bool       `json:"requires_approval"`
+	Cap              *int       `json:"cap,omitempty"       validate:"omitempty,min=0,max=1000000"`
+}
+
+// ReviewInput approves or rejects pending registrants in bulk.
+//
+// swagger:model RegistrationReviewInput
+type ReviewInput struct {
+	Action   string      `json:"action"    validate:"required,oneof=approve reject"`
+	GuestIDs []uuid.UUID `json:"guest_ids" validate:"required,min=1,max=500"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger  logger.Logger
+	tx      transaction.TxManager
+	store   Store
+	guests  guests.GuestService
+	fields  guests.FieldStore
+	captcha Verifier
+}
+
+// NewService returns a Service with the given dependencies.
+func NewService(
+	logger logger.Logger, tx transaction.TxManager, store Store, guestService guests.GuestService,
+	fields guests.FieldStore, captcha Verifier,
+) Service {
+	return &serviceImpl{
+		logger: logger, tx: tx, store: store, guests: guestService, fields: fields, captcha: captcha,
+	}
+}
+
+// Availability implements Service.
+func (s *serviceImpl) Availability(ctx context.Context, eventID uuid.UUID) (*Availability, error) {
+	st, err := s.Settings(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	a := &Availability{
+		EventID: eventID, State: st.State(time.Now()), OpensAt: st.OpensAt, ClosesAt: st.ClosesAt,
+		RequiresApproval: st.RequiresApproval,
+	}
+	if st.Cap != nil {
+		left := max(*st.Cap-st.Registered, 0)
+		a.SpotsLeft = &left
+	}
+	return a, nil
+}
+
+// Register implements Service. Registering with an email already on the
+// guest list answers exactly like a new registration, so the endpoint can't
+// be used to find out who is invited.
+func (s *serviceImpl) Register(
+	ctx context.Context, eventID uuid.UUID, in RegisterInput, remoteIP string,
+) (*Result, error) {
+	if err := s.captcha.Verify(ctx, in.CaptchaToken, remoteIP); err != nil {
+		if errors.Is(err, ErrCaptchaRejected) {
+			return nil, errcode.CaptchaFailed.New()
+		}
+		s.logger.ErrorWithContext(ctx, "captcha verification failed", logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeServiceUnavailable).
+			WithMessage("captcha verification is unavailable, try again later")
+	}
+	if err := s.checkFields(ctx, eventID, in.CustomFields); err != nil {
+		return nil, err
+	}
+
+	res := &Result{}
+	now := time.Now()
+	duplicate := false // the email is already on the guest list
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		st, err := s.store.LockSettings(ctx, eventID)
+		if err != nil {
+			return s.translate(ctx, "registration settings lock failed", eventID, err)
+		}
+		switch st.State(now) {
+		case StateClosed, StateUpcoming:
+			return errcode.RegistrationClosed.New()
+		case StateFull:
+			return errcode.RegistrationFull.New()
+		}
+		res.Status = guests.RSVPInvited
+		if st.RequiresApproval {
+			res.Status = guests.RSVPPending
+		}
+
+		guest, err := s.guests.Create(ctx, eventID, guests.CreateGuestInput{
+			Name: in.Name, Email: in.Email, Phone: in.Phone, RSVPStatus: res.Status, CustomFields: in.CustomFields,
+		})
+		if err != nil {
+			var ez *errorz.Error
+			duplicate = errors.As(err, &ez) && ez.Code == errorz.CodeConflict
+			return err
+		}
+		if err := s.store.MarkRegistered(ctx, guest.ID, now); err != nil {
+			return s.translate(ctx, "registrant mark failed", eventID, err)
+		}
+		s.logger.InfoWithContext(ctx, "guest registered",
+			logger.F("event_id", eventID), logger.F("guest_id", guest.ID), logger.F("rsvp_status", res.Status))
+		return nil
+	})
+	if duplicate {
+		s.logger.InfoWithContext(ctx, "registration for a listed email ignored", logger.F("event_id", eventID))
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Settings implements Service.
+func (s *serviceImpl) Settings(ctx context.Context, eventID uuid.UUID) (*Settings, error) {
+	st, err := s.store.Settings(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "registration settings read failed", eventID, err)
+	}
+	return st, nil
+}
+
+// UpdateSettings implements Service.
+func (s *serviceImpl) UpdateSettings(ctx context.Context, eventID uuid.UUID, in SettingsInput) (*Settings, error) {
+	if in.OpensAt != nil && in.ClosesAt != nil && !in.ClosesAt.After(*in.OpensAt) {
+		return nil, errorz.BadRequest().WithMessage("closes_at must be after opens_at")
+	}
+	var st *Settings
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if st, err = s.store.LockSettings(ctx, eventID); err != nil {
+			return s.translate(ctx, "registration settings lock failed", eventID, err)
+		}
+		st.Enabled, st.OpensAt, st.ClosesAt = in.Enabled, in.OpensAt, in.ClosesAt
+		st.RequiresApproval, st.Cap = in.RequiresApproval, in.Cap
+		if err := s.store.SaveSettings(ctx, st); err != nil {
+			return s.translate(ctx, "registration settings save failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "registration settings updated",
+		logger.F("event_id", eventID), logger.F("enabled", st.Enabled))
+	return st, nil
+}
+
+// Pending implements Service.
+func (s *serviceImpl) Pending(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[Registrant], error) {
+	if _, err := s.Settings(ctx, eventID); err != nil {
+		return nil, err
+	}
+	items, total, err := s.store.Pending(ctx, eventID, params)
+	if err != nil {
+		return nil, s.translate(ctx, "registration queue read failed", eventID, err)
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// Review implements Service. Ids that aren't pending registrants of the event
+// (already reviewed, staff-added, another event's) are skipped, not errors,
+// so a retried or overlapping bulk review is harmless.
+func (s *serviceImpl) Review(ctx context.Context, eventID uuid.UUID, in ReviewInput) (*ReviewResult, error) {
+	if _, err := s.Settings(ctx, eventID); err != nil {
+		return nil, err
+	}
+	review := s.store.Approve
+	if in.Action == ActionReject {
+		review = s.store.Reject
+	}
+	reviewed, err := review(ctx, eventID, in.GuestIDs)
+	if err != nil {
+		return nil, s.translate(ctx, "registration review failed", eventID, err)
+	}
+
+	done := make(map[uuid.UUID]bool, len(reviewed))
+	for _, id := range reviewed {
+		done[id] = true
+	}
+	res := &ReviewResult{Action: in.Action, Reviewed: reviewed, Skipped: []uuid.UUID{}}
+	if res.Reviewed == nil {
+		res.Reviewed = []uuid.UUID{}
+	}
+	for _, id := range in.GuestIDs {
+		if !done[id] {
+			done[id] = true
+			res.Skipped = append(res.Skipped, id)
+		}
+	}
+	s.logger.InfoWithContext(ctx, "registrants reviewed", logger.F("event_id", eventID),
+		logger.F("action", in.Action), logger.F("reviewed", len(res.Reviewed)), logger.F("skipped", len(res.Skipped)))
+	return res, nil
+}
+
+// checkFields rejects custom field keys the event doesn't open to guests.
+func (s *serviceImpl) checkFields(ctx context.Context, eventID uuid.UUID, values guests.CustomFields) error {
+	if len(values) == 0 {
+		return nil
+	}
+	schema, err := s.fields.EventSchema(ctx, eventID)
+	if err != nil {
+		return s.translate(ctx, "field schema read failed", eventID, err)
+	}
+	editable := make(map[string]bool, len(schema))
+	for _, f := range schema {
+		editable[f.Key] = f.GuestEditable
+	}
+	for key := range values {
+		if !editable[key] {
+			return errorz.BadRequest().WithMessage("field " + key + " cannot be set at registration")
+		}
+	}
+	return nil
+}
+
+// translate maps a store error to 404 for a missing event, or logs it as msg
+// and reports it as 500.
+func (s *serviceImpl) translate(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to process registration")
+}
@@ -0,0 +1,201 @@
+package registration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/guests"
+	"github.com/biairmal/guest-management-be/internal/features/registration"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockguests "github.com/biairmal/guest-management-be/mocks/guests"
+	mockregistration "github.com/biairmal/guest-management-be/mocks/registration"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func at(d time.Duration) *time.Time {
+	t := time.Now().Add(d)
+	return &t
+}
+
+func limit(n int) *int { return &n }
+
+func TestService_Register(t *testing.T) {
+	eventID := uuid.New()
+	open := registration.Settings{EventID: eventID, Enabled: true}
+	schema := guests.Schema{
+		{Key: "diet", Type: guests.FieldTypeText, GuestEditable: true},
+		{Key: "vip_notes", Type: guests.FieldTypeText},
+	}
+
+	tests := []struct {
+		name       string
+		settings   registration.Settings
+		captcha    string
+		fields     guests.CustomFields
+		createErr  error
+		wantStatus string
+		wantCode   string
+	}{
+		{name: "open event invites", settings: open, wantStatus: guests.RSVPInvited},
+		{
+			name:     "approval step leaves the guest pending",
+			settings: registration.Settings{Enabled: true, RequiresApproval: true}, wantStatus: guests.RSVPPending,
+		},
+		{
+			name:     "guest-editable field accepted",
+			settings: open, fields: guests.CustomFields{"diet": "vegan"}, wantStatus: guests.RSVPInvited,
+		},
+		{
+			name: "staff-only field rejected", settings: open, fields: guests.CustomFields{"vip_notes": "x"},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{name: "wrong captcha", settings: open, captcha: "bot", wantCode: errorz.CodeBadRequest},
+		{name: "disabled", settings: registration.Settings{}, wantCode: errorz.CodeForbidden},
+		{
+			name: "not open yet", settings: registration.Settings{Enabled: true, OpensAt: at(time.Hour)},
+			wantCode: errorz.CodeForbidden,
+		},
+		{
+			name: "window passed", settings: registration.Settings{Enabled: true, ClosesAt: at(-time.Hour)},
+			wantCode: errorz.CodeForbidden,
+		},
+		{
+			name: "cap reached", settings: registration.Settings{Enabled: true, Cap: limit(2), Registered: 2},
+			wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "listed email answers like a new registration", settings: open,
+			createErr:  errorz.Conflict().WithMessage("a guest with this email already exists in the event"),
+			wantStatus: guests.RSVPInvited,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockregistration.NewMockStore(ctrl)
+			guestSvc := mockguests.NewMockGuestService(ctrl)
+			fields := mockguests.NewMockFieldStore(ctrl)
+			fields.EXPECT().EventSchema(gomock.Any(), eventID).Return(schema, nil).MaxTimes(1)
+			settings := tt.settings
+			store.EXPECT().LockSettings(gomock.Any(), eventID).Return(&settings, nil).MaxTimes(1)
+			guestID := uuid.New()
+			marked := false
+			guestSvc.EXPECT().Create(gomock.Any(), eventID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, in guests.CreateGuestInput) (*guests.Guest, error) {
+					if in.RSVPStatus != tt.wantStatus {
+						t.Errorf("created with %q, want %q", in.RSVPStatus, tt.wantStatus)
+					}
+					return &guests.Guest{ID: guestID}, tt.createErr
+				}).MaxTimes(1)
+			store.EXPECT().MarkRegistered(gomock.Any(), guestID, gomock.Any()).DoAndReturn(
+				func(context.Context, uuid.UUID, time.Time) error { marked = true; return nil }).MaxTimes(1)
+
+			captcha := tt.captcha
+			if captcha == "" {
+				captcha = "human"
+			}
+			svc := registration.NewService(logger.NewNoOp(), inlineTx(ctrl), store, guestSvc, fields,
+				registration.NewStaticVerifier("human"))
+			res, err := svc.Register(context.Background(), eventID, registration.RegisterInput{
+				Name: "Ann", Email: "ann@x.io", CustomFields: tt.fields, CaptchaToken: captcha,
+			}, "10.0.0.1")
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				return
+			}
+			if res.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", res.Status, tt.wantStatus)
+			}
+			if marked != (tt.createErr == nil) {
+				t.Errorf("marked = %v, want %v", marked, tt.createErr == nil)
+			}
+		})
+	}
+}
+
+func TestService_RegisterCaptchaUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	captcha := mockregistration.NewMockVerifier(ctrl)
+	captcha.EXPECT().Verify(gomock.Any(), "tok", "10.0.0.1").Return(errors.New("provider timeout"))
+
+	svc := registration.NewService(logger.NewNoOp(), inlineTx(ctrl), mockregistration.NewMockStore(ctrl),
+		mockguests.NewMockGuestService(ctrl), mockguests.NewMockFieldStore(ctrl), captcha)
+	_, err := svc.Register(context.Background(), uuid.New(),
+		registration.RegisterInput{Name: "Ann", Email: "ann@x.io", CaptchaToken: "tok"}, "10.0.0.1")
+	assertErrorzCode(t, err, errorz.CodeServiceUnavailable)
+}
+
+func TestService_Review(t *testing.T) {
+	eventID, a, b, c := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		action      string
+		reviewed    []uuid.UUID
+		wantSkipped []uuid.UUID
+	}{
+		{name: "approve all", action: registration.ActionApprove, reviewed: []uuid.UUID{a, b, c}},
+		{
+			name: "reject skips ids not pending", action: registration.ActionReject, reviewed: []uuid.UUID{b},
+			wantSkipped: []uuid.UUID{a, c},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockregistration.NewMockStore(ctrl)
+			store.EXPECT().Settings(gomock.Any(), eventID).Return(&registration.Settings{EventID: eventID}, nil)
+			ids := []uuid.UUID{a, b, c, a}
+			if tt.action == registration.ActionApprove {
+				store.EXPECT().Approve(gomock.Any(), eventID, ids).Return(tt.reviewed, nil)
+			} else {
+				store.EXPECT().Reject(gomock.Any(), eventID, ids).Return(tt.reviewed, nil)
+			}
+
+			svc := registration.NewService(logger.NewNoOp(), inlineTx(ctrl), store,
+				mockguests.NewMockGuestService(ctrl), mockguests.NewMockFieldStore(ctrl), registration.NewVerifier(
+					registration.CaptchaConfig{Provider: registration.CaptchaNone}))
+			res, err := svc.Review(context.Background(), eventID, registration.ReviewInput{Action: tt.action, GuestIDs: ids})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(res.Reviewed) != len(tt.reviewed) || len(res.Skipped) != len(tt.wantSkipped) {
+				t.Fatalf("result = %+v, want reviewed %v skipped %v", res, tt.reviewed, tt.wantSkipped)
+			}
+			for i := range tt.wantSkipped {
+				if res.Skipped[i] != tt.wantSkipped[i] {
+					t.Errorf("skipped = %v, want %v", res.Skipped, tt.wantSkipped)
+				}
+			}
+		})
+	}
+}
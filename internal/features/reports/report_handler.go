@@ -0,0 +1,313 @@
+package reports
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+)
+
+// noShowListConfig declares the allow-listed sort/filter fields for the
+// no-show list.
+var noShowListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"name", "email", "rsvp_status"},
+	AllowedFilterFields: []string{"name", "email", "rsvp_status"},
+}
+
+// Handler exposes HTTP handlers for reports.
+type Handler struct {
+	service Service
+}
+
+// NewHandler returns a Handler that uses the given service.
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Funnel handles GET /events/{eventId}/reports/funnel.
+//
+// Funnel godoc
+//
+//	@Summary		Event funnel
+//	@Description	Guest conversion invited → confirmed → ticketed → checked in, with stage-to-stage rates.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{object}	reports.Funnel
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/funnel [get]
+func (h *Handler) Funnel(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	f, err := h.service.Funnel(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(f), nil
+}
+
+// Throughput handles GET /events/{eventId}/reports/throughput.
+//
+// Throughput godoc
+//
+//	@Summary		Per-step throughput
+//	@Description	Scans per workflow step per time bucket. Only non-empty buckets are listed.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			bucket	query		string	false	"Bucket width as a Go duration, 1m to 24h (default 15m)"
+//	@Param			from	query		string	false	"Only scans at or after (RFC 3339)"
+//	@Param			to		query		string	false	"Only scans before (RFC 3339)"
+//	@Success		200		{array}		reports.StepThroughput
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or window"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/throughput [get]
+func (h *Handler) Throughput(r *http.Request) (any, error) {
+	eventID, w, err := parseEventWindow(r)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := h.service.Throughput(r.Context(), eventID, w)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(steps), nil
+}
+
+// Transitions handles GET /events/{eventId}/reports/step-times.
+//
+// Transitions godoc
+//
+//	@Summary		Time between steps
+//	@Description	Average and median time tickets took from each workflow step to the next, between first scans.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			from	query		string	false	"Only scans at or after (RFC 3339)"
+//	@Param			to		query		string	false	"Only scans before (RFC 3339)"
+//	@Success		200		{array}		reports.StepTransition
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or window"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/step-times [get]
+func (h *Handler) Transitions(r *http.Request) (any, error) {
+	eventID, w, err := parseEventWindow(r)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := h.service.Transitions(r.Context(), eventID, w)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(transitions), nil
+}
+
+// Arrivals handles GET /events/{eventId}/reports/arrivals.
+//
+// Arrivals godoc
+//
+//	@Summary		Arrival windows
+//	@Description	Tickets per time bucket of their first scan, and the peak bucket.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			bucket	query		string	false	"Bucket width as a Go duration, 1m to 24h (default 15m)"
+//	@Param			from	query		string	false	"Only arrivals at or after (RFC 3339)"
+//	@Param			to		query		string	false	"Only arrivals before (RFC 3339)"
+//	@Success		200		{object}	reports.Arrivals
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or window"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/arrivals [get]
+func (h *Handler) Arrivals(r *http.Request) (any, error) {
+	eventID, w, err := parseEventWindow(r)
+	if err != nil {
+		return nil, err
+	}
+	a, err := h.service.Arrivals(r.Context(), eventID, w)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(a), nil
+}
+
+// Operators handles GET /events/{eventId}/reports/operators.
+//
+// Operators godoc
+//
+//	@Summary		Per-operator scans
+//	@Description	Scan and distinct-ticket counts per operator, busiest first. Scans without an operator are grouped under a null operator.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			from	query		string	false	"Only scans at or after (RFC 3339)"
+//	@Param			to		query		string	false	"Only scans before (RFC 3339)"
+//	@Success		200		{array}		reports.OperatorScans
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or window"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/operators [get]
+func (h *Handler) Operators(r *http.Request) (any, error) {
+	eventID, w, err := parseEventWindow(r)
+	if err != nil {
+		return nil, err
+	}
+	operators, err := h.service.Operators(r.Context(), eventID, w)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(operators), nil
+}
+
+// Days handles GET /events/{eventId}/reports/days.
+//
+// Days godoc
+//
+//	@Summary		Per-day attendance
+//	@Description	Scans, checked-in tickets and first arrivals for each day of a multi-day event, by date. A scan counts for the day its workflow step is scoped to, or, at an unscoped step, for the day whose doors window holds it.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{array}		reports.DayAttendance
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/days [get]
+func (h *Handler) Days(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	days, err := h.service.Days(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(days), nil
+}
+
+// NoShows handles GET /events/{eventId}/reports/no-shows.
+//
+// NoShows godoc
+//
+//	@Summary		No-shows
+//	@Description	Guests holding a live ticket that was never scanned (paginated, filtered, sorted; default by name).
+//	@Tags			reports
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			page		query		int		false	"Page number (1-based)"
+//	@Param			size		query		int		false	"Page size (max 100)"
+//	@Param			sort		query		string	false	"Sort spec field,DIRECTION (repeatable): name, email, rsvp_status"
+//	@Param			rsvp_status	query		string	false	"Filter by RSVP status"
+//	@Success		200			{object}	dto.PageResponse[reports.NoShow]
+//	@Failure		400			{object}	problem.Problem	"Invalid event id or query"
+//	@Failure		404			{object}	problem.Problem	"Event not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/reports/no-shows [get]
+func (h *Handler) NoShows(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	params, err := query.ParseListParams(r.URL.Query(), noShowListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	page, err := h.service.NoShows(r.Context(), eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(page), nil
+}
+
+// TenantRollup handles GET /tenants/{tenantId}/reports/events.
+//
+// TenantRollup godoc
+//
+//	@Summary		Tenant event rollup
+//	@Description	Funnel of each of the tenant's events starting in [from, to), and the totals across them.
+//	@Tags			reports
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Param			from		query		string	false	"Events starting at or after (RFC 3339)"
+//	@Param			to			query		string	false	"Events starting before (RFC 3339)"
+//	@Success		200			{object}	reports.TenantRollup
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id or window"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/reports/events [get]
+func (h *Handler) TenantRollup(r *http.Request) (any, error) {
+	tenantID, err := parseID(r, "tenantId", "tenant")
+	if err != nil {
+		return nil, err
+	}
+	w, err := parseWindow(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	rollup, err := h.service.TenantRollup(r.Context(), tenantID, w)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(rollup), nil
+}
+
+// parseID parses the UUID path parameter param.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
+
+// parseEventWindow parses the eventId path parameter and the window query.
+func parseEventWindow(r *http.Request) (uuid.UUID, Window, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return uuid.Nil, Window{}, err
+	}
+	w, err := parseWindow(r.URL.Query())
+	return eventID, w, err
+}
+
+// parseWindow parses "bucket" (Go duration, default DefaultBucket) and the
+// RFC 3339 "from"/"to" bounds; the service checks their ranges.
+func parseWindow(q url.Values) (Window, error) {
+	w := Window{Bucket: DefaultBucket}
+	if v := q.Get("bucket"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return Window{}, errorz.BadRequest().WithMessage("invalid bucket: " + v)
+		}
+		w.Bucket = d
+	}
+	for _, b := range []struct {
+		name string
+		dst  **time.Time
+	}{{"from", &w.From}, {"to", &w.To}} {
+		v := q.Get(b.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return Window{}, errorz.BadRequest().WithMessage("invalid " + b.name + ": expected RFC 3339 time")
+		}
+		*b.dst = &t
+	}
+	return w, nil
+}
@@ -0,0 +1,38 @@
+package reports
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantBucket time.Duration
+		wantFrom   bool
+		wantTo     bool
+		wantErr    bool
+	}{
+		{name: "defaults", query: "", wantBucket: DefaultBucket},
+		{name: "bucket and bounds", query: "bucket=1h&from=2026-05-01T18:00:00Z&to=2026-05-02T02:00:00%2B07:00", wantBucket: time.Hour, wantFrom: true, wantTo: true},
+		{name: "bad bucket", query: "bucket=15", wantErr: true},
+		{name: "bad from", query: "from=2026-05-01", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, _ := url.ParseQuery(tt.query)
+			w, err := parseWindow(q)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if w.Bucket != tt.wantBucket || (w.From != nil) != tt.wantFrom || (w.To != nil) != tt.wantTo {
+				t.Errorf("window = %+v", w)
+			}
+		})
+	}
+}
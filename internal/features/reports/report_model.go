@@ -0,0 +1,132 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Window restricts a report to scans in [From, To) and sets the width of its
+// time buckets. Nil bounds are open.
+type Window struct {
+	From   *time.Time
+	To     *time.Time
+	Bucket time.Duration
+}
+
+// Funnel is an event's guest conversion, each stage a subset of the guests
+// counted by the previous one except Ticketed, which counts any ticketed
+// guest regardless of RSVP.
+//
+// swagger:model Funnel
+type Funnel struct {
+	Guests    int `json:"guests"`     // live guests
+	Invited   int `json:"invited"`    // rsvp_status invited, confirmed or declined
+	Confirmed int `json:"confirmed"`  // rsvp_status confirmed
+	Declined  int `json:"declined"`   // rsvp_status declined
+	Ticketed  int `json:"ticketed"`   // holding a live, not invalidated ticket
+	CheckedIn int `json:"checked_in"` // a ticket scanned at least once
+	// Rates are stage / previous stage in [0, 1]; 0 when the previous stage is empty.
+	ConfirmedRate float64 `json:"confirmed_rate"` // confirmed / invited
+	TicketedRate  float64 `json:"ticketed_rate"`  // ticketed / confirmed
+	CheckedInRate float64 `json:"checked_in_rate"`
+}
+
+// Bucket is the number of scans in [Start, Start+bucket).
+type Bucket struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+// StepThroughput is one workflow step's scans over time.
+//
+// swagger:model StepThroughput
+type StepThroughput struct {
+	WorkflowStepID uuid.UUID `json:"workflow_step_id"`
+	Name           string    `json:"name"`
+	OrderIndex     int       `json:"order_index"`
+	Total          int       `json:"total"`
+	Buckets        []Bucket  `json:"buckets"` // only non-empty buckets, by start
+}
+
+// StepTransition is how long tickets took from one workflow step to the next,
+// measured between each ticket's first scan at either.
+//
+// swagger:model StepTransition
+type StepTransition struct {
+	FromStepID    uuid.UUID `json:"from_step_id"`
+	FromStep      string    `json:"from_step"`
+	ToStepID      uuid.UUID `json:"to_step_id"`
+	ToStep        string    `json:"to_step"`
+	Tickets       int       `json:"tickets"` // tickets scanned at both, in order
+	AvgSeconds    float64   `json:"avg_seconds"`
+	MedianSeconds float64   `json:"median_seconds"`
+}
+
+// Arrivals buckets tickets by their first scan at any step.
+//
+// swagger:model Arrivals
+type Arrivals struct {
+	BucketSeconds int      `json:"bucket_seconds"`
+	Buckets       []Bucket `json:"buckets"` // only non-empty buckets, by start
+	Peak          *Bucket  `json:"peak"`    // the busiest bucket (earliest on ties); nil without scans
+}
+
+// OperatorScans is one operator's scanning activity; OperatorUserID and Email
+// are nil for scans recorded without (or whose user was deleted) an operator.
+//
+// swagger:model OperatorScans
+type OperatorScans struct {
+	OperatorUserID *uuid.UUID `json:"operator_user_id"`
+	Email          *string    `json:"email"`
+	Scans          int        `json:"scans"`
+	Tickets        int        `json:"tickets"` // distinct tickets scanned
+	FirstScanAt    time.Time  `json:"first_scan_at"`
+	LastScanAt     time.Time  `json:"last_scan_at"`
+}
+
+// DayAttendance is one day of a multi-day event with the scans attributed to
+// it: scans at a workflow step scoped to the day, and scans at unscoped steps
+// made while its doors were open.
+//
+// swagger:model DayAttendance
+type DayAttendance struct {
+	EventDayID    uuid.UUID `json:"event_day_id"`
+	Date          string    `json:"date"`
+	Name          *string   `json:"name,omitempty"`
+	DoorsOpenAt   time.Time `json:"doors_open_at"`
+	DoorsCloseAt  time.Time `json:"doors_close_at"`
+	Scans         int       `json:"scans"`
+	CheckedIn     int       `json:"checked_in"`     // distinct tickets scanned
+	FirstArrivals int       `json:"first_arrivals"` // tickets whose first scan at the event is on this day
+}
+
+// NoShow is a ticketed guest none of whose tickets was ever scanned.
+//
+// swagger:model NoShow
+type NoShow struct {
+	GuestID    uuid.UUID `json:"guest_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	Phone      *string   `json:"phone,omitempty"`
+	RSVPStatus string    `json:"rsvp_status"`
+}
+
+// EventRollup is one event's funnel in a tenant-wide report.
+//
+// swagger:model EventRollup
+type EventRollup struct {
+	EventID   uuid.UUID `json:"event_id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	Funnel
+}
+
+// TenantRollup is the funnel of every event of a tenant starting in the
+// report window, plus their sum.
+//
+// swagger:model TenantRollup
+type TenantRollup struct {
+	Events []EventRollup `json:"events"` // by start_date
+	Totals Funnel        `json:"totals"`
+}
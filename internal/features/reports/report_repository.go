@@ -0,0 +1,404 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/reports/mock_store.go -package=mockreports github.com/biairmal/guest-management-be/internal/features/reports Store
+
+// Store runs the report aggregates. Every query is bounded to one event (or
+// one tenant's events) and served by the indexes of migrations 000011/000013.
+type Store interface {
+	// EventExists returns repository.ErrNotFound unless the event is live.
+	EventExists(ctx context.Context, eventID uuid.UUID) error
+	// TenantExists returns repository.ErrNotFound unless the tenant is live.
+	TenantExists(ctx context.Context, tenantID uuid.UUID) error
+	// Funnel returns the event's funnel counts (rates left to the caller), or
+	// repository.ErrNotFound.
+	Funnel(ctx context.Context, eventID uuid.UUID) (*Funnel, error)
+	// Throughput returns every live step of the event with its scans in w.
+	Throughput(ctx context.Context, eventID uuid.UUID, w Window) ([]StepThroughput, error)
+	// Transitions returns, for each live step but the last, the time tickets
+	// first scanned at it in w took to the next step.
+	Transitions(ctx context.Context, eventID uuid.UUID, w Window) ([]StepTransition, error)
+	// Arrivals buckets the event's tickets by their first scan, for first
+	// scans in w.
+	Arrivals(ctx context.Context, eventID uuid.UUID, w Window) ([]Bucket, error)
+	// Operators returns per-operator scan counts in w, busiest first.
+	Operators(ctx context.Context, eventID uuid.UUID, w Window) ([]OperatorScans, error)
+	// Days returns the event's live days by date with their attributed scans.
+	Days(ctx context.Context, eventID uuid.UUID) ([]DayAttendance, error)
+	// NoShows returns one page of the event's no-shows and their total.
+	NoShows(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*NoShow, int64, error)
+	// TenantEvents returns the funnel counts of the tenant's live events
+	// starting in w (Bucket unused).
+	TenantEvents(ctx context.Context, tenantID uuid.UUID, w Window) ([]EventRollup, error)
+}
+
+// noShowColumns maps the no-show list's allow-listed filter/sort fields to SQL.
+var noShowColumns = map[string]string{
+	"name":        "g.name",
+	"email":       "g.email",
+	"rsvp_status": "g.rsvp_status",
+}
+
+// bucketOrigin aligns time buckets: with whole-minute widths, buckets start
+// on round UTC times.
+const bucketOrigin = "TIMESTAMPTZ '2000-01-01 00:00:00+00'"
+
+// funnelSelect aggregates the funnel of the guests g joined to events e;
+// tk flags each guest's tickets. Callers add WHERE and GROUP BY e.id.
+const funnelSelect = `
+	count(g.id),
+	count(g.id) FILTER (WHERE g.rsvp_status IN ('invited', 'confirmed', 'declined')),
+	count(g.id) FILTER (WHERE g.rsvp_status = 'confirmed'),
+	count(g.id) FILTER (WHERE g.rsvp_status = 'declined'),
+	count(g.id) FILTER (WHERE tk.ticketed),
+	count(g.id) FILTER (WHERE tk.checked_in)
+	FROM events e
+	LEFT JOIN guests g ON g.event_id = e.id AND g.deleted_at IS NULL
+	LEFT JOIN LATERAL (
+		SELECT bool_or(t.status <> 'invalidated') AS ticketed,
+			bool_or(EXISTS (SELECT 1 FROM scan_logs s WHERE s.ticket_id = t.id)) AS checked_in
+		FROM tickets t
+		WHERE t.guest_id = g.id AND t.deleted_at IS NULL
+	) tk ON true`
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// EventExists implements Store.
+func (s *store) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	return s.exists(ctx, "SELECT 1 FROM events WHERE id = $1 AND deleted_at IS NULL", eventID)
+}
+
+// TenantExists implements Store.
+func (s *store) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	return s.exists(ctx, "SELECT 1 FROM tenants WHERE id = $1 AND deleted_at IS NULL", tenantID)
+}
+
+// exists runs a "SELECT 1" lookup, mapping no row to repository.ErrNotFound.
+func (s *store) exists(ctx context.Context, q string, id uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, q, id).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// Funnel implements Store.
+func (s *store) Funnel(ctx context.Context, eventID uuid.UUID) (*Funnel, error) {
+	var f Funnel
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT"+funnelSelect+" WHERE e.id = $1 AND e.deleted_at IS NULL GROUP BY e.id", eventID,
+	).Scan(&f.Guests, &f.Invited, &f.Confirmed, &f.Declined, &f.Ticketed, &f.CheckedIn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Throughput implements Store.
+func (s *store) Throughput(ctx context.Context, eventID uuid.UUID, w Window) ([]StepThroughput, error) {
+	args := []any{eventID, intervalArg(w.Bucket)}
+	scanWindow, args := windowSQL("s.scanned_at", w, args)
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		SELECT w.id, w.name, w.order_index, b.bucket, b.scans
+		FROM workflow_steps w
+		LEFT JOIN LATERAL (
+			SELECT date_bin($2::interval, s.scanned_at, `+bucketOrigin+`) AS bucket, count(*) AS scans
+			FROM scan_logs s
+			WHERE s.event_id = $1 AND s.workflow_step_id = w.id`+scanWindow+`
+			GROUP BY 1
+		) b ON true
+		WHERE w.event_id = $1 AND w.deleted_at IS NULL
+		ORDER BY w.order_index, b.bucket`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var steps []StepThroughput
+	for rows.Next() {
+		var (
+			st     StepThroughput
+			bucket sql.NullTime
+			scans  sql.NullInt64
+		)
+		if err := rows.Scan(&st.WorkflowStepID, &st.Name, &st.OrderIndex, &bucket, &scans); err != nil {
+			return nil, err
+		}
+		if n := len(steps); n == 0 || steps[n-1].WorkflowStepID != st.WorkflowStepID {
+			st.Buckets = []Bucket{}
+			steps = append(steps, st)
+		}
+		if bucket.Valid {
+			last := &steps[len(steps)-1]
+			last.Buckets = append(last.Buckets, Bucket{Start: bucket.Time.UTC(), Count: int(scans.Int64)})
+			last.Total += int(scans.Int64)
+		}
+	}
+	return steps, rows.Err()
+}
+
+// Transitions implements Store.
+func (s *store) Transitions(ctx context.Context, eventID uuid.UUID, w Window) ([]StepTransition, error) {
+	args := []any{eventID}
+	scanWindow, args := windowSQL("s.scanned_at", w, args)
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		WITH firsts AS (
+			SELECT s.ticket_id, s.workflow_step_id, min(s.scanned_at) AS at
+			FROM scan_logs s
+			WHERE s.event_id = $1`+scanWindow+`
+			GROUP BY s.ticket_id, s.workflow_step_id
+		), steps AS (
+			SELECT id, name, order_index,
+				lead(id) OVER (ORDER BY order_index) AS next_id,
+				lead(name) OVER (ORDER BY order_index) AS next_name
+			FROM workflow_steps
+			WHERE event_id = $1 AND deleted_at IS NULL
+		)
+		SELECT st.id, st.name, st.next_id, st.next_name, count(b.ticket_id),
+			coalesce(avg(extract(epoch FROM b.at - a.at)), 0),
+			coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM b.at - a.at)), 0)
+		FROM steps st
+		LEFT JOIN firsts a ON a.workflow_step_id = st.id
+		LEFT JOIN firsts b ON b.workflow_step_id = st.next_id AND b.ticket_id = a.ticket_id AND b.at >= a.at
+		WHERE st.next_id IS NOT NULL
+		GROUP BY st.id, st.name, st.order_index, st.next_id, st.next_name
+		ORDER BY st.order_index`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	transitions := []StepTransition{}
+	for rows.Next() {
+		var t StepTransition
+		if err := rows.Scan(
+			&t.FromStepID, &t.FromStep, &t.ToStepID, &t.ToStep, &t.Tickets, &t.AvgSeconds, &t.MedianSeconds,
+		); err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, t)
+	}
+	return transitions, rows.Err()
+}
+
+// Arrivals implements Store.
+func (s *store) Arrivals(ctx context.Context, eventID uuid.UUID, w Window) ([]Bucket, error) {
+	args := []any{eventID, intervalArg(w.Bucket)}
+	arrivalWindow, args := windowSQL("a.at", w, args)
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		SELECT date_bin($2::interval, a.at, `+bucketOrigin+`), count(*)
+		FROM (
+			SELECT min(s.scanned_at) AS at
+			FROM scan_logs s
+			WHERE s.event_id = $1
+			GROUP BY s.ticket_id
+		) a
+		WHERE true`+arrivalWindow+`
+		GROUP BY 1
+		ORDER BY 1`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	buckets := []Bucket{}
+	for rows.Next() {
+		var b Bucket
+		if err := rows.Scan(&b.Start, &b.Count); err != nil {
+			return nil, err
+		}
+		b.Start = b.Start.UTC()
+		buckets = append(buckets, b)
+	}
+	return buckets, rows.Err()
+}
+
+// Operators implements Store.
+func (s *store) Operators(ctx context.Context, eventID uuid.UUID, w Window) ([]OperatorScans, error) {
+	args := []any{eventID}
+	scanWindow, args := windowSQL("s.scanned_at", w, args)
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		SELECT u.id, u.email, count(*), count(DISTINCT s.ticket_id), min(s.scanned_at), max(s.scanned_at)
+		FROM scan_logs s
+		LEFT JOIN users u ON u.id = s.operator_user_id
+		WHERE s.event_id = $1`+scanWindow+`
+		GROUP BY u.id, u.email
+		ORDER BY count(*) DESC, u.email`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	operators := []OperatorScans{}
+	for rows.Next() {
+		var o OperatorScans
+		if err := rows.Scan(&o.OperatorUserID, &o.Email, &o.Scans, &o.Tickets, &o.FirstScanAt, &o.LastScanAt); err != nil {
+			return nil, err
+		}
+		o.FirstScanAt, o.LastScanAt = o.FirstScanAt.UTC(), o.LastScanAt.UTC()
+		operators = append(operators, o)
+	}
+	return operators, rows.Err()
+}
+
+// Days implements Store. A scan belongs to the day its step is scoped to, or,
+// at an unscoped step, to the day whose doors window holds it.
+func (s *store) Days(ctx context.Context, eventID uuid.UUID) ([]DayAttendance, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		WITH day_scans AS (
+			SELECT d.id AS day_id, s.ticket_id, s.scanned_at
+			FROM event_days d
+			JOIN scan_logs s ON s.event_id = d.event_id
+			JOIN workflow_steps w ON w.id = s.workflow_step_id
+			WHERE d.event_id = $1 AND d.deleted_at IS NULL
+				AND (w.event_day_id = d.id OR (w.event_day_id IS NULL
+					AND s.scanned_at >= d.doors_open_at AND s.scanned_at < d.doors_close_at))
+		), firsts AS (
+			SELECT ticket_id, min(scanned_at) AS at FROM scan_logs WHERE event_id = $1 GROUP BY ticket_id
+		)
+		SELECT d.id, to_char(d.day_date, 'YYYY-MM-DD'), d.name, d.doors_open_at, d.doors_close_at,
+			count(ds.ticket_id), count(DISTINCT ds.ticket_id),
+			count(DISTINCT ds.ticket_id) FILTER (WHERE ds.scanned_at = f.at)
+		FROM event_days d
+		LEFT JOIN day_scans ds ON ds.day_id = d.id
+		LEFT JOIN firsts f ON f.ticket_id = ds.ticket_id
+		WHERE d.event_id = $1 AND d.deleted_at IS NULL
+		GROUP BY d.id
+		ORDER BY d.day_date`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	days := []DayAttendance{}
+	for rows.Next() {
+		var d DayAttendance
+		if err := rows.Scan(
+			&d.EventDayID, &d.Date, &d.Name, &d.DoorsOpenAt, &d.DoorsCloseAt, &d.Scans, &d.CheckedIn, &d.FirstArrivals,
+		); err != nil {
+			return nil, err
+		}
+		d.DoorsOpenAt, d.DoorsCloseAt = d.DoorsOpenAt.UTC(), d.DoorsCloseAt.UTC()
+		days = append(days, d)
+	}
+	return days, rows.Err()
+}
+
+// NoShows implements Store.
+func (s *store) NoShows(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*NoShow, int64, error) {
+	clauses := query.ToSQL(params, noShowColumns, 2)
+	where := append([]string{
+		"g.event_id = $1",
+		"g.deleted_at IS NULL",
+		`EXISTS (SELECT 1 FROM tickets t
+			WHERE t.guest_id = g.id AND t.deleted_at IS NULL AND t.status <> 'invalidated')`,
+		`NOT EXISTS (SELECT 1 FROM tickets t JOIN scan_logs s ON s.ticket_id = t.id WHERE t.guest_id = g.id)`,
+	}, clauses.Where...)
+	from := " FROM guests g WHERE " + strings.Join(where, " AND ")
+	args := append([]any{eventID}, clauses.Args...)
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, "SELECT count(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := "g.name, g.id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", g.id"
+	}
+	n := len(args)
+	args = append(args, params.Size, (params.Page-1)*params.Size)
+	rows, err := conn.QueryContext(ctx,
+		"SELECT g.id, g.name, g.email, g.phone, g.rsvp_status"+from+
+			fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, n+1, n+2), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var noShows []*NoShow
+	for rows.Next() {
+		var ns NoShow
+		if err := rows.Scan(&ns.GuestID, &ns.Name, &ns.Email, &ns.Phone, &ns.RSVPStatus); err != nil {
+			return nil, 0, err
+		}
+		noShows = append(noShows, &ns)
+	}
+	return noShows, total, rows.Err()
+}
+
+// TenantEvents implements Store.
+func (s *store) TenantEvents(ctx context.Context, tenantID uuid.UUID, w Window) ([]EventRollup, error) {
+	args := []any{tenantID}
+	startWindow, args := windowSQL("e.start_date", w, args)
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx,
+		"SELECT e.id, e.name, e.start_date,"+funnelSelect+
+			" WHERE e.tenant_id = $1 AND e.deleted_at IS NULL"+startWindow+
+			" GROUP BY e.id, e.name, e.start_date ORDER BY e.start_date, e.id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	events := []EventRollup{}
+	for rows.Next() {
+		var e EventRollup
+		if err := rows.Scan(
+			&e.EventID, &e.Name, &e.StartDate,
+			&e.Guests, &e.Invited, &e.Confirmed, &e.Declined, &e.Ticketed, &e.CheckedIn,
+		); err != nil {
+			return nil, err
+		}
+		e.StartDate = e.StartDate.UTC()
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// windowSQL returns " AND col >= $n AND col < $m" for w's bounds, with the
+// bound values appended to args.
+func windowSQL(col string, w Window, args []any) (string, []any) {
+	var b strings.Builder
+	if w.From != nil {
+		args = append(args, *w.From)
+		fmt.Fprintf(&b, " AND %s >= $%d", col, len(args))
+	}
+	if w.To != nil {
+		args = append(args, *w.To)
+		fmt.Fprintf(&b, " AND %s < $%d", col, len(args))
+	}
+	return b.String(), args
+}
+
+// intervalArg renders d as a PostgreSQL interval literal.
+func intervalArg(d time.Duration) string {
+	return fmt.Sprintf("%d seconds", int64(d/time.Second))
+}
@@ -0,0 +1,21 @@
+package reports
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitReportRoutes registers the event and tenant report routes on the given router.
+func InitReportRoutes(r *chi.Mux, reportH *Handler) {
+	r.Route("/api/v1/events/{eventId}/reports", func(r chi.Router) {
+		r.Get("/funnel", problem.Handle(reportH.Funnel))
+		r.Get("/throughput", problem.Handle(reportH.Throughput))
+		r.Get("/step-times", problem.Handle(reportH.Transitions))
+		r.Get("/arrivals", problem.Handle(reportH.Arrivals))
+		r.Get("/operators", problem.Handle(reportH.Operators))
+		r.Get("/days", problem.Handle(reportH.Days))
+		r.Get("/no-shows", problem.Handle(reportH.NoShows))
+	})
+	r.Get("/api/v1/tenants/{tenantId}/reports/events", problem.Handle(reportH.TenantRollup))
+}
@@ -0,0 +1,226 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/reports/mock_service.go -package=mockreports github.com/biairmal/guest-management-be/internal/features/reports Service
+
+// Bucket width bounds accepted in a Window.
+const (
+	MinBucket     = time.Minute
+	MaxBucket     = 24 * time.Hour
+	DefaultBucket = 15 * time.Minute
+)
+
+// Service builds post-event and tenant-wide reports.
+type Service interface {
+	// Funnel returns the event's invited → confirmed → ticketed → checked-in conversion.
+	Funnel(ctx context.Context, eventID uuid.UUID) (*Funnel, error)
+	// Throughput returns each workflow step's scans per time bucket.
+	Throughput(ctx context.Context, eventID uuid.UUID, w Window) ([]StepThroughput, error)
+	// Transitions returns the time between consecutive workflow steps.
+	Transitions(ctx context.Context, eventID uuid.UUID, w Window) ([]StepTransition, error)
+	// Arrivals returns first scans per time bucket and the peak bucket.
+	Arrivals(ctx context.Context, eventID uuid.UUID, w Window) (*Arrivals, error)
+	// Operators returns per-operator scan counts.
+	Operators(ctx context.Context, eventID uuid.UUID, w Window) ([]OperatorScans, error)
+	// Days returns the attendance of each day of a multi-day event.
+	Days(ctx context.Context, eventID uuid.UUID) ([]DayAttendance, error)
+	// NoShows returns a page of ticketed guests who were never scanned.
+	NoShows(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*common.PageResponse[NoShow], error)
+	// TenantRollup returns the funnel of each of the tenant's events starting
+	// in the window, and their totals.
+	TenantRollup(ctx context.Context, tenantID uuid.UUID, w Window) (*TenantRollup, error)
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger logger.Logger
+	store  Store
+}
+
+// NewService returns a Service reading aggregates from store.
+func NewService(logger logger.Logger, store Store) Service {
+	return &serviceImpl{logger: logger, store: store}
+}
+
+// Funnel implements Service.
+func (s *serviceImpl) Funnel(ctx context.Context, eventID uuid.UUID) (*Funnel, error) {
+	f, err := s.store.Funnel(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	f.withRates()
+	return f, nil
+}
+
+// Throughput implements Service.
+func (s *serviceImpl) Throughput(ctx context.Context, eventID uuid.UUID, w Window) ([]StepThroughput, error) {
+	if err := s.checkEvent(ctx, eventID, w); err != nil {
+		return nil, err
+	}
+	steps, err := s.store.Throughput(ctx, eventID, w)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	if steps == nil {
+		steps = []StepThroughput{}
+	}
+	return steps, nil
+}
+
+// Transitions implements Service.
+func (s *serviceImpl) Transitions(ctx context.Context, eventID uuid.UUID, w Window) ([]StepTransition, error) {
+	if err := s.checkEvent(ctx, eventID, w); err != nil {
+		return nil, err
+	}
+	transitions, err := s.store.Transitions(ctx, eventID, w)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return transitions, nil
+}
+
+// Arrivals implements Service.
+func (s *serviceImpl) Arrivals(ctx context.Context, eventID uuid.UUID, w Window) (*Arrivals, error) {
+	if err := s.checkEvent(ctx, eventID, w); err != nil {
+		return nil, err
+	}
+	buckets, err := s.store.Arrivals(ctx, eventID, w)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	a := &Arrivals{BucketSeconds: int(w.Bucket / time.Second), Buckets: buckets}
+	for i := range buckets {
+		if a.Peak == nil || buckets[i].Count > a.Peak.Count {
+			a.Peak = &buckets[i]
+		}
+	}
+	return a, nil
+}
+
+// Operators implements Service.
+func (s *serviceImpl) Operators(ctx context.Context, eventID uuid.UUID, w Window) ([]OperatorScans, error) {
+	if err := s.checkEvent(ctx, eventID, w); err != nil {
+		return nil, err
+	}
+	operators, err := s.store.Operators(ctx, eventID, w)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return operators, nil
+}
+
+// Days implements Service.
+func (s *serviceImpl) Days(ctx context.Context, eventID uuid.UUID) ([]DayAttendance, error) {
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	days, err := s.store.Days(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return days, nil
+}
+
+// NoShows implements Service.
+func (s *serviceImpl) NoShows(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[NoShow], error) {
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	items, total, err := s.store.NoShows(ctx, eventID, params)
+	if err != nil {
+		return nil, s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// TenantRollup implements Service.
+func (s *serviceImpl) TenantRollup(ctx context.Context, tenantID uuid.UUID, w Window) (*TenantRollup, error) {
+	if err := validateWindow(w); err != nil {
+		return nil, err
+	}
+	if err := s.store.TenantExists(ctx, tenantID); err != nil {
+		return nil, s.translate(ctx, "tenant", err, logger.F("tenant_id", tenantID))
+	}
+	events, err := s.store.TenantEvents(ctx, tenantID, w)
+	if err != nil {
+		return nil, s.translate(ctx, "tenant", err, logger.F("tenant_id", tenantID))
+	}
+
+	r := &TenantRollup{Events: events}
+	for i := range events {
+		e := &events[i]
+		e.withRates()
+		r.Totals.Guests += e.Guests
+		r.Totals.Invited += e.Invited
+		r.Totals.Confirmed += e.Confirmed
+		r.Totals.Declined += e.Declined
+		r.Totals.Ticketed += e.Ticketed
+		r.Totals.CheckedIn += e.CheckedIn
+	}
+	r.Totals.withRates()
+	return r, nil
+}
+
+// checkEvent validates w and that the event exists.
+func (s *serviceImpl) checkEvent(ctx context.Context, eventID uuid.UUID, w Window) error {
+	if err := validateWindow(w); err != nil {
+		return err
+	}
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		return s.translate(ctx, "event", err, logger.F("event_id", eventID))
+	}
+	return nil
+}
+
+// translate maps a store error to errorz: repository.ErrNotFound → 404 for
+// the report's subject ("event", "tenant"), anything else is logged and
+// becomes a 500.
+func (s *serviceImpl) translate(ctx context.Context, subject string, err error, fields ...logger.Field) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errorz.NotFound().WithMessage(subject + " not found")
+	}
+	s.logger.ErrorWithContext(ctx, "report query failed", append(fields, logger.F("error", err))...)
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to build report")
+}
+
+// validateWindow enforces the bucket bounds and a non-empty range.
+func validateWindow(w Window) error {
+	if w.Bucket < MinBucket || w.Bucket > MaxBucket || w.Bucket%time.Second != 0 {
+		return errorz.BadRequest().WithMessage(fmt.Sprintf("bucket must be whole seconds between %s and %s", MinBucket, MaxBucket))
+	}
+	if w.From != nil && w.To != nil && !w.From.Before(*w.To) {
+		return errorz.BadRequest().WithMessage("from must be before to")
+	}
+	return nil
+}
+
+// withRates fills in f's conversion rates from its counts.
+func (f *Funnel) withRates() {
+	f.ConfirmedRate = ratio(f.Confirmed, f.Invited)
+	f.TicketedRate = ratio(f.Ticketed, f.Confirmed)
+	f.CheckedInRate = ratio(f.CheckedIn, f.Ticketed)
+}
+
+// ratio returns n/d, or 0 when d is 0.
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
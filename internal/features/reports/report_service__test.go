@@ -0,0 +1,234 @@
+package reports_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/features/reports"
+	mockreports "github.com/biairmal/guest-management-be/mocks/reports"
+)
+
+// assertErrorzCode fails unless err carries the wanted errorz code (or is nil when want == "").
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var e *errorz.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *errorz.Error, got %T: %v", err, err)
+	}
+	if e.Code != want {
+		t.Errorf("code = %q, want %q", e.Code, want)
+	}
+}
+
+func TestService_Funnel(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name     string
+		stored   *reports.Funnel
+		storeErr error
+		want     reports.Funnel
+		wantCode string
+	}{
+		{
+			name:   "rates are stage over previous stage",
+			stored: &reports.Funnel{Guests: 10, Invited: 8, Confirmed: 4, Ticketed: 4, CheckedIn: 3},
+			want: reports.Funnel{
+				Guests: 10, Invited: 8, Confirmed: 4, Ticketed: 4, CheckedIn: 3,
+				ConfirmedRate: 0.5, TicketedRate: 1, CheckedInRate: 0.75,
+			},
+		},
+		{name: "empty event has zero rates", stored: &reports.Funnel{}, want: reports.Funnel{}},
+		{name: "event not found", storeErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "store failure", storeErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockreports.NewMockStore(ctrl)
+			store.EXPECT().Funnel(gomock.Any(), eventID).Return(tt.stored, tt.storeErr)
+
+			got, err := reports.NewService(logger.NewNoOp(), store).Funnel(context.Background(), eventID)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && *got != tt.want {
+				t.Errorf("funnel = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_WindowValidation(t *testing.T) {
+	eventID := uuid.New()
+	from := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	tests := []struct {
+		name     string
+		w        reports.Window
+		wantCode string
+	}{
+		{name: "default bucket", w: reports.Window{Bucket: reports.DefaultBucket}},
+		{name: "bounded window", w: reports.Window{Bucket: time.Hour, From: &from, To: &to}},
+		{name: "bucket too small", w: reports.Window{Bucket: 30 * time.Second}, wantCode: errorz.CodeBadRequest},
+		{name: "bucket too large", w: reports.Window{Bucket: 48 * time.Hour}, wantCode: errorz.CodeBadRequest},
+		{name: "fractional seconds", w: reports.Window{Bucket: 90*time.Second + time.Millisecond}, wantCode: errorz.CodeBadRequest},
+		{name: "from not before to", w: reports.Window{Bucket: time.Hour, From: &to, To: &from}, wantCode: errorz.CodeBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockreports.NewMockStore(ctrl)
+			if tt.wantCode == "" {
+				store.EXPECT().EventExists(gomock.Any(), eventID).Return(nil)
+				store.EXPECT().Operators(gomock.Any(), eventID, tt.w).Return([]reports.OperatorScans{}, nil)
+			}
+
+			_, err := reports.NewService(logger.NewNoOp(), store).Operators(context.Background(), eventID, tt.w)
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestService_EventReportsCheckEvent(t *testing.T) {
+	eventID := uuid.New()
+	w := reports.Window{Bucket: reports.DefaultBucket}
+	calls := map[string]func(reports.Service) error{
+		"throughput": func(s reports.Service) error { _, err := s.Throughput(context.Background(), eventID, w); return err },
+		"transitions": func(s reports.Service) error {
+			_, err := s.Transitions(context.Background(), eventID, w)
+			return err
+		},
+		"arrivals":  func(s reports.Service) error { _, err := s.Arrivals(context.Background(), eventID, w); return err },
+		"operators": func(s reports.Service) error { _, err := s.Operators(context.Background(), eventID, w); return err },
+		"days":      func(s reports.Service) error { _, err := s.Days(context.Background(), eventID); return err },
+		"no-shows": func(s reports.Service) error {
+			_, err := s.NoShows(context.Background(), eventID, &query.ListParams{})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockreports.NewMockStore(ctrl)
+			store.EXPECT().EventExists(gomock.Any(), eventID).Return(repository.ErrNotFound)
+
+			assertErrorzCode(t, call(reports.NewService(logger.NewNoOp(), store)), errorz.CodeNotFound)
+		})
+	}
+}
+
+func TestService_ArrivalsPeak(t *testing.T) {
+	eventID := uuid.New()
+	t0 := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		buckets  []reports.Bucket
+		wantPeak *reports.Bucket
+	}{
+		{name: "no arrivals", buckets: []reports.Bucket{}},
+		{
+			name: "earliest of the busiest buckets",
+			buckets: []reports.Bucket{
+				{Start: t0, Count: 3}, {Start: t0.Add(15 * time.Minute), Count: 7}, {Start: t0.Add(30 * time.Minute), Count: 7},
+			},
+			wantPeak: &reports.Bucket{Start: t0.Add(15 * time.Minute), Count: 7},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockreports.NewMockStore(ctrl)
+			w := reports.Window{Bucket: 15 * time.Minute}
+			store.EXPECT().EventExists(gomock.Any(), eventID).Return(nil)
+			store.EXPECT().Arrivals(gomock.Any(), eventID, w).Return(tt.buckets, nil)
+
+			got, err := reports.NewService(logger.NewNoOp(), store).Arrivals(context.Background(), eventID, w)
+			assertErrorzCode(t, err, "")
+			if got.BucketSeconds != 900 {
+				t.Errorf("BucketSeconds = %d, want 900", got.BucketSeconds)
+			}
+			switch {
+			case tt.wantPeak == nil && got.Peak != nil:
+				t.Errorf("Peak = %+v, want nil", *got.Peak)
+			case tt.wantPeak != nil && (got.Peak == nil || *got.Peak != *tt.wantPeak):
+				t.Errorf("Peak = %v, want %+v", got.Peak, *tt.wantPeak)
+			}
+		})
+	}
+}
+
+func TestService_NoShowsPage(t *testing.T) {
+	eventID := uuid.New()
+	ctrl := gomock.NewController(t)
+	store := mockreports.NewMockStore(ctrl)
+	params := &query.ListParams{}
+	params.Page, params.Size = 2, 1
+	store.EXPECT().EventExists(gomock.Any(), eventID).Return(nil)
+	store.EXPECT().NoShows(gomock.Any(), eventID, params).Return([]*reports.NoShow{{Name: "Bob"}}, int64(3), nil)
+
+	page, err := reports.NewService(logger.NewNoOp(), store).NoShows(context.Background(), eventID, params)
+	assertErrorzCode(t, err, "")
+	if page.Total != 3 || len(page.Items) != 1 || page.Items[0].Name != "Bob" {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+func TestService_TenantRollup(t *testing.T) {
+	tenantID := uuid.New()
+	w := reports.Window{Bucket: reports.DefaultBucket}
+	tests := []struct {
+		name       string
+		lookup     error
+		events     []reports.EventRollup
+		wantTotals reports.Funnel
+		wantCode   string
+	}{
+		{
+			name: "totals sum the events",
+			events: []reports.EventRollup{
+				{Name: "Gala", Funnel: reports.Funnel{Guests: 4, Invited: 4, Confirmed: 2, Ticketed: 2, CheckedIn: 1}},
+				{Name: "Expo", Funnel: reports.Funnel{Guests: 6, Invited: 4, Confirmed: 2, Ticketed: 2, CheckedIn: 2}},
+			},
+			wantTotals: reports.Funnel{
+				Guests: 10, Invited: 8, Confirmed: 4, Ticketed: 4, CheckedIn: 3,
+				ConfirmedRate: 0.5, TicketedRate: 1, CheckedInRate: 0.75,
+			},
+		},
+		{name: "tenant not found", lookup: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockreports.NewMockStore(ctrl)
+			store.EXPECT().TenantExists(gomock.Any(), tenantID).Return(tt.lookup)
+			if tt.lookup == nil {
+				store.EXPECT().TenantEvents(gomock.Any(), tenantID, w).Return(tt.events, nil)
+			}
+
+			got, err := reports.NewService(logger.NewNoOp(), store).TenantRollup(context.Background(), tenantID, w)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				return
+			}
+			if got.Totals != tt.wantTotals {
+				t.Errorf("totals = %+v, want %+v", got.Totals, tt.wantTotals)
+			}
+			if got.Events[0].CheckedInRate != 0.5 {
+				t.Errorf("event rates not filled in: %+v", got.Events[0].Funnel)
+			}
+		})
+	}
+}
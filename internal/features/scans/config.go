@@ -0,0 +1,63 @@
+package scans
+
+import (
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// Config aggregates the scans feature's own configuration, one field per
+// layer (app.scans.<layer> in config.yaml).
+type Config struct {
+	Service ServiceConfig `mapstructure:"service"`
+}
+
+// ServiceConfig holds config for the scans feature's service layer.
+type ServiceConfig struct {
+	Live LiveConfig `mapstructure:"live"`
+}
+
+// LiveConfig tunes the live attendance stream.
+type LiveConfig struct {
+	// ChannelPrefix namespaces the Redis pub/sub channels scans are announced on.
+	ChannelPrefix string `mapstructure:"channel_prefix"`
+	// RefreshInterval is the least time between two snapshots sent to one
+	// client; scans arriving in between are folded into the next one.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	// IdleInterval is the most time between two snapshots, so scan rates
+	// decay and the connection stays alive while nobody scans.
+	IdleInterval time.Duration `mapstructure:"idle_interval"`
+}
+
+// DefaultConfig returns the scans feature config with its defaults.
+func DefaultConfig() Config {
+	return Config{Service: ServiceConfig{Live: LiveConfig{
+		ChannelPrefix:   "guest-management",
+		RefreshInterval: time.Second,
+		IdleInterval:    15 * time.Second,
+	}}}
+}
+
+// Validate validates the scans feature configuration.
+func (c *Config) Validate() error {
+	return c.Service.Validate()
+}
+
+// Validate validates the scans feature's service-layer configuration.
+func (c *ServiceConfig) Validate() error {
+	return c.Live.Validate()
+}
+
+// Validate validates the live stream settings.
+func (c *LiveConfig) Validate() error {
+	if c.ChannelPrefix == "" {
+		return errorz.Internal().WithMessage("scans: live.channel_prefix is required")
+	}
+	if c.RefreshInterval <= 0 {
+		return errorz.Internal().WithMessage("scans: live.refresh_interval must be positive")
+	}
+	if c.IdleInterval < c.RefreshInterval {
+		return errorz.Internal().WithMessage("scans: live.idle_interval must be at least live.refresh_interval")
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package scans
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/export"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/tabular"
+)
+
+// ExportHandler exposes the scan log export over HTTP.
+type ExportHandler struct {
+	logger  logger.Logger
+	service ExportService
+}
+
+// NewExportHandler returns an ExportHandler that uses the given service.
+func NewExportHandler(logger logger.Logger, service ExportService) *ExportHandler {
+	return &ExportHandler{logger: logger, service: service}
+}
+
+// Export handles GET /events/{eventId}/scans/export.
+//
+// Export godoc
+//
+//	@Summary		Export scans
+//	@Description	Streams the event's scan log, with guest, workflow step and operator names, as CSV, XLSX or JSON Lines. Accepts the scan list's filters and sorts.
+//	@Tags			scans
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Produce		application/x-ndjson
+//	@Param			eventId				path		string	true	"Event UUID"
+//	@Param			format				query		string	false	"csv (default), xlsx or ndjson"
+//	@Param			sort				query		string	false	"Sort spec field,DIRECTION (repeatable): scanned_at, step_name"
+//	@Param			ticket_id			query		string	false	"Filter by ticket UUID"
+//	@Param			workflow_step_id	query		string	false	"Filter by workflow step UUID"
+//	@Param			operator_user_id	query		string	false	"Filter by operator UUID"
+//	@Success		200					{file}		file
+//	@Failure		400					{object}	problem.Problem	"Invalid event id, format or query"
+//	@Failure		404					{object}	problem.Problem	"Event not found"
+//	@Failure		500					{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/scans/export [get]
+func (h *ExportHandler) Export(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		export.Error(w, r, errcode.InvalidID.WithMessage("invalid event id"))
+		return
+	}
+	name := r.URL.Query().Get("format")
+	if name == "" {
+		name = string(tabular.FormatCSV)
+	}
+	format, err := tabular.ParseExportFormat(name)
+	if err != nil {
+		export.Error(w, r, errorz.BadRequest().WithMessage("format must be csv, xlsx or ndjson"))
+		return
+	}
+	params, err := query.ParseListParams(r.URL.Query(), scanListConfig)
+	if err != nil {
+		export.Error(w, r, errorz.BadRequest().WithMessage(err.Error()))
+		return
+	}
+
+	export.Write(w, r, h.logger, format, "scans-"+eventID.String(), scanExportHeader,
+		func(ctx context.Context, emit func([]string) error) error {
+			return h.service.Export(ctx, eventID, params, emit)
+		})
+}
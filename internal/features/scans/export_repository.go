@@ -0,0 +1,106 @@
+package scans
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_export_store.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ExportStore
+
+// scanExportColumns maps the scan list's allow-listed filter/sort fields to
+// the SQL expressions of the export query.
+var scanExportColumns = map[string]string{
+	"ticket_id":        "s.ticket_id",
+	"workflow_step_id": "s.workflow_step_id",
+	"operator_user_id": "s.operator_user_id",
+	"scanned_at":       "s.scanned_at",
+	"step_name":        "w.name",
+}
+
+// ScanExportRow is one scan as exported, with the names behind its ids.
+type ScanExportRow struct {
+	ScanLog
+	GuestName     string
+	GuestEmail    string
+	StepName      string
+	OperatorEmail *string // nil when the scan has no (remaining) operator
+}
+
+// ExportStore reads scan logs for export.
+type ExportStore interface {
+	// EventExists returns repository.ErrNotFound unless the event is live.
+	EventExists(ctx context.Context, eventID uuid.UUID) error
+	// StreamScans calls fn for each scan of the event matching params'
+	// filters, in params' sort order (default: scanned_at), reading through a
+	// server-side cursor.
+	StreamScans(ctx context.Context, eventID uuid.UUID, params *query.ListParams, fn func(*ScanExportRow) error) error
+}
+
+// exportStore implements ExportStore on PostgreSQL.
+type exportStore struct {
+	db *sqlkit.DB
+}
+
+// NewExportStore returns an ExportStore backed by db.
+func NewExportStore(db *sqlkit.DB) ExportStore {
+	return &exportStore{db: db}
+}
+
+// EventExists implements ExportStore.
+func (s *exportStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	return eventExists(ctx, s.db, eventID)
+}
+
+// eventExists returns repository.ErrNotFound unless the event is live.
+func eventExists(ctx context.Context, db *sqlkit.DB, eventID uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, db).QueryRowContext(ctx,
+		"SELECT 1 FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// StreamScans implements ExportStore. Scans outlive soft deletes of their
+// ticket, guest or step, so the joins deliberately ignore deleted_at.
+func (s *exportStore) StreamScans(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams, fn func(*ScanExportRow) error,
+) error {
+	clauses := query.ToSQL(params, scanExportColumns, 2)
+	where := append([]string{"s.event_id = $1"}, clauses.Where...)
+	order := "s.scanned_at, s.id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", s.id"
+	}
+	q := `SELECT s.id, s.event_id, s.ticket_id, s.workflow_step_id, s.scanned_at, s.operator_user_id,
+			g.name, g.email, w.name, u.email
+		FROM scan_logs s
+		JOIN tickets t ON t.id = s.ticket_id
+		JOIN guests g ON g.id = t.guest_id
+		JOIN workflow_steps w ON w.id = s.workflow_step_id
+		LEFT JOIN users u ON u.id = s.operator_user_id
+		WHERE ` + strings.Join(where, " AND ") + " ORDER BY " + order
+	args := append([]any{eventID}, clauses.Args...)
+
+	return corerepository.Stream(ctx, s.db, corerepository.DefaultFetchSize, q, args, func(rows *sql.Rows) error {
+		var row ScanExportRow
+		if err := rows.Scan(
+			&row.ID, &row.EventID, &row.TicketID, &row.WorkflowStepID, &row.ScannedAt, &row.OperatorUserID,
+			&row.GuestName, &row.GuestEmail, &row.StepName, &row.OperatorEmail,
+		); err != nil {
+			return err
+		}
+		return fn(&row)
+	})
+}
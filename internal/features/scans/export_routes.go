@@ -0,0 +1,8 @@
+package scans
+
+import "github.com/go-chi/chi/v5"
+
+// InitExportRoutes registers the scan log export route on the given router.
+func InitExportRoutes(r *chi.Mux, exportH *ExportHandler) {
+	r.Get("/api/v1/events/{eventId}/scans/export", exportH.Export)
+}
@@ -0,0 +1,97 @@
+package scans
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_export_service.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ExportService
+
+// scanListConfig is the scan list's filter/sort allow-list, shared by every
+// endpoint that lists scans so they accept the same query.
+var scanListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"scanned_at", "step_name"},
+	AllowedFilterFields: []string{"ticket_id", "workflow_step_id", "operator_user_id"},
+}
+
+// scanUUIDFilters are the scanListConfig filters whose values must be UUIDs.
+var scanUUIDFilters = []string{"ticket_id", "workflow_step_id", "operator_user_id"}
+
+// scanExportHeader is the header row of a scan export.
+var scanExportHeader = []string{
+	"id", "scanned_at", "ticket_id", "guest_name", "guest_email",
+	"workflow_step_id", "step_name", "operator_user_id", "operator_email",
+}
+
+// ExportService exports an event's scan log.
+type ExportService interface {
+	// Export calls emit with each matching scan as a row under
+	// scanExportHeader. An error returned by emit is returned unchanged.
+	Export(ctx context.Context, eventID uuid.UUID, params *query.ListParams, emit func(row []string) error) error
+}
+
+// exportServiceImpl is the concrete implementation of ExportService.
+type exportServiceImpl struct {
+	logger logger.Logger
+	store  ExportStore
+}
+
+// NewExportService returns an ExportService that reads scans from store.
+func NewExportService(logger logger.Logger, store ExportStore) ExportService {
+	return &exportServiceImpl{logger: logger, store: store}
+}
+
+// Export implements ExportService.
+func (s *exportServiceImpl) Export(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams, emit func(row []string) error,
+) error {
+	for _, field := range scanUUIDFilters {
+		if v, ok := params.Filters[field]; ok {
+			if _, err := uuid.Parse(v); err != nil {
+				return errorz.BadRequest().WithMessage("invalid " + field)
+			}
+		}
+	}
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "scan export event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to export scans")
+	}
+
+	var emitErr error
+	err := s.store.StreamScans(ctx, eventID, params, func(sc *ScanExportRow) error {
+		emitErr = emit(scanExportRecord(sc))
+		return emitErr
+	})
+	if err == nil || emitErr != nil {
+		return err
+	}
+	s.logger.ErrorWithContext(ctx, "scan export failed", logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to export scans")
+}
+
+// scanExportRecord formats sc as a row under scanExportHeader.
+func scanExportRecord(sc *ScanExportRow) []string {
+	var operatorID, operatorEmail string
+	if sc.OperatorUserID != nil {
+		operatorID = sc.OperatorUserID.String()
+	}
+	if sc.OperatorEmail != nil {
+		operatorEmail = *sc.OperatorEmail
+	}
+	return []string{
+		sc.ID.String(), sc.ScannedAt.UTC().Format(time.RFC3339), sc.TicketID.String(), sc.GuestName, sc.GuestEmail,
+		sc.WorkflowStepID.String(), sc.StepName, operatorID, operatorEmail,
+	}
+}
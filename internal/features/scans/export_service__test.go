@@ -0,0 +1,112 @@
+package scans_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	mockscans "github.com/biairmal/guest-management-be/mocks/scans"
+)
+
+// assertErrorzCode fails unless err carries the wanted errorz code (or is nil when want == "").
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var e *errorz.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *errorz.Error, got %T: %v", err, err)
+	}
+	if e.Code != want {
+		t.Errorf("code = %q, want %q", e.Code, want)
+	}
+}
+
+func TestExportService_Export(t *testing.T) {
+	eventID, scanID, ticketID, stepID, operatorID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	operatorEmail := "gate@x.io"
+	scannedAt := time.Date(2026, 5, 1, 19, 0, 5, 0, time.UTC)
+	stored := []*scans.ScanExportRow{
+		{
+			ScanLog: scans.ScanLog{
+				ID: scanID, EventID: eventID, TicketID: ticketID, WorkflowStepID: stepID,
+				ScannedAt: scannedAt, OperatorUserID: &operatorID,
+			},
+			GuestName: "Ann", GuestEmail: "ann@x.io", StepName: "Check-in", OperatorEmail: &operatorEmail,
+		},
+		{
+			ScanLog:   scans.ScanLog{ID: scanID, EventID: eventID, TicketID: ticketID, WorkflowStepID: stepID, ScannedAt: scannedAt},
+			GuestName: "Ann", GuestEmail: "ann@x.io", StepName: "Dinner",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		filters   map[string]string
+		lookup    error
+		streamErr error
+		wantRows  [][]string
+		wantCode  string
+	}{
+		{
+			name: "rows are formatted under the header",
+			wantRows: [][]string{
+				{scanID.String(), "2026-05-01T19:00:05Z", ticketID.String(), "Ann", "ann@x.io", stepID.String(), "Check-in", operatorID.String(), "gate@x.io"},
+				{scanID.String(), "2026-05-01T19:00:05Z", ticketID.String(), "Ann", "ann@x.io", stepID.String(), "Dinner", "", ""},
+			},
+		},
+		{name: "non-uuid filter value", filters: map[string]string{"ticket_id": "abc"}, wantCode: errorz.CodeBadRequest},
+		{name: "event not found", lookup: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "stream failure", streamErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockscans.NewMockExportStore(ctrl)
+			params := &query.ListParams{Filters: tt.filters}
+			if tt.filters == nil {
+				store.EXPECT().EventExists(gomock.Any(), eventID).Return(tt.lookup)
+			}
+			if tt.filters == nil && tt.lookup == nil {
+				store.EXPECT().StreamScans(gomock.Any(), eventID, params, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ *query.ListParams, fn func(*scans.ScanExportRow) error) error {
+						if tt.streamErr != nil {
+							return tt.streamErr
+						}
+						for _, sc := range stored {
+							if err := fn(sc); err != nil {
+								return err
+							}
+						}
+						return nil
+					})
+			}
+
+			var got [][]string
+			svc := scans.NewExportService(logger.NewNoOp(), store)
+			err := svc.Export(context.Background(), eventID, params, func(row []string) error {
+				got = append(got, row)
+				return nil
+			})
+
+			assertErrorzCode(t, err, tt.wantCode)
+			if !slices.EqualFunc(got, tt.wantRows, slices.Equal) {
+				t.Errorf("rows = %v, want %v", got, tt.wantRows)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package scans
+
+import (
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+	"github.com/biairmal/guest-management-be/internal/core/sse"
+)
+
+// liveEvent is the SSE event name snapshots are sent under.
+const liveEvent = "snapshot"
+
+// LiveHandler exposes the live attendance stream over HTTP.
+type LiveHandler struct {
+	logger  logger.Logger
+	service LiveService
+}
+
+// NewLiveHandler returns a LiveHandler that uses the given service.
+func NewLiveHandler(logger logger.Logger, service LiveService) *LiveHandler {
+	return &LiveHandler{logger: logger, service: service}
+}
+
+// Live handles GET /events/{eventId}/live.
+//
+// Live godoc
+//
+//	@Summary		Live attendance
+//	@Description	Server-Sent Events stream of the event's attendance per workflow step. Every event is named "snapshot" and carries a full LiveSnapshot: one on connect, then after new scans (at most every app.scans.service.live.refresh_interval) and at least every idle_interval.
+//	@Tags			scans
+//	@Produce		text/event-stream
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{object}	scans.LiveSnapshot	"Stream of snapshot events"
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Failure		503		{object}	problem.Problem	"Server shutting down"
+//	@Router			/api/v1/events/{eventId}/live [get]
+func (h *LiveHandler) Live(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		renderError(w, r, errcode.InvalidID.WithMessage("invalid event id"))
+		return
+	}
+
+	// The stream is opened with the first snapshot, so a failure before it
+	// (unknown event, shutdown) is still a regular error response.
+	var stream *sse.Stream
+	opened := false
+	err = h.service.Watch(ctx, eventID, func(snap *LiveSnapshot) error {
+		if !opened {
+			opened = true
+			var err error
+			if stream, err = sse.Open(ctx, h.logger, w); err != nil {
+				return err
+			}
+		}
+		return stream.Send(liveEvent, snap)
+	})
+	if err == nil {
+		return
+	}
+	if !opened {
+		renderError(w, r, err)
+		return
+	}
+	if ctx.Err() == nil {
+		h.logger.WarnWithContext(ctx, "live attendance stream ended", logger.F("event_id", eventID), logger.F("error", err))
+	}
+}
+
+// renderError writes err as problem details (see internal/core/problem).
+func renderError(w http.ResponseWriter, r *http.Request, err error) {
+	problem.Write(w, r, err)
+}
@@ -0,0 +1,28 @@
+package scans
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LiveSnapshot is an event's attendance at one moment, as pushed to the live
+// dashboard.
+//
+// swagger:model LiveSnapshot
+type LiveSnapshot struct {
+	EventID     uuid.UUID        `json:"event_id"`
+	Tickets     int              `json:"tickets"` // live, not invalidated tickets
+	Steps       []StepAttendance `json:"steps"`   // by order_index
+	GeneratedAt time.Time        `json:"generated_at"`
+}
+
+// StepAttendance is the attendance at one workflow step.
+type StepAttendance struct {
+	WorkflowStepID uuid.UUID `json:"workflow_step_id"`
+	Name           string    `json:"name"`
+	OrderIndex     int       `json:"order_index"`
+	CheckedIn      int       `json:"checked_in"`       // distinct tickets scanned at the step
+	Remaining      int       `json:"remaining"`        // tickets not scanned at the step yet
+	ScansPerMinute int       `json:"scans_per_minute"` // scans at the step in the last minute
+}
@@ -0,0 +1,43 @@
+package scans
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/biairmal/go-sdk/lib/redis"
+	"github.com/google/uuid"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_live_publisher.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans LivePublisher
+
+// LivePublisher announces recorded scans to the live streams of every API
+// instance. Scan writes call it once the scan is committed (see
+// transaction.AfterCommit), so a dashboard never counts a rolled-back scan.
+type LivePublisher interface {
+	PublishScan(ctx context.Context, scan *ScanLog) error
+}
+
+// redisLivePublisher implements LivePublisher with Redis PUBLISH.
+type redisLivePublisher struct {
+	client redis.Client
+	prefix string
+}
+
+// NewLivePublisher returns a LivePublisher publishing on client.
+func NewLivePublisher(client redis.Client, cfg LiveConfig) LivePublisher {
+	return &redisLivePublisher{client: client, prefix: cfg.ChannelPrefix}
+}
+
+// PublishScan implements LivePublisher.
+func (p *redisLivePublisher) PublishScan(ctx context.Context, scan *ScanLog) error {
+	payload, err := json.Marshal(scan)
+	if err != nil {
+		return err
+	}
+	return p.client.Publish(ctx, liveChannel(p.prefix, scan.EventID), string(payload))
+}
+
+// liveChannel is the pub/sub channel scans of eventID are announced on.
+func liveChannel(prefix string, eventID uuid.UUID) string {
+	return prefix + ":scans:live:" + eventID.String()
+}
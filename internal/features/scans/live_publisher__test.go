@@ -0,0 +1,35 @@
+package scans
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLivePublisher_PublishScan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockredis.NewMockClient(ctrl)
+	scan := &ScanLog{ID: uuid.New(), EventID: uuid.New(), TicketID: uuid.New(), ScannedAt: time.Now().UTC()}
+
+	client.EXPECT().Publish(gomock.Any(), "gm:scans:live:"+scan.EventID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, message any) error {
+			var got ScanLog
+			if err := json.Unmarshal([]byte(message.(string)), &got); err != nil {
+				t.Fatalf("payload is not a ScanLog: %v", err)
+			}
+			if got.ID != scan.ID {
+				t.Errorf("payload id = %s, want %s", got.ID, scan.ID)
+			}
+			return nil
+		})
+
+	p := NewLivePublisher(client, LiveConfig{ChannelPrefix: "gm"})
+	if err := p.PublishScan(context.Background(), scan); err != nil {
+		t.Fatalf("PublishScan: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package scans
+
+import (
+	"context"
+
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_live_store.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans LiveStore
+
+// LiveStore aggregates attendance for the live dashboard.
+type LiveStore interface {
+	// EventExists returns repository.ErrNotFound unless the event is live.
+	EventExists(ctx context.Context, eventID uuid.UUID) error
+	// Attendance returns the number of live, not invalidated tickets of the
+	// event and, per live workflow step, CheckedIn and ScansPerMinute
+	// (Remaining is left to the caller).
+	Attendance(ctx context.Context, eventID uuid.UUID) (tickets int, steps []StepAttendance, err error)
+}
+
+// liveStore implements LiveStore on PostgreSQL.
+type liveStore struct {
+	db *sqlkit.DB
+}
+
+// NewLiveStore returns a LiveStore backed by db.
+func NewLiveStore(db *sqlkit.DB) LiveStore {
+	return &liveStore{db: db}
+}
+
+// EventExists implements LiveStore.
+func (s *liveStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	return eventExists(ctx, s.db, eventID)
+}
+
+// Attendance implements LiveStore. Both queries are served by the
+// (event_id, ...) indexes on tickets and scan_logs.
+func (s *liveStore) Attendance(ctx context.Context, eventID uuid.UUID) (int, []StepAttendance, error) {
+	conn := corerepository.Conn(ctx, s.db)
+
+	var tickets int
+	if err := conn.QueryRowContext(ctx,
+		"SELECT count(*) FROM tickets WHERE event_id = $1 AND deleted_at IS NULL AND status <> 'invalidated'", eventID,
+	).Scan(&tickets); err != nil {
+		return 0, nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx, `
+		SELECT w.id, w.name, w.order_index,
+			count(DISTINCT s.ticket_id),
+			count(s.id) FILTER (WHERE s.scanned_at > now() - interval '1 minute')
+		FROM workflow_steps w
+		LEFT JOIN scan_logs s ON s.workflow_step_id = w.id AND s.event_id = w.event_id
+		WHERE w.event_id = $1 AND w.deleted_at IS NULL
+		GROUP BY w.id, w.name, w.order_index
+		ORDER BY w.order_index`, eventID)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var steps []StepAttendance
+	for rows.Next() {
+		var st StepAttendance
+		if err := rows.Scan(&st.WorkflowStepID, &st.Name, &st.OrderIndex, &st.CheckedIn, &st.ScansPerMinute); err != nil {
+			return 0, nil, err
+		}
+		steps = append(steps, st)
+	}
+	return tickets, steps, rows.Err()
+}
@@ -0,0 +1,8 @@
+package scans
+
+import "github.com/go-chi/chi/v5"
+
+// InitLiveRoutes registers the live attendance stream route on the given router.
+func InitLiveRoutes(r *chi.Mux, liveH *LiveHandler) {
+	r.Get("/api/v1/events/{eventId}/live", liveH.Live)
+}
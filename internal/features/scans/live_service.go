@@ -0,0 +1,109 @@
+package scans
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/pubsub"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_live_service.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans LiveService
+
+// LiveService streams an event's attendance to the live dashboard.
+type LiveService interface {
+	// Watch calls send with the event's current LiveSnapshot, then with a
+	// fresh one after new scans (at most every RefreshInterval) and at least
+	// every IdleInterval. It returns nil when ctx is done or the stream is
+	// closed on shutdown, and send's error unchanged when send fails.
+	Watch(ctx context.Context, eventID uuid.UUID, send func(*LiveSnapshot) error) error
+}
+
+// liveServiceImpl is the concrete implementation of LiveService.
+type liveServiceImpl struct {
+	logger     logger.Logger
+	store      LiveStore
+	subscriber pubsub.Subscriber
+	cfg        LiveConfig
+}
+
+// NewLiveService returns a LiveService that learns about scans through
+// subscriber (the channels LivePublisher publishes on) and aggregates
+// snapshots from store.
+func NewLiveService(logger logger.Logger, store LiveStore, subscriber pubsub.Subscriber, cfg LiveConfig) LiveService {
+	return &liveServiceImpl{logger: logger, store: store, subscriber: subscriber, cfg: cfg}
+}
+
+// Watch implements LiveService.
+func (s *liveServiceImpl) Watch(ctx context.Context, eventID uuid.UUID, send func(*LiveSnapshot) error) error {
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "live attendance event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to open live attendance")
+	}
+
+	// Subscribe before the first snapshot so no scan falls in between.
+	scans, err := s.subscriber.Subscribe(ctx, liveChannel(s.cfg.ChannelPrefix, eventID))
+	if err != nil {
+		if errors.Is(err, pubsub.ErrClosed) {
+			return errorz.ServiceUnavailable().WithMessage("server is shutting down")
+		}
+		s.logger.ErrorWithContext(ctx, "live attendance subscribe failed", logger.F("event_id", eventID), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to open live attendance")
+	}
+	if err := s.push(ctx, eventID, send); err != nil {
+		return err
+	}
+
+	refresh := time.NewTicker(s.cfg.RefreshInterval)
+	defer refresh.Stop()
+	idle := time.NewTimer(s.cfg.IdleInterval)
+	defer idle.Stop()
+	dirty := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-scans:
+			if !ok {
+				return nil
+			}
+			dirty = true
+			continue
+		case <-refresh.C:
+			if !dirty {
+				continue
+			}
+		case <-idle.C:
+		}
+		if err := s.push(ctx, eventID, send); err != nil {
+			return err
+		}
+		dirty = false
+		idle.Reset(s.cfg.IdleInterval)
+	}
+}
+
+// push aggregates a snapshot and sends it.
+func (s *liveServiceImpl) push(ctx context.Context, eventID uuid.UUID, send func(*LiveSnapshot) error) error {
+	tickets, steps, err := s.store.Attendance(ctx, eventID)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "live attendance snapshot failed", logger.F("event_id", eventID), logger.F("error", err))
+		return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to aggregate attendance")
+	}
+	if steps == nil {
+		steps = []StepAttendance{}
+	}
+	for i := range steps {
+		steps[i].Remaining = max(tickets-steps[i].CheckedIn, 0)
+	}
+	return send(&LiveSnapshot{EventID: eventID, Tickets: tickets, Steps: steps, GeneratedAt: time.Now().UTC()})
+}
@@ -0,0 +1,123 @@
+package scans_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/pubsub"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	mockpubsub "github.com/biairmal/guest-management-be/mocks/core/pubsub"
+	mockscans "github.com/biairmal/guest-management-be/mocks/scans"
+)
+
+func testLiveConfig() scans.LiveConfig {
+	return scans.LiveConfig{ChannelPrefix: "gm", RefreshInterval: time.Millisecond, IdleInterval: time.Hour}
+}
+
+func TestLiveService_WatchOpenErrors(t *testing.T) {
+	eventID := uuid.New()
+	tests := []struct {
+		name         string
+		lookup       error
+		subscribeErr error
+		wantCode     string
+	}{
+		{name: "event not found", lookup: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "event lookup failure", lookup: errors.New("boom"), wantCode: errorz.CodeInternal},
+		{name: "hub closed on shutdown", subscribeErr: pubsub.ErrClosed, wantCode: errorz.CodeServiceUnavailable},
+		{name: "subscribe failure", subscribeErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockscans.NewMockLiveStore(ctrl)
+			sub := mockpubsub.NewMockSubscriber(ctrl)
+			store.EXPECT().EventExists(gomock.Any(), eventID).Return(tt.lookup)
+			if tt.lookup == nil {
+				sub.EXPECT().Subscribe(gomock.Any(), "gm:scans:live:"+eventID.String()).Return(nil, tt.subscribeErr)
+			}
+
+			svc := scans.NewLiveService(logger.NewNoOp(), store, sub, testLiveConfig())
+			err := svc.Watch(context.Background(), eventID, func(*scans.LiveSnapshot) error {
+				t.Fatal("send called")
+				return nil
+			})
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestLiveService_WatchPushesSnapshots(t *testing.T) {
+	eventID, stepID := uuid.New(), uuid.New()
+	ctrl := gomock.NewController(t)
+	store := mockscans.NewMockLiveStore(ctrl)
+	sub := mockpubsub.NewMockSubscriber(ctrl)
+	updates := make(chan string, 1)
+	store.EXPECT().EventExists(gomock.Any(), eventID).Return(nil)
+	sub.EXPECT().Subscribe(gomock.Any(), gomock.Any()).Return(updates, nil)
+	gomock.InOrder(
+		store.EXPECT().Attendance(gomock.Any(), eventID).Return(10, []scans.StepAttendance{
+			{WorkflowStepID: stepID, Name: "Check-in", CheckedIn: 4, ScansPerMinute: 2},
+		}, nil),
+		store.EXPECT().Attendance(gomock.Any(), eventID).Return(3, []scans.StepAttendance{
+			{WorkflowStepID: stepID, Name: "Check-in", CheckedIn: 5, ScansPerMinute: 3},
+		}, nil),
+	)
+
+	var got []*scans.LiveSnapshot
+	svc := scans.NewLiveService(logger.NewNoOp(), store, sub, testLiveConfig())
+	err := svc.Watch(context.Background(), eventID, func(s *scans.LiveSnapshot) error {
+		got = append(got, s)
+		switch len(got) {
+		case 1:
+			updates <- `{"ticket_id":"..."}` // a scan makes the next refresh push
+		case 2:
+			close(updates) // stream closed on shutdown
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d snapshots, want 2", len(got))
+	}
+	if s := got[0].Steps[0]; got[0].Tickets != 10 || s.Remaining != 6 || s.ScansPerMinute != 2 {
+		t.Errorf("first snapshot = %+v", got[0])
+	}
+	// Remaining never goes negative, e.g. after tickets were invalidated.
+	if s := got[1].Steps[0]; s.CheckedIn != 5 || s.Remaining != 0 {
+		t.Errorf("second snapshot step = %+v", s)
+	}
+}
+
+func TestLiveService_WatchReturnsSendError(t *testing.T) {
+	eventID := uuid.New()
+	ctrl := gomock.NewController(t)
+	store := mockscans.NewMockLiveStore(ctrl)
+	sub := mockpubsub.NewMockSubscriber(ctrl)
+	store.EXPECT().EventExists(gomock.Any(), eventID).Return(nil)
+	sub.EXPECT().Subscribe(gomock.Any(), gomock.Any()).Return(make(chan string), nil)
+	store.EXPECT().Attendance(gomock.Any(), eventID).Return(0, nil, nil)
+
+	gone := errors.New("client gone")
+	svc := scans.NewLiveService(logger.NewNoOp(), store, sub, testLiveConfig())
+	err := svc.Watch(context.Background(), eventID, func(s *scans.LiveSnapshot) error {
+		if s.Steps == nil {
+			t.Error("Steps is nil; want an empty list")
+		}
+		return gone
+	})
+	if !errors.Is(err, gone) {
+		t.Errorf("err = %v, want %v", err, gone)
+	}
+}
@@ -0,0 +1,66 @@
+package scans
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
+)
+
+// ScanHandler exposes the scan write path over HTTP.
+type ScanHandler struct {
+	service   ScanService
+	validator validation.Validator
+}
+
+// NewScanHandler returns a ScanHandler that uses the given service and
+// validator.
+func NewScanHandler(service ScanService, validator validation.Validator) *ScanHandler {
+	return &ScanHandler{service: service, validator: validator}
+}
+
+// Record handles POST /events/{eventId}/scans.
+//
+// Record godoc
+//
+//	@Summary		Record scan
+//	@Description	Checks the ticket with the QR code in at a workflow step of the event, on the scanner device whose credential is in X-Device-Credential; the device must be active, registered to the event and allowed the step. A single-entry step lets a ticket pass once (once per day with daily re-entry); a step scoped to a day only accepts scans on that day. The first scan marks the ticket used. Accepted scans are published to the live stream and the scan.accepted webhook.
+//	@Tags			scans
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId				path		string			true	"Event UUID"
+//	@Param			X-Device-Credential	header		string			true	"Scanner device credential"
+//	@Param			body				body		scans.ScanInput	true	"Scan"
+//	@Success		201		{object}	scans.ScanLog
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body, or step not in the event"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated, or device credential missing or unknown"
+//	@Failure		403		{object}	problem.Problem	"Missing check_in, or device inactive, of another event or not allowed the step"
+//	@Failure		404		{object}	problem.Problem	"Event or ticket not found"
+//	@Failure		409		{object}	problem.Problem	"Ticket invalidated or already used at the step, or step not running today"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/scans [post]
+func (h *ScanHandler) Record(r *http.Request) (any, error) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "eventId"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid event id")
+	}
+	var body ScanInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	scan, err := h.service.Record(r.Context(), eventID, r.Header.Get(devices.HeaderCredential), body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(scan), nil
+}
@@ -0,0 +1,26 @@
+package scans
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScanLog represents a row in the scan_logs table: one ticket scanned at one
+// workflow step. Scan logs are append-only, so there is no soft delete.
+//
+// swagger:model ScanLog
+type ScanLog struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	EventID        uuid.UUID  `json:"event_id" db:"event_id"`
+	TicketID       uuid.UUID  `json:"ticket_id" db:"ticket_id"`
+	WorkflowStepID uuid.UUID  `json:"workflow_step_id" db:"workflow_step_id"`
+	ScannedAt      time.Time  `json:"scanned_at" db:"scanned_at"`
+	OperatorUserID *uuid.UUID `json:"operator_user_id,omitempty" db:"operator_user_id"`
+	DeviceID       *uuid.UUID `json:"device_id,omitempty" db:"device_id"` // scanner device, when one was used
+}
+
+// TableName returns the database table name.
+func (ScanLog) TableName() string {
+	return "scan_logs"
+}
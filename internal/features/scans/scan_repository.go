@@ -0,0 +1,172 @@
+package scans
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+	"github.com/biairmal/guest-management-be/internal/features/events"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_scan_store.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ScanStore
+
+// ScanStep is the part of a workflow step a scan is checked against.
+type ScanStep struct {
+	ID             uuid.UUID
+	AllowsMultiple bool       // a ticket may pass the step any number of times
+	EventDayID     *uuid.UUID // the only day the step runs on; nil for every day
+}
+
+// ScanTicket is the part of a ticket a scan is checked against.
+type ScanTicket struct {
+	ID           uuid.UUID
+	Status       string
+	DailyReentry bool // its ticket type allows daily re-entry
+}
+
+// ScanStore holds the queries of the scan write path. LockTicket must run
+// inside a transaction, which the scan's writes then share.
+type ScanStore interface {
+	// EventTenant returns the tenant of the live event, or
+	// repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// Step returns a live workflow step of the event, or
+	// repository.ErrNotFound.
+	Step(ctx context.Context, eventID, stepID uuid.UUID) (*ScanStep, error)
+	// Schedule returns the event's live days by date and its timezone.
+	Schedule(ctx context.Context, eventID uuid.UUID) (events.Schedule, string, error)
+	// LockTicket locks the live ticket of the event with the QR code until
+	// the surrounding transaction ends, serialising scans of one ticket, and
+	// returns it. It returns repository.ErrNotFound when there is none.
+	LockTicket(ctx context.Context, eventID uuid.UUID, qrCode string) (*ScanTicket, error)
+	// Scanned reports whether the ticket was scanned at the step, at or after
+	// since when since is set. Scans voided by a reactivation don't count.
+	Scanned(ctx context.Context, ticketID, stepID uuid.UUID, since *time.Time) (bool, error)
+	// Insert appends a scan log, setting its id.
+	Insert(ctx context.Context, s *ScanLog) error
+	// MarkUsed moves an active ticket to used.
+	MarkUsed(ctx context.Context, ticketID uuid.UUID) error
+}
+
+// scanStore implements ScanStore on PostgreSQL.
+type scanStore struct {
+	db *sqlkit.DB
+}
+
+// NewScanStore returns a ScanStore backed by db.
+func NewScanStore(db *sqlkit.DB) ScanStore {
+	return &scanStore{db: db}
+}
+
+// EventTenant implements ScanStore.
+func (s *scanStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// Step implements ScanStore.
+func (s *scanStore) Step(ctx context.Context, eventID, stepID uuid.UUID) (*ScanStep, error) {
+	st := ScanStep{ID: stepID}
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT allows_multiple, event_day_id
+		FROM workflow_steps WHERE id = $1 AND event_id = $2 AND deleted_at IS NULL`, stepID, eventID,
+	).Scan(&st.AllowsMultiple, &st.EventDayID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// Schedule implements ScanStore.
+func (s *scanStore) Schedule(ctx context.Context, eventID uuid.UUID) (events.Schedule, string, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	var timezone string
+	if err := conn.QueryRowContext(ctx,
+		"SELECT timezone FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&timezone); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", repository.ErrNotFound
+		}
+		return nil, "", err
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT id, event_id, to_char(day_date, 'YYYY-MM-DD'), doors_open_at,
+			doors_close_at
+		FROM event_days WHERE event_id = $1 AND deleted_at IS NULL ORDER BY day_date`, eventID)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = rows.Close() }()
+
+	schedule := events.Schedule{}
+	for rows.Next() {
+		var d events.EventDay
+		if err := rows.Scan(&d.ID, &d.EventID, &d.Date, &d.DoorsOpenAt, &d.DoorsCloseAt); err != nil {
+			return nil, "", err
+		}
+		schedule = append(schedule, &d)
+	}
+	return schedule, timezone, rows.Err()
+}
+
+// LockTicket implements ScanStore.
+func (s *scanStore) LockTicket(ctx context.Context, eventID uuid.UUID, qrCode string) (*ScanTicket, error) {
+	var t ScanTicket
+	var rules []byte
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT t.id, t.status, tt.rules
+		FROM tickets t
+		JOIN ticket_types tt ON tt.id = t.ticket_type_id
+		WHERE t.event_id = $1 AND t.qr_code = $2 AND t.deleted_at IS NULL
+		FOR UPDATE OF t`, eventID, qrCode,
+	).Scan(&t.ID, &t.Status, &rules)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.DailyReentry = events.DailyReentry(rules)
+	return &t, nil
+}
+
+// Scanned implements ScanStore. It is served by idx_scan_logs_ticket_step.
+func (s *scanStore) Scanned(ctx context.Context, ticketID, stepID uuid.UUID, since *time.Time) (bool, error) {
+	var scanned bool
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM scan_logs
+		WHERE ticket_id = $1 AND workflow_step_id = $2 AND voided_at IS NULL
+			AND ($3::timestamptz IS NULL OR scanned_at >= $3))`,
+		ticketID, stepID, since,
+	).Scan(&scanned)
+	return scanned, err
+}
+
+// Insert implements ScanStore.
+func (s *scanStore) Insert(ctx context.Context, scan *ScanLog) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `INSERT INTO scan_logs
+			(event_id, ticket_id, workflow_step_id, scanned_at, operator_user_id, device_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`,
+		scan.EventID, scan.TicketID, scan.WorkflowStepID, scan.ScannedAt, scan.OperatorUserID, scan.DeviceID,
+	).Scan(&scan.ID)
+}
+
+// MarkUsed implements ScanStore.
+func (s *scanStore) MarkUsed(ctx context.Context, ticketID uuid.UUID) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE tickets SET status = 'used', updated_at = now() WHERE id = $1 AND status = 'active'", ticketID)
+	return err
+}
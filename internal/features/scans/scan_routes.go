@@ -0,0 +1,15 @@
+package scans
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitScanRoutes registers the scan write route on the given router. It is
+// open only to callers holding auth.CheckIn; the service checks they belong
+// to the event's tenant.
+func InitScanRoutes(r *chi.Mux, scanH *ScanHandler) {
+	r.With(auth.Require(auth.CheckIn)).Post("/api/v1/events/{eventId}/scans", problem.Handle(scanH.Record))
+}
@@ -0,0 +1,204 @@
+package scans
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
+	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/scans/mock_scan_service.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ScanService
+
+// ScanService records ticket scans: the check-in write path.
+type ScanService interface {
+	// Record checks a ticket in at a workflow step of the event on the
+	// scanner device with the credential and returns the scan log.
+	Record(ctx context.Context, eventID uuid.UUID, credential string, in ScanInput) (*ScanLog, error)
+}
+
+// ScanInput is the body of a scan: the QR code read off the ticket and the
+// step it is scanned at.
+//
+// swagger:model ScanInput
+type ScanInput struct {
+	QRCode         string    `json:"qr_code" validate:"required,max=255"`
+	WorkflowStepID uuid.UUID `json:"workflow_step_id" validate:"required"`
+}
+
+// scanServiceImpl is the concrete implementation of ScanService.
+type scanServiceImpl struct {
+	logger  logger.Logger
+	tx      transaction.TxManager
+	store   ScanStore
+	devices devices.Service
+	hooks   webhooks.Publisher
+	live    LivePublisher
+	now     func() time.Time
+}
+
+// NewScanService returns a ScanService that authorizes scanner devices with
+// devices and announces accepted scans to webhooks through hooks and to live
+// dashboards through live.
+func NewScanService(
+	logger logger.Logger, tx transaction.TxManager, store ScanStore, devices devices.Service,
+	hooks webhooks.Publisher, live LivePublisher,
+) ScanService {
+	return &scanServiceImpl{
+		logger: logger, tx: tx, store: store, devices: devices, hooks: hooks, live: live, now: time.Now,
+	}
+}
+
+// Record implements ScanService. The device must be authorized for the step
+// (devices.Service.Authorize). The ticket must be a live, not invalidated
+// ticket of the event and the step a live step of it that runs today. At a
+// single-entry step (allows_multiple false) a ticket passes once — once per
+// event day when its type allows daily re-entry. The first scan moves an
+// active ticket to used. Accepted scans are counted once committed, refusals
+// by reason.
+func (s *scanServiceImpl) Record(
+	ctx context.Context, eventID uuid.UUID, credential string, in ScanInput,
+) (*ScanLog, error) {
+	tenantID, err := s.store.EventTenant(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "scan event read failed", eventID, err)
+	}
+	if !auth.InTenant(ctx, tenantID) {
+		return nil, errcode.EventNotFound.New()
+	}
+	device, err := s.devices.Authorize(ctx, credential, eventID, in.WorkflowStepID)
+	if err != nil {
+		return nil, err
+	}
+
+	var scan *ScanLog
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		step, err := s.store.Step(ctx, eventID, in.WorkflowStepID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return reject("step_unknown", errorz.BadRequest().WithMessage("workflow step not found in this event"))
+		}
+		if err != nil {
+			return s.translate(ctx, "scan step read failed", eventID, err)
+		}
+		ticket, err := s.store.LockTicket(ctx, eventID, in.QRCode)
+		if errors.Is(err, repository.ErrNotFound) {
+			return reject("ticket_unknown", errcode.TicketNotFound.New())
+		}
+		if err != nil {
+			return s.translate(ctx, "scan ticket read failed", eventID, err)
+		}
+		if ticket.Status == tickets.StatusInvalidated {
+			return reject("ticket_invalidated", errcode.ScanTicketInvalidated.New())
+		}
+
+		at := s.now().UTC()
+		today, err := s.today(ctx, eventID, at)
+		if err != nil {
+			return err
+		}
+		if step.EventDayID != nil && (today == nil || today.ID != *step.EventDayID) {
+			return reject("step_not_today", errcode.ScanStepNotToday.New())
+		}
+		if !step.AllowsMultiple {
+			scanned, err := s.store.Scanned(ctx, ticket.ID, step.ID, events.EntrySince(today, ticket.DailyReentry))
+			if err != nil {
+				return s.translate(ctx, "scan history read failed", eventID, err)
+			}
+			if scanned {
+				return reject("already_used", errcode.TicketAlreadyUsed.New())
+			}
+		}
+
+		scan = &ScanLog{
+			EventID: eventID, TicketID: ticket.ID, WorkflowStepID: step.ID, ScannedAt: at,
+			OperatorUserID: operator(ctx, device), DeviceID: &device.ID,
+		}
+		if err := s.store.Insert(ctx, scan); err != nil {
+			return s.translate(ctx, "scan insert failed", eventID, err)
+		}
+		if ticket.Status == tickets.StatusActive {
+			if err := s.store.MarkUsed(ctx, ticket.ID); err != nil {
+				return s.translate(ctx, "scan ticket update failed", eventID, err)
+			}
+		}
+		if err := s.hooks.Publish(ctx, eventID, webhooks.ScanAccepted, scan); err != nil {
+			return s.translate(ctx, "scan webhook enqueue failed", eventID, err)
+		}
+		transaction.AfterCommit(ctx, func(ctx context.Context) {
+			metrics.ScanAccepted()
+			s.announce(ctx, scan)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "scan recorded", logger.F("event_id", eventID), logger.F("scan_id", scan.ID),
+		logger.F("ticket_id", scan.TicketID), logger.F("workflow_step_id", scan.WorkflowStepID),
+		logger.F("device_id", device.ID))
+	return scan, nil
+}
+
+// today returns the event day at, or nil when at falls on none.
+func (s *scanServiceImpl) today(ctx context.Context, eventID uuid.UUID, at time.Time) (*events.EventDay, error) {
+	schedule, timezone, err := s.store.Schedule(ctx, eventID)
+	if err != nil {
+		return nil, s.translate(ctx, "scan schedule read failed", eventID, err)
+	}
+	loc, err := events.LoadTimezone(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return schedule.Today(at, loc), nil
+}
+
+// announce publishes a committed scan to the live streams. The scan stands
+// whether or not it does; a dashboard that misses it catches up on its next
+// idle refresh.
+func (s *scanServiceImpl) announce(ctx context.Context, scan *ScanLog) {
+	if err := s.live.PublishScan(ctx, scan); err != nil {
+		s.logger.WarnWithContext(ctx, "live scan publish failed", logger.F("event_id", scan.EventID),
+			logger.F("scan_id", scan.ID), logger.F("error", err))
+	}
+}
+
+// reject counts a scan refused for reason, as devices.Service.Authorize does
+// for device refusals, and returns err.
+func reject(reason string, err error) error {
+	metrics.ScanRejected(reason)
+	return err
+}
+
+// translate maps a store error to 404 for a missing event, or logs it as msg
+// and wraps it as a 500.
+func (s *scanServiceImpl) translate(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to record scan")
+}
+
+// operator returns the user accountable for a scan on device: the logged-in
+// user making it or, for a caller without one (an API key), the user of the
+// device's open shift, if any.
+func operator(ctx context.Context, device *devices.Device) *uuid.UUID {
+	id, err := uuid.Parse(ctxkit.UserID(ctx))
+	if err != nil {
+		return device.Operator
+	}
+	return &id
+}
@@ -0,0 +1,396 @@
+package scans_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/redis"
+	"github.com/biairmal/go-sdk/lib/repository"
+	mockredis "github.com/biairmal/go-sdk/mocks/redis"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/pubsub"
+	"github.com/biairmal/guest-management-be/internal/features/devices"
+	"github.com/biairmal/guest-management-be/internal/features/events"
+	"github.com/biairmal/guest-management-be/internal/features/scans"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockdevices "github.com/biairmal/guest-management-be/mocks/devices"
+	mockscans "github.com/biairmal/guest-management-be/mocks/scans"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// staff returns a context authenticated as a user of the tenant.
+func staff(tenantID, userID uuid.UUID) context.Context {
+	ctx := ctxkit.WithTenantID(context.Background(), tenantID.String())
+	return ctxkit.WithUserID(ctx, userID.String())
+}
+
+// scanCount returns guest_management_scans_total for the result and reason.
+func scanCount(t *testing.T, result, reason string) float64 {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	for _, f := range families {
+		if f.GetName() != "guest_management_scans_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["result"] == result && labels["reason"] == reason {
+				return m.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+// scanner returns a device service that authorizes "CRED-1" as device for
+// the event's step.
+func scanner(ctrl *gomock.Controller, device *devices.Device, eventID, stepID uuid.UUID) *mockdevices.MockService {
+	svc := mockdevices.NewMockService(ctrl)
+	svc.EXPECT().Authorize(gomock.Any(), "CRED-1", eventID, stepID).Return(device, nil).AnyTimes()
+	return svc
+}
+
+func TestScanService_Record(t *testing.T) {
+	tenantID, userID, eventID, stepID, ticketID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	today := &events.EventDay{
+		ID: uuid.New(), Date: time.Now().UTC().Format(events.DateLayout),
+		DoorsOpenAt: time.Now().Add(-time.Hour), DoorsCloseAt: time.Now().Add(time.Hour),
+	}
+	otherDay := uuid.New()
+	shiftUser := uuid.New()
+	keyCaller := ctxkit.WithTenantID(context.Background(), tenantID.String())
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		device    *devices.Device
+		deviceErr error
+		step      *scans.ScanStep
+		stepErr   error
+		ticket    *scans.ScanTicket
+		ticketErr error
+		schedule  events.Schedule
+		scanned   bool
+		wantSince *time.Time
+		wantUsed  bool
+		wantOp    *uuid.UUID
+		wantCode  string
+		rejected  string // reason the refusal is counted under
+	}{
+		{
+			name: "first scan marks the ticket used", step: &scans.ScanStep{ID: stepID},
+			ticket: &scans.ScanTicket{ID: ticketID, Status: "active"}, wantUsed: true,
+		},
+		{
+			name: "repeat step takes used tickets", step: &scans.ScanStep{ID: stepID, AllowsMultiple: true},
+			ticket: &scans.ScanTicket{ID: ticketID, Status: "used"},
+		},
+		{
+			name: "daily re-entry counts only today's scans", step: &scans.ScanStep{ID: stepID},
+			ticket:   &scans.ScanTicket{ID: ticketID, Status: "used", DailyReentry: true},
+			schedule: events.Schedule{today}, wantSince: &today.DoorsOpenAt,
+		},
+		{
+			name: "step scoped to today", step: &scans.ScanStep{ID: stepID, EventDayID: &today.ID},
+			ticket: &scans.ScanTicket{ID: ticketID, Status: "active"}, schedule: events.Schedule{today}, wantUsed: true,
+		},
+		{
+			name: "logged-in user operates over the shift user", step: &scans.ScanStep{ID: stepID},
+			device: &devices.Device{Operator: &shiftUser}, ticket: &scans.ScanTicket{ID: ticketID, Status: "active"},
+			wantUsed: true,
+		},
+		{
+			name: "api key scans under the shift user", ctx: keyCaller, step: &scans.ScanStep{ID: stepID},
+			device: &devices.Device{Operator: &shiftUser}, ticket: &scans.ScanTicket{ID: ticketID, Status: "active"},
+			wantUsed: true, wantOp: &shiftUser,
+		},
+		{
+			name: "other tenant", ctx: staff(uuid.New(), userID), wantCode: errorz.CodeNotFound,
+		},
+		{
+			name: "device refused", deviceErr: errcode.ScanDeviceInactive.New(), wantCode: errorz.CodeForbidden,
+		},
+		{
+			name: "step not in the event", stepErr: repository.ErrNotFound, wantCode: errorz.CodeBadRequest,
+			rejected: "step_unknown",
+		},
+		{
+			name: "unknown ticket", step: &scans.ScanStep{ID: stepID}, ticketErr: repository.ErrNotFound,
+			wantCode: errorz.CodeNotFound, rejected: "ticket_unknown",
+		},
+		{
+			name: "invalidated ticket", step: &scans.ScanStep{ID: stepID},
+			ticket: &scans.ScanTicket{ID: ticketID, Status: "invalidated"}, wantCode: errorz.CodeConflict,
+			rejected: "ticket_invalidated",
+		},
+		{
+			name: "step scoped to another day", step: &scans.ScanStep{ID: stepID, EventDayID: &otherDay},
+			ticket: &scans.ScanTicket{ID: ticketID, Status: "active"}, schedule: events.Schedule{today},
+			wantCode: errorz.CodeConflict, rejected: "step_not_today",
+		},
+		{
+			name: "already through a single-entry step", step: &scans.ScanStep{ID: stepID},
+			ticket: &scans.ScanTicket{ID: ticketID, Status: "used"}, scanned: true, wantCode: errorz.CodeConflict,
+			rejected: "already_used",
+		},
+		{
+			name: "store failure", step: &scans.ScanStep{ID: stepID}, ticketErr: errors.New("boom"),
+			wantCode: errorz.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockscans.NewMockScanStore(ctrl)
+			hooks := mockwebhooks.NewMockPublisher(ctrl)
+			live := mockscans.NewMockLivePublisher(ctrl)
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = staff(tenantID, userID)
+			}
+			device := tt.device
+			if device == nil {
+				device = &devices.Device{}
+			}
+			device.ID = uuid.New()
+			wantOp := tt.wantOp
+			if wantOp == nil {
+				wantOp = &userID
+			}
+			deviceSvc := mockdevices.NewMockService(ctrl)
+			deviceSvc.EXPECT().Authorize(gomock.Any(), "CRED-1", eventID, stepID).Return(device, tt.deviceErr).MaxTimes(1)
+
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+			store.EXPECT().Step(gomock.Any(), eventID, stepID).Return(tt.step, tt.stepErr).MaxTimes(1)
+			store.EXPECT().LockTicket(gomock.Any(), eventID, "QR-1").Return(tt.ticket, tt.ticketErr).MaxTimes(1)
+			store.EXPECT().Schedule(gomock.Any(), eventID).Return(tt.schedule, "UTC", nil).MaxTimes(1)
+			store.EXPECT().Scanned(gomock.Any(), ticketID, stepID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ uuid.UUID, since *time.Time) (bool, error) {
+					if (since == nil) != (tt.wantSince == nil) || (since != nil && !since.Equal(*tt.wantSince)) {
+						t.Errorf("Scanned since = %v, want %v", since, tt.wantSince)
+					}
+					return tt.scanned, nil
+				}).MaxTimes(1)
+			if tt.wantCode == "" {
+				store.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *scans.ScanLog) error {
+					s.ID = uuid.New()
+					return nil
+				})
+				if tt.wantUsed {
+					store.EXPECT().MarkUsed(gomock.Any(), ticketID).Return(nil)
+				}
+				hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.ScanAccepted, gomock.Any()).Return(nil)
+				live.EXPECT().PublishScan(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			accepted := scanCount(t, "accepted", "")
+			rejected := scanCount(t, "rejected", tt.rejected)
+			svc := scans.NewScanService(logger.NewNoOp(), inlineTx(ctrl), store, deviceSvc, hooks, live)
+			scan, err := svc.Record(ctx, eventID, "CRED-1", scans.ScanInput{QRCode: "QR-1", WorkflowStepID: stepID})
+			assertErrorzCode(t, err, tt.wantCode)
+			wantAccepted := 0.0
+			if err == nil {
+				wantAccepted = 1
+			}
+			if got := scanCount(t, "accepted", "") - accepted; got != wantAccepted {
+				t.Errorf("accepted scans grew by %v, want %v", got, wantAccepted)
+			}
+			if got := scanCount(t, "rejected", tt.rejected) - rejected; tt.rejected != "" && got != 1 {
+				t.Errorf("scans rejected as %s grew by %v, want 1", tt.rejected, got)
+			}
+			if err != nil {
+				return
+			}
+			if scan.TicketID != ticketID || scan.OperatorUserID == nil || *scan.OperatorUserID != *wantOp {
+				t.Errorf("scan = %+v, want ticket %s by operator %s", scan, ticketID, *wantOp)
+			}
+			if scan.DeviceID == nil || *scan.DeviceID != device.ID {
+				t.Errorf("scan device = %v, want %s", scan.DeviceID, device.ID)
+			}
+		})
+	}
+}
+
+// TestScanService_RecordReachesLiveStream records a scan while a dashboard
+// watches the event, through the real publisher and hub over one Redis mock,
+// and expects the watcher to be sent a fresh snapshot for it.
+func TestScanService_RecordReachesLiveStream(t *testing.T) {
+	tenantID, eventID, stepID, ticketID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	ctrl := gomock.NewController(t)
+	cfg := testLiveConfig()
+
+	messages := make(chan *redis.Message, 1)
+	client := mockredis.NewMockClient(ctrl)
+	sub := mockredis.NewMockPubSub(ctrl)
+	sub.EXPECT().Channel().Return(messages)
+	sub.EXPECT().Close().Return(nil).AnyTimes()
+	client.EXPECT().Subscribe(gomock.Any(), "gm:scans:live:"+eventID.String()).Return(sub)
+	client.EXPECT().Publish(gomock.Any(), "gm:scans:live:"+eventID.String(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, message any) error {
+			messages <- &redis.Message{Payload: message.(string)}
+			return nil
+		})
+
+	store := mockscans.NewMockScanStore(ctrl)
+	store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+	store.EXPECT().Step(gomock.Any(), eventID, stepID).Return(&scans.ScanStep{ID: stepID}, nil)
+	store.EXPECT().LockTicket(gomock.Any(), eventID, "QR-1").Return(&scans.ScanTicket{ID: ticketID, Status: "active"}, nil)
+	store.EXPECT().Schedule(gomock.Any(), eventID).Return(events.Schedule{}, "UTC", nil)
+	store.EXPECT().Scanned(gomock.Any(), ticketID, stepID, gomock.Nil()).Return(false, nil)
+	store.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+	store.EXPECT().MarkUsed(gomock.Any(), ticketID).Return(nil)
+	hooks := mockwebhooks.NewMockPublisher(ctrl)
+	hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.ScanAccepted, gomock.Any()).Return(nil)
+	deviceSvc := scanner(ctrl, &devices.Device{ID: uuid.New()}, eventID, stepID)
+	scanSvc := scans.NewScanService(
+		logger.NewNoOp(), inlineTx(ctrl), store, deviceSvc, hooks, scans.NewLivePublisher(client, cfg))
+
+	liveStore := mockscans.NewMockLiveStore(ctrl)
+	liveStore.EXPECT().EventExists(gomock.Any(), eventID).Return(nil)
+	gomock.InOrder(
+		liveStore.EXPECT().Attendance(gomock.Any(), eventID).Return(1, []scans.StepAttendance{{WorkflowStepID: stepID}}, nil),
+		liveStore.EXPECT().Attendance(gomock.Any(), eventID).Return(1, []scans.StepAttendance{
+			{WorkflowStepID: stepID, CheckedIn: 1, ScansPerMinute: 1},
+		}, nil),
+	)
+	hub := pubsub.NewHub(logger.NewNoOp(), client)
+	defer hub.Close()
+	liveSvc := scans.NewLiveService(logger.NewNoOp(), liveStore, hub, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var got []*scans.LiveSnapshot
+	err := liveSvc.Watch(ctx, eventID, func(s *scans.LiveSnapshot) error {
+		got = append(got, s)
+		if len(got) == 1 {
+			_, err := scanSvc.Record(staff(tenantID, uuid.New()), eventID, "CRED-1",
+				scans.ScanInput{QRCode: "QR-1", WorkflowStepID: stepID})
+			return err
+		}
+		cancel()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if len(got) != 2 || got[1].Steps[0].CheckedIn != 1 {
+		t.Fatalf("got %d snapshots, want the scan to trigger a second one with the ticket checked in", len(got))
+	}
+}
+
+// TestScanService_RecordAfterReactivate scans a ticket in at a single-entry
+// step, reactivates it through the ticket lifecycle and expects the next scan
+// to be accepted, over one in-memory scan log shared by both slices.
+func TestScanService_RecordAfterReactivate(t *testing.T) {
+	tenantID, eventID, stepID, ticketID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	ctrl := gomock.NewController(t)
+	ctx := staff(tenantID, uuid.New())
+
+	type logged struct {
+		stepID uuid.UUID
+		voided bool
+	}
+	status := tickets.StatusActive
+	var log []*logged
+
+	store := mockscans.NewMockScanStore(ctrl)
+	store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil).AnyTimes()
+	store.EXPECT().Step(gomock.Any(), eventID, stepID).Return(&scans.ScanStep{ID: stepID}, nil).AnyTimes()
+	store.EXPECT().Schedule(gomock.Any(), eventID).Return(events.Schedule{}, "UTC", nil).AnyTimes()
+	store.EXPECT().LockTicket(gomock.Any(), eventID, "QR-1").DoAndReturn(
+		func(context.Context, uuid.UUID, string) (*scans.ScanTicket, error) {
+			return &scans.ScanTicket{ID: ticketID, Status: status}, nil
+		}).AnyTimes()
+	store.EXPECT().Scanned(gomock.Any(), ticketID, stepID, gomock.Nil()).DoAndReturn(
+		func(context.Context, uuid.UUID, uuid.UUID, *time.Time) (bool, error) {
+			for _, l := range log {
+				if l.stepID == stepID && !l.voided {
+					return true, nil
+				}
+			}
+			return false, nil
+		}).AnyTimes()
+	store.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *scans.ScanLog) error {
+		log = append(log, &logged{stepID: s.WorkflowStepID})
+		return nil
+	}).AnyTimes()
+	store.EXPECT().MarkUsed(gomock.Any(), ticketID).DoAndReturn(func(context.Context, uuid.UUID) error {
+		status = tickets.StatusUsed
+		return nil
+	}).AnyTimes()
+	hooks := mockwebhooks.NewMockPublisher(ctrl)
+	hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.ScanAccepted, gomock.Any()).Return(nil).AnyTimes()
+	client := mockredis.NewMockClient(ctrl)
+	client.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	scanSvc := scans.NewScanService(logger.NewNoOp(), inlineTx(ctrl), store,
+		scanner(ctrl, &devices.Device{ID: uuid.New()}, eventID, stepID), hooks,
+		scans.NewLivePublisher(client, testLiveConfig()))
+
+	ticketStore := mocktickets.NewMockStore(ctrl)
+	ticketStore.EXPECT().LockUsage(gomock.Any(), eventID).Return(&tickets.Usage{}, nil)
+	lifecycle := mocktickets.NewMockLifecycleStore(ctrl)
+	lifecycle.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+	lifecycle.EXPECT().Ticket(gomock.Any(), eventID, ticketID).DoAndReturn(
+		func(context.Context, uuid.UUID, uuid.UUID) (*tickets.Ticket, error) {
+			return &tickets.Ticket{ID: ticketID, EventID: eventID, Status: status}, nil
+		})
+	lifecycle.EXPECT().SetStatus(gomock.Any(), gomock.Any(), tickets.StatusActive).DoAndReturn(
+		func(_ context.Context, t *tickets.Ticket, s string) error {
+			t.Status, status = s, s
+			return nil
+		})
+	lifecycle.EXPECT().VoidScans(gomock.Any(), ticketID).DoAndReturn(func(context.Context, uuid.UUID) error {
+		for _, l := range log {
+			l.voided = true
+		}
+		return nil
+	})
+	lifecycle.EXPECT().AddAudit(gomock.Any(), gomock.Any()).Return(nil)
+	lifecycleSvc := tickets.NewLifecycleService(logger.NewNoOp(), inlineTx(ctrl), ticketStore, lifecycle,
+		mocktickets.NewMockIssuer(ctrl), mocktickets.NewMockNotifier(ctrl))
+
+	scan := func() error {
+		_, err := scanSvc.Record(ctx, eventID, "CRED-1", scans.ScanInput{QRCode: "QR-1", WorkflowStepID: stepID})
+		return err
+	}
+	if err := scan(); err != nil {
+		t.Fatalf("first scan: %v", err)
+	}
+	if code, _ := errcode.Of(scan()); code != errcode.TicketAlreadyUsed {
+		t.Fatalf("second scan = %s, want %s", code, errcode.TicketAlreadyUsed)
+	}
+	if _, err := lifecycleSvc.Reactivate(ctx, eventID, ticketID, tickets.ChangeInput{Reason: "scanned by mistake"}); err != nil {
+		t.Fatalf("Reactivate: %v", err)
+	}
+	if err := scan(); err != nil {
+		t.Fatalf("scan after reactivation: %v", err)
+	}
+	if status != tickets.StatusUsed || len(log) != 2 {
+		t.Errorf("status = %s with %d scans, want used with 2", status, len(log))
+	}
+}
@@ -0,0 +1,239 @@
+package staffing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// maxUploadBytes caps bulk assignment uploads; MaxBulkRows emails fit well
+// within it.
+const maxUploadBytes = 1 << 20
+
+// rosterListConfig declares the allow-listed sort/filter fields for the
+// roster.
+var rosterListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"email", "created_at"},
+	AllowedFilterFields: []string{"email", "role_id"},
+}
+
+// Handler exposes HTTP handlers for event staff rosters.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+}
+
+// NewHandler returns a Handler that uses the given service and validator.
+func NewHandler(service Service, validator validation.Validator) *Handler {
+	return &Handler{service: service, validator: validator}
+}
+
+// Roster handles GET /events/{eventId}/staff.
+//
+// Roster godoc
+//
+//	@Summary		Staff roster
+//	@Description	The event's staff (paginated, filtered, sorted; default by email), each with their role and the permission codes it grants at this event.
+//	@Tags			staffing
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			page	query		int		false	"Page number (1-based)"
+//	@Param			size	query		int		false	"Page size (max 100)"
+//	@Param			sort	query		string	false	"Sort spec field,DIRECTION (repeatable): email, created_at"
+//	@Param			email	query		string	false	"Filter by email"
+//	@Param			role_id	query		string	false	"Filter by role UUID"
+//	@Success		200		{object}	dto.PageResponse[staffing.Member]
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or query"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated"
+//	@Failure		403		{object}	problem.Problem	"Missing manage_staff, or event of another tenant"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/staff [get]
+func (h *Handler) Roster(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	params, err := query.ParseListParams(r.URL.Query(), rosterListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	page, err := h.service.Roster(r.Context(), eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(page), nil
+}
+
+// Assign handles POST /events/{eventId}/staff.
+//
+// Assign godoc
+//
+//	@Summary		Assign staff
+//	@Description	Puts a user of the event's tenant on its staff with a role. Assigning someone already on the staff changes their role.
+//	@Tags			staffing
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			body	body		staffing.AssignInput	true	"User and role"
+//	@Success		200		{object}	staffing.Member
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body, or unknown role"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated"
+//	@Failure		403		{object}	problem.Problem	"Missing manage_staff, or caller or user of another tenant"
+//	@Failure		404		{object}	problem.Problem	"Event or user not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/staff [post]
+func (h *Handler) Assign(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	var body AssignInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	m, err := h.service.Assign(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(m), nil
+}
+
+// BulkAssign handles POST /events/{eventId}/staff/bulk.
+//
+// BulkAssign godoc
+//
+//	@Summary		Bulk assign staff
+//	@Description	Assigns the users listed by email in a CSV (the column headed "email", else the first column; at most 1000 rows) with one role. Rows whose email is invalid, repeated or not a user of the event's tenant are returned as rejected; the rest are assigned.
+//	@Tags			staffing
+//	@Security		BearerAuth
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Param			file	formData	file	true	"CSV of emails"
+//	@Param			role_id	formData	string	true	"Role UUID for every listed user"
+//	@Success		200		{object}	staffing.BulkResult
+//	@Failure		400		{object}	problem.Problem	"Invalid event id, role, or file"
+//	@Failure		401		{object}	problem.Problem	"Not authenticated"
+//	@Failure		403		{object}	problem.Problem	"Missing manage_staff, or event of another tenant"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/staff/bulk [post]
+func (h *Handler) BulkAssign(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, errorz.BadRequest().WithMessage(fmt.Sprintf("upload exceeds %d bytes", maxUploadBytes))
+		}
+		return nil, errorz.BadRequest().WithMessage(`multipart field "file" is required`)
+	}
+	defer func() { _ = file.Close() }()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage("invalid upload")
+	}
+	roleID, err := uuid.Parse(r.FormValue("role_id"))
+	if err != nil {
+		return nil, errcode.InvalidID.WithMessage("invalid role id")
+	}
+	res, err := h.service.BulkAssign(r.Context(), eventID, BulkAssignInput{RoleID: roleID, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(res), nil
+}
+
+// Unassign handles DELETE /events/{eventId}/staff/{userId}.
+//
+// Unassign godoc
+//
+//	@Summary		Unassign staff
+//	@Description	Takes the user off the event's staff.
+//	@Tags			staffing
+//	@Security		BearerAuth
+//	@Param			eventId	path	string	true	"Event UUID"
+//	@Param			userId	path	string	true	"User UUID"
+//	@Success		204
+//	@Failure		400	{object}	problem.Problem	"Invalid id"
+//	@Failure		401	{object}	problem.Problem	"Not authenticated"
+//	@Failure		403	{object}	problem.Problem	"Missing manage_staff, or event of another tenant"
+//	@Failure		404	{object}	problem.Problem	"Event not found or user not on its staff"
+//	@Failure		500	{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/staff/{userId} [delete]
+func (h *Handler) Unassign(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(r, "userId", "user")
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Unassign(r.Context(), eventID, userID); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// MyEvents handles GET /me/events.
+//
+// MyEvents godoc
+//
+//	@Summary		My events
+//	@Description	The events the logged-in user is on the staff of, by start date, with their role and permissions at each; scanner apps use it to pick the event to work. Events that have ended are left out unless include_past is true.
+//	@Tags			staffing
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			include_past	query		bool	false	"Also list ended events"
+//	@Success		200				{array}		staffing.Assignment
+//	@Failure		400				{object}	problem.Problem	"Invalid include_past"
+//	@Failure		401				{object}	problem.Problem	"Not logged in (no user access token; API keys have no user)"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/me/events [get]
+func (h *Handler) MyEvents(r *http.Request) (any, error) {
+	includePast := false
+	if raw := r.URL.Query().Get("include_past"); raw != "" {
+		var err error
+		if includePast, err = strconv.ParseBool(raw); err != nil {
+			return nil, errorz.BadRequest().WithMessage("invalid include_past value")
+		}
+	}
+	events, err := h.service.MyEvents(r.Context(), includePast)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(events), nil
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
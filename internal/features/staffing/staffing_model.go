@@ -0,0 +1,65 @@
+package staffing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Member is a user on an event's staff roster. Permissions are the effective
+// permission codes of the assignment: those of its role, not of the user's
+// own tenant-wide role.
+//
+// swagger:model StaffMember
+type Member struct {
+	ID          uuid.UUID `json:"id"`
+	EventID     uuid.UUID `json:"event_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Email       string    `json:"email"`
+	RoleID      uuid.UUID `json:"role_id"`
+	RoleName    string    `json:"role_name"`
+	Permissions []string  `json:"permissions"` // sorted
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Assignment is an event the logged-in user works, with what they may do at
+// it; scanner apps list these to pick an event.
+//
+// swagger:model StaffEvent
+type Assignment struct {
+	EventID     uuid.UUID `json:"event_id"`
+	Name        string    `json:"name"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	Timezone    string    `json:"timezone"`
+	RoleID      uuid.UUID `json:"role_id"`
+	RoleName    string    `json:"role_name"`
+	Permissions []string  `json:"permissions"` // sorted
+}
+
+// User is the part of a users row staffing checks.
+type User struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Email    string
+}
+
+// RowError describes one rejected row of a bulk assignment. Row is the
+// 1-based row number in the uploaded sheet, header included.
+//
+// swagger:model StaffRowError
+type RowError struct {
+	Row    int    `json:"row"`
+	Email  string `json:"email,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// BulkResult reports a bulk assignment: the users assigned and the rows
+// rejected.
+//
+// swagger:model StaffBulkResult
+type BulkResult struct {
+	Assigned []uuid.UUID `json:"assigned"` // user ids
+	Rejected []RowError  `json:"rejected"`
+}
@@ -0,0 +1,269 @@
+package staffing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/staffing/mock_store.go -package=mockstaffing github.com/biairmal/guest-management-be/internal/features/staffing Store
+
+// Store holds the staffing queries on event_staff_assignments.
+type Store interface {
+	// EventTenant returns the tenant of the live event, or
+	// repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// User returns the live user, or repository.ErrNotFound.
+	User(ctx context.Context, userID uuid.UUID) (*User, error)
+	// UsersByEmail returns the tenant's live users among emails, keyed by
+	// lower-cased email.
+	UsersByEmail(ctx context.Context, tenantID uuid.UUID, emails []string) (map[string]uuid.UUID, error)
+	// RoleExists reports whether the role exists.
+	RoleExists(ctx context.Context, roleID uuid.UUID) (bool, error)
+	// Assign puts the user on the event's staff with the role, reviving a
+	// removed assignment and replacing the role of a live one.
+	Assign(ctx context.Context, eventID, userID, roleID uuid.UUID) error
+	// Unassign soft-deletes the user's live assignment to the event, or
+	// returns repository.ErrNotFound.
+	Unassign(ctx context.Context, eventID, userID uuid.UUID) error
+	// Member returns the user's live assignment to the event, or
+	// repository.ErrNotFound.
+	Member(ctx context.Context, eventID, userID uuid.UUID) (*Member, error)
+	// Roster returns one page of the event's live staff, by email by default,
+	// and their total.
+	Roster(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*Member, int64, error)
+	// Assignments returns the user's live assignments to live events ending at
+	// or after since, by start date.
+	Assignments(ctx context.Context, userID uuid.UUID, since time.Time) ([]*Assignment, error)
+}
+
+// rosterColumns maps the roster's allow-listed filter/sort fields to SQL.
+var rosterColumns = map[string]string{
+	"email":      "u.email",
+	"role_id":    "a.role_id",
+	"created_at": "a.created_at",
+}
+
+// permissionsOf selects the sorted permission codes of the role a.role_id.
+const permissionsOf = `ARRAY(SELECT p.code FROM role_permissions rp JOIN permissions p ON p.id = rp.permission_id
+	WHERE rp.role_id = a.role_id ORDER BY p.code)`
+
+// memberSelect reads the columns scanMember scans, from the live assignments
+// of live users.
+const memberSelect = `SELECT a.id, a.event_id, a.user_id, u.email, a.role_id, r.name, ` + permissionsOf + `,
+		a.created_at, a.updated_at`
+
+// memberFrom joins an assignment a to its user u and role r.
+const memberFrom = ` FROM event_staff_assignments a
+	JOIN users u ON u.id = a.user_id AND u.deleted_at IS NULL
+	JOIN roles r ON r.id = a.role_id
+	WHERE a.event_id = $1 AND a.deleted_at IS NULL`
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// EventTenant implements Store.
+func (s *store) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// User implements Store.
+func (s *store) User(ctx context.Context, userID uuid.UUID) (*User, error) {
+	var u User
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT id, tenant_id, email FROM users WHERE id = $1 AND deleted_at IS NULL", userID,
+	).Scan(&u.ID, &u.TenantID, &u.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// UsersByEmail implements Store.
+func (s *store) UsersByEmail(ctx context.Context, tenantID uuid.UUID, emails []string) (map[string]uuid.UUID, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `SELECT lower(email), id FROM users
+		WHERE tenant_id = $1 AND lower(email) = ANY($2::text[]) AND deleted_at IS NULL`,
+		tenantID, pq.StringArray(emails))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	users := make(map[string]uuid.UUID, len(emails))
+	for rows.Next() {
+		var (
+			email string
+			id    uuid.UUID
+		)
+		if err := rows.Scan(&email, &id); err != nil {
+			return nil, err
+		}
+		users[email] = id
+	}
+	return users, rows.Err()
+}
+
+// RoleExists implements Store.
+func (s *store) RoleExists(ctx context.Context, roleID uuid.UUID) (bool, error) {
+	var ok bool
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM roles WHERE id = $1)", roleID).Scan(&ok)
+	return ok, err
+}
+
+// Assign implements Store.
+func (s *store) Assign(ctx context.Context, eventID, userID, roleID uuid.UUID) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `
+		INSERT INTO event_staff_assignments (event_id, user_id, role_id) VALUES ($1, $2, $3)
+		ON CONFLICT (event_id, user_id) DO UPDATE SET role_id = EXCLUDED.role_id, deleted_at = NULL,
+			updated_at = now()`, eventID, userID, roleID)
+	return err
+}
+
+// Unassign implements Store.
+func (s *store) Unassign(ctx context.Context, eventID, userID uuid.UUID) error {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE event_staff_assignments
+		SET deleted_at = now(), updated_at = now()
+		WHERE event_id = $1 AND user_id = $2 AND deleted_at IS NULL`, eventID, userID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// Member implements Store.
+func (s *store) Member(ctx context.Context, eventID, userID uuid.UUID) (*Member, error) {
+	m, err := scanMember(corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		memberSelect+memberFrom+" AND a.user_id = $2", eventID, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return m, err
+}
+
+// Roster implements Store.
+func (s *store) Roster(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*Member, int64, error) {
+	clauses := query.ToSQL(params, rosterColumns, 2)
+	from := memberFrom
+	if len(clauses.Where) > 0 {
+		from += " AND " + strings.Join(clauses.Where, " AND ")
+	}
+	args := append([]any{eventID}, clauses.Args...)
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	if err := conn.QueryRowContext(ctx, "SELECT count(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := "u.email, a.id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", a.id"
+	}
+	n := len(args)
+	args = append(args, params.Size, (params.Page-1)*params.Size)
+	rows, err := conn.QueryContext(ctx,
+		memberSelect+from+fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, n+1, n+2), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var members []*Member
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		members = append(members, m)
+	}
+	return members, total, rows.Err()
+}
+
+// Assignments implements Store.
+func (s *store) Assignments(ctx context.Context, userID uuid.UUID, since time.Time) ([]*Assignment, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		SELECT e.id, e.name, e.start_date, e.end_date, e.timezone, a.role_id, r.name, `+permissionsOf+`
+		FROM event_staff_assignments a
+		JOIN events e ON e.id = a.event_id AND e.deleted_at IS NULL
+		JOIN roles r ON r.id = a.role_id
+		WHERE a.user_id = $1 AND a.deleted_at IS NULL AND e.end_date >= $2
+		ORDER BY e.start_date, e.id`, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	assignments := []*Assignment{}
+	for rows.Next() {
+		var (
+			a     Assignment
+			perms pq.StringArray
+		)
+		if err := rows.Scan(
+			&a.EventID, &a.Name, &a.StartDate, &a.EndDate, &a.Timezone, &a.RoleID, &a.RoleName, &perms,
+		); err != nil {
+			return nil, err
+		}
+		a.Permissions = codes(perms)
+		assignments = append(assignments, &a)
+	}
+	return assignments, rows.Err()
+}
+
+// scanMember scans one memberSelect row.
+func scanMember(row interface{ Scan(dest ...any) error }) (*Member, error) {
+	var (
+		m     Member
+		perms pq.StringArray
+	)
+	if err := row.Scan(
+		&m.ID, &m.EventID, &m.UserID, &m.Email, &m.RoleID, &m.RoleName, &perms, &m.CreatedAt, &m.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	m.Permissions = codes(perms)
+	return &m, nil
+}
+
+// codes returns perms as a non-nil slice, so an empty role lists [].
+func codes(perms pq.StringArray) []string {
+	if perms == nil {
+		return []string{}
+	}
+	return perms
+}
@@ -0,0 +1,24 @@
+package staffing
+
+import (
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitStaffRoutes registers the staff roster routes and the logged-in user's
+// events on the given router. The roster routes need auth.ManageStaff; the
+// service checks the caller belongs to the event's tenant. Bulk uploads are
+// capped at maxUploadBytes before the multipart form is parsed.
+func InitStaffRoutes(r *chi.Mux, staffH *Handler) {
+	r.Route("/api/v1/events/{eventId}/staff", func(r chi.Router) {
+		r.Use(auth.Require(auth.ManageStaff))
+		r.Get("/", problem.Handle(staffH.Roster))
+		r.Post("/", problem.Handle(staffH.Assign))
+		r.With(chiMiddleware.RequestSize(maxUploadBytes)).Post("/bulk", problem.Handle(staffH.BulkAssign))
+		r.Delete("/{userId}", problem.Handle(staffH.Unassign))
+	})
+	r.Get("/api/v1/me/events", problem.Handle(staffH.MyEvents))
+}
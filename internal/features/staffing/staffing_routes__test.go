@@ -0,0 +1,58 @@
+package staffing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/features/staffing"
+	mockstaffing "github.com/biairmal/guest-management-be/mocks/staffing"
+	mockvalidation "github.com/biairmal/guest-management-be/mocks/validation"
+)
+
+func TestInitStaffRoutes_Guards(t *testing.T) {
+	base := "/api/v1/events/" + uuid.NewString() + "/staff"
+	routes := []struct{ method, path string }{
+		{http.MethodGet, base},
+		{http.MethodPost, base},
+		{http.MethodPost, base + "/bulk"},
+		{http.MethodDelete, base + "/" + uuid.NewString()},
+	}
+	callers := []struct {
+		name       string
+		perms      []string
+		wantStatus int
+	}{
+		{name: "anonymous", wantStatus: http.StatusUnauthorized},
+		{name: "missing manage_staff", perms: []string{auth.CheckIn}, wantStatus: http.StatusForbidden},
+	}
+
+	for _, c := range callers {
+		for _, rt := range routes {
+			t.Run(c.name+" "+rt.method+" "+rt.path, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				// The service must never be reached.
+				h := staffing.NewHandler(mockstaffing.NewMockService(ctrl), mockvalidation.NewMockValidator(ctrl))
+				r := chi.NewRouter()
+				staffing.InitStaffRoutes(r, h)
+
+				ctx := context.Background()
+				if c.perms != nil {
+					ctx = ctxkit.WithPermissions(ctxkit.WithTenantID(ctx, uuid.NewString()), c.perms)
+				}
+				rec := httptest.NewRecorder()
+				r.ServeHTTP(rec, httptest.NewRequest(rt.method, rt.path, nil).WithContext(ctx))
+				if rec.Code != c.wantStatus {
+					t.Errorf("status = %d, want %d", rec.Code, c.wantStatus)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package staffing
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/staffing/mock_service.go -package=mockstaffing github.com/biairmal/guest-management-be/internal/features/staffing Service
+
+// MaxBulkRows is the most data rows a bulk assignment may have.
+const MaxBulkRows = 1000
+
+// Service manages events' staff rosters.
+type Service interface {
+	// Roster returns a page of the event's staff with their permissions.
+	Roster(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*common.PageResponse[Member], error)
+	// Assign puts a user of the event's tenant on its staff with a role, or
+	// changes the role of one already on it.
+	Assign(ctx context.Context, eventID uuid.UUID, in AssignInput) (*Member, error)
+	// BulkAssign assigns the users listed by email in a CSV with one role.
+	BulkAssign(ctx context.Context, eventID uuid.UUID, in BulkAssignInput) (*BulkResult, error)
+	// Unassign takes the user off the event's staff.
+	Unassign(ctx context.Context, eventID, userID uuid.UUID) error
+	// MyEvents returns the events the logged-in user is assigned to; past
+	// events only when includePast.
+	MyEvents(ctx context.Context, includePast bool) ([]*Assignment, error)
+}
+
+// AssignInput assigns one user.
+//
+// swagger:model StaffAssignInput
+type AssignInput struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+	RoleID uuid.UUID `json:"role_id" validate:"required"`
+}
+
+// BulkAssignInput assigns the users of a CSV. Data has one email per row, in
+// the column headed "email" or, without such a header, the first column.
+type BulkAssignInput struct {
+	RoleID uuid.UUID
+	Data   []byte
+}
+
+// BulkRow is one email of a bulk assignment, checked by the validator.
+type BulkRow struct {
+	Email string `validate:"required,email,max=320"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger    logger.Logger
+	tx        transaction.TxManager
+	validator validation.Validator
+	store     Store
+	now       func() time.Time
+}
+
+// NewService returns a Service with the given dependencies.
+func NewService(
+	logger logger.Logger, tx transaction.TxManager, validator validation.Validator, store Store,
+) Service {
+	return &serviceImpl{logger: logger, tx: tx, validator: validator, store: store, now: time.Now}
+}
+
+// Roster implements Service.
+func (s *serviceImpl) Roster(
+	ctx context.Context, eventID uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[Member], error) {
+	if _, err := s.eventTenant(ctx, eventID); err != nil {
+		return nil, err
+	}
+	items, total, err := s.store.Roster(ctx, eventID, params)
+	if err != nil {
+		return nil, s.translate(ctx, "staff roster read failed", eventID, err)
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// Assign implements Service.
+func (s *serviceImpl) Assign(ctx context.Context, eventID uuid.UUID, in AssignInput) (*Member, error) {
+	tenantID, err := s.eventTenant(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.store.User(ctx, in.UserID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.UserNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "staff user read failed", eventID, err)
+	}
+	if user.TenantID != tenantID {
+		return nil, errcode.TenantMismatch.New()
+	}
+	if err := s.checkRole(ctx, eventID, in.RoleID); err != nil {
+		return nil, err
+	}
+
+	var m *Member
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.store.Assign(ctx, eventID, user.ID, in.RoleID); err != nil {
+			return s.translate(ctx, "staff assign failed", eventID, err)
+		}
+		if m, err = s.store.Member(ctx, eventID, user.ID); err != nil {
+			return s.translate(ctx, "staff member read failed", eventID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "staff assigned", logger.F("event_id", eventID),
+		logger.F("user_id", user.ID), logger.F("role_id", in.RoleID))
+	return m, nil
+}
+
+// BulkAssign implements Service. Rows are rejected, not failed, when their
+// email is malformed, repeated or isn't a user of the event's tenant, so one
+// bad row doesn't hold up the rest; the file as a whole is a 400 only when it
+// can't be read or is too long.
+func (s *serviceImpl) BulkAssign(ctx context.Context, eventID uuid.UUID, in BulkAssignInput) (*BulkResult, error) {
+	rows, err := readEmails(in.Data)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	tenantID, err := s.eventTenant(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRole(ctx, eventID, in.RoleID); err != nil {
+		return nil, err
+	}
+
+	res := &BulkResult{Assigned: []uuid.UUID{}, Rejected: []RowError{}}
+	seen := make(map[string]bool, len(rows))
+	var valid []emailRow
+	for _, row := range rows {
+		if err := s.validator.Struct(BulkRow{Email: row.email}); err != nil {
+			res.Rejected = append(res.Rejected, RowError{Row: row.number, Email: row.email, Reason: "invalid email"})
+			continue
+		}
+		key := strings.ToLower(row.email)
+		if seen[key] {
+			res.Rejected = append(res.Rejected, RowError{
+				Row: row.number, Email: row.email, Reason: "duplicate email earlier in the file",
+			})
+			continue
+		}
+		seen[key] = true
+		valid = append(valid, row)
+	}
+	if len(valid) == 0 {
+		return res, nil
+	}
+
+	emails := make([]string, len(valid))
+	for i, row := range valid {
+		emails[i] = strings.ToLower(row.email)
+	}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		users, err := s.store.UsersByEmail(ctx, tenantID, emails)
+		if err != nil {
+			return s.translate(ctx, "staff users read failed", eventID, err)
+		}
+		for i, row := range valid {
+			userID, ok := users[emails[i]]
+			if !ok {
+				res.Rejected = append(res.Rejected, RowError{
+					Row: row.number, Email: row.email, Reason: "no user with this email in the event's tenant",
+				})
+				continue
+			}
+			if err := s.store.Assign(ctx, eventID, userID, in.RoleID); err != nil {
+				return s.translate(ctx, "staff assign failed", eventID, err)
+			}
+			res.Assigned = append(res.Assigned, userID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(res.Rejected, func(a, b RowError) int { return a.Row - b.Row })
+	s.logger.InfoWithContext(ctx, "staff bulk assigned", logger.F("event_id", eventID),
+		logger.F("role_id", in.RoleID), logger.F("assigned", len(res.Assigned)),
+		logger.F("rejected", len(res.Rejected)))
+	return res, nil
+}
+
+// Unassign implements Service.
+func (s *serviceImpl) Unassign(ctx context.Context, eventID, userID uuid.UUID) error {
+	if _, err := s.eventTenant(ctx, eventID); err != nil {
+		return err
+	}
+	err := s.store.Unassign(ctx, eventID, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.StaffMemberNotFound.New()
+	}
+	if err != nil {
+		return s.translate(ctx, "staff unassign failed", eventID, err)
+	}
+	s.logger.InfoWithContext(ctx, "staff unassigned", logger.F("event_id", eventID), logger.F("user_id", userID))
+	return nil
+}
+
+// MyEvents implements Service. The user is the sub of the access token
+// auth.Middleware verified. Events count as past once they have ended.
+func (s *serviceImpl) MyEvents(ctx context.Context, includePast bool) ([]*Assignment, error) {
+	userID, err := uuid.Parse(ctxkit.UserID(ctx))
+	if err != nil {
+		return nil, errcode.LoginRequired.New()
+	}
+	since := s.now()
+	if includePast {
+		since = time.Time{}
+	}
+	assignments, err := s.store.Assignments(ctx, userID, since)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "staff events read failed", logger.F("user_id", userID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to process staffing")
+	}
+	return assignments, nil
+}
+
+// eventTenant returns the live event's tenant, 404 when there is none and
+// 403 when the caller belongs to another tenant.
+func (s *serviceImpl) eventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	tenantID, err := s.store.EventTenant(ctx, eventID)
+	if err != nil {
+		return uuid.Nil, s.translate(ctx, "staff event read failed", eventID, err)
+	}
+	if !auth.InTenant(ctx, tenantID) {
+		return uuid.Nil, errcode.TenantMismatch.New()
+	}
+	return tenantID, nil
+}
+
+// checkRole rejects a role that doesn't exist.
+func (s *serviceImpl) checkRole(ctx context.Context, eventID, roleID uuid.UUID) error {
+	ok, err := s.store.RoleExists(ctx, roleID)
+	if err != nil {
+		return s.translate(ctx, "staff role read failed", eventID, err)
+	}
+	if !ok {
+		return errorz.BadRequest().WithMessage("role not found")
+	}
+	return nil
+}
+
+// translate maps a store error to 404 for a missing event, or logs it as msg
+// and reports it as 500.
+func (s *serviceImpl) translate(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to process staffing")
+}
+
+// emailRow is a non-blank email of the CSV with its 1-based sheet row number.
+type emailRow struct {
+	number int
+	email  string
+}
+
+// readEmails reads the emails of a bulk assignment CSV.
+func readEmails(data []byte) ([]emailRow, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	var (
+		rows  []emailRow
+		col   int
+		first = true
+	)
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("file could not be read as csv")
+		}
+		if first {
+			first = false
+			if i := headerColumn(record); i >= 0 {
+				col = i
+				continue
+			}
+		}
+		if col >= len(record) || strings.TrimSpace(record[col]) == "" {
+			continue
+		}
+		line, _ := r.FieldPos(0) // blank lines are skipped, so count lines, not records
+		rows = append(rows, emailRow{number: line, email: strings.TrimSpace(record[col])})
+		if len(rows) > MaxBulkRows {
+			return nil, fmt.Errorf("file has more than %d rows", MaxBulkRows)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("file has no emails")
+	}
+	return rows, nil
+}
+
+// headerColumn returns the index of the "email" heading in record, or -1.
+func headerColumn(record []string) int {
+	for i, cell := range record {
+		if strings.EqualFold(strings.TrimSpace(cell), "email") {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,287 @@
+package staffing_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/features/staffing"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockstaffing "github.com/biairmal/guest-management-be/mocks/staffing"
+	mockvalidation "github.com/biairmal/guest-management-be/mocks/validation"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// emailValidator stands in for the tag-driven validator: a staffing.BulkRow
+// is invalid when its email has no "@".
+func emailValidator(ctrl *gomock.Controller) *mockvalidation.MockValidator {
+	v := mockvalidation.NewMockValidator(ctrl)
+	v.EXPECT().Struct(gomock.Any()).DoAndReturn(func(x any) error {
+		if row, ok := x.(staffing.BulkRow); ok && !strings.Contains(row.Email, "@") {
+			return errorz.BadRequest().WithMessage("email must be a valid email")
+		}
+		return nil
+	}).AnyTimes()
+	return v
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func newService(t *testing.T) (staffing.Service, *mockstaffing.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockstaffing.NewMockStore(ctrl)
+	return staffing.NewService(logger.NewNoOp(), inlineTx(ctrl), emailValidator(ctrl), store), store
+}
+
+// inTenant returns a context of a caller authenticated for tenantID.
+func inTenant(tenantID uuid.UUID) context.Context {
+	return ctxkit.WithTenantID(context.Background(), tenantID.String())
+}
+
+func TestService_Assign(t *testing.T) {
+	eventID, tenantID, userID, roleID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	in := staffing.AssignInput{UserID: userID, RoleID: roleID}
+	tests := []struct {
+		name     string
+		mock     func(store *mockstaffing.MockStore)
+		wantCode string
+	}{
+		{
+			name: "user of the event's tenant",
+			mock: func(store *mockstaffing.MockStore) {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+				store.EXPECT().User(gomock.Any(), userID).Return(&staffing.User{ID: userID, TenantID: tenantID}, nil)
+				store.EXPECT().RoleExists(gomock.Any(), roleID).Return(true, nil)
+				store.EXPECT().Assign(gomock.Any(), eventID, userID, roleID).Return(nil)
+				store.EXPECT().Member(gomock.Any(), eventID, userID).Return(&staffing.Member{
+					UserID: userID, RoleID: roleID, Permissions: []string{"check_in"},
+				}, nil)
+			},
+		},
+		{
+			name: "user of another tenant",
+			mock: func(store *mockstaffing.MockStore) {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+				store.EXPECT().User(gomock.Any(), userID).Return(&staffing.User{ID: userID, TenantID: uuid.New()}, nil)
+			},
+			wantCode: errorz.CodeForbidden,
+		},
+		{
+			name: "unknown role",
+			mock: func(store *mockstaffing.MockStore) {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+				store.EXPECT().User(gomock.Any(), userID).Return(&staffing.User{ID: userID, TenantID: tenantID}, nil)
+				store.EXPECT().RoleExists(gomock.Any(), roleID).Return(false, nil)
+			},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "unknown user",
+			mock: func(store *mockstaffing.MockStore) {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+				store.EXPECT().User(gomock.Any(), userID).Return(nil, repository.ErrNotFound)
+			},
+			wantCode: errorz.CodeNotFound,
+		},
+		{
+			name: "missing event",
+			mock: func(store *mockstaffing.MockStore) {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(uuid.Nil, repository.ErrNotFound)
+			},
+			wantCode: errorz.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			tt.mock(store)
+			m, err := svc.Assign(inTenant(tenantID), eventID, in)
+			assertErrorzCode(t, err, tt.wantCode)
+			if err == nil && (m.UserID != userID || m.RoleID != roleID) {
+				t.Errorf("member = %+v, want user %s with role %s", m, userID, roleID)
+			}
+		})
+	}
+}
+
+func TestService_BulkAssign(t *testing.T) {
+	eventID, tenantID, roleID := uuid.New(), uuid.New(), uuid.New()
+	ana, budi := uuid.New(), uuid.New()
+	tests := []struct {
+		name         string
+		csv          string
+		users        map[string]uuid.UUID
+		wantAssigned []uuid.UUID
+		wantRejected []int // row numbers
+		wantCode     string
+	}{
+		{
+			name:         "email column with header",
+			csv:          "name,Email\nAna,ana@example.com\nBudi,BUDI@example.com\n",
+			users:        map[string]uuid.UUID{"ana@example.com": ana, "budi@example.com": budi},
+			wantAssigned: []uuid.UUID{ana, budi},
+		},
+		{
+			name: "rejects invalid, repeated and foreign emails",
+			csv: "ana@example.com\nnot-an-email\n\nAna@Example.com\nstranger@other.org\n" +
+				"budi@example.com\n",
+			users:        map[string]uuid.UUID{"ana@example.com": ana, "budi@example.com": budi},
+			wantAssigned: []uuid.UUID{ana, budi},
+			wantRejected: []int{2, 4, 5},
+		},
+		{name: "no emails", csv: "email\n\n", wantCode: errorz.CodeBadRequest},
+		{name: "too many rows", csv: strings.Repeat("a@example.com\n", staffing.MaxBulkRows+1),
+			wantCode: errorz.CodeBadRequest},
+		{name: "unreadable csv", csv: "\"unterminated\n", wantCode: errorz.CodeBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			if tt.users != nil {
+				store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+				store.EXPECT().RoleExists(gomock.Any(), roleID).Return(true, nil)
+				store.EXPECT().UsersByEmail(gomock.Any(), tenantID, gomock.Any()).Return(tt.users, nil)
+				for _, id := range tt.wantAssigned {
+					store.EXPECT().Assign(gomock.Any(), eventID, id, roleID).Return(nil)
+				}
+			}
+			res, err := svc.BulkAssign(inTenant(tenantID), eventID, staffing.BulkAssignInput{
+				RoleID: roleID, Data: []byte(tt.csv),
+			})
+			assertErrorzCode(t, err, tt.wantCode)
+			if err != nil {
+				return
+			}
+			if !slices.Equal(res.Assigned, tt.wantAssigned) {
+				t.Errorf("assigned = %v, want %v", res.Assigned, tt.wantAssigned)
+			}
+			rows := make([]int, len(res.Rejected))
+			for i, r := range res.Rejected {
+				rows[i] = r.Row
+			}
+			if !slices.Equal(rows, tt.wantRejected) {
+				t.Errorf("rejected rows = %v, want %v", rows, tt.wantRejected)
+			}
+		})
+	}
+}
+
+func TestService_Unassign(t *testing.T) {
+	eventID, tenantID, userID := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+	}{
+		{name: "on the staff"},
+		{name: "not on the staff", err: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "store failure", err: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+			store.EXPECT().Unassign(gomock.Any(), eventID, userID).Return(tt.err)
+			assertErrorzCode(t, svc.Unassign(inTenant(tenantID), eventID, userID), tt.wantCode)
+		})
+	}
+}
+
+// TestService_CallerOfAnotherTenant refuses a caller outside the event's
+// tenant before the roster is read or changed.
+func TestService_CallerOfAnotherTenant(t *testing.T) {
+	eventID, userID, roleID := uuid.New(), uuid.New(), uuid.New()
+	ctx := inTenant(uuid.New())
+	calls := map[string]func(staffing.Service) error{
+		"Roster": func(svc staffing.Service) error {
+			_, err := svc.Roster(ctx, eventID, &query.ListParams{})
+			return err
+		},
+		"Assign": func(svc staffing.Service) error {
+			_, err := svc.Assign(ctx, eventID, staffing.AssignInput{UserID: userID, RoleID: roleID})
+			return err
+		},
+		"BulkAssign": func(svc staffing.Service) error {
+			_, err := svc.BulkAssign(ctx, eventID, staffing.BulkAssignInput{RoleID: roleID, Data: []byte("a@example.com\n")})
+			return err
+		},
+		"Unassign": func(svc staffing.Service) error { return svc.Unassign(ctx, eventID, userID) },
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			svc, store := newService(t)
+			store.EXPECT().EventTenant(gomock.Any(), eventID).Return(uuid.New(), nil)
+			assertErrorzCode(t, call(svc), errorz.CodeForbidden)
+		})
+	}
+}
+
+func TestService_MyEvents_RequiresLogin(t *testing.T) {
+	svc, _ := newService(t)
+	_, err := svc.MyEvents(context.Background(), false)
+	assertErrorzCode(t, err, errorz.CodeUnauthorized)
+}
+
+func TestService_MyEvents(t *testing.T) {
+	userID := uuid.New()
+	// The user id auth.Middleware puts on the context from the token's sub.
+	ctx := ctxkit.WithTenantID(ctxkit.WithUserID(context.Background(), userID.String()), uuid.NewString())
+	tests := []struct {
+		name        string
+		includePast bool
+		wantPast    bool
+	}{
+		{name: "upcoming and running events", wantPast: false},
+		{name: "including past events", includePast: true, wantPast: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store := newService(t)
+			want := []*staffing.Assignment{{EventID: uuid.New()}}
+			store.EXPECT().Assignments(gomock.Any(), userID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, since time.Time) ([]*staffing.Assignment, error) {
+					if since.IsZero() != tt.wantPast {
+						t.Errorf("since = %v, want zero: %v", since, tt.wantPast)
+					}
+					return want, nil
+				})
+			got, err := svc.MyEvents(ctx, tt.includePast)
+			assertErrorzCode(t, err, "")
+			if len(got) != 1 || got[0] != want[0] {
+				t.Errorf("MyEvents() = %v, want the store's assignments", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package tickets
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// LifecycleHandler exposes HTTP handlers for ticket lifecycle operations and
+// the ticket audit log.
+type LifecycleHandler struct {
+	service   LifecycleService
+	validator validation.Validator
+}
+
+// NewLifecycleHandler returns a LifecycleHandler that uses the given service
+// and validator.
+func NewLifecycleHandler(service LifecycleService, validator validation.Validator) *LifecycleHandler {
+	return &LifecycleHandler{service: service, validator: validator}
+}
+
+// Invalidate handles POST /events/{eventId}/tickets/{ticketId}/invalidate.
+//
+// Invalidate godoc
+//
+//	@Summary		Invalidate ticket
+//	@Description	Invalidates an active or used ticket, recording the reason in the ticket's audit log. Its QR code stops working, the guest is left without a ticket and the seat goes to the waitlist. With notify, the guest is told once the change is committed.
+//	@Tags			tickets
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string						true	"Event UUID"
+//	@Param			ticketId	path		string						true	"Ticket UUID"
+//	@Param			body		body		tickets.InvalidateInput		true	"Reason and whether to notify the guest"
+//	@Success		200			{object}	tickets.ChangeResult
+//	@Failure		400			{object}	problem.Problem						"Invalid ids or body"
+//	@Failure		401			{object}	problem.Problem						"Not authenticated"
+//	@Failure		403			{object}	problem.Problem						"Missing manage_guests"
+//	@Failure		404			{object}	problem.Problem						"Event or ticket not found"
+//	@Failure		409			{object}	problem.Problem						"Ticket already invalidated"
+//	@Failure		500			{object}	problem.Problem						"Internal server error"
+//	@Router			/api/v1/events/{eventId}/tickets/{ticketId}/invalidate [post]
+func (h *LifecycleHandler) Invalidate(r *http.Request) (any, error) {
+	eventID, ticketID, err := ticketPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body InvalidateInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Invalidate(r.Context(), eventID, ticketID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(res), nil
+}
+
+// Reissue handles POST /events/{eventId}/tickets/{ticketId}/reissue.
+//
+// Reissue godoc
+//
+//	@Summary		Reissue ticket
+//	@Description	Replaces an active ticket, e.g. on a lost phone: the old ticket is invalidated and the same guest gets a new ticket of the same type with a new QR code. Capacity is unchanged.
+//	@Tags			tickets
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string					true	"Event UUID"
+//	@Param			ticketId	path		string					true	"Ticket UUID"
+//	@Param			body		body		tickets.ChangeInput		true	"Optional reason and whether to notify the guest"
+//	@Success		201			{object}	tickets.ChangeResult	"Replacement issued"
+//	@Failure		400			{object}	problem.Problem					"Invalid ids or body"
+//	@Failure		401			{object}	problem.Problem					"Not authenticated"
+//	@Failure		403			{object}	problem.Problem					"Missing manage_guests"
+//	@Failure		404			{object}	problem.Problem					"Event or ticket not found"
+//	@Failure		409			{object}	problem.Problem					"Ticket isn't active"
+//	@Failure		500			{object}	problem.Problem					"Internal server error"
+//	@Router			/api/v1/events/{eventId}/tickets/{ticketId}/reissue [post]
+func (h *LifecycleHandler) Reissue(r *http.Request) (any, error) {
+	eventID, ticketID, err := ticketPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body ChangeInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Reissue(r.Context(), eventID, ticketID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(res), nil
+}
+
+// Transfer handles POST /events/{eventId}/tickets/{ticketId}/transfer.
+//
+// Transfer godoc
+//
+//	@Summary		Transfer ticket
+//	@Description	Gives an active ticket's seat to another guest of the event who holds no ticket and isn't waitlisted: the old ticket is invalidated and the new guest gets a ticket of the same type with a new QR code.
+//	@Tags			tickets
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string					true	"Event UUID"
+//	@Param			ticketId	path		string					true	"Ticket UUID"
+//	@Param			body		body		tickets.TransferInput	true	"New holder, optional reason and whether to notify"
+//	@Success		201			{object}	tickets.ChangeResult	"Replacement issued to the new holder"
+//	@Failure		400			{object}	problem.Problem					"Invalid ids or body, or the guest already holds the ticket"
+//	@Failure		401			{object}	problem.Problem					"Not authenticated"
+//	@Failure		403			{object}	problem.Problem					"Missing manage_guests"
+//	@Failure		404			{object}	problem.Problem					"Event, ticket or guest not found"
+//	@Failure		409			{object}	problem.Problem					"Ticket isn't active, or the guest holds a ticket or is waitlisted"
+//	@Failure		500			{object}	problem.Problem					"Internal server error"
+//	@Router			/api/v1/events/{eventId}/tickets/{ticketId}/transfer [post]
+func (h *LifecycleHandler) Transfer(r *http.Request) (any, error) {
+	eventID, ticketID, err := ticketPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body TransferInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Transfer(r.Context(), eventID, ticketID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(res), nil
+}
+
+// Reactivate handles POST /events/{eventId}/tickets/{ticketId}/reactivate.
+//
+// Reactivate godoc
+//
+//	@Summary		Reactivate ticket
+//	@Description	Makes a ticket active again: undoes a mistaken "used" mark, or an invalidation when the guest holds no other ticket, isn't waitlisted and capacity has room. Scan logs are kept.
+//	@Tags			tickets
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId		path		string					true	"Event UUID"
+//	@Param			ticketId	path		string					true	"Ticket UUID"
+//	@Param			body		body		tickets.ChangeInput		true	"Optional reason and whether to notify the guest"
+//	@Success		200			{object}	tickets.ChangeResult
+//	@Failure		400			{object}	problem.Problem					"Invalid ids or body"
+//	@Failure		401			{object}	problem.Problem					"Not authenticated"
+//	@Failure		403			{object}	problem.Problem					"Missing manage_guests"
+//	@Failure		404			{object}	problem.Problem					"Event or ticket not found"
+//	@Failure		409			{object}	problem.Problem					"Ticket already active, or the invalidated ticket can't be revived"
+//	@Failure		500			{object}	problem.Problem					"Internal server error"
+//	@Router			/api/v1/events/{eventId}/tickets/{ticketId}/reactivate [post]
+func (h *LifecycleHandler) Reactivate(r *http.Request) (any, error) {
+	eventID, ticketID, err := ticketPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	var body ChangeInput
+	if err := h.decode(r, &body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Reactivate(r.Context(), eventID, ticketID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(res), nil
+}
+
+// Audit handles GET /events/{eventId}/tickets/{ticketId}/audit.
+//
+// Audit godoc
+//
+//	@Summary		Ticket audit log
+//	@Description	Returns the lifecycle operations on the ticket, oldest first, including the reissue or transfer that issued it.
+//	@Tags			tickets
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			eventId		path		string	true	"Event UUID"
+//	@Param			ticketId	path		string	true	"Ticket UUID"
+//	@Success		200			{array}		tickets.AuditEntry
+//	@Failure		400			{object}	problem.Problem	"Invalid ids"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Missing manage_guests"
+//	@Failure		404			{object}	problem.Problem	"Event or ticket not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/tickets/{ticketId}/audit [get]
+func (h *LifecycleHandler) Audit(r *http.Request) (any, error) {
+	eventID, ticketID, err := ticketPathIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := h.service.Audit(r.Context(), eventID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(entries), nil
+}
+
+// decode decodes the request body into dst and validates it.
+func (h *LifecycleHandler) decode(r *http.Request, dst any) error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return errcode.InvalidRequestBody.New()
+	}
+	return h.validator.Struct(dst)
+}
+
+// ticketPathIDs parses the eventId and ticketId path parameters.
+func ticketPathIDs(r *http.Request) (eventID, ticketID uuid.UUID, err error) {
+	if eventID, err = parseID(r, "eventId", "event"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if ticketID, err = parseID(r, "ticketId", "ticket"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return eventID, ticketID, nil
+}
@@ -0,0 +1,67 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ticket lifecycle actions recorded in the audit log.
+const (
+	ActionInvalidate = "invalidate"
+	ActionReissue    = "reissue"
+	ActionTransfer   = "transfer"
+	ActionReactivate = "reactivate"
+)
+
+// Message template variables of a ticket change (AuditEntry.Variables).
+const (
+	VarTicketAction = "ticket_action"
+	VarTicketReason = "ticket_reason"
+	VarTicketQRCode = "ticket_qr_code"
+)
+
+// AuditEntry represents a row in the ticket_audit_log table: one lifecycle
+// operation on a ticket. Reissue and transfer invalidate TicketID and name the
+// ticket that replaced it in NewTicketID.
+//
+// swagger:model TicketAuditEntry
+type AuditEntry struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	EventID     uuid.UUID  `json:"event_id" db:"event_id"`
+	TicketID    uuid.UUID  `json:"ticket_id" db:"ticket_id"`
+	Action      string     `json:"action" db:"action"`
+	FromStatus  string     `json:"from_status" db:"from_status"`
+	ToStatus    string     `json:"to_status" db:"to_status"`
+	GuestID     uuid.UUID  `json:"guest_id" db:"guest_id"`                     // holder before the change
+	ToGuestID   *uuid.UUID `json:"to_guest_id,omitempty" db:"to_guest_id"`     // transfer target
+	NewTicketID *uuid.UUID `json:"new_ticket_id,omitempty" db:"new_ticket_id"` // replacement on reissue/transfer
+	Reason      *string    `json:"reason,omitempty" db:"reason"`
+	ActorUserID *uuid.UUID `json:"actor_user_id,omitempty" db:"actor_user_id"`
+	RequestID   *string    `json:"request_id,omitempty" db:"request_id"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Variables returns the change as message template variables for a guest
+// notification. VarTicketQRCode is only set when ticket, the guest's ticket
+// after the change, is live.
+func (e *AuditEntry) Variables(ticket *Ticket) map[string]string {
+	v := map[string]string{VarTicketAction: e.Action, VarTicketReason: ""}
+	if e.Reason != nil {
+		v[VarTicketReason] = *e.Reason
+	}
+	if ticket != nil && ticket.Status != StatusInvalidated {
+		v[VarTicketQRCode] = ticket.QRCode
+	}
+	return v
+}
+
+// ChangeResult is the outcome of a lifecycle operation: the ticket the
+// operation acted on and, for reissue and transfer, the ticket replacing it.
+//
+// swagger:model TicketChangeResult
+type ChangeResult struct {
+	Ticket      *Ticket     `json:"ticket"`
+	Replacement *Ticket     `json:"replacement,omitempty"`
+	Audit       *AuditEntry `json:"audit"`
+}
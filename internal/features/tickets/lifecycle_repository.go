@@ -0,0 +1,143 @@
+package tickets
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/tickets/mock_lifecycle_store.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets LifecycleStore
+
+// LifecycleStore holds the ticket lifecycle and audit log queries. Methods
+// that write must run inside a transaction holding the event lock
+// (Store.LockUsage).
+type LifecycleStore interface {
+	// EventTenant returns the tenant of the live event, or
+	// repository.ErrNotFound.
+	EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+	// Ticket returns a live ticket of the event. It returns
+	// repository.ErrNotFound unless the ticket exists and belongs to the event.
+	Ticket(ctx context.Context, eventID, ticketID uuid.UUID) (*Ticket, error)
+	// SetStatus moves t to status and keeps its holder's guests.ticket_id on
+	// the live ticket: cleared when t is invalidated, set when it is live.
+	SetStatus(ctx context.Context, t *Ticket, status string) error
+	// VoidScans voids the ticket's scan logs so the scan path's re-entry
+	// check (scans.ScanStore.Scanned) no longer counts them. They stay in
+	// the log.
+	VoidScans(ctx context.Context, ticketID uuid.UUID) error
+	// AddAudit records a lifecycle operation.
+	AddAudit(ctx context.Context, e *AuditEntry) error
+	// Audit returns the audit entries of the ticket, including the one that
+	// issued it as a replacement, oldest first.
+	Audit(ctx context.Context, eventID, ticketID uuid.UUID) ([]*AuditEntry, error)
+}
+
+// lifecycleStore implements LifecycleStore on PostgreSQL.
+type lifecycleStore struct {
+	db *sqlkit.DB
+}
+
+// NewLifecycleStore returns a LifecycleStore backed by db.
+func NewLifecycleStore(db *sqlkit.DB) LifecycleStore {
+	return &lifecycleStore{db: db}
+}
+
+// EventTenant implements LifecycleStore.
+func (s *lifecycleStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var tenantID uuid.UUID
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT tenant_id FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repository.ErrNotFound
+	}
+	return tenantID, err
+}
+
+// Ticket implements LifecycleStore.
+func (s *lifecycleStore) Ticket(ctx context.Context, eventID, ticketID uuid.UUID) (*Ticket, error) {
+	var t Ticket
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT id, guest_id, event_id, ticket_type_id,
+			qr_code, status, group_id, created_at, updated_at
+		FROM tickets
+		WHERE id = $2 AND event_id = $1 AND deleted_at IS NULL`, eventID, ticketID,
+	).Scan(&t.ID, &t.GuestID, &t.EventID, &t.TicketTypeID, &t.QRCode, &t.Status, &t.GroupID, &t.CreatedAt, &t.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// SetStatus implements LifecycleStore. The two statements must run in one
+// transaction; callers go through TxManager.
+func (s *lifecycleStore) SetStatus(ctx context.Context, t *Ticket, status string) error {
+	conn := corerepository.Conn(ctx, s.db)
+	if err := conn.QueryRowContext(ctx,
+		"UPDATE tickets SET status = $2, updated_at = now() WHERE id = $1 RETURNING updated_at", t.ID, status,
+	).Scan(&t.UpdatedAt); err != nil {
+		return err
+	}
+	t.Status = status
+	if status == StatusInvalidated {
+		_, err := conn.ExecContext(ctx,
+			"UPDATE guests SET ticket_id = NULL, updated_at = now() WHERE id = $1 AND ticket_id = $2", t.GuestID, t.ID)
+		return err
+	}
+	_, err := conn.ExecContext(ctx,
+		"UPDATE guests SET ticket_id = $2, updated_at = now() WHERE id = $1", t.GuestID, t.ID)
+	return err
+}
+
+// VoidScans implements LifecycleStore.
+func (s *lifecycleStore) VoidScans(ctx context.Context, ticketID uuid.UUID) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE scan_logs SET voided_at = now() WHERE ticket_id = $1 AND voided_at IS NULL", ticketID)
+	return err
+}
+
+// AddAudit implements LifecycleStore.
+func (s *lifecycleStore) AddAudit(ctx context.Context, e *AuditEntry) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `INSERT INTO ticket_audit_log
+			(id, event_id, ticket_id, action, from_status, to_status, guest_id, to_guest_id, new_ticket_id,
+			reason, actor_user_id, request_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING created_at`,
+		e.ID, e.EventID, e.TicketID, e.Action, e.FromStatus, e.ToStatus, e.GuestID, e.ToGuestID, e.NewTicketID,
+		e.Reason, e.ActorUserID, e.RequestID,
+	).Scan(&e.CreatedAt)
+}
+
+// Audit implements LifecycleStore.
+func (s *lifecycleStore) Audit(ctx context.Context, eventID, ticketID uuid.UUID) ([]*AuditEntry, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `SELECT id, event_id, ticket_id, action,
+			from_status, to_status, guest_id, to_guest_id, new_ticket_id, reason, actor_user_id, request_id, created_at
+		FROM ticket_audit_log
+		WHERE event_id = $1 AND (ticket_id = $2 OR new_ticket_id = $2)
+		ORDER BY created_at, id`, eventID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := []*AuditEntry{}
+	for rows.Next() {
+		var e AuditEntry
+		if err := rows.Scan(
+			&e.ID, &e.EventID, &e.TicketID, &e.Action, &e.FromStatus, &e.ToStatus, &e.GuestID, &e.ToGuestID,
+			&e.NewTicketID, &e.Reason, &e.ActorUserID, &e.RequestID, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, &e)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,22 @@
+package tickets
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitLifecycleRoutes registers ticket lifecycle and audit log routes on the
+// given router. They need auth.ManageGuests; the service checks the caller
+// belongs to the event's tenant.
+func InitLifecycleRoutes(r *chi.Mux, lifecycleH *LifecycleHandler) {
+	r.Route("/api/v1/events/{eventId}/tickets/{ticketId}", func(r chi.Router) {
+		r.Use(auth.Require(auth.ManageGuests))
+		r.Post("/invalidate", problem.Handle(lifecycleH.Invalidate))
+		r.Post("/reissue", problem.Handle(lifecycleH.Reissue))
+		r.Post("/transfer", problem.Handle(lifecycleH.Transfer))
+		r.Post("/reactivate", problem.Handle(lifecycleH.Reactivate))
+		r.Get("/audit", problem.Handle(lifecycleH.Audit))
+	})
+}
@@ -0,0 +1,59 @@
+package tickets_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+	mockvalidation "github.com/biairmal/guest-management-be/mocks/validation"
+)
+
+func TestInitLifecycleRoutes_Guards(t *testing.T) {
+	base := "/api/v1/events/" + uuid.NewString() + "/tickets/" + uuid.NewString()
+	routes := []struct{ method, path string }{
+		{http.MethodPost, base + "/invalidate"},
+		{http.MethodPost, base + "/reissue"},
+		{http.MethodPost, base + "/transfer"},
+		{http.MethodPost, base + "/reactivate"},
+		{http.MethodGet, base + "/audit"},
+	}
+	callers := []struct {
+		name       string
+		perms      []string
+		wantStatus int
+	}{
+		{name: "anonymous", wantStatus: http.StatusUnauthorized},
+		{name: "missing manage_guests", perms: []string{auth.CheckIn}, wantStatus: http.StatusForbidden},
+	}
+
+	for _, c := range callers {
+		for _, rt := range routes {
+			t.Run(c.name+" "+rt.method+" "+rt.path, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				// The service must never be reached.
+				h := tickets.NewLifecycleHandler(mocktickets.NewMockLifecycleService(ctrl), mockvalidation.NewMockValidator(ctrl))
+				r := chi.NewRouter()
+				tickets.InitLifecycleRoutes(r, h)
+
+				ctx := context.Background()
+				if c.perms != nil {
+					ctx = ctxkit.WithPermissions(ctxkit.WithTenantID(ctx, uuid.NewString()), c.perms)
+				}
+				rec := httptest.NewRecorder()
+				r.ServeHTTP(rec, httptest.NewRequest(rt.method, rt.path, nil).WithContext(ctx))
+				if rec.Code != c.wantStatus {
+					t.Errorf("status = %d, want %d", rec.Code, c.wantStatus)
+				}
+			})
+		}
+	}
+}
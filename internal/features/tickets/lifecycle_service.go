@@ -0,0 +1,340 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/tickets/mock_lifecycle_service.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets LifecycleService
+
+// LifecycleService runs staff operations on issued tickets of an event of
+// the caller's tenant; another tenant's event is not found. Each operation
+// holds the event lock, keeps guests.ticket_id on the holder's live ticket,
+// writes an audit entry and, when asked, notifies the guests concerned once
+// committed.
+type LifecycleService interface {
+	// Invalidate invalidates an active or used ticket and promotes waitlisted
+	// guests into the seat it frees.
+	Invalidate(ctx context.Context, eventID, ticketID uuid.UUID, in InvalidateInput) (*ChangeResult, error)
+	// Reissue replaces an active ticket with a new one, with a new QR code, for
+	// the same guest; the old code stops working.
+	Reissue(ctx context.Context, eventID, ticketID uuid.UUID, in ChangeInput) (*ChangeResult, error)
+	// Transfer replaces an active ticket with a new one for another guest of
+	// the event who holds no ticket and isn't waitlisted.
+	Transfer(ctx context.Context, eventID, ticketID uuid.UUID, in TransferInput) (*ChangeResult, error)
+	// Reactivate makes a used or invalidated ticket active again and voids
+	// its scans, so it can be scanned in again. An invalidated ticket needs
+	// its guest to hold no other ticket and room within capacity.
+	Reactivate(ctx context.Context, eventID, ticketID uuid.UUID, in ChangeInput) (*ChangeResult, error)
+	// Audit returns the ticket's audit entries, oldest first.
+	Audit(ctx context.Context, eventID, ticketID uuid.UUID) ([]*AuditEntry, error)
+}
+
+// ChangeInput is the input for reissuing or re-activating a ticket.
+//
+// swagger:model TicketChangeInput
+type ChangeInput struct {
+	Reason string `json:"reason,omitempty" validate:"max=500"`
+	Notify bool   `json:"notify"`
+}
+
+// InvalidateInput is the input for invalidating a ticket; the reason is
+// required.
+//
+// swagger:model InvalidateTicketInput
+type InvalidateInput struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+	Notify bool   `json:"notify"`
+}
+
+// TransferInput is the input for transferring a ticket to another guest.
+//
+// swagger:model TransferTicketInput
+type TransferInput struct {
+	GuestID uuid.UUID `json:"guest_id"         validate:"required"`
+	Reason  string    `json:"reason,omitempty" validate:"max=500"`
+	Notify  bool      `json:"notify"`
+}
+
+// changeFunc applies one lifecycle operation to t under the event lock,
+// filling in e, and returns the ticket replacing t, if any.
+type changeFunc func(ctx context.Context, usage *Usage, t *Ticket, e *AuditEntry) (*Ticket, error)
+
+// lifecycleServiceImpl is the concrete implementation of LifecycleService.
+type lifecycleServiceImpl struct {
+	logger    logger.Logger
+	tx        transaction.TxManager
+	store     Store
+	lifecycle LifecycleStore
+	issuer    Issuer
+	notify    Notifier
+}
+
+// NewLifecycleService returns a LifecycleService with the given dependencies.
+func NewLifecycleService(
+	logger logger.Logger,
+	tx transaction.TxManager,
+	store Store,
+	lifecycle LifecycleStore,
+	issuer Issuer,
+	notify Notifier,
+) LifecycleService {
+	return &lifecycleServiceImpl{
+		logger: logger, tx: tx, store: store, lifecycle: lifecycle, issuer: issuer, notify: notify,
+	}
+}
+
+// Invalidate implements LifecycleService.
+func (s *lifecycleServiceImpl) Invalidate(
+	ctx context.Context, eventID, ticketID uuid.UUID, in InvalidateInput,
+) (*ChangeResult, error) {
+	return s.apply(ctx, eventID, ticketID, ActionInvalidate, in.Reason, in.Notify,
+		func(ctx context.Context, _ *Usage, t *Ticket, _ *AuditEntry) (*Ticket, error) {
+			if t.Status == StatusInvalidated {
+				return nil, errcode.TicketAlreadyInvalidated.New()
+			}
+			if err := s.lifecycle.SetStatus(ctx, t, StatusInvalidated); err != nil {
+				return nil, s.fail(ctx, "ticket invalidation failed", eventID, err)
+			}
+			return nil, s.issuer.Promote(ctx, eventID)
+		})
+}
+
+// Reissue implements LifecycleService.
+func (s *lifecycleServiceImpl) Reissue(
+	ctx context.Context, eventID, ticketID uuid.UUID, in ChangeInput,
+) (*ChangeResult, error) {
+	return s.apply(ctx, eventID, ticketID, ActionReissue, in.Reason, in.Notify,
+		func(ctx context.Context, _ *Usage, t *Ticket, _ *AuditEntry) (*Ticket, error) {
+			if t.Status != StatusActive {
+				return nil, errcode.TicketNotActive.WithMessage("only an active ticket can be reissued")
+			}
+			return s.replace(ctx, t, t.GuestID, t.GroupID)
+		})
+}
+
+// Transfer implements LifecycleService. The new ticket isn't linked to the
+// old holder's guest group.
+func (s *lifecycleServiceImpl) Transfer(
+	ctx context.Context, eventID, ticketID uuid.UUID, in TransferInput,
+) (*ChangeResult, error) {
+	return s.apply(ctx, eventID, ticketID, ActionTransfer, in.Reason, in.Notify,
+		func(ctx context.Context, _ *Usage, t *Ticket, e *AuditEntry) (*Ticket, error) {
+			if t.Status != StatusActive {
+				return nil, errcode.TicketNotActive.WithMessage("only an active ticket can be transferred")
+			}
+			if in.GuestID == t.GuestID {
+				return nil, errorz.BadRequest().WithMessage("guest already holds this ticket")
+			}
+			st, err := s.store.GuestState(ctx, eventID, in.GuestID)
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, errcode.GuestNotFound.New()
+			}
+			if err != nil {
+				return nil, s.fail(ctx, "ticket transfer failed", eventID, err)
+			}
+			if st.TicketID != nil {
+				return nil, errcode.GuestAlreadyTicketed.New()
+			}
+			if st.WaitlistID != nil {
+				return nil, errcode.GuestOnWaitlist.New()
+			}
+			e.ToGuestID = &in.GuestID
+			return s.replace(ctx, t, in.GuestID, nil)
+		})
+}
+
+// Reactivate implements LifecycleService.
+func (s *lifecycleServiceImpl) Reactivate(
+	ctx context.Context, eventID, ticketID uuid.UUID, in ChangeInput,
+) (*ChangeResult, error) {
+	return s.apply(ctx, eventID, ticketID, ActionReactivate, in.Reason, in.Notify,
+		func(ctx context.Context, usage *Usage, t *Ticket, _ *AuditEntry) (*Ticket, error) {
+			switch t.Status {
+			case StatusActive:
+				return nil, errcode.TicketAlreadyActive.New()
+			case StatusInvalidated:
+				if err := s.checkRevival(ctx, usage, t); err != nil {
+					return nil, err
+				}
+			}
+			if err := s.lifecycle.SetStatus(ctx, t, StatusActive); err != nil {
+				return nil, s.fail(ctx, "ticket reactivation failed", eventID, err)
+			}
+			if err := s.lifecycle.VoidScans(ctx, t.ID); err != nil {
+				return nil, s.fail(ctx, "ticket reactivation failed", eventID, err)
+			}
+			return nil, nil
+		})
+}
+
+// Audit implements LifecycleService.
+func (s *lifecycleServiceImpl) Audit(ctx context.Context, eventID, ticketID uuid.UUID) ([]*AuditEntry, error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	if _, err := s.lifecycle.Ticket(ctx, eventID, ticketID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.TicketNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "ticket get failed", logger.F("ticket_id", ticketID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get ticket")
+	}
+	entries, err := s.lifecycle.Audit(ctx, eventID, ticketID)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "ticket audit read failed", logger.F("ticket_id", ticketID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get ticket audit")
+	}
+	return entries, nil
+}
+
+// apply runs op on the event's ticket in a transaction holding the event
+// lock, records the audit entry and schedules the notification.
+func (s *lifecycleServiceImpl) apply(
+	ctx context.Context, eventID, ticketID uuid.UUID, action, reason string, notify bool, op changeFunc,
+) (*ChangeResult, error) {
+	if err := s.checkEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	var res *ChangeResult
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		usage, err := s.store.LockUsage(ctx, eventID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.EventNotFound.New()
+		}
+		if err != nil {
+			return s.fail(ctx, "event lock failed", eventID, err)
+		}
+		t, err := s.lifecycle.Ticket(ctx, eventID, ticketID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.TicketNotFound.New()
+		}
+		if err != nil {
+			return s.fail(ctx, "ticket get failed", eventID, err)
+		}
+
+		e := &AuditEntry{
+			ID:          uuid.New(),
+			EventID:     eventID,
+			TicketID:    t.ID,
+			Action:      action,
+			FromStatus:  t.Status,
+			GuestID:     t.GuestID,
+			Reason:      optional(reason),
+			ActorUserID: actor(ctx),
+			RequestID:   optional(ctxkit.RequestID(ctx)),
+		}
+		replacement, err := op(ctx, usage, t, e)
+		if err != nil {
+			return err
+		}
+		e.ToStatus = t.Status
+		current := replacement
+		if replacement != nil {
+			e.NewTicketID = &replacement.ID
+		} else if t.Status != StatusInvalidated {
+			current = t
+		}
+		if err := s.lifecycle.AddAudit(ctx, e); err != nil {
+			return s.fail(ctx, "ticket audit write failed", eventID, err)
+		}
+		if notify {
+			transaction.AfterCommit(ctx, func(ctx context.Context) { s.notify.Changed(ctx, e, current) })
+		}
+		res = &ChangeResult{Ticket: t, Replacement: replacement, Audit: e}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "ticket changed",
+		logger.F("event_id", eventID), logger.F("ticket_id", ticketID), logger.F("action", action))
+	return res, nil
+}
+
+// checkEvent returns 404 unless the event is live and belongs to the
+// caller's tenant.
+func (s *lifecycleServiceImpl) checkEvent(ctx context.Context, eventID uuid.UUID) error {
+	tenantID, err := s.lifecycle.EventTenant(ctx, eventID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	if err != nil {
+		return s.fail(ctx, "ticket event read failed", eventID, err)
+	}
+	if !auth.InTenant(ctx, tenantID) {
+		return errcode.EventNotFound.New()
+	}
+	return nil
+}
+
+// replace invalidates t and has the issuer issue a ticket of the same type to
+// guestID, published and counted like any other. The seat passes straight
+// from one ticket to the other, so capacity is unchanged.
+func (s *lifecycleServiceImpl) replace(
+	ctx context.Context, t *Ticket, guestID uuid.UUID, groupID *uuid.UUID,
+) (*Ticket, error) {
+	if err := s.lifecycle.SetStatus(ctx, t, StatusInvalidated); err != nil {
+		return nil, s.fail(ctx, "ticket replacement failed", t.EventID, err)
+	}
+	return s.issuer.Replace(ctx, IssueRequest{
+		EventID: t.EventID, GuestID: guestID, TicketTypeID: t.TicketTypeID, GroupID: groupID,
+	})
+}
+
+// checkRevival reports why the invalidated ticket t can't be active again:
+// its guest is gone, holds another ticket or waits for one, or capacity is
+// full.
+func (s *lifecycleServiceImpl) checkRevival(ctx context.Context, usage *Usage, t *Ticket) error {
+	st, err := s.store.GuestState(ctx, t.EventID, t.GuestID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.TicketHolderNotGuest.New()
+	}
+	if err != nil {
+		return s.fail(ctx, "ticket reactivation failed", t.EventID, err)
+	}
+	if st.TicketID != nil {
+		return errcode.GuestHoldsOtherTicket.New()
+	}
+	if st.WaitlistID != nil {
+		return errcode.GuestOnWaitlist.New()
+	}
+	if !usage.Fits(t.TicketTypeID) {
+		return errcode.TicketTypeSoldOut.New()
+	}
+	return nil
+}
+
+// fail logs err as msg and reports it as 500.
+func (s *lifecycleServiceImpl) fail(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update tickets")
+}
+
+// actor returns the user acting through ctx — the sub of the access token
+// auth.Middleware verified — or nil for API keys and anonymous callers.
+func actor(ctx context.Context) *uuid.UUID {
+	id, err := uuid.Parse(ctxkit.UserID(ctx))
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// optional returns nil for an empty s.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
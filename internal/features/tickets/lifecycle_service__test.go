@@ -0,0 +1,304 @@
+package tickets_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+)
+
+func TestLifecycleService(t *testing.T) {
+	eventID, ticketID, guestID, otherID, typeID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	tenantID, waitID := uuid.New(), uuid.New()
+	ctx := ctxkit.WithTenantID(context.Background(), tenantID.String())
+	room := usage(limit(10), 3, typeID, nil, 3)
+	full := usage(limit(3), 3, typeID, nil, 3)
+
+	type op func(svc tickets.LifecycleService) (*tickets.ChangeResult, error)
+	invalidate := func(svc tickets.LifecycleService) (*tickets.ChangeResult, error) {
+		return svc.Invalidate(ctx, eventID, ticketID, tickets.InvalidateInput{Reason: "fraud", Notify: true})
+	}
+	reissue := func(svc tickets.LifecycleService) (*tickets.ChangeResult, error) {
+		return svc.Reissue(ctx, eventID, ticketID, tickets.ChangeInput{Notify: true})
+	}
+	transfer := func(to uuid.UUID) op {
+		return func(svc tickets.LifecycleService) (*tickets.ChangeResult, error) {
+			return svc.Transfer(ctx, eventID, ticketID, tickets.TransferInput{GuestID: to, Notify: true})
+		}
+	}
+	reactivate := func(svc tickets.LifecycleService) (*tickets.ChangeResult, error) {
+		return svc.Reactivate(ctx, eventID, ticketID, tickets.ChangeInput{Reason: "scanned by mistake"})
+	}
+
+	tests := []struct {
+		name            string
+		op              op
+		status          string // status of the ticket before the operation
+		usage           *tickets.Usage
+		ticketErr       error
+		guestState      *tickets.GuestState
+		guestErr        error
+		wantCode        string
+		wantStatus      string // status of the ticket after the operation
+		wantReplacement *uuid.UUID
+		wantPromote     bool
+		wantVoid        bool // the ticket's scans are voided
+		wantNotify      bool
+	}{
+		{
+			name: "invalidate frees the seat for the waitlist", op: invalidate, status: tickets.StatusUsed,
+			wantStatus: tickets.StatusInvalidated, wantPromote: true, wantNotify: true,
+		},
+		{
+			name: "invalidate twice", op: invalidate, status: tickets.StatusInvalidated,
+			wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "ticket of another event", op: invalidate, ticketErr: repository.ErrNotFound,
+			wantCode: errorz.CodeNotFound,
+		},
+		{
+			name: "reissue replaces the code for the same guest", op: reissue, status: tickets.StatusActive,
+			wantStatus: tickets.StatusInvalidated, wantReplacement: &guestID, wantNotify: true,
+		},
+		{name: "reissue a used ticket", op: reissue, status: tickets.StatusUsed, wantCode: errorz.CodeConflict},
+		{
+			name: "transfer to a guest without a ticket", op: transfer(otherID), status: tickets.StatusActive,
+			guestState: &tickets.GuestState{}, wantStatus: tickets.StatusInvalidated, wantReplacement: &otherID,
+			wantNotify: true,
+		},
+		{
+			name: "transfer to the holder", op: transfer(guestID), status: tickets.StatusActive,
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "transfer to a guest holding a ticket", op: transfer(otherID), status: tickets.StatusActive,
+			guestState: &tickets.GuestState{TicketID: &waitID}, wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "transfer to a waitlisted guest", op: transfer(otherID), status: tickets.StatusActive,
+			guestState: &tickets.GuestState{WaitlistID: &waitID}, wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "transfer to a guest of another event", op: transfer(otherID), status: tickets.StatusActive,
+			guestErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound,
+		},
+		{
+			name: "undo a used mark", op: reactivate, status: tickets.StatusUsed, usage: full,
+			wantStatus: tickets.StatusActive, wantVoid: true,
+		},
+		{
+			name: "revive an invalidated ticket within capacity", op: reactivate, status: tickets.StatusInvalidated,
+			guestState: &tickets.GuestState{}, wantStatus: tickets.StatusActive, wantVoid: true,
+		},
+		{
+			name: "revive an invalidated ticket at capacity", op: reactivate, status: tickets.StatusInvalidated,
+			usage: full, guestState: &tickets.GuestState{}, wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "revive for a deleted guest", op: reactivate, status: tickets.StatusInvalidated,
+			guestErr: repository.ErrNotFound, wantCode: errorz.CodeConflict,
+		},
+		{name: "reactivate an active ticket", op: reactivate, status: tickets.StatusActive, wantCode: errorz.CodeConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mocktickets.NewMockStore(ctrl)
+			lifecycle := mocktickets.NewMockLifecycleStore(ctrl)
+			issuer := mocktickets.NewMockIssuer(ctrl)
+			notify := mocktickets.NewMockNotifier(ctrl)
+
+			u := tt.usage
+			if u == nil {
+				u = room
+			}
+			lifecycle.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+			store.EXPECT().LockUsage(gomock.Any(), eventID).Return(u, nil)
+			ticket := &tickets.Ticket{
+				ID: ticketID, EventID: eventID, GuestID: guestID, TicketTypeID: typeID, Status: tt.status,
+			}
+			if tt.ticketErr != nil {
+				ticket = nil
+			}
+			lifecycle.EXPECT().Ticket(gomock.Any(), eventID, ticketID).Return(ticket, tt.ticketErr)
+			store.EXPECT().GuestState(gomock.Any(), eventID, gomock.Any()).Return(tt.guestState, tt.guestErr).MaxTimes(1)
+			lifecycle.EXPECT().SetStatus(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, t *tickets.Ticket, status string) error {
+					t.Status = status
+					return nil
+				}).MaxTimes(1)
+			var issued *tickets.Ticket
+			issuer.EXPECT().Replace(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req tickets.IssueRequest) (*tickets.Ticket, error) {
+					issued = &tickets.Ticket{
+						ID: uuid.New(), EventID: req.EventID, GuestID: req.GuestID, TicketTypeID: req.TicketTypeID,
+						QRCode: "QR-NEW", Status: tickets.StatusActive, GroupID: req.GroupID,
+					}
+					return issued, nil
+				}).MaxTimes(1)
+			promoted := false
+			issuer.EXPECT().Promote(gomock.Any(), eventID).DoAndReturn(func(context.Context, uuid.UUID) error {
+				promoted = true
+				return nil
+			}).MaxTimes(1)
+			voided := false
+			lifecycle.EXPECT().VoidScans(gomock.Any(), ticketID).DoAndReturn(func(context.Context, uuid.UUID) error {
+				voided = true
+				return nil
+			}).MaxTimes(1)
+			var audit *tickets.AuditEntry
+			lifecycle.EXPECT().AddAudit(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, e *tickets.AuditEntry) error {
+					audit = e
+					return nil
+				}).MaxTimes(1)
+			notified := false
+			notify.EXPECT().Changed(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+				func(context.Context, *tickets.AuditEntry, *tickets.Ticket) { notified = true }).MaxTimes(1)
+
+			svc := tickets.NewLifecycleService(logger.NewNoOp(), inlineTx(ctrl), store, lifecycle, issuer, notify)
+			res, err := tt.op(svc)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode != "" {
+				if audit != nil || issued != nil {
+					t.Errorf("failed operation wrote audit %v, ticket %v", audit, issued)
+				}
+				return
+			}
+
+			if res.Ticket.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", res.Ticket.Status, tt.wantStatus)
+			}
+			if audit == nil || audit.FromStatus != tt.status || audit.ToStatus != tt.wantStatus {
+				t.Fatalf("audit = %+v, want %s → %s", audit, tt.status, tt.wantStatus)
+			}
+			if tt.wantReplacement == nil {
+				if res.Replacement != nil || audit.NewTicketID != nil {
+					t.Errorf("unexpected replacement %+v", res.Replacement)
+				}
+			} else {
+				if issued == nil || issued.GuestID != *tt.wantReplacement || issued.TicketTypeID != typeID ||
+					issued.Status != tickets.StatusActive || issued.QRCode == "" {
+					t.Fatalf("replacement = %+v, want an active ticket for %s", issued, *tt.wantReplacement)
+				}
+				if audit.NewTicketID == nil || *audit.NewTicketID != issued.ID {
+					t.Errorf("audit new_ticket_id = %v, want %s", audit.NewTicketID, issued.ID)
+				}
+			}
+			if voided != tt.wantVoid {
+				t.Errorf("voided = %v, want %v", voided, tt.wantVoid)
+			}
+			if promoted != tt.wantPromote {
+				t.Errorf("promoted = %v, want %v", promoted, tt.wantPromote)
+			}
+			if notified != tt.wantNotify {
+				t.Errorf("notified = %v, want %v", notified, tt.wantNotify)
+			}
+		})
+	}
+}
+
+func TestLifecycleService_RecordsActor(t *testing.T) {
+	eventID, ticketID, typeID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	tenantID := uuid.New()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantActor *uuid.UUID
+	}{
+		{
+			// auth.Middleware puts the access token's sub on the context.
+			name:      "logged-in user",
+			ctx:       ctxkit.WithTenantID(ctxkit.WithUserID(context.Background(), userID.String()), tenantID.String()),
+			wantActor: &userID,
+		},
+		{
+			// API keys authenticate a tenant, not a user.
+			name: "API key",
+			ctx:  ctxkit.WithTenantID(context.Background(), tenantID.String()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mocktickets.NewMockStore(ctrl)
+			lifecycle := mocktickets.NewMockLifecycleStore(ctrl)
+			issuer := mocktickets.NewMockIssuer(ctrl)
+
+			lifecycle.EXPECT().EventTenant(gomock.Any(), eventID).Return(tenantID, nil)
+			store.EXPECT().LockUsage(gomock.Any(), eventID).Return(usage(limit(10), 3, typeID, nil, 3), nil)
+			lifecycle.EXPECT().Ticket(gomock.Any(), eventID, ticketID).Return(&tickets.Ticket{
+				ID: ticketID, EventID: eventID, GuestID: uuid.New(), TicketTypeID: typeID, Status: tickets.StatusActive,
+			}, nil)
+			lifecycle.EXPECT().SetStatus(gomock.Any(), gomock.Any(), tickets.StatusInvalidated).Return(nil)
+			issuer.EXPECT().Promote(gomock.Any(), eventID).Return(nil)
+			var audit *tickets.AuditEntry
+			lifecycle.EXPECT().AddAudit(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, e *tickets.AuditEntry) error {
+					audit = e
+					return nil
+				})
+
+			svc := tickets.NewLifecycleService(
+				logger.NewNoOp(), inlineTx(ctrl), store, lifecycle, issuer, mocktickets.NewMockNotifier(ctrl),
+			)
+			_, err := svc.Invalidate(tt.ctx, eventID, ticketID, tickets.InvalidateInput{Reason: "fraud"})
+			assertErrorzCode(t, err, "")
+			switch {
+			case tt.wantActor == nil && audit.ActorUserID != nil:
+				t.Errorf("actor = %s, want none", *audit.ActorUserID)
+			case tt.wantActor != nil && (audit.ActorUserID == nil || *audit.ActorUserID != *tt.wantActor):
+				t.Errorf("actor = %v, want %s", audit.ActorUserID, *tt.wantActor)
+			}
+		})
+	}
+}
+
+// TestLifecycleService_OtherTenant hides another tenant's event behind a 404
+// before the ticket is locked or read.
+func TestLifecycleService_OtherTenant(t *testing.T) {
+	eventID, ticketID := uuid.New(), uuid.New()
+	ctx := ctxkit.WithTenantID(context.Background(), uuid.NewString())
+	calls := map[string]func(tickets.LifecycleService) error{
+		"Invalidate": func(svc tickets.LifecycleService) error {
+			_, err := svc.Invalidate(ctx, eventID, ticketID, tickets.InvalidateInput{Reason: "fraud"})
+			return err
+		},
+		"Reissue": func(svc tickets.LifecycleService) error {
+			_, err := svc.Reissue(ctx, eventID, ticketID, tickets.ChangeInput{})
+			return err
+		},
+		"Transfer": func(svc tickets.LifecycleService) error {
+			_, err := svc.Transfer(ctx, eventID, ticketID, tickets.TransferInput{GuestID: uuid.New()})
+			return err
+		},
+		"Reactivate": func(svc tickets.LifecycleService) error {
+			_, err := svc.Reactivate(ctx, eventID, ticketID, tickets.ChangeInput{})
+			return err
+		},
+		"Audit": func(svc tickets.LifecycleService) error {
+			_, err := svc.Audit(ctx, eventID, ticketID)
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			lifecycle := mocktickets.NewMockLifecycleStore(ctrl)
+			lifecycle.EXPECT().EventTenant(gomock.Any(), eventID).Return(uuid.New(), nil)
+			svc := tickets.NewLifecycleService(logger.NewNoOp(), inlineTx(ctrl), mocktickets.NewMockStore(ctrl),
+				lifecycle, mocktickets.NewMockIssuer(ctrl), mocktickets.NewMockNotifier(ctrl))
+			assertErrorzCode(t, call(svc), errorz.CodeNotFound)
+		})
+	}
+}
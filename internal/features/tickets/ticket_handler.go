@@ -0,0 +1,212 @@
+package tickets
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// Handler exposes HTTP handlers for ticket issuance, capacity and the waitlist.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+}
+
+// NewHandler returns a Handler that uses the given service and validator.
+func NewHandler(service Service, validator validation.Validator) *Handler {
+	return &Handler{service: service, validator: validator}
+}
+
+// Issue handles POST /events/{eventId}/guests/{guestId}/tickets.
+//
+// Issue godoc
+//
+//	@Summary		Issue ticket
+//	@Description	Issues the guest a ticket of the given type when both the event and the ticket type have room; otherwise puts the guest on the event's waitlist, from which they are promoted automatically as tickets are freed.
+//	@Tags			tickets
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId			path		string				true	"Event UUID"
+//	@Param			guestId			path		string				true	"Guest UUID"
+//	@Param			Idempotency-Key	header		string				false	"Client-chosen key; retries with the same key and body replay the first response"
+//	@Param			body			body		tickets.IssueInput	true	"Ticket type"
+//	@Success		201				{object}	tickets.IssueResult	"Ticket issued"
+//	@Success		200				{object}	tickets.IssueResult	"Capacity full; guest waitlisted"
+//	@Failure		400				{object}	problem.Problem				"Invalid ids or body, or the ticket type is not the event's"
+//	@Failure		404				{object}	problem.Problem				"Event or guest not found"
+//	@Failure		409				{object}	problem.Problem				"Guest already holds a ticket or is already waitlisted"
+//	@Failure		500				{object}	problem.Problem				"Internal server error"
+//	@Router			/api/v1/events/{eventId}/guests/{guestId}/tickets [post]
+func (h *Handler) Issue(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	guestID, err := parseID(r, "guestId", "guest")
+	if err != nil {
+		return nil, err
+	}
+	var body IssueInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return nil, err
+	}
+	res, err := h.service.Issue(r.Context(), eventID, guestID, body)
+	if err != nil {
+		return nil, err
+	}
+	if res.Outcome == OutcomeIssued {
+		return response.Created(res), nil
+	}
+	return response.OK(res), nil
+}
+
+// Capacity handles GET /events/{eventId}/capacity.
+//
+// Capacity godoc
+//
+//	@Summary		Event capacity
+//	@Description	Returns the event's capacity, issued tickets and waitlist length, overall and per ticket type. A null capacity is unlimited.
+//	@Tags			tickets
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{object}	tickets.CapacityReport
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/capacity [get]
+func (h *Handler) Capacity(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.service.Capacity(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(report), nil
+}
+
+// SetEventCapacity handles PUT /events/{eventId}/capacity.
+//
+// SetEventCapacity godoc
+//
+//	@Summary		Set event capacity
+//	@Description	Sets how many tickets the event may have issued; null removes the limit. Raising it promotes waitlisted guests; lowering it below the tickets already issued revokes none.
+//	@Tags			tickets
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId	path		string					true	"Event UUID"
+//	@Param			body	body		tickets.CapacityInput	true	"Capacity"
+//	@Success		200		{object}	tickets.CapacityReport
+//	@Failure		400		{object}	problem.Problem	"Invalid event id or body"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/capacity [put]
+func (h *Handler) SetEventCapacity(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	body, err := h.capacityBody(r)
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.service.SetEventCapacity(r.Context(), eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(report), nil
+}
+
+// SetTypeCapacity handles PUT /events/{eventId}/ticket-types/{ticketTypeId}/capacity.
+//
+// SetTypeCapacity godoc
+//
+//	@Summary		Set ticket type capacity
+//	@Description	Sets how many tickets of the type may be issued; null removes the limit. Raising it promotes waitlisted guests; lowering it below the tickets already issued revokes none.
+//	@Tags			tickets
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventId			path		string					true	"Event UUID"
+//	@Param			ticketTypeId	path		string					true	"Ticket type UUID"
+//	@Param			body			body		tickets.CapacityInput	true	"Capacity"
+//	@Success		200				{object}	tickets.CapacityReport
+//	@Failure		400				{object}	problem.Problem	"Invalid ids or body"
+//	@Failure		404				{object}	problem.Problem	"Event or ticket type not found"
+//	@Failure		500				{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/ticket-types/{ticketTypeId}/capacity [put]
+func (h *Handler) SetTypeCapacity(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	typeID, err := parseID(r, "ticketTypeId", "ticket type")
+	if err != nil {
+		return nil, err
+	}
+	body, err := h.capacityBody(r)
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.service.SetTypeCapacity(r.Context(), eventID, typeID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(report), nil
+}
+
+// Waitlist handles GET /events/{eventId}/waitlist.
+//
+// Waitlist godoc
+//
+//	@Summary		Event waitlist
+//	@Description	Returns the guests waiting for a ticket, in promotion order.
+//	@Tags			tickets
+//	@Produce		json
+//	@Param			eventId	path		string	true	"Event UUID"
+//	@Success		200		{array}		tickets.WaitlistEntry
+//	@Failure		400		{object}	problem.Problem	"Invalid event id"
+//	@Failure		404		{object}	problem.Problem	"Event not found"
+//	@Failure		500		{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/events/{eventId}/waitlist [get]
+func (h *Handler) Waitlist(r *http.Request) (any, error) {
+	eventID, err := parseID(r, "eventId", "event")
+	if err != nil {
+		return nil, err
+	}
+	entries, err := h.service.Waitlist(r.Context(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(entries), nil
+}
+
+// capacityBody decodes and validates a CapacityInput body.
+func (h *Handler) capacityBody(r *http.Request) (CapacityInput, error) {
+	var body CapacityInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return body, err
+	}
+	return body, nil
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
@@ -0,0 +1,252 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/tickets/mock_issuer.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Issuer
+
+// Issuer issues tickets within the event's and ticket types' capacity and
+// runs the waitlist. Every method locks the event row first, so concurrent
+// issuances of one event are serialised and capacity is never oversold. Each
+// call joins the caller's transaction when ctx carries one, and returns
+// errorz errors. Every ticket issued is published to the tenant's webhooks as
+// ticket.issued and, once committed, counted in internal/core/metrics.
+type Issuer interface {
+	// Issue gives the guest a ticket when capacity allows, or puts them on the
+	// waitlist otherwise. A guest holding a live ticket or already waiting is
+	// a conflict.
+	Issue(ctx context.Context, req IssueRequest) (*IssueResult, error)
+	// Withdraw invalidates the guests' active tickets, takes them off the
+	// waitlist, and promotes waitlisted guests into the capacity freed.
+	Withdraw(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) error
+	// Promote issues tickets to waitlisted guests, first come first served,
+	// while capacity allows. An entry whose ticket type is full is skipped, not
+	// blocking those behind it.
+	Promote(ctx context.Context, eventID uuid.UUID) error
+	// Replace issues req's guest a ticket in place of one the caller has just
+	// invalidated under the event lock, as a reissue or transfer does. The
+	// seat passes straight across, so capacity isn't checked.
+	Replace(ctx context.Context, req IssueRequest) (*Ticket, error)
+}
+
+// issuer is the concrete implementation of Issuer.
+type issuer struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	store  Store
+	notify Notifier
+	hooks  webhooks.Publisher
+}
+
+// NewIssuer returns an Issuer with the given dependencies.
+func NewIssuer(
+	logger logger.Logger, tx transaction.TxManager, store Store, notify Notifier, hooks webhooks.Publisher,
+) Issuer {
+	return &issuer{logger: logger, tx: tx, store: store, notify: notify, hooks: hooks}
+}
+
+// Issue implements Issuer.
+func (i *issuer) Issue(ctx context.Context, req IssueRequest) (*IssueResult, error) {
+	var res *IssueResult
+	err := i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		usage, err := i.store.LockUsage(ctx, req.EventID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.EventNotFound.New()
+		}
+		if err != nil {
+			return i.fail(ctx, "ticket issue failed", req.EventID, err)
+		}
+		if _, ok := usage.Types[req.TicketTypeID]; !ok {
+			return errorz.BadRequest().WithMessage("ticket type is not a ticket type of the event")
+		}
+		st, err := i.store.GuestState(ctx, req.EventID, req.GuestID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.GuestNotFound.New()
+		}
+		if err != nil {
+			return i.fail(ctx, "ticket issue failed", req.EventID, err)
+		}
+		if st.TicketID != nil {
+			return errcode.GuestAlreadyTicketed.New()
+		}
+		if st.WaitlistID != nil {
+			return errcode.GuestAlreadyWaitlisted.New()
+		}
+
+		if usage.Fits(req.TicketTypeID) {
+			t, err := i.insert(ctx, req)
+			if err != nil {
+				return i.fail(ctx, "ticket issue failed", req.EventID, err)
+			}
+			res = &IssueResult{Outcome: OutcomeIssued, Ticket: t}
+			transaction.AfterCommit(ctx, func(context.Context) { metrics.TicketIssued(metrics.TicketDirect) })
+			return nil
+		}
+		e := &WaitlistEntry{
+			ID:           uuid.New(),
+			EventID:      req.EventID,
+			GuestID:      req.GuestID,
+			TicketTypeID: req.TicketTypeID,
+			GroupID:      req.GroupID,
+			Status:       WaitlistWaiting,
+		}
+		if err := i.store.Enqueue(ctx, e); err != nil {
+			return i.fail(ctx, "waitlist enqueue failed", req.EventID, err)
+		}
+		res = &IssueResult{Outcome: OutcomeWaitlisted, Waitlist: e}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.logger.InfoWithContext(ctx, "ticket requested",
+		logger.F("event_id", req.EventID), logger.F("guest_id", req.GuestID), logger.F("outcome", res.Outcome))
+	return res, nil
+}
+
+// Withdraw implements Issuer. Withdrawing from a deleted event does nothing.
+func (i *issuer) Withdraw(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) error {
+	if len(guestIDs) == 0 {
+		return nil
+	}
+	return i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := i.store.LockUsage(ctx, eventID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil
+			}
+			return i.fail(ctx, "ticket withdraw failed", eventID, err)
+		}
+		freed, err := i.store.Withdraw(ctx, eventID, guestIDs)
+		if err != nil {
+			return i.fail(ctx, "ticket withdraw failed", eventID, err)
+		}
+		if freed == 0 {
+			return nil
+		}
+		return i.promote(ctx, eventID)
+	})
+}
+
+// Promote implements Issuer.
+func (i *issuer) Promote(ctx context.Context, eventID uuid.UUID) error {
+	return i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return i.promote(ctx, eventID)
+	})
+}
+
+// Replace implements Issuer.
+func (i *issuer) Replace(ctx context.Context, req IssueRequest) (*Ticket, error) {
+	var t *Ticket
+	err := i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if t, err = i.insert(ctx, req); err != nil {
+			return i.fail(ctx, "ticket replacement failed", req.EventID, err)
+		}
+		transaction.AfterCommit(ctx, func(context.Context) { metrics.TicketIssued(metrics.TicketReplacement) })
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// promote fills the event's free capacity from its waitlist. It (re)reads the
+// usage under the event lock, which ctx's transaction may already hold.
+func (i *issuer) promote(ctx context.Context, eventID uuid.UUID) error {
+	usage, err := i.store.LockUsage(ctx, eventID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.EventNotFound.New()
+	}
+	if err != nil {
+		return i.fail(ctx, "waitlist promotion failed", eventID, err)
+	}
+	if usage.Full() {
+		return nil
+	}
+	entries, err := i.store.Waiting(ctx, eventID)
+	if err != nil {
+		return i.fail(ctx, "waitlist promotion failed", eventID, err)
+	}
+
+	for _, e := range entries {
+		if usage.Full() {
+			break
+		}
+		if !usage.Fits(e.TicketTypeID) {
+			continue
+		}
+		t, err := i.insert(ctx, IssueRequest{
+			EventID: eventID, GuestID: e.GuestID, TicketTypeID: e.TicketTypeID, GroupID: e.GroupID,
+		})
+		if err != nil {
+			return i.fail(ctx, "waitlist promotion failed", eventID, err)
+		}
+		if err := i.store.Promoted(ctx, e.ID, t.ID); err != nil {
+			return i.fail(ctx, "waitlist promotion failed", eventID, err)
+		}
+		usage.Take(e.TicketTypeID)
+		e.Status, e.TicketID = WaitlistPromoted, &t.ID
+
+		entry := e
+		transaction.AfterCommit(ctx, func(ctx context.Context) {
+			metrics.TicketIssued(metrics.TicketWaitlist)
+			i.notify.Promoted(ctx, entry, t)
+		})
+		i.logger.InfoWithContext(ctx, "waitlist entry promoted",
+			logger.F("event_id", eventID), logger.F("guest_id", e.GuestID), logger.F("ticket_id", t.ID))
+	}
+	return nil
+}
+
+// insert issues an active ticket for req and publishes it.
+func (i *issuer) insert(ctx context.Context, req IssueRequest) (*Ticket, error) {
+	t, err := insertTicket(ctx, i.store, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.hooks.Publish(ctx, req.EventID, webhooks.TicketIssued, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// insertTicket issues an active ticket with a fresh QR code for req and makes
+// it the guest's ticket. The caller holds the event lock.
+func insertTicket(ctx context.Context, store Store, req IssueRequest) (*Ticket, error) {
+	code, err := newQRCode()
+	if err != nil {
+		return nil, err
+	}
+	t := &Ticket{
+		ID:           uuid.New(),
+		GuestID:      req.GuestID,
+		EventID:      req.EventID,
+		TicketTypeID: req.TicketTypeID,
+		QRCode:       code,
+		Status:       StatusActive,
+		GroupID:      req.GroupID,
+	}
+	if err := store.InsertTicket(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// fail logs err as msg and reports it as 500.
+func (i *issuer) fail(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	i.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update tickets")
+}
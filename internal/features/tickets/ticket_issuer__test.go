@@ -0,0 +1,294 @@
+package tickets_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// anyHooks returns a webhook publisher accepting any event.
+func anyHooks(ctrl *gomock.Controller) *mockwebhooks.MockPublisher {
+	hooks := mockwebhooks.NewMockPublisher(ctrl)
+	hooks.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return hooks
+}
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func limit(n int) *int { return &n }
+
+// usage returns an event usage with one ticket type.
+func usage(capacity *int, issued int, typeID uuid.UUID, typeCapacity *int, typeIssued int) *tickets.Usage {
+	return &tickets.Usage{
+		Capacity: capacity,
+		Issued:   issued,
+		Types:    map[uuid.UUID]*tickets.TypeUsage{typeID: {Capacity: typeCapacity, Issued: typeIssued}},
+	}
+}
+
+func TestIssuer_Issue(t *testing.T) {
+	eventID, guestID, typeID, held := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		usage       *tickets.Usage
+		usageErr    error
+		typeID      uuid.UUID
+		state       *tickets.GuestState
+		stateErr    error
+		wantOutcome string
+		wantCode    string
+	}{
+		{
+			name: "issued within capacity", usage: usage(limit(10), 9, typeID, nil, 0),
+			state: &tickets.GuestState{}, wantOutcome: tickets.OutcomeIssued,
+		},
+		{
+			name: "unlimited event", usage: usage(nil, 500, typeID, nil, 500),
+			state: &tickets.GuestState{}, wantOutcome: tickets.OutcomeIssued,
+		},
+		{
+			name: "event full", usage: usage(limit(10), 10, typeID, nil, 3),
+			state: &tickets.GuestState{}, wantOutcome: tickets.OutcomeWaitlisted,
+		},
+		{
+			name: "ticket type full", usage: usage(limit(10), 4, typeID, limit(4), 4),
+			state: &tickets.GuestState{}, wantOutcome: tickets.OutcomeWaitlisted,
+		},
+		{name: "event not found", usageErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{
+			name: "ticket type of another event", usage: usage(nil, 0, uuid.New(), nil, 0),
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "guest not found", usage: usage(nil, 0, typeID, nil, 0),
+			stateErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound,
+		},
+		{
+			name: "guest already ticketed", usage: usage(nil, 0, typeID, nil, 0),
+			state: &tickets.GuestState{TicketID: &held}, wantCode: errorz.CodeConflict,
+		},
+		{
+			name: "guest already waiting", usage: usage(limit(1), 1, typeID, nil, 1),
+			state: &tickets.GuestState{WaitlistID: &held}, wantCode: errorz.CodeConflict,
+		},
+		{name: "lock failure", usageErr: errors.New("boom"), wantCode: errorz.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mocktickets.NewMockStore(ctrl)
+			store.EXPECT().LockUsage(gomock.Any(), eventID).Return(tt.usage, tt.usageErr)
+			store.EXPECT().GuestState(gomock.Any(), eventID, guestID).Return(tt.state, tt.stateErr).MaxTimes(1)
+			store.EXPECT().InsertTicket(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, tk *tickets.Ticket) error {
+					if tk.QRCode == "" || tk.Status != tickets.StatusActive || tk.TicketTypeID != typeID {
+						t.Errorf("ticket = %+v, want an active ticket of type %v with a code", tk, typeID)
+					}
+					return nil
+				}).MaxTimes(1)
+			store.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(1)
+			hooks := mockwebhooks.NewMockPublisher(ctrl)
+			published := 0
+			if tt.wantOutcome == tickets.OutcomeIssued {
+				published = 1
+			}
+			hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.TicketIssued, gomock.Any()).
+				Return(nil).Times(published)
+
+			iss := tickets.NewIssuer(logger.NewNoOp(), inlineTx(ctrl), store, mocktickets.NewMockNotifier(ctrl), hooks)
+			got, err := iss.Issue(context.Background(),
+				tickets.IssueRequest{EventID: eventID, GuestID: guestID, TicketTypeID: typeID})
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && got.Outcome != tt.wantOutcome {
+				t.Errorf("outcome = %q, want %q", got.Outcome, tt.wantOutcome)
+			}
+		})
+	}
+}
+
+func TestIssuer_Withdraw(t *testing.T) {
+	eventID, guestID, vip, general := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	first := &tickets.WaitlistEntry{ID: uuid.New(), EventID: eventID, GuestID: uuid.New(), TicketTypeID: vip}
+	second := &tickets.WaitlistEntry{ID: uuid.New(), EventID: eventID, GuestID: uuid.New(), TicketTypeID: general}
+	third := &tickets.WaitlistEntry{ID: uuid.New(), EventID: eventID, GuestID: uuid.New(), TicketTypeID: general}
+
+	tests := []struct {
+		name         string
+		freed        int64
+		after        *tickets.Usage // usage re-read once tickets are freed
+		wantPromoted []uuid.UUID
+	}{
+		{name: "nothing freed promotes no one"},
+		{
+			name:  "freed seat goes to the first entry that fits",
+			freed: 1,
+			after: &tickets.Usage{Capacity: limit(10), Issued: 9, Types: map[uuid.UUID]*tickets.TypeUsage{
+				vip: {Capacity: limit(2), Issued: 2}, general: {Issued: 7},
+			}},
+			wantPromoted: []uuid.UUID{second.ID},
+		},
+		{
+			name:  "several seats freed",
+			freed: 2,
+			after: &tickets.Usage{Capacity: limit(10), Issued: 8, Types: map[uuid.UUID]*tickets.TypeUsage{
+				vip: {Issued: 1}, general: {Issued: 7},
+			}},
+			wantPromoted: []uuid.UUID{first.ID, second.ID},
+		},
+		{
+			name:  "type freed while the event stays full",
+			freed: 1,
+			after: &tickets.Usage{Capacity: limit(10), Issued: 10, Types: map[uuid.UUID]*tickets.TypeUsage{
+				vip: {Capacity: limit(2), Issued: 1}, general: {Issued: 9},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mocktickets.NewMockStore(ctrl)
+			notify := mocktickets.NewMockNotifier(ctrl)
+			locks := 0
+			store.EXPECT().LockUsage(gomock.Any(), eventID).DoAndReturn(
+				func(context.Context, uuid.UUID) (*tickets.Usage, error) {
+					locks++
+					if locks == 1 {
+						return &tickets.Usage{}, nil
+					}
+					return tt.after, nil
+				}).AnyTimes()
+			store.EXPECT().Withdraw(gomock.Any(), eventID, []uuid.UUID{guestID}).Return(tt.freed, nil)
+			entries := []*tickets.WaitlistEntry{
+				{ID: first.ID, EventID: eventID, GuestID: first.GuestID, TicketTypeID: vip},
+				{ID: second.ID, EventID: eventID, GuestID: second.GuestID, TicketTypeID: general},
+				{ID: third.ID, EventID: eventID, GuestID: third.GuestID, TicketTypeID: general},
+			}
+			store.EXPECT().Waiting(gomock.Any(), eventID).Return(entries, nil).MaxTimes(1)
+			store.EXPECT().InsertTicket(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			var promoted []uuid.UUID
+			store.EXPECT().Promoted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, entryID, _ uuid.UUID) error {
+					promoted = append(promoted, entryID)
+					return nil
+				}).AnyTimes()
+			notified := 0
+			notify.EXPECT().Promoted(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+				func(context.Context, *tickets.WaitlistEntry, *tickets.Ticket) { notified++ }).AnyTimes()
+
+			iss := tickets.NewIssuer(logger.NewNoOp(), inlineTx(ctrl), store, notify, anyHooks(ctrl))
+			if err := iss.Withdraw(context.Background(), eventID, []uuid.UUID{guestID}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(promoted) != len(tt.wantPromoted) {
+				t.Fatalf("promoted = %v, want %v", promoted, tt.wantPromoted)
+			}
+			for i := range promoted {
+				if promoted[i] != tt.wantPromoted[i] {
+					t.Errorf("promoted = %v, want %v", promoted, tt.wantPromoted)
+				}
+			}
+			if notified != len(tt.wantPromoted) {
+				t.Errorf("notified = %d, want %d", notified, len(tt.wantPromoted))
+			}
+		})
+	}
+}
+
+func TestIssuer_WithdrawFromDeletedEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mocktickets.NewMockStore(ctrl)
+	store.EXPECT().LockUsage(gomock.Any(), gomock.Any()).Return(nil, repository.ErrNotFound)
+
+	iss := tickets.NewIssuer(logger.NewNoOp(), inlineTx(ctrl), store, mocktickets.NewMockNotifier(ctrl), anyHooks(ctrl))
+	if err := iss.Withdraw(context.Background(), uuid.New(), []uuid.UUID{uuid.New()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// issuedCount returns guest_management_tickets_issued_total for the source.
+func issuedCount(t *testing.T, source string) float64 {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	for _, f := range families {
+		if f.GetName() != "guest_management_tickets_issued_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "source" && l.GetValue() == source {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// TestIssuer_Replace issues the replacement of a reissued or transferred
+// ticket without a capacity check, publishes it as ticket.issued and counts it.
+func TestIssuer_Replace(t *testing.T) {
+	eventID, guestID, typeID := uuid.New(), uuid.New(), uuid.New()
+	ctrl := gomock.NewController(t)
+	store := mocktickets.NewMockStore(ctrl)
+	store.EXPECT().InsertTicket(gomock.Any(), gomock.Any()).Return(nil)
+	hooks := mockwebhooks.NewMockPublisher(ctrl)
+	var published *tickets.Ticket
+	hooks.EXPECT().Publish(gomock.Any(), eventID, webhooks.TicketIssued, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, _ string, data any) error {
+			published = data.(*tickets.Ticket)
+			return nil
+		})
+	before := issuedCount(t, metrics.TicketReplacement)
+
+	iss := tickets.NewIssuer(logger.NewNoOp(), inlineTx(ctrl), store, mocktickets.NewMockNotifier(ctrl), hooks)
+	got, err := iss.Replace(context.Background(),
+		tickets.IssueRequest{EventID: eventID, GuestID: guestID, TicketTypeID: typeID})
+	assertErrorzCode(t, err, "")
+	if got.GuestID != guestID || got.Status != tickets.StatusActive || got.QRCode == "" {
+		t.Errorf("ticket = %+v, want an active ticket with a code for %s", got, guestID)
+	}
+	if published != got {
+		t.Errorf("published %+v, want the replacement", published)
+	}
+	if n := issuedCount(t, metrics.TicketReplacement) - before; n != 1 {
+		t.Errorf("replacement tickets counted = %v, want 1", n)
+	}
+}
@@ -0,0 +1,153 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ticket statuses. Active and used tickets are issued: they count against
+// capacity.
+const (
+	StatusActive      = "active"
+	StatusUsed        = "used"
+	StatusInvalidated = "invalidated"
+)
+
+// Waitlist entry statuses.
+const (
+	WaitlistWaiting   = "waiting"
+	WaitlistPromoted  = "promoted"
+	WaitlistWithdrawn = "withdrawn"
+)
+
+// Outcomes of an issue request.
+const (
+	OutcomeIssued     = "issued"
+	OutcomeWaitlisted = "waitlisted"
+)
+
+// Ticket represents a row in the tickets table.
+//
+// swagger:model Ticket
+type Ticket struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	GuestID      uuid.UUID  `json:"guest_id" db:"guest_id"`
+	EventID      uuid.UUID  `json:"event_id" db:"event_id"`
+	TicketTypeID uuid.UUID  `json:"ticket_type_id" db:"ticket_type_id"`
+	QRCode       string     `json:"qr_code" db:"qr_code"`
+	Status       string     `json:"status" db:"status"`
+	GroupID      *uuid.UUID `json:"group_id,omitempty" db:"group_id"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// WaitlistEntry represents a row in the ticket_waitlist table: a guest waiting
+// for a ticket of a type while the event or the type is full.
+//
+// swagger:model WaitlistEntry
+type WaitlistEntry struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	EventID      uuid.UUID  `json:"event_id" db:"event_id"`
+	GuestID      uuid.UUID  `json:"guest_id" db:"guest_id"`
+	TicketTypeID uuid.UUID  `json:"ticket_type_id" db:"ticket_type_id"`
+	GroupID      *uuid.UUID `json:"group_id,omitempty" db:"group_id"`
+	Status       string     `json:"status" db:"status"`
+	TicketID     *uuid.UUID `json:"ticket_id,omitempty" db:"ticket_id"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
+	GuestName    string     `json:"guest_name,omitempty"` // set on waitlist reads
+	Position     int        `json:"position,omitempty"`   // 1-based place in the queue, set on waitlist reads
+}
+
+// IssueRequest asks for a ticket of TicketTypeID for a guest of the event.
+// GroupID links the ticket to the guest group it was issued through.
+type IssueRequest struct {
+	EventID      uuid.UUID
+	GuestID      uuid.UUID
+	TicketTypeID uuid.UUID
+	GroupID      *uuid.UUID
+}
+
+// IssueResult is the outcome of an issue request: a ticket when capacity
+// allowed it, a waitlist entry otherwise.
+//
+// swagger:model IssueResult
+type IssueResult struct {
+	Outcome  string         `json:"outcome"` // issued or waitlisted
+	Ticket   *Ticket        `json:"ticket,omitempty"`
+	Waitlist *WaitlistEntry `json:"waitlist,omitempty"`
+}
+
+// GuestState is what a guest already holds: a live ticket and/or a place on
+// the waitlist.
+type GuestState struct {
+	TicketID   *uuid.UUID
+	WaitlistID *uuid.UUID
+}
+
+// Usage is an event's capacity and issued tickets, read under the event lock.
+// A nil capacity is unlimited.
+type Usage struct {
+	Capacity *int
+	Issued   int
+	Types    map[uuid.UUID]*TypeUsage // the event's live ticket types
+}
+
+// TypeUsage is a ticket type's capacity and issued tickets.
+type TypeUsage struct {
+	Capacity *int
+	Issued   int
+}
+
+// Fits reports whether one more ticket of typeID fits both the event's and
+// the type's capacity. An unknown type never fits.
+func (u *Usage) Fits(typeID uuid.UUID) bool {
+	t, ok := u.Types[typeID]
+	if !ok {
+		return false
+	}
+	return !full(u.Capacity, u.Issued) && !full(t.Capacity, t.Issued)
+}
+
+// Full reports whether the event itself has no room left.
+func (u *Usage) Full() bool {
+	return full(u.Capacity, u.Issued)
+}
+
+// Take counts one more issued ticket of typeID.
+func (u *Usage) Take(typeID uuid.UUID) {
+	u.Issued++
+	if t, ok := u.Types[typeID]; ok {
+		t.Issued++
+	}
+}
+
+// full reports whether issued has reached a non-nil capacity.
+func full(capacity *int, issued int) bool {
+	return capacity != nil && issued >= *capacity
+}
+
+// CapacityReport is an event's capacity, issued tickets and waitlist length,
+// overall and per ticket type. A null capacity is unlimited.
+//
+// swagger:model CapacityReport
+type CapacityReport struct {
+	EventID     uuid.UUID      `json:"event_id"`
+	Capacity    *int           `json:"capacity"`
+	Issued      int            `json:"issued"`
+	Waiting     int            `json:"waiting"`
+	TicketTypes []TypeCapacity `json:"ticket_types"`
+}
+
+// TypeCapacity is one ticket type's line of a CapacityReport.
+//
+// swagger:model TypeCapacity
+type TypeCapacity struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Capacity *int      `json:"capacity"`
+	Issued   int       `json:"issued"`
+	Waiting  int       `json:"waiting"`
+}
@@ -0,0 +1,49 @@
+package tickets
+
+import (
+	"context"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/tickets/mock_notifier.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Notifier
+
+// Notifier tells guests what happened to their tickets. It is called after
+// the issuing transaction commits, so a failure can't undo the ticket.
+type Notifier interface {
+	// Promoted tells a waitlisted guest that a ticket was issued to them.
+	Promoted(ctx context.Context, entry *WaitlistEntry, ticket *Ticket)
+	// Changed tells the guests concerned about a lifecycle operation on their
+	// ticket: the holder, and the new holder of a transfer. ticket is the live
+	// ticket the operation left (the re-activated ticket, or the replacement of
+	// a reissue or transfer), nil after an invalidation.
+	Changed(ctx context.Context, entry *AuditEntry, ticket *Ticket)
+}
+
+// logNotifier records notifications in the log until guest messaging exists,
+// counting each as a sent notification.
+type logNotifier struct {
+	logger logger.Logger
+}
+
+// NewLogNotifier returns a Notifier that logs each notification.
+func NewLogNotifier(logger logger.Logger) Notifier {
+	return &logNotifier{logger: logger}
+}
+
+// Promoted implements Notifier.
+func (n *logNotifier) Promoted(ctx context.Context, entry *WaitlistEntry, ticket *Ticket) {
+	metrics.MessageSent(metrics.ChannelNotification)
+	n.logger.InfoWithContext(ctx, "waitlisted guest promoted",
+		logger.F("event_id", entry.EventID), logger.F("guest_id", entry.GuestID), logger.F("ticket_id", ticket.ID))
+}
+
+// Changed implements Notifier.
+func (n *logNotifier) Changed(ctx context.Context, entry *AuditEntry, _ *Ticket) {
+	metrics.MessageSent(metrics.ChannelNotification)
+	n.logger.InfoWithContext(ctx, "ticket change notified",
+		logger.F("event_id", entry.EventID), logger.F("ticket_id", entry.TicketID), logger.F("action", entry.Action),
+		logger.F("guest_id", entry.GuestID), logger.F("to_guest_id", entry.ToGuestID))
+}
@@ -0,0 +1,299 @@
+package tickets
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/tickets/mock_store.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Store
+
+// issuedTickets is the SQL predicate of a ticket t counting against capacity.
+const issuedTickets = "t.deleted_at IS NULL AND t.status IN ('active', 'used')"
+
+// Store holds the ticket issuance, capacity and waitlist queries. Methods that
+// write must run inside a transaction holding the event lock (LockUsage).
+type Store interface {
+	// EventExists returns repository.ErrNotFound unless the event is live.
+	EventExists(ctx context.Context, eventID uuid.UUID) error
+	// LockUsage locks the event row until the surrounding transaction ends,
+	// serialising every issuance, promotion and capacity change of the event,
+	// and returns its capacities and issued tickets. It returns
+	// repository.ErrNotFound unless the event is live.
+	LockUsage(ctx context.Context, eventID uuid.UUID) (*Usage, error)
+	// GuestState returns what the guest holds. It returns
+	// repository.ErrNotFound unless the guest is a live guest of the event.
+	GuestState(ctx context.Context, eventID, guestID uuid.UUID) (*GuestState, error)
+	// InsertTicket inserts an active ticket and makes it the guest's ticket.
+	InsertTicket(ctx context.Context, t *Ticket) error
+	// Enqueue puts the guest on the waitlist.
+	Enqueue(ctx context.Context, e *WaitlistEntry) error
+	// Waiting returns the event's waiting entries of live guests, first come first.
+	Waiting(ctx context.Context, eventID uuid.UUID) ([]*WaitlistEntry, error)
+	// Promoted marks a waitlist entry as promoted to ticketID.
+	Promoted(ctx context.Context, entryID, ticketID uuid.UUID) error
+	// Withdraw invalidates the guests' active tickets of the event, takes them
+	// off its waitlist and returns how many tickets it invalidated. Used
+	// tickets are kept.
+	Withdraw(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) (int64, error)
+	// SetEventCapacity sets the event's capacity; nil removes the limit.
+	SetEventCapacity(ctx context.Context, eventID uuid.UUID, capacity *int) error
+	// SetTypeCapacity sets a ticket type's capacity; nil removes the limit. It
+	// returns repository.ErrNotFound unless the type is a live type of the event.
+	SetTypeCapacity(ctx context.Context, eventID, typeID uuid.UUID, capacity *int) error
+	// Report returns the event's capacity report. It returns
+	// repository.ErrNotFound unless the event is live.
+	Report(ctx context.Context, eventID uuid.UUID) (*CapacityReport, error)
+}
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// EventExists implements Store.
+func (s *store) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT 1 FROM events WHERE id = $1 AND deleted_at IS NULL", eventID,
+	).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// LockUsage implements Store.
+func (s *store) LockUsage(ctx context.Context, eventID uuid.UUID) (*Usage, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	u := &Usage{Types: map[uuid.UUID]*TypeUsage{}}
+	err := conn.QueryRowContext(ctx,
+		"SELECT capacity FROM events WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", eventID,
+	).Scan(&u.Capacity)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.QueryRowContext(ctx,
+		"SELECT count(*) FROM tickets t WHERE t.event_id = $1 AND "+issuedTickets, eventID,
+	).Scan(&u.Issued); err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT tt.id, tt.capacity,
+			(SELECT count(*) FROM tickets t WHERE t.ticket_type_id = tt.id AND `+issuedTickets+`)
+		FROM ticket_types tt
+		WHERE tt.event_id = $1 AND tt.deleted_at IS NULL`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var (
+			id uuid.UUID
+			t  TypeUsage
+		)
+		if err := rows.Scan(&id, &t.Capacity, &t.Issued); err != nil {
+			return nil, err
+		}
+		u.Types[id] = &t
+	}
+	return u, rows.Err()
+}
+
+// GuestState implements Store.
+func (s *store) GuestState(ctx context.Context, eventID, guestID uuid.UUID) (*GuestState, error) {
+	var st GuestState
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `SELECT
+			(SELECT t.id FROM tickets t WHERE t.id = g.ticket_id AND t.deleted_at IS NULL AND t.status <> 'invalidated'),
+			(SELECT w.id FROM ticket_waitlist w WHERE w.guest_id = g.id AND w.status = 'waiting')
+		FROM guests g
+		WHERE g.id = $2 AND g.event_id = $1 AND g.deleted_at IS NULL`, eventID, guestID,
+	).Scan(&st.TicketID, &st.WaitlistID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// InsertTicket implements Store. The two statements must run in one
+// transaction; callers go through TxManager.
+func (s *store) InsertTicket(ctx context.Context, t *Ticket) error {
+	conn := corerepository.Conn(ctx, s.db)
+	if err := conn.QueryRowContext(ctx, `INSERT INTO tickets (id, guest_id, event_id, ticket_type_id, qr_code, status, group_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at, updated_at`,
+		t.ID, t.GuestID, t.EventID, t.TicketTypeID, t.QRCode, t.Status, t.GroupID,
+	).Scan(&t.CreatedAt, &t.UpdatedAt); err != nil {
+		return err
+	}
+	_, err := conn.ExecContext(ctx,
+		"UPDATE guests SET ticket_id = $1, updated_at = now() WHERE id = $2", t.ID, t.GuestID)
+	return err
+}
+
+// Enqueue implements Store.
+func (s *store) Enqueue(ctx context.Context, e *WaitlistEntry) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `INSERT INTO ticket_waitlist
+			(id, event_id, guest_id, ticket_type_id, group_id, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at`,
+		e.ID, e.EventID, e.GuestID, e.TicketTypeID, e.GroupID, e.Status,
+	).Scan(&e.CreatedAt, &e.UpdatedAt)
+}
+
+// Waiting implements Store.
+func (s *store) Waiting(ctx context.Context, eventID uuid.UUID) ([]*WaitlistEntry, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `SELECT w.id, w.event_id, w.guest_id,
+			w.ticket_type_id, w.group_id, w.status, w.ticket_id, w.created_at, w.updated_at, w.resolved_at, g.name
+		FROM ticket_waitlist w
+		JOIN guests g ON g.id = w.guest_id AND g.deleted_at IS NULL
+		WHERE w.event_id = $1 AND w.status = 'waiting'
+		ORDER BY w.created_at, w.id`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []*WaitlistEntry
+	for rows.Next() {
+		var e WaitlistEntry
+		if err := rows.Scan(
+			&e.ID, &e.EventID, &e.GuestID, &e.TicketTypeID, &e.GroupID, &e.Status, &e.TicketID,
+			&e.CreatedAt, &e.UpdatedAt, &e.ResolvedAt, &e.GuestName,
+		); err != nil {
+			return nil, err
+		}
+		e.Position = len(out) + 1
+		out = append(out, &e)
+	}
+	return out, rows.Err()
+}
+
+// Promoted implements Store.
+func (s *store) Promoted(ctx context.Context, entryID, ticketID uuid.UUID) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE ticket_waitlist
+		SET status = 'promoted', ticket_id = $2, resolved_at = now(), updated_at = now()
+		WHERE id = $1`, entryID, ticketID)
+	return err
+}
+
+// Withdraw implements Store. The two statements must run in one transaction;
+// callers go through TxManager.
+func (s *store) Withdraw(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) (int64, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	ids := uuidArray(guestIDs)
+	res, err := conn.ExecContext(ctx, `UPDATE tickets SET status = 'invalidated', updated_at = now()
+		WHERE event_id = $1 AND guest_id = ANY($2::uuid[]) AND status = 'active' AND deleted_at IS NULL`,
+		eventID, ids)
+	if err != nil {
+		return 0, err
+	}
+	freed, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	_, err = conn.ExecContext(ctx, `UPDATE ticket_waitlist
+		SET status = 'withdrawn', resolved_at = now(), updated_at = now()
+		WHERE event_id = $1 AND guest_id = ANY($2::uuid[]) AND status = 'waiting'`, eventID, ids)
+	return freed, err
+}
+
+// SetEventCapacity implements Store.
+func (s *store) SetEventCapacity(ctx context.Context, eventID uuid.UUID, capacity *int) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx,
+		"UPDATE events SET capacity = $2, updated_at = now() WHERE id = $1 AND deleted_at IS NULL",
+		eventID, capacity)
+	return err
+}
+
+// SetTypeCapacity implements Store.
+func (s *store) SetTypeCapacity(ctx context.Context, eventID, typeID uuid.UUID, capacity *int) error {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE ticket_types SET capacity = $3, updated_at = now()
+		WHERE id = $2 AND event_id = $1 AND deleted_at IS NULL`, eventID, typeID, capacity)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// Report implements Store.
+func (s *store) Report(ctx context.Context, eventID uuid.UUID) (*CapacityReport, error) {
+	conn := corerepository.Conn(ctx, s.db)
+	r := &CapacityReport{EventID: eventID, TicketTypes: []TypeCapacity{}}
+	err := conn.QueryRowContext(ctx, `SELECT e.capacity,
+			(SELECT count(*) FROM tickets t WHERE t.event_id = e.id AND `+issuedTickets+`),
+			(SELECT count(*) FROM ticket_waitlist w WHERE w.event_id = e.id AND w.status = 'waiting')
+		FROM events e
+		WHERE e.id = $1 AND e.deleted_at IS NULL`, eventID,
+	).Scan(&r.Capacity, &r.Issued, &r.Waiting)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT tt.id, tt.name, tt.capacity,
+			(SELECT count(*) FROM tickets t WHERE t.ticket_type_id = tt.id AND `+issuedTickets+`),
+			(SELECT count(*) FROM ticket_waitlist w WHERE w.ticket_type_id = tt.id AND w.status = 'waiting')
+		FROM ticket_types tt
+		WHERE tt.event_id = $1 AND tt.deleted_at IS NULL
+		ORDER BY tt.name, tt.id`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var t TypeCapacity
+		if err := rows.Scan(&t.ID, &t.Name, &t.Capacity, &t.Issued, &t.Waiting); err != nil {
+			return nil, err
+		}
+		r.TicketTypes = append(r.TicketTypes, t)
+	}
+	return r, rows.Err()
+}
+
+// uuidArray binds ids as a PostgreSQL text array, cast to uuid[] in SQL.
+func uuidArray(ids []uuid.UUID) any {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return pq.StringArray(s)
+}
+
+// newQRCode returns a fresh, unguessable ticket code.
+func newQRCode() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
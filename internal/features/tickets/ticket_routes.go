@@ -0,0 +1,17 @@
+package tickets
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitTicketRoutes registers ticket issuance, capacity and waitlist routes on
+// the given router.
+func InitTicketRoutes(r *chi.Mux, ticketH *Handler) {
+	r.Post("/api/v1/events/{eventId}/guests/{guestId}/tickets", problem.Handle(ticketH.Issue))
+	r.Get("/api/v1/events/{eventId}/capacity", problem.Handle(ticketH.Capacity))
+	r.Put("/api/v1/events/{eventId}/capacity", problem.Handle(ticketH.SetEventCapacity))
+	r.Put("/api/v1/events/{eventId}/ticket-types/{ticketTypeId}/capacity", problem.Handle(ticketH.SetTypeCapacity))
+	r.Get("/api/v1/events/{eventId}/waitlist", problem.Handle(ticketH.Waitlist))
+}
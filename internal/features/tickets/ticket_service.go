@@ -0,0 +1,159 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/tickets/mock_service.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Service
+
+// Service exposes ticket issuance, capacity limits and the waitlist of an event.
+type Service interface {
+	// Issue issues a ticket to a guest of the event, or waitlists them when
+	// capacity is full.
+	Issue(ctx context.Context, eventID, guestID uuid.UUID, in IssueInput) (*IssueResult, error)
+	// Capacity returns the event's capacity report.
+	Capacity(ctx context.Context, eventID uuid.UUID) (*CapacityReport, error)
+	// SetEventCapacity sets the event's capacity and promotes waitlisted guests
+	// into any room it makes.
+	SetEventCapacity(ctx context.Context, eventID uuid.UUID, in CapacityInput) (*CapacityReport, error)
+	// SetTypeCapacity sets a ticket type's capacity and promotes waitlisted
+	// guests into any room it makes.
+	SetTypeCapacity(ctx context.Context, eventID, typeID uuid.UUID, in CapacityInput) (*CapacityReport, error)
+	// Waitlist returns the event's waiting guests in promotion order.
+	Waitlist(ctx context.Context, eventID uuid.UUID) ([]*WaitlistEntry, error)
+}
+
+// IssueInput is the input for issuing a ticket to a guest.
+//
+// swagger:model IssueInput
+type IssueInput struct {
+	TicketTypeID uuid.UUID `json:"ticket_type_id" validate:"required"`
+}
+
+// CapacityInput sets a capacity. A null or omitted capacity removes the
+// limit. Lowering it below the tickets already issued revokes none; new
+// tickets wait until enough are freed.
+//
+// swagger:model CapacityInput
+type CapacityInput struct {
+	Capacity *int `json:"capacity" validate:"omitempty,min=0,max=1000000"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	store  Store
+	issuer Issuer
+}
+
+// NewService returns a Service with the given dependencies.
+func NewService(logger logger.Logger, tx transaction.TxManager, store Store, issuer Issuer) Service {
+	return &serviceImpl{logger: logger, tx: tx, store: store, issuer: issuer}
+}
+
+// Issue implements Service.
+func (s *serviceImpl) Issue(ctx context.Context, eventID, guestID uuid.UUID, in IssueInput) (*IssueResult, error) {
+	return s.issuer.Issue(ctx, IssueRequest{EventID: eventID, GuestID: guestID, TicketTypeID: in.TicketTypeID})
+}
+
+// Capacity implements Service.
+func (s *serviceImpl) Capacity(ctx context.Context, eventID uuid.UUID) (*CapacityReport, error) {
+	r, err := s.store.Report(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "capacity report failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get capacity")
+	}
+	return r, nil
+}
+
+// SetEventCapacity implements Service.
+func (s *serviceImpl) SetEventCapacity(
+	ctx context.Context, eventID uuid.UUID, in CapacityInput,
+) (*CapacityReport, error) {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.lock(ctx, eventID); err != nil {
+			return err
+		}
+		if err := s.store.SetEventCapacity(ctx, eventID, in.Capacity); err != nil {
+			return s.fail(ctx, "event capacity update failed", eventID, err)
+		}
+		return s.issuer.Promote(ctx, eventID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "event capacity updated", logger.F("event_id", eventID), logger.F("capacity", in.Capacity))
+	return s.Capacity(ctx, eventID)
+}
+
+// SetTypeCapacity implements Service.
+func (s *serviceImpl) SetTypeCapacity(
+	ctx context.Context, eventID, typeID uuid.UUID, in CapacityInput,
+) (*CapacityReport, error) {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.lock(ctx, eventID); err != nil {
+			return err
+		}
+		if err := s.store.SetTypeCapacity(ctx, eventID, typeID, in.Capacity); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return errcode.TicketTypeNotFound.New()
+			}
+			return s.fail(ctx, "ticket type capacity update failed", eventID, err)
+		}
+		return s.issuer.Promote(ctx, eventID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "ticket type capacity updated",
+		logger.F("event_id", eventID), logger.F("ticket_type_id", typeID), logger.F("capacity", in.Capacity))
+	return s.Capacity(ctx, eventID)
+}
+
+// Waitlist implements Service.
+func (s *serviceImpl) Waitlist(ctx context.Context, eventID uuid.UUID) ([]*WaitlistEntry, error) {
+	if err := s.store.EventExists(ctx, eventID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errcode.EventNotFound.New()
+		}
+		s.logger.ErrorWithContext(ctx, "event lookup failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get event")
+	}
+	entries, err := s.store.Waiting(ctx, eventID)
+	if err != nil {
+		s.logger.ErrorWithContext(ctx, "waitlist read failed", logger.F("event_id", eventID), logger.F("error", err))
+		return nil, errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to get waitlist")
+	}
+	return entries, nil
+}
+
+// lock takes the event lock so a capacity change can't interleave with an
+// issuance, mapping a missing event to 404.
+func (s *serviceImpl) lock(ctx context.Context, eventID uuid.UUID) error {
+	if _, err := s.store.LockUsage(ctx, eventID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errcode.EventNotFound.New()
+		}
+		return s.fail(ctx, "event lock failed", eventID, err)
+	}
+	return nil
+}
+
+// fail logs err as msg and reports it as 500.
+func (s *serviceImpl) fail(ctx context.Context, msg string, eventID uuid.UUID, err error) error {
+	s.logger.ErrorWithContext(ctx, msg, logger.F("event_id", eventID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to update tickets")
+}
@@ -0,0 +1,53 @@
+package tickets_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/tickets"
+	mocktickets "github.com/biairmal/guest-management-be/mocks/tickets"
+)
+
+func TestService_SetTypeCapacity(t *testing.T) {
+	eventID, typeID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		lockErr     error
+		setErr      error
+		wantPromote bool
+		wantCode    string
+	}{
+		{name: "capacity set and waitlist promoted", wantPromote: true},
+		{name: "event not found", lockErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "ticket type not found", setErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mocktickets.NewMockStore(ctrl)
+			issuer := mocktickets.NewMockIssuer(ctrl)
+			store.EXPECT().LockUsage(gomock.Any(), eventID).Return(&tickets.Usage{}, tt.lockErr)
+			store.EXPECT().SetTypeCapacity(gomock.Any(), eventID, typeID, limit(5)).Return(tt.setErr).MaxTimes(1)
+			promoted := false
+			issuer.EXPECT().Promote(gomock.Any(), eventID).DoAndReturn(func(context.Context, uuid.UUID) error {
+				promoted = true
+				return nil
+			}).MaxTimes(1)
+			store.EXPECT().Report(gomock.Any(), eventID).Return(&tickets.CapacityReport{EventID: eventID}, nil).MaxTimes(1)
+
+			svc := tickets.NewService(logger.NewNoOp(), inlineTx(ctrl), store, issuer)
+			_, err := svc.SetTypeCapacity(context.Background(), eventID, typeID, tickets.CapacityInput{Capacity: limit(5)})
+			assertErrorzCode(t, err, tt.wantCode)
+			if promoted != tt.wantPromote {
+				t.Errorf("promoted = %v, want %v", promoted, tt.wantPromote)
+			}
+		})
+	}
+}
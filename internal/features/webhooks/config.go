@@ -0,0 +1,90 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+)
+
+// Config aggregates the webhooks feature's own configuration, one field per
+// layer (app.webhooks.<layer> in config.yaml). Events are queued whatever the
+// config; the dispatcher that sends them only runs when Enabled.
+type Config struct {
+	Enabled  bool           `mapstructure:"enabled"`
+	Service  ServiceConfig  `mapstructure:"service"`
+	Delivery DeliveryConfig `mapstructure:"delivery"`
+}
+
+// ServiceConfig holds config for the webhooks feature's service layer.
+type ServiceConfig struct {
+	// RequireHTTPS rejects endpoint URLs that aren't https. Turn it off only
+	// for local development.
+	RequireHTTPS bool `mapstructure:"require_https"`
+}
+
+// DeliveryConfig tunes the dispatcher that sends queued events.
+type DeliveryConfig struct {
+	// PollInterval is how often the queue is checked for due deliveries.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// BatchSize is the most deliveries one poll claims and sends at once.
+	BatchSize int `mapstructure:"batch_size"`
+	// Timeout bounds one attempt, from connecting to reading the response.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxAttempts is how many times a delivery is tried before it fails.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// BackoffBase is the wait after the first failed attempt; it doubles with
+	// every further failure up to BackoffMax.
+	BackoffBase time.Duration `mapstructure:"backoff_base"`
+	BackoffMax  time.Duration `mapstructure:"backoff_max"`
+	// DisableAfter is how many failed attempts in a row, across deliveries,
+	// disable an endpoint.
+	DisableAfter int `mapstructure:"disable_after"`
+}
+
+// DefaultConfig returns the webhooks feature config with its defaults: eight
+// attempts spread over about an hour, and endpoints disabled after 20 failed
+// attempts in a row.
+func DefaultConfig() Config {
+	return Config{
+		Enabled: true,
+		Service: ServiceConfig{RequireHTTPS: true},
+		Delivery: DeliveryConfig{
+			PollInterval: 5 * time.Second,
+			BatchSize:    20,
+			Timeout:      10 * time.Second,
+			MaxAttempts:  8,
+			BackoffBase:  30 * time.Second,
+			BackoffMax:   time.Hour,
+			DisableAfter: 20,
+		},
+	}
+}
+
+// Validate validates the webhooks feature configuration.
+func (c *Config) Validate() error {
+	return c.Delivery.Validate()
+}
+
+// Validate validates the dispatcher settings.
+func (c *DeliveryConfig) Validate() error {
+	if c.PollInterval <= 0 {
+		return errorz.Internal().WithMessage("webhooks: delivery.poll_interval must be positive")
+	}
+	if c.BatchSize < 1 {
+		return errorz.Internal().WithMessage("webhooks: delivery.batch_size must be at least 1")
+	}
+	if c.Timeout <= 0 {
+		return errorz.Internal().WithMessage("webhooks: delivery.timeout must be positive")
+	}
+	if c.MaxAttempts < 1 {
+		return errorz.Internal().WithMessage("webhooks: delivery.max_attempts must be at least 1")
+	}
+	if c.BackoffBase <= 0 || c.BackoffMax < c.BackoffBase {
+		return errorz.Internal().WithMessage(
+			"webhooks: delivery.backoff_base must be positive and at most delivery.backoff_max")
+	}
+	if c.DisableAfter < 1 {
+		return errorz.Internal().WithMessage("webhooks: delivery.disable_after must be at least 1")
+	}
+	return nil
+}
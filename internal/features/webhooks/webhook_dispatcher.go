@@ -0,0 +1,315 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+
+	"github.com/biairmal/guest-management-be/internal/core/metrics"
+	"github.com/biairmal/guest-management-be/internal/core/tracing"
+	"github.com/biairmal/guest-management-be/internal/core/transaction"
+)
+
+// maxResponseBytes is how much of a receiver's response body is kept in the
+// delivery log.
+const maxResponseBytes = 1024
+
+// userAgent identifies deliveries to receivers.
+const userAgent = "guest-management-webhooks/1"
+
+// errBlockedTarget refuses a connection to an address that isn't public.
+var errBlockedTarget = errors.New("webhook target address is not public")
+
+// nonPublic lists the special-purpose ranges netip's predicates don't cover:
+// "this network", carrier-grade NAT, IETF protocol assignments, benchmarking,
+// reserved, and NAT64 (which can reach any IPv4 address).
+var nonPublic = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// publicAddr reports whether ip is a public unicast address: not loopback,
+// private, link-local (169.254.0.0/16, where cloud metadata services live),
+// multicast, unspecified or otherwise reserved.
+func publicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, p := range nonPublic {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// publicHost reports whether host may name a receiver: it isn't localhost
+// and, when it is an IP literal, the address is public. Names are checked
+// again, on every connection, by guardDial.
+func publicHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	ip, err := netip.ParseAddr(host)
+	return err != nil || publicAddr(ip)
+}
+
+// guardDial is the net.Dialer Control hook of deliveries. It runs on the
+// resolved address of every connection, so a receiver name that resolves, or
+// is later re-pointed, to an internal address is refused too.
+func guardDial(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil || !publicAddr(ap.Addr()) {
+		return errBlockedTarget
+	}
+	return nil
+}
+
+// guardedTransport returns a transport that only connects to public
+// addresses, directly rather than through an environment proxy.
+func guardedTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: guardDial}).DialContext
+	return t
+}
+
+// Dispatcher sends queued deliveries. Every PollInterval it claims up to
+// BatchSize due deliveries and sends them concurrently, so several API
+// instances can share the queue. A delivery answered with a 2xx status
+// succeeds; anything else, a redirect included, is retried with exponential
+// backoff until MaxAttempts, and DisableAfter failed attempts in a row disable
+// the endpoint. Deliveries only connect to public addresses (guardDial).
+type Dispatcher struct {
+	logger logger.Logger
+	tx     transaction.TxManager
+	store  Store
+	client *http.Client
+	cfg    DeliveryConfig
+	now    func() time.Time
+
+	stop    chan struct{}
+	done    chan struct{}
+	cancel  context.CancelFunc
+	started sync.Once
+	stopped sync.Once
+}
+
+// NewDispatcher returns a Dispatcher sending store's deliveries with client,
+// or, when client is nil, with a client bounded by cfg.Timeout that refuses
+// connections to loopback, private, link-local and other non-public
+// addresses. Each attempt is traced as an outbound HTTP span when tracing is
+// enabled.
+func NewDispatcher(
+	logger logger.Logger, tx transaction.TxManager, store Store, client *http.Client, cfg DeliveryConfig,
+) *Dispatcher {
+	if client == nil {
+		client = &http.Client{Timeout: cfg.Timeout, Transport: guardedTransport()}
+	}
+	// A redirect is reported as the failure it is rather than followed to a
+	// URL the tenant didn't register.
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	c.Transport = tracing.Transport(c.Transport)
+	return &Dispatcher{
+		logger: logger, tx: tx, store: store, client: &c, cfg: cfg, now: time.Now,
+		stop: make(chan struct{}), done: make(chan struct{}),
+	}
+}
+
+// Start polls the queue in the background until Shutdown.
+func (d *Dispatcher) Start() {
+	d.started.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		d.cancel = cancel
+		go d.loop(ctx)
+	})
+}
+
+// Shutdown stops polling and waits for the deliveries in flight. When ctx
+// expires first they are cancelled, left to be retried once their lease runs
+// out, and ctx.Err() is returned.
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.stopped.Do(func() { close(d.stop) })
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-d.done
+		return ctx.Err()
+	}
+}
+
+// loop runs RunOnce every PollInterval until stopped.
+func (d *Dispatcher) loop(ctx context.Context) {
+	defer close(d.done)
+	defer d.cancel()
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			if _, err := d.RunOnce(ctx); err != nil {
+				d.logger.ErrorWithContext(ctx, "webhook poll failed", logger.F("error", err))
+			}
+		}
+	}
+}
+
+// RunOnce claims one batch of due deliveries, sends them and records the
+// outcomes. It returns how many it sent.
+func (d *Dispatcher) RunOnce(ctx context.Context) (int, error) {
+	// The lease outlasts an attempt, so a delivery isn't claimed again while
+	// it is still being sent.
+	jobs, err := d.store.Claim(ctx, d.cfg.BatchSize, 2*d.cfg.Timeout)
+	if err != nil {
+		return 0, err
+	}
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.deliver(ctx, job)
+		}()
+	}
+	wg.Wait()
+	return len(jobs), nil
+}
+
+// deliver sends one job and records its outcome; failures to record are
+// logged, leaving the delivery to be retried when its lease runs out.
+func (d *Dispatcher) deliver(ctx context.Context, job *Job) {
+	a := d.send(ctx, job)
+	if ctx.Err() != nil {
+		return
+	}
+	ok := a.ResponseCode != nil && *a.ResponseCode >= 200 && *a.ResponseCode < 300
+	if ok {
+		metrics.MessageSent(metrics.ChannelWebhook)
+	} else {
+		metrics.MessageFailed(metrics.ChannelWebhook)
+	}
+	attempts := job.Attempts + 1
+	switch {
+	case ok:
+		a.Status = StatusSucceeded
+	case attempts >= d.cfg.MaxAttempts:
+		a.Status = StatusFailed
+	default:
+		a.Status = StatusPending
+		next := a.At.Add(Backoff(d.cfg, attempts))
+		a.Next = &next
+	}
+
+	err := d.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := d.store.Record(ctx, job.ID, a); err != nil {
+			return err
+		}
+		failures, err := d.store.CountOutcome(ctx, job.EndpointID, ok)
+		if err != nil || ok || failures < d.cfg.DisableAfter {
+			return err
+		}
+		d.logger.WarnWithContext(ctx, "webhook endpoint disabled",
+			logger.F("endpoint_id", job.EndpointID), logger.F("failures", failures))
+		return d.store.Disable(ctx, job.EndpointID)
+	})
+	if err != nil {
+		d.logger.ErrorWithContext(ctx, "webhook delivery record failed",
+			logger.F("delivery_id", job.ID), logger.F("error", err))
+		return
+	}
+	if !ok {
+		d.logger.WarnWithContext(ctx, "webhook delivery failed", logger.F("delivery_id", job.ID),
+			logger.F("endpoint_id", job.EndpointID), logger.F("attempt", attempts), logger.F("status", a.Status))
+	}
+}
+
+// send makes one attempt at job.
+func (d *Dispatcher) send(ctx context.Context, job *Job) *Attempt {
+	a := &Attempt{At: d.now()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.URL, bytes.NewReader(job.Payload))
+	if err != nil {
+		return a.fail(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderID, job.ID.String())
+	req.Header.Set(HeaderEvent, job.EventType)
+	req.Header.Set(HeaderSignature, Sign(job.Secret, a.At, job.Payload))
+
+	res, err := d.client.Do(req)
+	a.Duration = d.now().Sub(a.At)
+	if err != nil {
+		return a.fail(err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBytes))
+	code, text := res.StatusCode, string(body)
+	a.ResponseCode, a.ResponseBody = &code, &text
+	return a
+}
+
+// fail records err as the attempt's error.
+func (a *Attempt) fail(err error) *Attempt {
+	var msg string
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		msg = "timed out"
+	case errors.Is(err, errBlockedTarget):
+		msg = errBlockedTarget.Error()
+	default:
+		msg = err.Error()
+	}
+	a.Error = &msg
+	return a
+}
+
+// Backoff returns the wait after the given number of failed attempts:
+// BackoffBase doubled for each attempt after the first, capped at BackoffMax.
+func Backoff(cfg DeliveryConfig, attempts int) time.Duration {
+	wait := cfg.BackoffBase
+	for i := 1; i < attempts && wait < cfg.BackoffMax; i++ {
+		wait *= 2
+	}
+	return min(wait, cfg.BackoffMax)
+}
+
+// Sign returns the X-Webhook-Signature header value for body sent at t:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>" keyed by
+// secret>". Receivers recompute it and reject stale timestamps to stop
+// replays.
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
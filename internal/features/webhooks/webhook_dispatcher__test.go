@@ -0,0 +1,187 @@
+package webhooks_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mocktransaction "github.com/biairmal/guest-management-be/mocks/core/transaction"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+// inlineTx returns a transaction manager that runs its callback inline.
+func inlineTx(ctrl *gomock.Controller) *mocktransaction.MockTxManager {
+	tx := mocktransaction.NewMockTxManager(ctrl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).AnyTimes()
+	return tx
+}
+
+// verify recomputes the signature header independently of Sign.
+func verify(secret, header string, body []byte) bool {
+	ts, sig, ok := strings.Cut(header, ",v1=")
+	if !ok || !strings.HasPrefix(ts, "t=") {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.TrimPrefix(ts, "t=") + "." + string(body)))
+	return hmac.Equal([]byte(sig), []byte(hex.EncodeToString(mac.Sum(nil))))
+}
+
+func TestDispatcher_RunOnce(t *testing.T) {
+	cfg := webhooks.DefaultConfig().Delivery
+	tests := []struct {
+		name        string
+		code        int
+		attempts    int // attempts made before this one
+		failures    int // consecutive failures counted after this one
+		wantStatus  string
+		wantDisable bool
+	}{
+		{name: "2xx succeeds", code: http.StatusNoContent, wantStatus: webhooks.StatusSucceeded},
+		{
+			name: "5xx is retried", code: http.StatusInternalServerError, attempts: 2, failures: 3,
+			wantStatus: webhooks.StatusPending,
+		},
+		{name: "redirect is a failure", code: http.StatusFound, failures: 1, wantStatus: webhooks.StatusPending},
+		{
+			name: "last attempt fails the delivery", code: http.StatusBadGateway, attempts: cfg.MaxAttempts - 1,
+			failures: 8, wantStatus: webhooks.StatusFailed,
+		},
+		{
+			name: "repeated failures disable the endpoint", code: http.StatusServiceUnavailable, attempts: 1,
+			failures: cfg.DisableAfter, wantStatus: webhooks.StatusPending, wantDisable: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &webhooks.Job{
+				ID: uuid.New(), EndpointID: uuid.New(), EventType: webhooks.GuestCreated,
+				Payload: []byte(`{"type":"guest.created"}`), Attempts: tt.attempts, Secret: "whsec_test",
+			}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != string(job.Payload) {
+					t.Errorf("body = %s, want %s", body, job.Payload)
+				}
+				if !verify(job.Secret, r.Header.Get(webhooks.HeaderSignature), body) {
+					t.Errorf("signature %q doesn't verify", r.Header.Get(webhooks.HeaderSignature))
+				}
+				if r.Header.Get(webhooks.HeaderID) != job.ID.String() ||
+					r.Header.Get(webhooks.HeaderEvent) != job.EventType {
+					t.Errorf("headers = %v, want delivery id and event type", r.Header)
+				}
+				if tt.code == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.code)
+			}))
+			defer srv.Close()
+			job.URL = srv.URL
+
+			ctrl := gomock.NewController(t)
+			store := mockwebhooks.NewMockStore(ctrl)
+			store.EXPECT().Claim(gomock.Any(), cfg.BatchSize, 2*cfg.Timeout).Return([]*webhooks.Job{job}, nil)
+			store.EXPECT().Record(gomock.Any(), job.ID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, a *webhooks.Attempt) error {
+					if a.Status != tt.wantStatus {
+						t.Errorf("status = %q, want %q", a.Status, tt.wantStatus)
+					}
+					if a.ResponseCode == nil || *a.ResponseCode != tt.code {
+						t.Errorf("response code = %v, want %d", a.ResponseCode, tt.code)
+					}
+					wantNext := tt.wantStatus == webhooks.StatusPending
+					if (a.Next != nil) != wantNext {
+						t.Fatalf("next attempt = %v, want one %v", a.Next, wantNext)
+					}
+					if wantNext && a.Next.Sub(a.At) != webhooks.Backoff(cfg, tt.attempts+1) {
+						t.Errorf("next attempt after %v, want %v", a.Next.Sub(a.At), webhooks.Backoff(cfg, tt.attempts+1))
+					}
+					return nil
+				})
+			ok := tt.wantStatus == webhooks.StatusSucceeded
+			store.EXPECT().CountOutcome(gomock.Any(), job.EndpointID, ok).Return(tt.failures, nil)
+			disables := 0
+			if tt.wantDisable {
+				disables = 1
+			}
+			store.EXPECT().Disable(gomock.Any(), job.EndpointID).Return(nil).Times(disables)
+
+			d := webhooks.NewDispatcher(logger.NewNoOp(), inlineTx(ctrl), store, srv.Client(), cfg)
+			n, err := d.RunOnce(context.Background())
+			if err != nil || n != 1 {
+				t.Fatalf("RunOnce() = %d, %v, want 1 delivery", n, err)
+			}
+		})
+	}
+}
+
+// TestDispatcher_RefusesInternalTargets sends a delivery with the default
+// client to a receiver on loopback, standing in for any internal service, and
+// expects the connection refused before anything is sent or stored.
+func TestDispatcher_RefusesInternalTargets(t *testing.T) {
+	cfg := webhooks.DefaultConfig().Delivery
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("internal receiver was reached")
+		_, _ = w.Write([]byte("secret"))
+	}))
+	defer srv.Close()
+	job := &webhooks.Job{
+		ID: uuid.New(), EndpointID: uuid.New(), URL: srv.URL, EventType: webhooks.GuestCreated,
+		Payload: []byte(`{}`), Secret: "whsec_test",
+	}
+
+	ctrl := gomock.NewController(t)
+	store := mockwebhooks.NewMockStore(ctrl)
+	store.EXPECT().Claim(gomock.Any(), cfg.BatchSize, 2*cfg.Timeout).Return([]*webhooks.Job{job}, nil)
+	store.EXPECT().Record(gomock.Any(), job.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, a *webhooks.Attempt) error {
+			if a.ResponseCode != nil || a.ResponseBody != nil {
+				t.Errorf("response = %v, %v, want none stored", a.ResponseCode, a.ResponseBody)
+			}
+			if a.Error == nil || !strings.Contains(*a.Error, "not public") {
+				t.Errorf("error = %v, want the target refused", a.Error)
+			}
+			if a.Status != webhooks.StatusPending {
+				t.Errorf("status = %q, want %q", a.Status, webhooks.StatusPending)
+			}
+			return nil
+		})
+	store.EXPECT().CountOutcome(gomock.Any(), job.EndpointID, false).Return(1, nil)
+
+	d := webhooks.NewDispatcher(logger.NewNoOp(), inlineTx(ctrl), store, nil, cfg)
+	if n, err := d.RunOnce(context.Background()); err != nil || n != 1 {
+		t.Fatalf("RunOnce() = %d, %v, want 1 delivery", n, err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := webhooks.DeliveryConfig{BackoffBase: 30 * time.Second, BackoffMax: 10 * time.Minute}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 5, want: 8 * time.Minute},
+		{attempts: 6, want: 10 * time.Minute},
+		{attempts: 60, want: 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := webhooks.Backoff(cfg, tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	// dto.PageResponse is named by the swag annotations of list routes.
+	_ "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/httpkit/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	"github.com/biairmal/guest-management-be/internal/core/validation"
+)
+
+// deliveryListConfig declares the allow-listed sort/filter fields for the
+// delivery log.
+var deliveryListConfig = query.ListParseConfig{
+	AllowedSortFields:   []string{"created_at"},
+	AllowedFilterFields: []string{"status", "event_type"},
+}
+
+// Handler exposes HTTP handlers for webhook endpoints and their deliveries.
+type Handler struct {
+	service   Service
+	validator validation.Validator
+}
+
+// NewHandler returns a Handler that uses the given service and validator.
+func NewHandler(service Service, validator validation.Validator) *Handler {
+	return &Handler{service: service, validator: validator}
+}
+
+// List handles GET /tenants/{tenantId}/webhooks.
+//
+// List godoc
+//
+//	@Summary		List webhook endpoints
+//	@Description	The tenant's webhook endpoints, oldest first. Secrets are never returned here.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Success		200			{array}		webhooks.Endpoint
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks [get]
+func (h *Handler) List(r *http.Request) (any, error) {
+	tenantID, err := parseID(r, "tenantId", "tenant")
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := h.service.List(r.Context(), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(endpoints), nil
+}
+
+// Create handles POST /tenants/{tenantId}/webhooks.
+//
+// Create godoc
+//
+//	@Summary		Register webhook endpoint
+//	@Description	Registers a URL to receive the listed event types (guest.created, guest.rsvp_changed, ticket.issued, scan.accepted) and issues its signing secret, shown only in this response. Every delivery is a POST signed in the X-Webhook-Signature header.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			tenantId	path		string					true	"Tenant UUID"
+//	@Param			body		body		webhooks.EndpointInput	true	"Endpoint"
+//	@Success		201			{object}	webhooks.Secreted
+//	@Failure		400			{object}	problem.Problem	"Invalid tenant id or body, unknown event type, or URL not allowed"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Tenant not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks [post]
+func (h *Handler) Create(r *http.Request) (any, error) {
+	tenantID, err := parseID(r, "tenantId", "tenant")
+	if err != nil {
+		return nil, err
+	}
+	body, err := h.decode(r)
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.service.Create(r.Context(), tenantID, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(e), nil
+}
+
+// Get handles GET /tenants/{tenantId}/webhooks/{endpointId}.
+//
+// Get godoc
+//
+//	@Summary		Get webhook endpoint
+//	@Description	One endpoint with its consecutive failures and, when the dispatcher disabled it, when.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Param			endpointId	path		string	true	"Endpoint UUID"
+//	@Success		200			{object}	webhooks.Endpoint
+//	@Failure		400			{object}	problem.Problem	"Invalid id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Endpoint not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks/{endpointId} [get]
+func (h *Handler) Get(r *http.Request) (any, error) {
+	tenantID, id, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.service.Get(r.Context(), tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(e), nil
+}
+
+// Update handles PUT /tenants/{tenantId}/webhooks/{endpointId}.
+//
+// Update godoc
+//
+//	@Summary		Replace webhook endpoint
+//	@Description	Replaces the endpoint's URL, description, event types and active flag. Re-activating an endpoint disabled after repeated failures clears its failure count; deliveries failed while it was disabled can be redelivered.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			tenantId	path		string					true	"Tenant UUID"
+//	@Param			endpointId	path		string					true	"Endpoint UUID"
+//	@Param			body		body		webhooks.EndpointInput	true	"Endpoint"
+//	@Success		200			{object}	webhooks.Endpoint
+//	@Failure		400			{object}	problem.Problem	"Invalid id or body, unknown event type, or URL not allowed"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Endpoint not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks/{endpointId} [put]
+func (h *Handler) Update(r *http.Request) (any, error) {
+	tenantID, id, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	body, err := h.decode(r)
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.service.Update(r.Context(), tenantID, id, body)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(e), nil
+}
+
+// Delete handles DELETE /tenants/{tenantId}/webhooks/{endpointId}.
+//
+// Delete godoc
+//
+//	@Summary		Remove webhook endpoint
+//	@Description	Removes the endpoint; its pending deliveries fail.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Param			tenantId	path	string	true	"Tenant UUID"
+//	@Param			endpointId	path	string	true	"Endpoint UUID"
+//	@Success		204
+//	@Failure		400	{object}	problem.Problem	"Invalid id"
+//	@Failure		401	{object}	problem.Problem	"Not authenticated"
+//	@Failure		403	{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404	{object}	problem.Problem	"Endpoint not found"
+//	@Failure		500	{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks/{endpointId} [delete]
+func (h *Handler) Delete(r *http.Request) (any, error) {
+	tenantID, id, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Delete(r.Context(), tenantID, id); err != nil {
+		return nil, err
+	}
+	return response.NoContent(), nil
+}
+
+// RotateSecret handles POST /tenants/{tenantId}/webhooks/{endpointId}/secret.
+//
+// RotateSecret godoc
+//
+//	@Summary		Rotate webhook secret
+//	@Description	Issues the endpoint a new signing secret, shown only in this response and used from the next attempt on.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Param			endpointId	path		string	true	"Endpoint UUID"
+//	@Success		200			{object}	webhooks.Secreted
+//	@Failure		400			{object}	problem.Problem	"Invalid id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Endpoint not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks/{endpointId}/secret [post]
+func (h *Handler) RotateSecret(r *http.Request) (any, error) {
+	tenantID, id, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.service.RotateSecret(r.Context(), tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(e), nil
+}
+
+// Deliveries handles GET /tenants/{tenantId}/webhooks/{endpointId}/deliveries.
+//
+// Deliveries godoc
+//
+//	@Summary		Webhook delivery log
+//	@Description	The endpoint's deliveries (paginated, filtered, sorted; default latest first) with their payload, attempts and the status code, body excerpt or error of the latest attempt.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Param			endpointId	path		string	true	"Endpoint UUID"
+//	@Param			page		query		int		false	"Page number (1-based)"
+//	@Param			size		query		int		false	"Page size (max 100)"
+//	@Param			sort		query		string	false	"Sort spec field,DIRECTION (repeatable): created_at"
+//	@Param			status		query		string	false	"Filter by status: pending, succeeded, failed"
+//	@Param			event_type	query		string	false	"Filter by event type"
+//	@Success		200			{object}	dto.PageResponse[webhooks.Delivery]
+//	@Failure		400			{object}	problem.Problem	"Invalid id or query"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Endpoint not found"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks/{endpointId}/deliveries [get]
+func (h *Handler) Deliveries(r *http.Request) (any, error) {
+	tenantID, id, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	params, err := query.ParseListParams(r.URL.Query(), deliveryListConfig)
+	if err != nil {
+		return nil, errorz.BadRequest().WithMessage(err.Error())
+	}
+	page, err := h.service.Deliveries(r.Context(), tenantID, id, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.OK(page), nil
+}
+
+// Redeliver handles POST
+// /tenants/{tenantId}/webhooks/{endpointId}/deliveries/{deliveryId}/redeliver.
+//
+// Redeliver godoc
+//
+//	@Summary		Redeliver webhook
+//	@Description	Queues the delivery's payload again as a new delivery pointing at it (redelivery_of). The payload keeps its envelope id, so receivers can drop it if they already processed it.
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			tenantId	path		string	true	"Tenant UUID"
+//	@Param			endpointId	path		string	true	"Endpoint UUID"
+//	@Param			deliveryId	path		string	true	"Delivery UUID"
+//	@Success		201			{object}	webhooks.Delivery
+//	@Failure		400			{object}	problem.Problem	"Invalid id"
+//	@Failure		401			{object}	problem.Problem	"Not authenticated"
+//	@Failure		403			{object}	problem.Problem	"Another tenant, or missing manage_webhooks"
+//	@Failure		404			{object}	problem.Problem	"Endpoint or delivery not found"
+//	@Failure		409			{object}	problem.Problem	"Endpoint inactive"
+//	@Failure		500			{object}	problem.Problem	"Internal server error"
+//	@Router			/api/v1/tenants/{tenantId}/webhooks/{endpointId}/deliveries/{deliveryId}/redeliver [post]
+func (h *Handler) Redeliver(r *http.Request) (any, error) {
+	tenantID, id, err := parseIDs(r)
+	if err != nil {
+		return nil, err
+	}
+	deliveryID, err := parseID(r, "deliveryId", "delivery")
+	if err != nil {
+		return nil, err
+	}
+	d, err := h.service.Redeliver(r.Context(), tenantID, id, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	return response.Created(d), nil
+}
+
+// decode reads and validates an endpoint body.
+func (h *Handler) decode(r *http.Request) (EndpointInput, error) {
+	var body EndpointInput
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, errcode.InvalidRequestBody.New()
+	}
+	if err := h.validator.Struct(body); err != nil {
+		return body, err
+	}
+	return body, nil
+}
+
+// parseIDs parses the tenantId and endpointId path parameters.
+func parseIDs(r *http.Request) (tenantID, id uuid.UUID, err error) {
+	if tenantID, err = parseID(r, "tenantId", "tenant"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if id, err = parseID(r, "endpointId", "endpoint"); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return tenantID, id, nil
+}
+
+// parseID parses the UUID path parameter param, naming subject in the error.
+func parseID(r *http.Request, param, subject string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		return uuid.Nil, errcode.InvalidID.WithMessage("invalid " + subject + " id")
+	}
+	return id, nil
+}
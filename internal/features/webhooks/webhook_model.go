@@ -0,0 +1,120 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event types an endpoint can subscribe to.
+const (
+	GuestCreated     = "guest.created"
+	GuestRSVPChanged = "guest.rsvp_changed"
+	TicketIssued     = "ticket.issued"
+	ScanAccepted     = "scan.accepted"
+)
+
+// EventTypes lists every event type, in the order they are documented.
+var EventTypes = []string{GuestCreated, GuestRSVPChanged, TicketIssued, ScanAccepted}
+
+// Delivery statuses.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Request headers every delivery carries.
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// secretPrefix starts every endpoint signing secret.
+const secretPrefix = "whsec_"
+
+// Endpoint represents a row in the webhook_endpoints table: a tenant's URL
+// that receives the events it subscribes to. DisabledAt is set when the
+// dispatcher disabled it after repeated failures.
+//
+// swagger:model WebhookEndpoint
+type Endpoint struct {
+	ID                  uuid.UUID  `json:"id"`
+	TenantID            uuid.UUID  `json:"tenant_id"`
+	URL                 string     `json:"url"`
+	Description         *string    `json:"description,omitempty"`
+	EventTypes          []string   `json:"event_types"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// Secreted is an endpoint with its signing secret, returned only when the
+// secret is issued.
+//
+// swagger:model WebhookEndpointSecret
+type Secreted struct {
+	Endpoint
+	Secret string `json:"secret"`
+}
+
+// Delivery represents a row in the webhook_deliveries table: one event sent,
+// or to be sent, to one endpoint, with the outcome of its latest attempt.
+//
+// swagger:model WebhookDelivery
+type Delivery struct {
+	ID            uuid.UUID       `json:"id"`
+	EndpointID    uuid.UUID       `json:"endpoint_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload" swaggertype:"object"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt *time.Time      `json:"next_attempt_at,omitempty"`
+	LastAttemptAt *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseCode  *int            `json:"response_code,omitempty"`
+	ResponseBody  *string         `json:"response_body,omitempty"`
+	Error         *string         `json:"error,omitempty"`
+	DurationMs    *int            `json:"duration_ms,omitempty"`
+	RedeliveryOf  *uuid.UUID      `json:"redelivery_of,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// Envelope is the JSON body of every delivery. ID identifies the occurrence:
+// it is the same for every endpoint and every redelivery, so receivers can
+// drop duplicates.
+//
+// swagger:model WebhookEnvelope
+type Envelope struct {
+	ID        uuid.UUID `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	EventID   uuid.UUID `json:"event_id"`
+	Data      any       `json:"data"`
+}
+
+// Job is a claimed delivery with what the dispatcher needs to send it.
+type Job struct {
+	ID         uuid.UUID
+	EndpointID uuid.UUID
+	EventType  string
+	Payload    []byte
+	Attempts   int // made before this one
+	URL        string
+	Secret     string
+}
+
+// Attempt is the outcome of one delivery attempt. Status is the delivery's
+// status after it; Next is when to retry a delivery still pending.
+type Attempt struct {
+	Status       string
+	At           time.Time
+	Next         *time.Time
+	ResponseCode *int
+	ResponseBody *string
+	Error        *string
+	Duration     time.Duration
+}
@@ -0,0 +1,45 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/webhooks/mock_publisher.go -package=mockwebhooks github.com/biairmal/guest-management-be/internal/features/webhooks Publisher
+
+// Publisher queues events for the webhook endpoints subscribed to them. It is
+// what other features call when something worth telling integrations
+// happens.
+type Publisher interface {
+	// Publish queues an event of eventType about the event eventID, with data
+	// as its payload, for every active endpoint of the event's tenant
+	// subscribed to eventType. Call it inside the transaction that made the
+	// change: the queued deliveries commit or roll back with it, and the
+	// dispatcher sends them after commit.
+	Publish(ctx context.Context, eventID uuid.UUID, eventType string, data any) error
+}
+
+// publisher implements Publisher on the delivery queue.
+type publisher struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewPublisher returns a Publisher queueing deliveries in store.
+func NewPublisher(store Store) Publisher {
+	return &publisher{store: store, now: time.Now}
+}
+
+// Publish implements Publisher.
+func (p *publisher) Publish(ctx context.Context, eventID uuid.UUID, eventType string, data any) error {
+	payload, err := json.Marshal(Envelope{
+		ID: uuid.New(), Type: eventType, CreatedAt: p.now().UTC(), EventID: eventID, Data: data,
+	})
+	if err != nil {
+		return err
+	}
+	return p.store.Enqueue(ctx, eventID, eventType, payload)
+}
@@ -0,0 +1,367 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/biairmal/go-sdk/lib/sqlkit"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/biairmal/guest-management-be/internal/core/query"
+	corerepository "github.com/biairmal/guest-management-be/internal/core/repository"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/webhooks/mock_store.go -package=mockwebhooks github.com/biairmal/guest-management-be/internal/features/webhooks Store
+
+// endpointSelect reads the webhook_endpoints columns scanEndpoint scans.
+const endpointSelect = `SELECT id, tenant_id, url, description, event_types, active, consecutive_failures,
+	disabled_at, created_at, updated_at FROM webhook_endpoints`
+
+// deliveryColumns are the webhook_deliveries columns scanDelivery scans.
+const deliveryColumns = `id, endpoint_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at,
+	response_code, response_body, error, duration_ms, redelivery_of, created_at`
+
+// deliveryFields maps the delivery log's allow-listed filter/sort fields to
+// SQL.
+var deliveryFields = map[string]string{
+	"status":     "status",
+	"event_type": "event_type",
+	"created_at": "created_at",
+}
+
+// Store holds the webhook endpoint and delivery queries.
+type Store interface {
+	// TenantExists returns repository.ErrNotFound unless the tenant is live.
+	TenantExists(ctx context.Context, tenantID uuid.UUID) error
+	// Endpoints returns the tenant's live endpoints, oldest first.
+	Endpoints(ctx context.Context, tenantID uuid.UUID) ([]*Endpoint, error)
+	// Endpoint returns a live endpoint of the tenant, or
+	// repository.ErrNotFound.
+	Endpoint(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error)
+	// InsertEndpoint inserts an endpoint with its secret, setting its id and
+	// timestamps.
+	InsertEndpoint(ctx context.Context, e *Endpoint, secret string) error
+	// UpdateEndpoint saves an endpoint's URL, description, event types and
+	// active flag; activating it clears its failures and DisabledAt. It
+	// returns repository.ErrNotFound unless the endpoint is a live endpoint of
+	// its tenant.
+	UpdateEndpoint(ctx context.Context, e *Endpoint) error
+	// SetSecret replaces an endpoint's secret, or returns
+	// repository.ErrNotFound.
+	SetSecret(ctx context.Context, tenantID, id uuid.UUID, secret string) error
+	// DeleteEndpoint soft-deletes an endpoint and fails its pending
+	// deliveries, or returns repository.ErrNotFound.
+	DeleteEndpoint(ctx context.Context, tenantID, id uuid.UUID) error
+	// Deliveries returns one page of the endpoint's delivery log, latest first
+	// by default, and its total.
+	Deliveries(ctx context.Context, endpointID uuid.UUID, params *query.ListParams) ([]*Delivery, int64, error)
+	// Delivery returns a delivery of the endpoint, or repository.ErrNotFound.
+	Delivery(ctx context.Context, endpointID, id uuid.UUID) (*Delivery, error)
+	// InsertDelivery queues a delivery, setting its id, status and
+	// timestamps.
+	InsertDelivery(ctx context.Context, d *Delivery) error
+	// Enqueue queues payload for every active endpoint of the event's tenant
+	// subscribed to eventType. It joins the transaction carried by ctx.
+	Enqueue(ctx context.Context, eventID uuid.UUID, eventType string, payload []byte) error
+	// Claim leases up to limit due deliveries of active endpoints, pushing
+	// their next attempt lease ahead so no other dispatcher takes them.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Job, error)
+	// Record saves the outcome of an attempt at the delivery.
+	Record(ctx context.Context, deliveryID uuid.UUID, a *Attempt) error
+	// CountOutcome resets the endpoint's consecutive failures after a success
+	// or adds one after a failure, and returns the new count.
+	CountOutcome(ctx context.Context, endpointID uuid.UUID, ok bool) (int, error)
+	// Disable deactivates the endpoint, setting DisabledAt, and fails its
+	// pending deliveries.
+	Disable(ctx context.Context, endpointID uuid.UUID) error
+}
+
+// store implements Store on PostgreSQL.
+type store struct {
+	db *sqlkit.DB
+}
+
+// NewStore returns a Store backed by db.
+func NewStore(db *sqlkit.DB) Store {
+	return &store{db: db}
+}
+
+// TenantExists implements Store.
+func (s *store) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	var one int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT 1 FROM tenants WHERE id = $1 AND deleted_at IS NULL", tenantID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// Endpoints implements Store.
+func (s *store) Endpoints(ctx context.Context, tenantID uuid.UUID) ([]*Endpoint, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx,
+		endpointSelect+" WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY created_at, id", tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	endpoints := []*Endpoint{}
+	for rows.Next() {
+		e, err := scanEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, rows.Err()
+}
+
+// Endpoint implements Store.
+func (s *store) Endpoint(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error) {
+	e, err := scanEndpoint(corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		endpointSelect+" WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL", id, tenantID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return e, err
+}
+
+// InsertEndpoint implements Store.
+func (s *store) InsertEndpoint(ctx context.Context, e *Endpoint, secret string) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		INSERT INTO webhook_endpoints (tenant_id, url, description, event_types, secret, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at`,
+		e.TenantID, e.URL, e.Description, pq.StringArray(e.EventTypes), secret, e.Active,
+	).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
+}
+
+// UpdateEndpoint implements Store.
+func (s *store) UpdateEndpoint(ctx context.Context, e *Endpoint) error {
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		UPDATE webhook_endpoints SET url = $3, description = $4, event_types = $5,
+			consecutive_failures = CASE WHEN $6 AND NOT active THEN 0 ELSE consecutive_failures END,
+			disabled_at = CASE WHEN $6 THEN NULL ELSE disabled_at END,
+			active = $6, updated_at = now()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		RETURNING consecutive_failures, disabled_at, updated_at`,
+		e.ID, e.TenantID, e.URL, e.Description, pq.StringArray(e.EventTypes), e.Active,
+	).Scan(&e.ConsecutiveFailures, &e.DisabledAt, &e.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// SetSecret implements Store.
+func (s *store) SetSecret(ctx context.Context, tenantID, id uuid.UUID, secret string) error {
+	return s.exec(ctx, `UPDATE webhook_endpoints SET secret = $3, updated_at = now()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`, id, tenantID, secret)
+}
+
+// DeleteEndpoint implements Store.
+func (s *store) DeleteEndpoint(ctx context.Context, tenantID, id uuid.UUID) error {
+	if err := s.exec(ctx, `UPDATE webhook_endpoints SET deleted_at = now(), updated_at = now()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`, id, tenantID); err != nil {
+		return err
+	}
+	return s.failPending(ctx, id, "endpoint deleted")
+}
+
+// Deliveries implements Store.
+func (s *store) Deliveries(
+	ctx context.Context, endpointID uuid.UUID, params *query.ListParams,
+) ([]*Delivery, int64, error) {
+	clauses := query.ToSQL(params, deliveryFields, 2)
+	where := " WHERE " + strings.Join(append([]string{"endpoint_id = $1"}, clauses.Where...), " AND ")
+	args := append([]any{endpointID}, clauses.Args...)
+	conn := corerepository.Conn(ctx, s.db)
+
+	var total int64
+	row := conn.QueryRowContext(ctx, "SELECT count(*) FROM webhook_deliveries"+where, args...)
+	if err := row.Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := "created_at DESC, id"
+	if clauses.OrderBy != "" {
+		order = clauses.OrderBy + ", id"
+	}
+	n := len(args)
+	args = append(args, params.Size, (params.Page-1)*params.Size)
+	rows, err := conn.QueryContext(ctx, "SELECT "+deliveryColumns+" FROM webhook_deliveries"+where+
+		fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, n+1, n+2), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var deliveries []*Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, total, rows.Err()
+}
+
+// Delivery implements Store.
+func (s *store) Delivery(ctx context.Context, endpointID, id uuid.UUID) (*Delivery, error) {
+	d, err := scanDelivery(corerepository.Conn(ctx, s.db).QueryRowContext(ctx,
+		"SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE id = $1 AND endpoint_id = $2", id, endpointID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return d, err
+}
+
+// InsertDelivery implements Store.
+func (s *store) InsertDelivery(ctx context.Context, d *Delivery) error {
+	return corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		INSERT INTO webhook_deliveries (endpoint_id, event_type, payload, redelivery_of) VALUES ($1, $2, $3, $4)
+		RETURNING id, status, next_attempt_at, created_at`,
+		d.EndpointID, d.EventType, []byte(d.Payload), d.RedeliveryOf,
+	).Scan(&d.ID, &d.Status, &d.NextAttemptAt, &d.CreatedAt)
+}
+
+// Enqueue implements Store.
+func (s *store) Enqueue(ctx context.Context, eventID uuid.UUID, eventType string, payload []byte) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (endpoint_id, event_type, payload)
+		SELECT w.id, $2, $3 FROM webhook_endpoints w
+		JOIN events e ON e.tenant_id = w.tenant_id
+		WHERE e.id = $1 AND w.active AND w.deleted_at IS NULL AND $2 = ANY(w.event_types)`,
+		eventID, eventType, payload)
+	return err
+}
+
+// Claim implements Store. SKIP LOCKED lets several API instances poll the
+// same queue without sending a delivery twice.
+func (s *store) Claim(ctx context.Context, limit int, lease time.Duration) ([]*Job, error) {
+	rows, err := corerepository.Conn(ctx, s.db).QueryContext(ctx, `
+		WITH due AS (
+			SELECT d.id FROM webhook_deliveries d
+			JOIN webhook_endpoints w ON w.id = d.endpoint_id AND w.active AND w.deleted_at IS NULL
+			WHERE d.status = 'pending' AND d.next_attempt_at <= now()
+			ORDER BY d.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d SET next_attempt_at = now() + make_interval(secs => $2)
+		FROM due, webhook_endpoints w
+		WHERE d.id = due.id AND w.id = d.endpoint_id
+		RETURNING d.id, d.endpoint_id, d.event_type, d.payload, d.attempts, w.url, w.secret`,
+		limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var jobs []*Job
+	for rows.Next() {
+		var j Job
+		if err := rows.Scan(&j.ID, &j.EndpointID, &j.EventType, &j.Payload, &j.Attempts, &j.URL, &j.Secret); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, &j)
+	}
+	return jobs, rows.Err()
+}
+
+// Record implements Store.
+func (s *store) Record(ctx context.Context, deliveryID uuid.UUID, a *Attempt) error {
+	ms := int(a.Duration.Milliseconds())
+	return s.exec(ctx, `
+		UPDATE webhook_deliveries SET status = $2, attempts = attempts + 1, last_attempt_at = $3,
+			next_attempt_at = $4, response_code = $5, response_body = $6, error = $7, duration_ms = $8
+		WHERE id = $1`,
+		deliveryID, a.Status, a.At, a.Next, a.ResponseCode, a.ResponseBody, a.Error, ms)
+}
+
+// CountOutcome implements Store.
+func (s *store) CountOutcome(ctx context.Context, endpointID uuid.UUID, ok bool) (int, error) {
+	var failures int
+	err := corerepository.Conn(ctx, s.db).QueryRowContext(ctx, `
+		UPDATE webhook_endpoints
+		SET consecutive_failures = CASE WHEN $2 THEN 0 ELSE consecutive_failures + 1 END
+		WHERE id = $1 RETURNING consecutive_failures`, endpointID, ok).Scan(&failures)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, repository.ErrNotFound
+	}
+	return failures, err
+}
+
+// Disable implements Store.
+func (s *store) Disable(ctx context.Context, endpointID uuid.UUID) error {
+	if _, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE webhook_endpoints
+		SET active = false, disabled_at = now(), updated_at = now() WHERE id = $1 AND active`, endpointID); err != nil {
+		return err
+	}
+	return s.failPending(ctx, endpointID, "endpoint disabled after repeated failures")
+}
+
+// failPending fails the endpoint's pending deliveries with reason.
+func (s *store) failPending(ctx context.Context, endpointID uuid.UUID, reason string) error {
+	_, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, `UPDATE webhook_deliveries
+		SET status = 'failed', next_attempt_at = NULL, error = $2
+		WHERE endpoint_id = $1 AND status = 'pending'`, endpointID, reason)
+	return err
+}
+
+// exec runs a single-row write, mapping no row changed to
+// repository.ErrNotFound.
+func (s *store) exec(ctx context.Context, q string, args ...any) error {
+	res, err := corerepository.Conn(ctx, s.db).ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// scanEndpoint scans one endpointSelect row.
+func scanEndpoint(row interface{ Scan(dest ...any) error }) (*Endpoint, error) {
+	var (
+		e     Endpoint
+		types pq.StringArray
+	)
+	if err := row.Scan(
+		&e.ID, &e.TenantID, &e.URL, &e.Description, &types, &e.Active, &e.ConsecutiveFailures, &e.DisabledAt,
+		&e.CreatedAt, &e.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	e.EventTypes = append([]string{}, types...)
+	return &e, nil
+}
+
+// scanDelivery scans one row of deliveryColumns.
+func scanDelivery(row interface{ Scan(dest ...any) error }) (*Delivery, error) {
+	var (
+		d       Delivery
+		payload []byte
+	)
+	if err := row.Scan(
+		&d.ID, &d.EndpointID, &d.EventType, &payload, &d.Status, &d.Attempts, &d.NextAttemptAt, &d.LastAttemptAt,
+		&d.ResponseCode, &d.ResponseBody, &d.Error, &d.DurationMs, &d.RedeliveryOf, &d.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	d.Payload = payload
+	return &d, nil
+}
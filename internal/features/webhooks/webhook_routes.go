@@ -0,0 +1,25 @@
+package webhooks
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/core/problem"
+)
+
+// InitWebhookRoutes registers the tenant webhook endpoint and delivery log
+// routes on the given router. They are open only to callers of the tenant
+// holding auth.ManageWebhooks: endpoints receive guest data in their payloads.
+func InitWebhookRoutes(r *chi.Mux, webhookH *Handler) {
+	r.Route("/api/v1/tenants/{tenantId}/webhooks", func(r chi.Router) {
+		r.Use(auth.Require(auth.ManageWebhooks), auth.TenantParam("tenantId"))
+		r.Get("/", problem.Handle(webhookH.List))
+		r.Post("/", problem.Handle(webhookH.Create))
+		r.Get("/{endpointId}", problem.Handle(webhookH.Get))
+		r.Put("/{endpointId}", problem.Handle(webhookH.Update))
+		r.Delete("/{endpointId}", problem.Handle(webhookH.Delete))
+		r.Post("/{endpointId}/secret", problem.Handle(webhookH.RotateSecret))
+		r.Get("/{endpointId}/deliveries", problem.Handle(webhookH.Deliveries))
+		r.Post("/{endpointId}/deliveries/{deliveryId}/redeliver", problem.Handle(webhookH.Redeliver))
+	})
+}
@@ -0,0 +1,81 @@
+package webhooks_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/ctxkit"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/core/auth"
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mockvalidation "github.com/biairmal/guest-management-be/mocks/validation"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+func TestInitWebhookRoutes_Guards(t *testing.T) {
+	tenantID := uuid.New()
+	base := "/api/v1/tenants/" + tenantID.String() + "/webhooks"
+	endpoint := base + "/" + uuid.NewString()
+	routes := []struct{ method, path string }{
+		{http.MethodGet, base},
+		{http.MethodPost, base},
+		{http.MethodGet, endpoint},
+		{http.MethodPut, endpoint},
+		{http.MethodDelete, endpoint},
+		{http.MethodPost, endpoint + "/secret"},
+		{http.MethodGet, endpoint + "/deliveries"},
+		{http.MethodPost, endpoint + "/deliveries/" + uuid.NewString() + "/redeliver"},
+	}
+	callers := []struct {
+		name       string
+		tenant     string
+		perms      []string
+		wantStatus int
+	}{
+		{name: "anonymous", wantStatus: http.StatusUnauthorized},
+		{name: "missing manage_webhooks", tenant: tenantID.String(), perms: []string{auth.CheckIn}, wantStatus: http.StatusForbidden},
+		{name: "other tenant", tenant: uuid.NewString(), perms: []string{auth.ManageWebhooks}, wantStatus: http.StatusForbidden},
+	}
+
+	for _, c := range callers {
+		for _, rt := range routes {
+			t.Run(c.name+" "+rt.method+" "+rt.path, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				// The service must never be reached.
+				h := webhooks.NewHandler(mockwebhooks.NewMockService(ctrl), mockvalidation.NewMockValidator(ctrl))
+				r := chi.NewRouter()
+				webhooks.InitWebhookRoutes(r, h)
+
+				ctx := context.Background()
+				if c.tenant != "" {
+					ctx = ctxkit.WithPermissions(ctxkit.WithTenantID(ctx, c.tenant), c.perms)
+				}
+				rec := httptest.NewRecorder()
+				r.ServeHTTP(rec, httptest.NewRequest(rt.method, rt.path, nil).WithContext(ctx))
+				if rec.Code != c.wantStatus {
+					t.Errorf("status = %d, want %d", rec.Code, c.wantStatus)
+				}
+			})
+		}
+	}
+
+	t.Run("tenant member holding manage_webhooks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := mockwebhooks.NewMockService(ctrl)
+		svc.EXPECT().List(gomock.Any(), tenantID).Return([]*webhooks.Endpoint{}, nil)
+		r := chi.NewRouter()
+		webhooks.InitWebhookRoutes(r, webhooks.NewHandler(svc, mockvalidation.NewMockValidator(ctrl)))
+
+		ctx := ctxkit.WithPermissions(ctxkit.WithTenantID(context.Background(), tenantID.String()), []string{auth.ManageWebhooks})
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, base, nil).WithContext(ctx))
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+	})
+}
@@ -0,0 +1,267 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"slices"
+
+	common "github.com/biairmal/go-sdk/lib/common/dto"
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+
+	"github.com/biairmal/guest-management-be/internal/core/errcode"
+	"github.com/biairmal/guest-management-be/internal/core/query"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination=../../../mocks/webhooks/mock_service.go -package=mockwebhooks github.com/biairmal/guest-management-be/internal/features/webhooks Service
+
+// Service manages a tenant's webhook endpoints and their delivery logs.
+type Service interface {
+	// List returns the tenant's endpoints.
+	List(ctx context.Context, tenantID uuid.UUID) ([]*Endpoint, error)
+	// Create registers an endpoint and issues its signing secret.
+	Create(ctx context.Context, tenantID uuid.UUID, in EndpointInput) (*Secreted, error)
+	// Get returns one endpoint.
+	Get(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error)
+	// Update replaces an endpoint's URL, description, event types and active
+	// flag; re-activating a disabled endpoint clears its failures.
+	Update(ctx context.Context, tenantID, id uuid.UUID, in EndpointInput) (*Endpoint, error)
+	// Delete removes an endpoint, failing its pending deliveries.
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	// RotateSecret issues the endpoint a new signing secret, used from the
+	// next attempt on.
+	RotateSecret(ctx context.Context, tenantID, id uuid.UUID) (*Secreted, error)
+	// Deliveries returns a page of the endpoint's delivery log.
+	Deliveries(
+		ctx context.Context, tenantID, id uuid.UUID, params *query.ListParams,
+	) (*common.PageResponse[Delivery], error)
+	// Redeliver queues a delivery's payload again as a new delivery.
+	Redeliver(ctx context.Context, tenantID, id, deliveryID uuid.UUID) (*Delivery, error)
+}
+
+// EndpointInput is the body of an endpoint create or replace. Active
+// defaults to true. EventTypes must be among EventTypes.
+//
+// swagger:model WebhookEndpointInput
+type EndpointInput struct {
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255"`
+	EventTypes  []string `json:"event_types" validate:"required,min=1,dive,required"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// serviceImpl is the concrete implementation of Service.
+type serviceImpl struct {
+	logger logger.Logger
+	store  Store
+	cfg    ServiceConfig
+}
+
+// NewService returns a Service with the given dependencies.
+func NewService(logger logger.Logger, store Store, cfg ServiceConfig) Service {
+	return &serviceImpl{logger: logger, store: store, cfg: cfg}
+}
+
+// List implements Service.
+func (s *serviceImpl) List(ctx context.Context, tenantID uuid.UUID) ([]*Endpoint, error) {
+	if err := s.checkTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	endpoints, err := s.store.Endpoints(ctx, tenantID)
+	if err != nil {
+		return nil, s.translate(ctx, "webhook endpoints read failed", tenantID, err)
+	}
+	return endpoints, nil
+}
+
+// Create implements Service.
+func (s *serviceImpl) Create(ctx context.Context, tenantID uuid.UUID, in EndpointInput) (*Secreted, error) {
+	if err := s.check(in); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return nil, s.translate(ctx, "webhook secret generation failed", tenantID, err)
+	}
+	e := Endpoint{TenantID: tenantID}
+	in.apply(&e)
+	if err := s.store.InsertEndpoint(ctx, &e, secret); err != nil {
+		return nil, s.translate(ctx, "webhook endpoint insert failed", tenantID, err)
+	}
+	s.logger.InfoWithContext(ctx, "webhook endpoint registered",
+		logger.F("tenant_id", tenantID), logger.F("endpoint_id", e.ID))
+	return &Secreted{Endpoint: e, Secret: secret}, nil
+}
+
+// Get implements Service.
+func (s *serviceImpl) Get(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error) {
+	e, err := s.store.Endpoint(ctx, tenantID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.WebhookEndpointNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "webhook endpoint read failed", tenantID, err)
+	}
+	return e, nil
+}
+
+// Update implements Service.
+func (s *serviceImpl) Update(ctx context.Context, tenantID, id uuid.UUID, in EndpointInput) (*Endpoint, error) {
+	if err := s.check(in); err != nil {
+		return nil, err
+	}
+	e, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	in.apply(e)
+	err = s.store.UpdateEndpoint(ctx, e)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.WebhookEndpointNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "webhook endpoint update failed", tenantID, err)
+	}
+	return e, nil
+}
+
+// Delete implements Service.
+func (s *serviceImpl) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	err := s.store.DeleteEndpoint(ctx, tenantID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.WebhookEndpointNotFound.New()
+	}
+	if err != nil {
+		return s.translate(ctx, "webhook endpoint delete failed", tenantID, err)
+	}
+	s.logger.InfoWithContext(ctx, "webhook endpoint removed", logger.F("tenant_id", tenantID), logger.F("endpoint_id", id))
+	return nil
+}
+
+// RotateSecret implements Service.
+func (s *serviceImpl) RotateSecret(ctx context.Context, tenantID, id uuid.UUID) (*Secreted, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return nil, s.translate(ctx, "webhook secret generation failed", tenantID, err)
+	}
+	err = s.store.SetSecret(ctx, tenantID, id, secret)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.WebhookEndpointNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "webhook secret update failed", tenantID, err)
+	}
+	e, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoWithContext(ctx, "webhook secret rotated", logger.F("tenant_id", tenantID), logger.F("endpoint_id", id))
+	return &Secreted{Endpoint: *e, Secret: secret}, nil
+}
+
+// Deliveries implements Service.
+func (s *serviceImpl) Deliveries(
+	ctx context.Context, tenantID, id uuid.UUID, params *query.ListParams,
+) (*common.PageResponse[Delivery], error) {
+	if _, err := s.Get(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+	items, total, err := s.store.Deliveries(ctx, id, params)
+	if err != nil {
+		return nil, s.translate(ctx, "webhook deliveries read failed", tenantID, err)
+	}
+	return common.NewPageResponse(items, total, params.Page, params.Size), nil
+}
+
+// Redeliver implements Service. The payload, and so its envelope id, is the
+// original's, so receivers that already processed it can tell. A disabled
+// endpoint must be re-activated first.
+func (s *serviceImpl) Redeliver(ctx context.Context, tenantID, id, deliveryID uuid.UUID) (*Delivery, error) {
+	e, err := s.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !e.Active {
+		return nil, errcode.WebhookEndpointInactive.New()
+	}
+	orig, err := s.store.Delivery(ctx, id, deliveryID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errcode.WebhookDeliveryNotFound.New()
+	}
+	if err != nil {
+		return nil, s.translate(ctx, "webhook delivery read failed", tenantID, err)
+	}
+	of := orig.ID
+	d := &Delivery{EndpointID: id, EventType: orig.EventType, Payload: orig.Payload, RedeliveryOf: &of}
+	if err := s.store.InsertDelivery(ctx, d); err != nil {
+		return nil, s.translate(ctx, "webhook redelivery insert failed", tenantID, err)
+	}
+	s.logger.InfoWithContext(ctx, "webhook redelivery queued",
+		logger.F("endpoint_id", id), logger.F("delivery_id", d.ID), logger.F("redelivery_of", of))
+	return d, nil
+}
+
+// checkTenant returns 404 unless the tenant is live.
+func (s *serviceImpl) checkTenant(ctx context.Context, tenantID uuid.UUID) error {
+	if err := s.store.TenantExists(ctx, tenantID); err != nil {
+		return s.translate(ctx, "webhook tenant read failed", tenantID, err)
+	}
+	return nil
+}
+
+// check rejects unknown event types and endpoint URLs that aren't absolute
+// http(s) URLs, aren't https when RequireHTTPS, or name localhost or a
+// non-public IP address.
+func (s *serviceImpl) check(in EndpointInput) error {
+	for _, t := range in.EventTypes {
+		if !slices.Contains(EventTypes, t) {
+			return errorz.BadRequest().WithMessage("unknown event type " + t)
+		}
+	}
+	u, err := url.Parse(in.URL)
+	if err != nil || u.Host == "" || !slices.Contains([]string{"http", "https"}, u.Scheme) {
+		return errorz.BadRequest().WithMessage("url must be an absolute http(s) URL")
+	}
+	if s.cfg.RequireHTTPS && u.Scheme != "https" {
+		return errorz.BadRequest().WithMessage("url must use https")
+	}
+	if !publicHost(u.Hostname()) {
+		return errorz.BadRequest().WithMessage("url must name a public host")
+	}
+	return nil
+}
+
+// translate maps a store error to 404 for a missing tenant, or logs it as msg
+// and wraps it as a 500.
+func (s *serviceImpl) translate(ctx context.Context, msg string, tenantID uuid.UUID, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return errcode.TenantNotFound.New()
+	}
+	s.logger.ErrorWithContext(ctx, msg, logger.F("tenant_id", tenantID), logger.F("error", err))
+	return errorz.Wrap(err).WithCode(errorz.CodeInternal).WithMessage("failed to process webhooks")
+}
+
+// apply copies the input onto e.
+func (in EndpointInput) apply(e *Endpoint) {
+	e.URL = in.URL
+	e.Description = in.Description
+	e.EventTypes = slices.Compact(slices.Sorted(slices.Values(in.EventTypes)))
+	e.Active = in.Active == nil || *in.Active
+}
+
+// newSecret returns a fresh signing secret.
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,172 @@
+package webhooks_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/biairmal/go-sdk/lib/errorz"
+	"github.com/biairmal/go-sdk/lib/logger"
+	"github.com/biairmal/go-sdk/lib/repository"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/biairmal/guest-management-be/internal/features/webhooks"
+	mockwebhooks "github.com/biairmal/guest-management-be/mocks/webhooks"
+)
+
+// assertErrorzCode fails unless err carries want, or is nil when want is empty.
+func assertErrorzCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var ez *errorz.Error
+	if !errors.As(err, &ez) || ez.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	tenantID := uuid.New()
+	tests := []struct {
+		name         string
+		in           webhooks.EndpointInput
+		requireHTTPS bool
+		tenantErr    error
+		wantTypes    []string // stored event types, when checked
+		wantCode     string
+	}{
+		{
+			name: "registered",
+			in: webhooks.EndpointInput{
+				URL:        "https://hooks.example.com/in",
+				EventTypes: []string{webhooks.TicketIssued, webhooks.GuestCreated, webhooks.TicketIssued},
+			},
+			requireHTTPS: true,
+			wantTypes:    []string{webhooks.GuestCreated, webhooks.TicketIssued},
+		},
+		{
+			name: "http allowed when https isn't required",
+			in:   webhooks.EndpointInput{URL: "http://hooks.example.com:9000/in", EventTypes: []string{webhooks.GuestCreated}},
+		},
+		{
+			name:     "localhost",
+			in:       webhooks.EndpointInput{URL: "http://localhost:9000/in", EventTypes: []string{webhooks.GuestCreated}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "cloud metadata address",
+			in: webhooks.EndpointInput{
+				URL: "https://169.254.169.254/latest/meta-data", EventTypes: []string{webhooks.GuestCreated},
+			},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:     "private address",
+			in:       webhooks.EndpointInput{URL: "https://10.0.0.5/in", EventTypes: []string{webhooks.GuestCreated}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:     "loopback IPv6 address",
+			in:       webhooks.EndpointInput{URL: "https://[::1]/in", EventTypes: []string{webhooks.GuestCreated}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:     "IPv4-mapped loopback address",
+			in:       webhooks.EndpointInput{URL: "https://[::ffff:127.0.0.1]/in", EventTypes: []string{webhooks.GuestCreated}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name: "public address",
+			in:   webhooks.EndpointInput{URL: "https://93.184.215.14/in", EventTypes: []string{webhooks.GuestCreated}},
+		},
+		{
+			name:         "http rejected when https is required",
+			in:           webhooks.EndpointInput{URL: "http://hooks.example.com", EventTypes: []string{webhooks.GuestCreated}},
+			requireHTTPS: true,
+			wantCode:     errorz.CodeBadRequest,
+		},
+		{
+			name:     "non-http scheme",
+			in:       webhooks.EndpointInput{URL: "ftp://hooks.example.com", EventTypes: []string{webhooks.GuestCreated}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:     "unknown event type",
+			in:       webhooks.EndpointInput{URL: "https://hooks.example.com", EventTypes: []string{"guest.deleted"}},
+			wantCode: errorz.CodeBadRequest,
+		},
+		{
+			name:      "tenant not found",
+			in:        webhooks.EndpointInput{URL: "https://hooks.example.com", EventTypes: []string{webhooks.GuestCreated}},
+			tenantErr: repository.ErrNotFound,
+			wantCode:  errorz.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockwebhooks.NewMockStore(ctrl)
+			store.EXPECT().TenantExists(gomock.Any(), tenantID).Return(tt.tenantErr).MaxTimes(1)
+			var secret string
+			store.EXPECT().InsertEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, e *webhooks.Endpoint, s string) error {
+					if !e.Active || (tt.wantTypes != nil && !slices.Equal(e.EventTypes, tt.wantTypes)) {
+						t.Errorf("endpoint = %+v, want active with event types %v", e, tt.wantTypes)
+					}
+					secret = s
+					return nil
+				}).MaxTimes(1)
+
+			svc := webhooks.NewService(logger.NewNoOp(), store, webhooks.ServiceConfig{RequireHTTPS: tt.requireHTTPS})
+			got, err := svc.Create(context.Background(), tenantID, tt.in)
+			assertErrorzCode(t, err, tt.wantCode)
+			if tt.wantCode == "" && (got.Secret != secret || !strings.HasPrefix(secret, "whsec_")) {
+				t.Errorf("secret = %q, want the stored whsec_ secret %q", got.Secret, secret)
+			}
+		})
+	}
+}
+
+func TestService_Redeliver(t *testing.T) {
+	tenantID, endpointID, deliveryID := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name        string
+		active      bool
+		endpointErr error
+		deliveryErr error
+		wantCode    string
+	}{
+		{name: "queued again", active: true},
+		{name: "inactive endpoint", wantCode: errorz.CodeConflict},
+		{name: "endpoint not found", endpointErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+		{name: "delivery not found", active: true, deliveryErr: repository.ErrNotFound, wantCode: errorz.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockwebhooks.NewMockStore(ctrl)
+			store.EXPECT().Endpoint(gomock.Any(), tenantID, endpointID).Return(
+				&webhooks.Endpoint{ID: endpointID, Active: tt.active}, tt.endpointErr)
+			orig := &webhooks.Delivery{ID: deliveryID, EventType: webhooks.GuestCreated, Payload: []byte(`{}`)}
+			store.EXPECT().Delivery(gomock.Any(), endpointID, deliveryID).Return(orig, tt.deliveryErr).MaxTimes(1)
+			store.EXPECT().InsertDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, d *webhooks.Delivery) error {
+					if d.RedeliveryOf == nil || *d.RedeliveryOf != deliveryID || string(d.Payload) != "{}" {
+						t.Errorf("delivery = %+v, want a copy of %v", d, deliveryID)
+					}
+					return nil
+				}).MaxTimes(1)
+
+			svc := webhooks.NewService(logger.NewNoOp(), store, webhooks.ServiceConfig{})
+			_, err := svc.Redeliver(context.Background(), tenantID, endpointID, deliveryID)
+			assertErrorzCode(t, err, tt.wantCode)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS guest_imports;
+DROP TABLE IF EXISTS guest_import_mappings;
+DROP INDEX IF EXISTS ux_guests_event_email;
@@ -0,0 +1,48 @@
+-- Guests added before the index may share an email within an event. Keep one
+-- live guest per email (preferring one holding a ticket, then the oldest) and
+-- soft-delete the rest, or the index below cannot be built.
+UPDATE guests g
+SET deleted_at = now(), updated_at = now()
+FROM (
+    SELECT id, row_number() OVER (
+        PARTITION BY event_id, lower(email)
+        ORDER BY ticket_id IS NULL, created_at, id
+    ) AS n
+    FROM guests
+    WHERE deleted_at IS NULL
+) d
+WHERE g.id = d.id AND d.n > 1;
+
+-- One live guest per email within an event; backs import dedupe and closes the
+-- race between concurrent imports into the same event.
+CREATE UNIQUE INDEX ux_guests_event_email ON guests(event_id, lower(email)) WHERE deleted_at IS NULL;
+
+-- Saved column mapping (spreadsheet header -> guest field) per tenant.
+CREATE TABLE guest_import_mappings (
+    tenant_id  UUID PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    mapping    JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE guest_imports (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id      UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    tenant_id     UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    filename      TEXT NOT NULL,
+    format        VARCHAR(16) NOT NULL CHECK (format IN ('csv', 'xlsx')),
+    status        VARCHAR(32) NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'running', 'completed', 'failed')),
+    total_rows    INT NOT NULL DEFAULT 0,
+    imported_rows INT NOT NULL DEFAULT 0,
+    rejected_rows INT NOT NULL DEFAULT 0,
+    row_errors    JSONB NOT NULL DEFAULT '[]',
+    failure       TEXT,
+    started_at    TIMESTAMPTZ,
+    finished_at   TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at    TIMESTAMPTZ
+);
+
+CREATE INDEX idx_guest_imports_event_id ON guest_imports(event_id);
+CREATE INDEX idx_guest_imports_deleted_at ON guest_imports(deleted_at) WHERE deleted_at IS NULL;
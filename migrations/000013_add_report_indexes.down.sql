@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tickets_guest_live;
+DROP INDEX IF EXISTS idx_scan_logs_event_operator;
+DROP INDEX IF EXISTS idx_scan_logs_event_ticket_scanned;
+DROP INDEX IF EXISTS idx_scan_logs_event_step_scanned;
@@ -0,0 +1,11 @@
+-- Per-step throughput and time-between-steps: scans of an event by step over time.
+CREATE INDEX idx_scan_logs_event_step_scanned ON scan_logs(event_id, workflow_step_id, scanned_at);
+
+-- Arrivals (first scan per ticket) and check-in status per ticket.
+CREATE INDEX idx_scan_logs_event_ticket_scanned ON scan_logs(event_id, ticket_id, scanned_at);
+
+-- Per-operator scan counts.
+CREATE INDEX idx_scan_logs_event_operator ON scan_logs(event_id, operator_user_id);
+
+-- Funnel and no-show lists: a guest's live tickets.
+CREATE INDEX idx_tickets_guest_live ON tickets(guest_id, status) WHERE deleted_at IS NULL;
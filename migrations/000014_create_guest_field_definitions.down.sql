@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_guests_custom_fields;
+ALTER TABLE guests DROP COLUMN IF EXISTS custom_fields;
+DROP TABLE IF EXISTS guest_field_definitions;
@@ -0,0 +1,26 @@
+-- Custom guest fields declared by a tenant (event_id NULL, inherited by all of
+-- its events) or by one event (overrides a tenant field with the same key).
+CREATE TABLE guest_field_definitions (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id  UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    event_id   UUID REFERENCES events(id) ON DELETE CASCADE,
+    key        VARCHAR(63) NOT NULL,
+    label      TEXT NOT NULL,
+    type       VARCHAR(16) NOT NULL CHECK (type IN ('text', 'number', 'boolean', 'date', 'select')),
+    required   BOOLEAN NOT NULL DEFAULT false,
+    options    JSONB NOT NULL DEFAULT '[]',
+    pattern    TEXT,
+    position   INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX ux_guest_field_definitions_tenant_key
+    ON guest_field_definitions(tenant_id, key) WHERE event_id IS NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX ux_guest_field_definitions_event_key
+    ON guest_field_definitions(event_id, key) WHERE event_id IS NOT NULL AND deleted_at IS NULL;
+
+-- Values keyed by field key; the GIN index serves containment (@>) filters.
+ALTER TABLE guests ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}';
+CREATE INDEX idx_guests_custom_fields ON guests USING GIN (custom_fields jsonb_path_ops);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tickets_group_id;
+ALTER TABLE tickets DROP COLUMN IF EXISTS group_id;
+DROP INDEX IF EXISTS idx_guests_group_id;
+ALTER TABLE guests DROP COLUMN IF EXISTS is_plus_one;
+ALTER TABLE guests DROP COLUMN IF EXISTS group_id;
+DROP TABLE IF EXISTS guest_groups;
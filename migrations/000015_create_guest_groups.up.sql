@@ -0,0 +1,24 @@
+-- Households/parties invited together: one primary contact receives the
+-- invitation, members RSVP together or one by one, and up to
+-- plus_ones_allowed companions may be named later.
+CREATE TABLE guest_groups (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id          UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name              TEXT NOT NULL,
+    primary_guest_id  UUID REFERENCES guests(id) ON DELETE SET NULL,
+    plus_ones_allowed INT NOT NULL DEFAULT 0 CHECK (plus_ones_allowed >= 0),
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at        TIMESTAMPTZ
+);
+
+CREATE INDEX idx_guest_groups_event_id ON guest_groups(event_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE guests
+    ADD COLUMN group_id    UUID REFERENCES guest_groups(id) ON DELETE SET NULL,
+    ADD COLUMN is_plus_one BOOLEAN NOT NULL DEFAULT false;
+CREATE INDEX idx_guests_group_id ON guests(group_id) WHERE group_id IS NOT NULL AND deleted_at IS NULL;
+
+-- Tickets issued to a named plus-one stay linked to the group they came with.
+ALTER TABLE tickets ADD COLUMN group_id UUID REFERENCES guest_groups(id) ON DELETE SET NULL;
+CREATE INDEX idx_tickets_group_id ON tickets(group_id) WHERE group_id IS NOT NULL;
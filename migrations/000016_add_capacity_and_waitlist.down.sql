@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS ticket_waitlist;
+DROP INDEX IF EXISTS idx_tickets_event_type_issued;
+ALTER TABLE ticket_types DROP COLUMN IF EXISTS capacity;
+ALTER TABLE events DROP COLUMN IF EXISTS capacity;
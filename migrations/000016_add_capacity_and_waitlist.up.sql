@@ -0,0 +1,25 @@
+-- Capacity limits: NULL means unlimited. Issued tickets (active or used) count
+-- against both the event's and their ticket type's capacity.
+ALTER TABLE events       ADD COLUMN capacity INT CHECK (capacity >= 0);
+ALTER TABLE ticket_types ADD COLUMN capacity INT CHECK (capacity >= 0);
+
+CREATE INDEX idx_tickets_event_type_issued ON tickets(event_id, ticket_type_id)
+    WHERE deleted_at IS NULL AND status IN ('active', 'used');
+
+-- Guests asking for a ticket while capacity is full wait here, first come first
+-- served, until a ticket is freed and they are promoted.
+CREATE TABLE ticket_waitlist (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id       UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    guest_id       UUID NOT NULL REFERENCES guests(id) ON DELETE CASCADE,
+    ticket_type_id UUID NOT NULL REFERENCES ticket_types(id) ON DELETE CASCADE,
+    group_id       UUID REFERENCES guest_groups(id) ON DELETE SET NULL,
+    status         VARCHAR(16) NOT NULL DEFAULT 'waiting' CHECK (status IN ('waiting', 'promoted', 'withdrawn')),
+    ticket_id      UUID REFERENCES tickets(id) ON DELETE SET NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    resolved_at    TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX ux_ticket_waitlist_guest_waiting ON ticket_waitlist(guest_id) WHERE status = 'waiting';
+CREATE INDEX idx_ticket_waitlist_event_waiting ON ticket_waitlist(event_id, created_at) WHERE status = 'waiting';
@@ -0,0 +1 @@
+ALTER TABLE guest_field_definitions DROP COLUMN IF EXISTS guest_editable;
@@ -0,0 +1,3 @@
+-- Marks custom guest fields a guest may fill in through the public RSVP portal.
+ALTER TABLE guest_field_definitions
+    ADD COLUMN guest_editable BOOLEAN NOT NULL DEFAULT false;
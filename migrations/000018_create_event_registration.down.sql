@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_guests_event_registered;
+ALTER TABLE guests DROP COLUMN IF EXISTS registered_at;
+UPDATE guests SET rsvp_status = 'none' WHERE rsvp_status = 'pending';
+ALTER TABLE guests DROP CONSTRAINT guests_rsvp_status_check;
+ALTER TABLE guests ADD CONSTRAINT guests_rsvp_status_check
+    CHECK (rsvp_status IN ('none', 'invited', 'confirmed', 'declined'));
+DROP TABLE IF EXISTS event_registration_settings;
@@ -0,0 +1,23 @@
+-- Public self-registration: per-event settings, and registrants as guests.
+CREATE TABLE event_registration_settings (
+    event_id          UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    enabled           BOOLEAN NOT NULL DEFAULT false,
+    opens_at          TIMESTAMPTZ,
+    closes_at         TIMESTAMPTZ,
+    requires_approval BOOLEAN NOT NULL DEFAULT false,
+    cap               INT CHECK (cap >= 0), -- NULL = unlimited registrants
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (opens_at IS NULL OR closes_at IS NULL OR closes_at > opens_at)
+);
+
+-- 'pending': registered themselves and awaiting staff approval.
+ALTER TABLE guests DROP CONSTRAINT guests_rsvp_status_check;
+ALTER TABLE guests ADD CONSTRAINT guests_rsvp_status_check
+    CHECK (rsvp_status IN ('none', 'pending', 'invited', 'confirmed', 'declined'));
+
+-- Set for guests who registered themselves; counts against the cap.
+ALTER TABLE guests ADD COLUMN registered_at TIMESTAMPTZ;
+
+CREATE INDEX idx_guests_event_registered ON guests(event_id, registered_at)
+    WHERE registered_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,21 @@
+-- One row per staff lifecycle operation on a ticket: invalidation, reissue,
+-- transfer and re-activation. Kept as an audit trail; no soft delete.
+CREATE TABLE ticket_audit_log (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id       UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    ticket_id      UUID NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+    action         VARCHAR(16) NOT NULL CHECK (action IN ('invalidate', 'reissue', 'transfer', 'reactivate')),
+    from_status    VARCHAR(32) NOT NULL,
+    to_status      VARCHAR(32) NOT NULL,
+    guest_id       UUID NOT NULL REFERENCES guests(id) ON DELETE CASCADE,
+    to_guest_id    UUID REFERENCES guests(id) ON DELETE SET NULL,
+    new_ticket_id  UUID REFERENCES tickets(id) ON DELETE SET NULL,
+    reason         TEXT,
+    actor_user_id  UUID REFERENCES users(id) ON DELETE SET NULL,
+    request_id     TEXT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_ticket_audit_log_ticket ON ticket_audit_log(ticket_id, created_at);
+CREATE INDEX idx_ticket_audit_log_new_ticket ON ticket_audit_log(new_ticket_id) WHERE new_ticket_id IS NOT NULL;
+CREATE INDEX idx_ticket_audit_log_event ON ticket_audit_log(event_id, created_at);
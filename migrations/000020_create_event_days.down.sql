@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_workflow_steps_event_day;
+ALTER TABLE workflow_steps DROP COLUMN IF EXISTS event_day_id;
+DROP TABLE IF EXISTS event_days;
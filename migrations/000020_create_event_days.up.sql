@@ -0,0 +1,20 @@
+-- Days of a multi-day event, each with its own doors-open/doors-close window.
+CREATE TABLE event_days (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id       UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    day_date       DATE NOT NULL,
+    name           TEXT,
+    doors_open_at  TIMESTAMPTZ NOT NULL,
+    doors_close_at TIMESTAMPTZ NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at     TIMESTAMPTZ,
+    CHECK (doors_close_at > doors_open_at)
+);
+
+CREATE UNIQUE INDEX ux_event_days_event_date ON event_days(event_id, day_date) WHERE deleted_at IS NULL;
+CREATE INDEX idx_event_days_event_doors ON event_days(event_id, doors_open_at) WHERE deleted_at IS NULL;
+
+-- A step scoped to a day only runs on that day; NULL runs every day.
+ALTER TABLE workflow_steps ADD COLUMN event_day_id UUID REFERENCES event_days(id) ON DELETE SET NULL;
+CREATE INDEX idx_workflow_steps_event_day ON workflow_steps(event_day_id) WHERE event_day_id IS NOT NULL;
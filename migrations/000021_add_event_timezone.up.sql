@@ -0,0 +1,3 @@
+-- The event's IANA timezone (e.g. Asia/Jakarta): its local calendar for event
+-- days, guest messages and calendar feeds. Existing events read as UTC.
+ALTER TABLE events ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_scan_logs_device_scanned;
+ALTER TABLE scan_logs DROP COLUMN IF EXISTS device_id;
+DROP TABLE IF EXISTS operator_shifts;
+DROP TABLE IF EXISTS scanner_devices;
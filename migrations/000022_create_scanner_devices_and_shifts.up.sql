@@ -0,0 +1,40 @@
+-- Scanner devices registered to an event, each at one gate and optionally
+-- limited to one workflow step. The API credential is stored as its SHA-256
+-- hash; only its prefix is kept readable to tell devices apart.
+CREATE TABLE scanner_devices (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id           UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name               TEXT NOT NULL,
+    gate               TEXT,
+    workflow_step_id   UUID REFERENCES workflow_steps(id) ON DELETE SET NULL,
+    credential_hash    TEXT NOT NULL UNIQUE,
+    credential_prefix  VARCHAR(16) NOT NULL,
+    active             BOOLEAN NOT NULL DEFAULT true,
+    last_seen_at       TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at         TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX ux_scanner_devices_event_name ON scanner_devices(event_id, name) WHERE deleted_at IS NULL;
+CREATE INDEX idx_scanner_devices_step ON scanner_devices(workflow_step_id) WHERE workflow_step_id IS NOT NULL;
+
+-- Operator shifts: a staff user working a device from check-in to check-out.
+-- A device has at most one open shift, and so has a user.
+CREATE TABLE operator_shifts (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id    UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    device_id   UUID NOT NULL REFERENCES scanner_devices(id) ON DELETE CASCADE,
+    user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    started_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ended_at    TIMESTAMPTZ,
+    CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+CREATE UNIQUE INDEX ux_operator_shifts_open_device ON operator_shifts(device_id) WHERE ended_at IS NULL;
+CREATE UNIQUE INDEX ux_operator_shifts_open_user ON operator_shifts(user_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_operator_shifts_event_started ON operator_shifts(event_id, started_at);
+
+-- The device a scan was made on; NULL for scans before devices existed.
+ALTER TABLE scan_logs ADD COLUMN device_id UUID REFERENCES scanner_devices(id) ON DELETE SET NULL;
+CREATE INDEX idx_scan_logs_device_scanned ON scan_logs(device_id, scanned_at) WHERE device_id IS NOT NULL;
@@ -0,0 +1,41 @@
+-- Tenant-managed webhook endpoints. The signing secret is kept as is, since
+-- every delivery is signed with it; it is only returned when issued.
+CREATE TABLE webhook_endpoints (
+    id                    UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id             UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    url                   TEXT NOT NULL,
+    description           TEXT,
+    event_types           TEXT[] NOT NULL,
+    secret                TEXT NOT NULL,
+    active                BOOLEAN NOT NULL DEFAULT true,
+    consecutive_failures  INT NOT NULL DEFAULT 0,
+    disabled_at           TIMESTAMPTZ,
+    created_at            TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at            TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at            TIMESTAMPTZ
+);
+
+CREATE INDEX idx_webhook_endpoints_tenant ON webhook_endpoints(tenant_id) WHERE deleted_at IS NULL;
+
+-- One row per event queued for an endpoint, kept as the delivery log. Pending
+-- rows are the queue: the dispatcher claims those whose next_attempt_at has
+-- passed. A redelivery is a new row pointing at the one it repeats.
+CREATE TABLE webhook_deliveries (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id      UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_type       VARCHAR(64) NOT NULL,
+    payload          JSONB NOT NULL,
+    status           VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts         INT NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ DEFAULT now(),
+    last_attempt_at  TIMESTAMPTZ,
+    response_code    INT,
+    response_body    TEXT,
+    error            TEXT,
+    duration_ms      INT,
+    redelivery_of    UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_endpoint ON webhook_deliveries(endpoint_id, created_at);
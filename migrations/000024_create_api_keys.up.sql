@@ -0,0 +1,27 @@
+-- Tenant API keys for server-to-server access. Only the key's SHA-256 is
+-- stored; key_prefix keeps its first characters so keys can be told apart.
+CREATE TABLE api_keys (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id    UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name         VARCHAR(100) NOT NULL,
+    key_hash     TEXT NOT NULL UNIQUE,
+    key_prefix   VARCHAR(16) NOT NULL,
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    created_by   UUID REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_api_keys_tenant_created ON api_keys(tenant_id, created_at);
+
+-- The permissions a key grants, a subset of the permissions table like a
+-- role's.
+CREATE TABLE api_key_permissions (
+    api_key_id    UUID NOT NULL REFERENCES api_keys(id) ON DELETE CASCADE,
+    permission_id UUID NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+    PRIMARY KEY (api_key_id, permission_id)
+);
+
+CREATE INDEX idx_api_key_permissions_permission_id ON api_key_permissions(permission_id);
@@ -0,0 +1,5 @@
+-- Permission guarding the tenant API key routes. Roles and keys grant it like
+-- any other permission.
+INSERT INTO permissions (code, name, description)
+VALUES ('manage_api_keys', 'Manage API keys', 'List, create and revoke the tenant''s API keys')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,5 @@
+-- Permission guarding the scan write route. Scanner staff roles and
+-- integration keys grant it like any other permission.
+INSERT INTO permissions (code, name, description)
+VALUES ('check_in', 'Check in', 'Record ticket scans at an event''s workflow steps')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,5 @@
+-- Permission guarding the staff routes that act for a guest, such as minting
+-- their RSVP portal link. Roles and keys grant it like any other permission.
+INSERT INTO permissions (code, name, description)
+VALUES ('manage_guests', 'Manage guests', 'Act for the guests of the tenant''s events, e.g. share their RSVP links')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,5 @@
+-- Permission guarding the tenant webhook routes. Endpoints receive guest data,
+-- so only roles and keys granting it may register or redirect them.
+INSERT INTO permissions (code, name, description)
+VALUES ('manage_webhooks', 'Manage webhooks', 'Register, change and remove the tenant''s webhook endpoints and redeliver events')
+ON CONFLICT (code) DO NOTHING;
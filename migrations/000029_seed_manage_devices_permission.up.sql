@@ -0,0 +1,5 @@
+-- Permission guarding the scanner device routes. A device credential lets its
+-- holder record scans, so only roles and keys granting it may mint one.
+INSERT INTO permissions (code, name, description)
+VALUES ('manage_devices', 'Manage scanner devices', 'Register, change and remove an event''s scanner devices and rotate their credentials')
+ON CONFLICT (code) DO NOTHING;
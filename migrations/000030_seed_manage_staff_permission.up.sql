@@ -0,0 +1,6 @@
+-- Permission guarding the event staff roster routes. A staff role grants its
+-- holder the event's permissions, so only roles and keys granting it may
+-- assign one.
+INSERT INTO permissions (code, name, description)
+VALUES ('manage_staff', 'Manage event staff', 'Assign the tenant''s users to an event''s staff, change their roles and take them off it')
+ON CONFLICT (code) DO NOTHING;
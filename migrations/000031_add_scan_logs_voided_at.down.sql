@@ -0,0 +1 @@
+ALTER TABLE scan_logs DROP COLUMN IF EXISTS voided_at;
@@ -0,0 +1,3 @@
+-- When a reactivation voided the scan. Voided scans stay in the log but no
+-- longer count against a single-entry step, so the ticket can pass again.
+ALTER TABLE scan_logs ADD COLUMN voided_at TIMESTAMPTZ;
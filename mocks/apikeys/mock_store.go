@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/apikeys (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/apikeys/mock_store.go -package=mockapikeys github.com/biairmal/guest-management-be/internal/features/apikeys Store
+//
+
+// Package mockapikeys is a generated GoMock package.
+package mockapikeys
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	apikeys "github.com/biairmal/guest-management-be/internal/features/apikeys"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// ByHash mocks base method.
+func (m *MockStore) ByHash(ctx context.Context, hash string) (*apikeys.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHash", ctx, hash)
+	ret0, _ := ret[0].(*apikeys.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHash indicates an expected call of ByHash.
+func (mr *MockStoreMockRecorder) ByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHash", reflect.TypeOf((*MockStore)(nil).ByHash), ctx, hash)
+}
+
+// Insert mocks base method.
+func (m *MockStore) Insert(ctx context.Context, k *apikeys.Key, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, k, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockStoreMockRecorder) Insert(ctx, k, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockStore)(nil).Insert), ctx, k, hash)
+}
+
+// Key mocks base method.
+func (m *MockStore) Key(ctx context.Context, tenantID, id uuid.UUID) (*apikeys.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", ctx, tenantID, id)
+	ret0, _ := ret[0].(*apikeys.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockStoreMockRecorder) Key(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockStore)(nil).Key), ctx, tenantID, id)
+}
+
+// Keys mocks base method.
+func (m *MockStore) Keys(ctx context.Context, tenantID uuid.UUID) ([]*apikeys.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys", ctx, tenantID)
+	ret0, _ := ret[0].([]*apikeys.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockStoreMockRecorder) Keys(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockStore)(nil).Keys), ctx, tenantID)
+}
+
+// Revoke mocks base method.
+func (m *MockStore) Revoke(ctx context.Context, tenantID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, tenantID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockStoreMockRecorder) Revoke(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockStore)(nil).Revoke), ctx, tenantID, id)
+}
+
+// TenantExists mocks base method.
+func (m *MockStore) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantExists", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TenantExists indicates an expected call of TenantExists.
+func (mr *MockStoreMockRecorder) TenantExists(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantExists", reflect.TypeOf((*MockStore)(nil).TenantExists), ctx, tenantID)
+}
+
+// Touch mocks base method.
+func (m *MockStore) Touch(ctx context.Context, id uuid.UUID, at, since time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id, at, since)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockStoreMockRecorder) Touch(ctx, id, at, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockStore)(nil).Touch), ctx, id, at, since)
+}
+
+// UnknownPermissions mocks base method.
+func (m *MockStore) UnknownPermissions(ctx context.Context, codes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnknownPermissions", ctx, codes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnknownPermissions indicates an expected call of UnknownPermissions.
+func (mr *MockStoreMockRecorder) UnknownPermissions(ctx, codes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnknownPermissions", reflect.TypeOf((*MockStore)(nil).UnknownPermissions), ctx, codes)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/calendar (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/calendar/mock_service.go -package=mockcalendar github.com/biairmal/guest-management-be/internal/features/calendar Service
+//
+
+// Package mockcalendar is a generated GoMock package.
+package mockcalendar
+
+import (
+	context "context"
+	reflect "reflect"
+
+	calendar "github.com/biairmal/guest-management-be/internal/features/calendar"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Attachment mocks base method.
+func (m *MockService) Attachment(ctx context.Context, eventID uuid.UUID) (*calendar.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attachment", ctx, eventID)
+	ret0, _ := ret[0].(*calendar.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attachment indicates an expected call of Attachment.
+func (mr *MockServiceMockRecorder) Attachment(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attachment", reflect.TypeOf((*MockService)(nil).Attachment), ctx, eventID)
+}
+
+// EventCalendar mocks base method.
+func (m *MockService) EventCalendar(ctx context.Context, eventID uuid.UUID) (*calendar.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventCalendar", ctx, eventID)
+	ret0, _ := ret[0].(*calendar.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventCalendar indicates an expected call of EventCalendar.
+func (mr *MockServiceMockRecorder) EventCalendar(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventCalendar", reflect.TypeOf((*MockService)(nil).EventCalendar), ctx, eventID)
+}
+
+// FeedLink mocks base method.
+func (m *MockService) FeedLink(ctx context.Context, tenantID uuid.UUID) (*calendar.FeedLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeedLink", ctx, tenantID)
+	ret0, _ := ret[0].(*calendar.FeedLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeedLink indicates an expected call of FeedLink.
+func (mr *MockServiceMockRecorder) FeedLink(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedLink", reflect.TypeOf((*MockService)(nil).FeedLink), ctx, tenantID)
+}
+
+// TenantFeed mocks base method.
+func (m *MockService) TenantFeed(ctx context.Context, tenantID uuid.UUID, token string) (*calendar.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantFeed", ctx, tenantID, token)
+	ret0, _ := ret[0].(*calendar.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantFeed indicates an expected call of TenantFeed.
+func (mr *MockServiceMockRecorder) TenantFeed(ctx, tenantID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantFeed", reflect.TypeOf((*MockService)(nil).TenantFeed), ctx, tenantID, token)
+}
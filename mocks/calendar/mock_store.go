@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/calendar (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/calendar/mock_store.go -package=mockcalendar github.com/biairmal/guest-management-be/internal/features/calendar Store
+//
+
+// Package mockcalendar is a generated GoMock package.
+package mockcalendar
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	calendar "github.com/biairmal/guest-management-be/internal/features/calendar"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Event mocks base method.
+func (m *MockStore) Event(ctx context.Context, eventID uuid.UUID) (*calendar.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Event", ctx, eventID)
+	ret0, _ := ret[0].(*calendar.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Event indicates an expected call of Event.
+func (mr *MockStoreMockRecorder) Event(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Event", reflect.TypeOf((*MockStore)(nil).Event), ctx, eventID)
+}
+
+// TenantEvents mocks base method.
+func (m *MockStore) TenantEvents(ctx context.Context, tenantID uuid.UUID, since time.Time) ([]*calendar.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantEvents", ctx, tenantID, since)
+	ret0, _ := ret[0].([]*calendar.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantEvents indicates an expected call of TenantEvents.
+func (mr *MockStoreMockRecorder) TenantEvents(ctx, tenantID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantEvents", reflect.TypeOf((*MockStore)(nil).TenantEvents), ctx, tenantID, since)
+}
+
+// TenantName mocks base method.
+func (m *MockStore) TenantName(ctx context.Context, tenantID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantName", ctx, tenantID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantName indicates an expected call of TenantName.
+func (mr *MockStoreMockRecorder) TenantName(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantName", reflect.TypeOf((*MockStore)(nil).TenantName), ctx, tenantID)
+}
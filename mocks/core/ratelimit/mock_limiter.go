@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/core/ratelimit (interfaces: Limiter)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/core/ratelimit/mock_limiter.go -package=mockratelimit github.com/biairmal/guest-management-be/internal/core/ratelimit Limiter
+//
+
+// Package mockratelimit is a generated GoMock package.
+package mockratelimit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ratelimit "github.com/biairmal/guest-management-be/internal/core/ratelimit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLimiter is a mock of Limiter interface.
+type MockLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockLimiterMockRecorder is the mock recorder for MockLimiter.
+type MockLimiterMockRecorder struct {
+	mock *MockLimiter
+}
+
+// NewMockLimiter creates a new mock instance.
+func NewMockLimiter(ctrl *gomock.Controller) *MockLimiter {
+	mock := &MockLimiter{ctrl: ctrl}
+	mock.recorder = &MockLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLimiter) EXPECT() *MockLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockLimiter) Allow(ctx context.Context, checks ...ratelimit.Check) ([]ratelimit.Decision, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range checks {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Allow", varargs...)
+	ret0, _ := ret[0].([]ratelimit.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockLimiterMockRecorder) Allow(ctx any, checks ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, checks...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockLimiter)(nil).Allow), varargs...)
+}
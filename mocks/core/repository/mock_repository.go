@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/core/repository (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/core/repository/mock_repository.go -package=mockcorerepository github.com/biairmal/guest-management-be/internal/core/repository Repository
+//
+
+// Package mockcorerepository is a generated GoMock package.
+package mockcorerepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repository "github.com/biairmal/go-sdk/lib/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository[TEntity any, TID comparable] struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder[TEntity, TID]
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder[TEntity any, TID comparable] struct {
+	mock *MockRepository[TEntity, TID]
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository[TEntity any, TID comparable](ctrl *gomock.Controller) *MockRepository[TEntity, TID] {
+	mock := &MockRepository[TEntity, TID]{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder[TEntity, TID]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository[TEntity, TID]) EXPECT() *MockRepositoryMockRecorder[TEntity, TID] {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockRepository[TEntity, TID]) Count(ctx context.Context, filter repository.Filter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Count), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockRepository[TEntity, TID]) Create(ctx context.Context, entity *TEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockRepository[TEntity, TID]) Delete(ctx context.Context, id TID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Delete), ctx, id)
+}
+
+// Exists mocks base method.
+func (m *MockRepository[TEntity, TID]) Exists(ctx context.Context, id TID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Exists), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository[TEntity, TID]) GetByID(ctx context.Context, id TID) (*TEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*TEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository[TEntity, TID]) List(ctx context.Context, opts *repository.ListOptions) ([]*TEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opts)
+	ret0, _ := ret[0].([]*TEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) List(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).List), ctx, opts)
+}
+
+// Purge mocks base method.
+func (m *MockRepository[TEntity, TID]) Purge(ctx context.Context, id TID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockRepository[TEntity, TID]) Restore(ctx context.Context, id TID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockRepository[TEntity, TID]) Update(ctx context.Context, id TID, entity *TEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).Update), ctx, id, entity)
+}
+
+// UpdateIfMatch mocks base method.
+func (m *MockRepository[TEntity, TID]) UpdateIfMatch(ctx context.Context, id TID, entity *TEntity, ifMatch string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIfMatch", ctx, id, entity, ifMatch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIfMatch indicates an expected call of UpdateIfMatch.
+func (mr *MockRepositoryMockRecorder[TEntity, TID]) UpdateIfMatch(ctx, id, entity, ifMatch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIfMatch", reflect.TypeOf((*MockRepository[TEntity, TID])(nil).UpdateIfMatch), ctx, id, entity, ifMatch)
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/devices (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/devices/mock_service.go -package=mockdevices github.com/biairmal/guest-management-be/internal/features/devices Service
+//
+
+// Package mockdevices is a generated GoMock package.
+package mockdevices
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	devices "github.com/biairmal/guest-management-be/internal/features/devices"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockService) Authorize(ctx context.Context, credential string, eventID, stepID uuid.UUID) (*devices.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, credential, eventID, stepID)
+	ret0, _ := ret[0].(*devices.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockServiceMockRecorder) Authorize(ctx, credential, eventID, stepID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockService)(nil).Authorize), ctx, credential, eventID, stepID)
+}
+
+// Create mocks base method.
+func (m *MockService) Create(ctx context.Context, eventID uuid.UUID, in devices.DeviceInput) (*devices.Credentialed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, eventID, in)
+	ret0, _ := ret[0].(*devices.Credentialed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceMockRecorder) Create(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, eventID, in)
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(ctx context.Context, eventID, deviceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID, deviceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(ctx, eventID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, eventID, deviceID)
+}
+
+// EndShift mocks base method.
+func (m *MockService) EndShift(ctx context.Context, eventID, deviceID uuid.UUID) (*devices.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndShift", ctx, eventID, deviceID)
+	ret0, _ := ret[0].(*devices.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndShift indicates an expected call of EndShift.
+func (mr *MockServiceMockRecorder) EndShift(ctx, eventID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndShift", reflect.TypeOf((*MockService)(nil).EndShift), ctx, eventID, deviceID)
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, eventID uuid.UUID) ([]*devices.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, eventID)
+	ret0, _ := ret[0].([]*devices.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, eventID)
+}
+
+// RotateCredential mocks base method.
+func (m *MockService) RotateCredential(ctx context.Context, eventID, deviceID uuid.UUID) (*devices.Credentialed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCredential", ctx, eventID, deviceID)
+	ret0, _ := ret[0].(*devices.Credentialed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCredential indicates an expected call of RotateCredential.
+func (mr *MockServiceMockRecorder) RotateCredential(ctx, eventID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCredential", reflect.TypeOf((*MockService)(nil).RotateCredential), ctx, eventID, deviceID)
+}
+
+// Shifts mocks base method.
+func (m *MockService) Shifts(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*dto.PageResponse[devices.Shift], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shifts", ctx, eventID, params)
+	ret0, _ := ret[0].(*dto.PageResponse[devices.Shift])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Shifts indicates an expected call of Shifts.
+func (mr *MockServiceMockRecorder) Shifts(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shifts", reflect.TypeOf((*MockService)(nil).Shifts), ctx, eventID, params)
+}
+
+// StartShift mocks base method.
+func (m *MockService) StartShift(ctx context.Context, eventID, deviceID uuid.UUID) (*devices.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartShift", ctx, eventID, deviceID)
+	ret0, _ := ret[0].(*devices.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartShift indicates an expected call of StartShift.
+func (mr *MockServiceMockRecorder) StartShift(ctx, eventID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShift", reflect.TypeOf((*MockService)(nil).StartShift), ctx, eventID, deviceID)
+}
+
+// Update mocks base method.
+func (m *MockService) Update(ctx context.Context, eventID, deviceID uuid.UUID, in devices.DeviceInput) (*devices.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, eventID, deviceID, in)
+	ret0, _ := ret[0].(*devices.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockServiceMockRecorder) Update(ctx, eventID, deviceID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, eventID, deviceID, in)
+}
@@ -0,0 +1,250 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/devices (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/devices/mock_store.go -package=mockdevices github.com/biairmal/guest-management-be/internal/features/devices Store
+//
+
+// Package mockdevices is a generated GoMock package.
+package mockdevices
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	devices "github.com/biairmal/guest-management-be/internal/features/devices"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// ByCredential mocks base method.
+func (m *MockStore) ByCredential(ctx context.Context, hash string) (*devices.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByCredential", ctx, hash)
+	ret0, _ := ret[0].(*devices.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByCredential indicates an expected call of ByCredential.
+func (mr *MockStoreMockRecorder) ByCredential(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByCredential", reflect.TypeOf((*MockStore)(nil).ByCredential), ctx, hash)
+}
+
+// Delete mocks base method.
+func (m *MockStore) Delete(ctx context.Context, eventID, deviceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID, deviceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStoreMockRecorder) Delete(ctx, eventID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), ctx, eventID, deviceID)
+}
+
+// Device mocks base method.
+func (m *MockStore) Device(ctx context.Context, eventID, deviceID uuid.UUID) (*devices.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Device", ctx, eventID, deviceID)
+	ret0, _ := ret[0].(*devices.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Device indicates an expected call of Device.
+func (mr *MockStoreMockRecorder) Device(ctx, eventID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Device", reflect.TypeOf((*MockStore)(nil).Device), ctx, eventID, deviceID)
+}
+
+// Devices mocks base method.
+func (m *MockStore) Devices(ctx context.Context, eventID uuid.UUID) ([]*devices.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Devices", ctx, eventID)
+	ret0, _ := ret[0].([]*devices.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Devices indicates an expected call of Devices.
+func (mr *MockStoreMockRecorder) Devices(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Devices", reflect.TypeOf((*MockStore)(nil).Devices), ctx, eventID)
+}
+
+// EndShift mocks base method.
+func (m *MockStore) EndShift(ctx context.Context, deviceID, userID uuid.UUID) (*devices.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndShift", ctx, deviceID, userID)
+	ret0, _ := ret[0].(*devices.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndShift indicates an expected call of EndShift.
+func (mr *MockStoreMockRecorder) EndShift(ctx, deviceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndShift", reflect.TypeOf((*MockStore)(nil).EndShift), ctx, deviceID, userID)
+}
+
+// EventTenant mocks base method.
+func (m *MockStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockStore)(nil).EventTenant), ctx, eventID)
+}
+
+// Insert mocks base method.
+func (m *MockStore) Insert(ctx context.Context, d *devices.Device, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, d, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockStoreMockRecorder) Insert(ctx, d, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockStore)(nil).Insert), ctx, d, hash)
+}
+
+// OnStaff mocks base method.
+func (m *MockStore) OnStaff(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnStaff", ctx, eventID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OnStaff indicates an expected call of OnStaff.
+func (mr *MockStoreMockRecorder) OnStaff(ctx, eventID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnStaff", reflect.TypeOf((*MockStore)(nil).OnStaff), ctx, eventID, userID)
+}
+
+// SetCredential mocks base method.
+func (m *MockStore) SetCredential(ctx context.Context, eventID, deviceID uuid.UUID, hash, prefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCredential", ctx, eventID, deviceID, hash, prefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCredential indicates an expected call of SetCredential.
+func (mr *MockStoreMockRecorder) SetCredential(ctx, eventID, deviceID, hash, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredential", reflect.TypeOf((*MockStore)(nil).SetCredential), ctx, eventID, deviceID, hash, prefix)
+}
+
+// Shifts mocks base method.
+func (m *MockStore) Shifts(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*devices.Shift, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shifts", ctx, eventID, params)
+	ret0, _ := ret[0].([]*devices.Shift)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Shifts indicates an expected call of Shifts.
+func (mr *MockStoreMockRecorder) Shifts(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shifts", reflect.TypeOf((*MockStore)(nil).Shifts), ctx, eventID, params)
+}
+
+// StartShift mocks base method.
+func (m *MockStore) StartShift(ctx context.Context, s *devices.Shift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartShift", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartShift indicates an expected call of StartShift.
+func (mr *MockStoreMockRecorder) StartShift(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShift", reflect.TypeOf((*MockStore)(nil).StartShift), ctx, s)
+}
+
+// StepInEvent mocks base method.
+func (m *MockStore) StepInEvent(ctx context.Context, eventID, stepID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StepInEvent", ctx, eventID, stepID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StepInEvent indicates an expected call of StepInEvent.
+func (mr *MockStoreMockRecorder) StepInEvent(ctx, eventID, stepID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepInEvent", reflect.TypeOf((*MockStore)(nil).StepInEvent), ctx, eventID, stepID)
+}
+
+// Touch mocks base method.
+func (m *MockStore) Touch(ctx context.Context, deviceID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, deviceID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockStoreMockRecorder) Touch(ctx, deviceID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockStore)(nil).Touch), ctx, deviceID, at)
+}
+
+// Update mocks base method.
+func (m *MockStore) Update(ctx context.Context, d *devices.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStoreMockRecorder) Update(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStore)(nil).Update), ctx, d)
+}
@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/events (interfaces: DayService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/events/mock_day_service.go -package=mockevents github.com/biairmal/guest-management-be/internal/features/events DayService
+//
+
+// Package mockevents is a generated GoMock package.
+package mockevents
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	events "github.com/biairmal/guest-management-be/internal/features/events"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDayService is a mock of DayService interface.
+type MockDayService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDayServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDayServiceMockRecorder is the mock recorder for MockDayService.
+type MockDayServiceMockRecorder struct {
+	mock *MockDayService
+}
+
+// NewMockDayService creates a new mock instance.
+func NewMockDayService(ctrl *gomock.Controller) *MockDayService {
+	mock := &MockDayService{ctrl: ctrl}
+	mock.recorder = &MockDayServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDayService) EXPECT() *MockDayServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDayService) Create(ctx context.Context, eventID uuid.UUID, in events.CreateDayInput) (*events.EventDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, eventID, in)
+	ret0, _ := ret[0].(*events.EventDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDayServiceMockRecorder) Create(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDayService)(nil).Create), ctx, eventID, in)
+}
+
+// Delete mocks base method.
+func (m *MockDayService) Delete(ctx context.Context, eventID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDayServiceMockRecorder) Delete(ctx, eventID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDayService)(nil).Delete), ctx, eventID, id)
+}
+
+// List mocks base method.
+func (m *MockDayService) List(ctx context.Context, eventID uuid.UUID) (events.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, eventID)
+	ret0, _ := ret[0].(events.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDayServiceMockRecorder) List(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDayService)(nil).List), ctx, eventID)
+}
+
+// ScopeStep mocks base method.
+func (m *MockDayService) ScopeStep(ctx context.Context, eventID, stepID uuid.UUID, in events.StepScopeInput) (*events.StepScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScopeStep", ctx, eventID, stepID, in)
+	ret0, _ := ret[0].(*events.StepScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScopeStep indicates an expected call of ScopeStep.
+func (mr *MockDayServiceMockRecorder) ScopeStep(ctx, eventID, stepID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScopeStep", reflect.TypeOf((*MockDayService)(nil).ScopeStep), ctx, eventID, stepID, in)
+}
+
+// SetTimezone mocks base method.
+func (m *MockDayService) SetTimezone(ctx context.Context, eventID uuid.UUID, in events.TimezoneInput) (*events.EventTimezone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimezone", ctx, eventID, in)
+	ret0, _ := ret[0].(*events.EventTimezone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTimezone indicates an expected call of SetTimezone.
+func (mr *MockDayServiceMockRecorder) SetTimezone(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimezone", reflect.TypeOf((*MockDayService)(nil).SetTimezone), ctx, eventID, in)
+}
+
+// Today mocks base method.
+func (m *MockDayService) Today(ctx context.Context, eventID uuid.UUID, at time.Time) (*events.EventDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Today", ctx, eventID, at)
+	ret0, _ := ret[0].(*events.EventDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Today indicates an expected call of Today.
+func (mr *MockDayServiceMockRecorder) Today(ctx, eventID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Today", reflect.TypeOf((*MockDayService)(nil).Today), ctx, eventID, at)
+}
+
+// Update mocks base method.
+func (m *MockDayService) Update(ctx context.Context, eventID, id uuid.UUID, in events.UpdateDayInput) (*events.EventDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, eventID, id, in)
+	ret0, _ := ret[0].(*events.EventDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDayServiceMockRecorder) Update(ctx, eventID, id, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDayService)(nil).Update), ctx, eventID, id, in)
+}
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/events (interfaces: DayStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/events/mock_day_store.go -package=mockevents github.com/biairmal/guest-management-be/internal/features/events DayStore
+//
+
+// Package mockevents is a generated GoMock package.
+package mockevents
+
+import (
+	context "context"
+	reflect "reflect"
+
+	events "github.com/biairmal/guest-management-be/internal/features/events"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDayStore is a mock of DayStore interface.
+type MockDayStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDayStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockDayStoreMockRecorder is the mock recorder for MockDayStore.
+type MockDayStoreMockRecorder struct {
+	mock *MockDayStore
+}
+
+// NewMockDayStore creates a new mock instance.
+func NewMockDayStore(ctrl *gomock.Controller) *MockDayStore {
+	mock := &MockDayStore{ctrl: ctrl}
+	mock.recorder = &MockDayStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDayStore) EXPECT() *MockDayStoreMockRecorder {
+	return m.recorder
+}
+
+// Days mocks base method.
+func (m *MockDayStore) Days(ctx context.Context, eventID uuid.UUID) (events.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Days", ctx, eventID)
+	ret0, _ := ret[0].(events.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Days indicates an expected call of Days.
+func (mr *MockDayStoreMockRecorder) Days(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Days", reflect.TypeOf((*MockDayStore)(nil).Days), ctx, eventID)
+}
+
+// Delete mocks base method.
+func (m *MockDayStore) Delete(ctx context.Context, eventID, dayID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID, dayID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDayStoreMockRecorder) Delete(ctx, eventID, dayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDayStore)(nil).Delete), ctx, eventID, dayID)
+}
+
+// Insert mocks base method.
+func (m *MockDayStore) Insert(ctx context.Context, d *events.EventDay) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockDayStoreMockRecorder) Insert(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDayStore)(nil).Insert), ctx, d)
+}
+
+// LockEvent mocks base method.
+func (m *MockDayStore) LockEvent(ctx context.Context, eventID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockEvent", ctx, eventID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockEvent indicates an expected call of LockEvent.
+func (mr *MockDayStoreMockRecorder) LockEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockEvent", reflect.TypeOf((*MockDayStore)(nil).LockEvent), ctx, eventID)
+}
+
+// ScopeStep mocks base method.
+func (m *MockDayStore) ScopeStep(ctx context.Context, eventID, stepID uuid.UUID, dayID *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScopeStep", ctx, eventID, stepID, dayID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScopeStep indicates an expected call of ScopeStep.
+func (mr *MockDayStoreMockRecorder) ScopeStep(ctx, eventID, stepID, dayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScopeStep", reflect.TypeOf((*MockDayStore)(nil).ScopeStep), ctx, eventID, stepID, dayID)
+}
+
+// SetTimezone mocks base method.
+func (m *MockDayStore) SetTimezone(ctx context.Context, eventID uuid.UUID, timezone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimezone", ctx, eventID, timezone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTimezone indicates an expected call of SetTimezone.
+func (mr *MockDayStoreMockRecorder) SetTimezone(ctx, eventID, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimezone", reflect.TypeOf((*MockDayStore)(nil).SetTimezone), ctx, eventID, timezone)
+}
+
+// SyncMultiDay mocks base method.
+func (m *MockDayStore) SyncMultiDay(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncMultiDay", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncMultiDay indicates an expected call of SyncMultiDay.
+func (mr *MockDayStoreMockRecorder) SyncMultiDay(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMultiDay", reflect.TypeOf((*MockDayStore)(nil).SyncMultiDay), ctx, eventID)
+}
+
+// Timezone mocks base method.
+func (m *MockDayStore) Timezone(ctx context.Context, eventID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timezone", ctx, eventID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timezone indicates an expected call of Timezone.
+func (mr *MockDayStoreMockRecorder) Timezone(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timezone", reflect.TypeOf((*MockDayStore)(nil).Timezone), ctx, eventID)
+}
+
+// Update mocks base method.
+func (m *MockDayStore) Update(ctx context.Context, d *events.EventDay) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDayStoreMockRecorder) Update(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDayStore)(nil).Update), ctx, d)
+}
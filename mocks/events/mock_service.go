@@ -103,17 +103,32 @@ func (mr *MockCategoryServiceMockRecorder) List(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCategoryService)(nil).List), ctx, params)
 }
 
+// Restore mocks base method.
+func (m *MockCategoryService) Restore(ctx context.Context, id uuid.UUID) (*events.EventCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(*events.EventCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCategoryServiceMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCategoryService)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
-func (m *MockCategoryService) Update(ctx context.Context, id uuid.UUID, in events.UpdateInput) (*events.EventCategory, error) {
+func (m *MockCategoryService) Update(ctx context.Context, id uuid.UUID, in events.UpdateInput, ifMatch string) (*events.EventCategory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, id, in)
+	ret := m.ctrl.Call(m, "Update", ctx, id, in, ifMatch)
 	ret0, _ := ret[0].(*events.EventCategory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockCategoryServiceMockRecorder) Update(ctx, id, in any) *gomock.Call {
+func (mr *MockCategoryServiceMockRecorder) Update(ctx, id, in, ifMatch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryService)(nil).Update), ctx, id, in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryService)(nil).Update), ctx, id, in, ifMatch)
 }
@@ -0,0 +1,60 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: ExportService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_export_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ExportService
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	export "github.com/biairmal/guest-management-be/internal/core/export"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportService is a mock of ExportService interface.
+type MockExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExportServiceMockRecorder is the mock recorder for MockExportService.
+type MockExportServiceMockRecorder struct {
+	mock *MockExportService
+}
+
+// NewMockExportService creates a new mock instance.
+func NewMockExportService(ctrl *gomock.Controller) *MockExportService {
+	mock := &MockExportService{ctrl: ctrl}
+	mock.recorder = &MockExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportService) EXPECT() *MockExportServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportService) Export(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]string, export.Source, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, eventID, params)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(export.Source)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportServiceMockRecorder) Export(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportService)(nil).Export), ctx, eventID, params)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: ExportStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_export_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ExportStore
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportStore is a mock of ExportStore interface.
+type MockExportStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockExportStoreMockRecorder is the mock recorder for MockExportStore.
+type MockExportStoreMockRecorder struct {
+	mock *MockExportStore
+}
+
+// NewMockExportStore creates a new mock instance.
+func NewMockExportStore(ctrl *gomock.Controller) *MockExportStore {
+	mock := &MockExportStore{ctrl: ctrl}
+	mock.recorder = &MockExportStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportStore) EXPECT() *MockExportStoreMockRecorder {
+	return m.recorder
+}
+
+// StreamGuests mocks base method.
+func (m *MockExportStore) StreamGuests(ctx context.Context, eventID uuid.UUID, params *query.ListParams, schema guests.Schema, fn func(*guests.GuestExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamGuests", ctx, eventID, params, schema, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamGuests indicates an expected call of StreamGuests.
+func (mr *MockExportStoreMockRecorder) StreamGuests(ctx, eventID, params, schema, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGuests", reflect.TypeOf((*MockExportStore)(nil).StreamGuests), ctx, eventID, params, schema, fn)
+}
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: FieldService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_field_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests FieldService
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFieldService is a mock of FieldService interface.
+type MockFieldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFieldServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFieldServiceMockRecorder is the mock recorder for MockFieldService.
+type MockFieldServiceMockRecorder struct {
+	mock *MockFieldService
+}
+
+// NewMockFieldService creates a new mock instance.
+func NewMockFieldService(ctrl *gomock.Controller) *MockFieldService {
+	mock := &MockFieldService{ctrl: ctrl}
+	mock.recorder = &MockFieldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFieldService) EXPECT() *MockFieldServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateForEvent mocks base method.
+func (m *MockFieldService) CreateForEvent(ctx context.Context, eventID uuid.UUID, in guests.CreateFieldInput) (*guests.FieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateForEvent", ctx, eventID, in)
+	ret0, _ := ret[0].(*guests.FieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateForEvent indicates an expected call of CreateForEvent.
+func (mr *MockFieldServiceMockRecorder) CreateForEvent(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateForEvent", reflect.TypeOf((*MockFieldService)(nil).CreateForEvent), ctx, eventID, in)
+}
+
+// CreateForTenant mocks base method.
+func (m *MockFieldService) CreateForTenant(ctx context.Context, tenantID uuid.UUID, in guests.CreateFieldInput) (*guests.FieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateForTenant", ctx, tenantID, in)
+	ret0, _ := ret[0].(*guests.FieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateForTenant indicates an expected call of CreateForTenant.
+func (mr *MockFieldServiceMockRecorder) CreateForTenant(ctx, tenantID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateForTenant", reflect.TypeOf((*MockFieldService)(nil).CreateForTenant), ctx, tenantID, in)
+}
+
+// Delete mocks base method.
+func (m *MockFieldService) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFieldServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFieldService)(nil).Delete), ctx, id)
+}
+
+// EventSchema mocks base method.
+func (m *MockFieldService) EventSchema(ctx context.Context, eventID uuid.UUID) (guests.Schema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventSchema", ctx, eventID)
+	ret0, _ := ret[0].(guests.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventSchema indicates an expected call of EventSchema.
+func (mr *MockFieldServiceMockRecorder) EventSchema(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventSchema", reflect.TypeOf((*MockFieldService)(nil).EventSchema), ctx, eventID)
+}
+
+// GetByID mocks base method.
+func (m *MockFieldService) GetByID(ctx context.Context, id uuid.UUID) (*guests.FieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*guests.FieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockFieldServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFieldService)(nil).GetByID), ctx, id)
+}
+
+// TenantFields mocks base method.
+func (m *MockFieldService) TenantFields(ctx context.Context, tenantID uuid.UUID) ([]guests.FieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantFields", ctx, tenantID)
+	ret0, _ := ret[0].([]guests.FieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantFields indicates an expected call of TenantFields.
+func (mr *MockFieldServiceMockRecorder) TenantFields(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantFields", reflect.TypeOf((*MockFieldService)(nil).TenantFields), ctx, tenantID)
+}
+
+// Update mocks base method.
+func (m *MockFieldService) Update(ctx context.Context, id uuid.UUID, in guests.UpdateFieldInput, ifMatch string) (*guests.FieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, in, ifMatch)
+	ret0, _ := ret[0].(*guests.FieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFieldServiceMockRecorder) Update(ctx, id, in, ifMatch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFieldService)(nil).Update), ctx, id, in, ifMatch)
+}
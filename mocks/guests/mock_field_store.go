@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: FieldStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_field_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests FieldStore
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFieldStore is a mock of FieldStore interface.
+type MockFieldStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockFieldStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockFieldStoreMockRecorder is the mock recorder for MockFieldStore.
+type MockFieldStoreMockRecorder struct {
+	mock *MockFieldStore
+}
+
+// NewMockFieldStore creates a new mock instance.
+func NewMockFieldStore(ctrl *gomock.Controller) *MockFieldStore {
+	mock := &MockFieldStore{ctrl: ctrl}
+	mock.recorder = &MockFieldStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFieldStore) EXPECT() *MockFieldStoreMockRecorder {
+	return m.recorder
+}
+
+// EventSchema mocks base method.
+func (m *MockFieldStore) EventSchema(ctx context.Context, eventID uuid.UUID) (guests.Schema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventSchema", ctx, eventID)
+	ret0, _ := ret[0].(guests.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventSchema indicates an expected call of EventSchema.
+func (mr *MockFieldStoreMockRecorder) EventSchema(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventSchema", reflect.TypeOf((*MockFieldStore)(nil).EventSchema), ctx, eventID)
+}
+
+// EventTenant mocks base method.
+func (m *MockFieldStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockFieldStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockFieldStore)(nil).EventTenant), ctx, eventID)
+}
+
+// TenantExists mocks base method.
+func (m *MockFieldStore) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantExists", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TenantExists indicates an expected call of TenantExists.
+func (mr *MockFieldStoreMockRecorder) TenantExists(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantExists", reflect.TypeOf((*MockFieldStore)(nil).TenantExists), ctx, tenantID)
+}
+
+// TenantFields mocks base method.
+func (m *MockFieldStore) TenantFields(ctx context.Context, tenantID uuid.UUID) ([]guests.FieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantFields", ctx, tenantID)
+	ret0, _ := ret[0].([]guests.FieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantFields indicates an expected call of TenantFields.
+func (mr *MockFieldStoreMockRecorder) TenantFields(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantFields", reflect.TypeOf((*MockFieldStore)(nil).TenantFields), ctx, tenantID)
+}
@@ -0,0 +1,194 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: GroupService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_group_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GroupService
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupService is a mock of GroupService interface.
+type MockGroupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupServiceMockRecorder is the mock recorder for MockGroupService.
+type MockGroupServiceMockRecorder struct {
+	mock *MockGroupService
+}
+
+// NewMockGroupService creates a new mock instance.
+func NewMockGroupService(ctrl *gomock.Controller) *MockGroupService {
+	mock := &MockGroupService{ctrl: ctrl}
+	mock.recorder = &MockGroupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupService) EXPECT() *MockGroupServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMembers mocks base method.
+func (m *MockGroupService) AddMembers(ctx context.Context, eventID, id uuid.UUID, in guests.GroupMembersInput) (*guests.GroupDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMembers", ctx, eventID, id, in)
+	ret0, _ := ret[0].(*guests.GroupDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMembers indicates an expected call of AddMembers.
+func (mr *MockGroupServiceMockRecorder) AddMembers(ctx, eventID, id, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembers", reflect.TypeOf((*MockGroupService)(nil).AddMembers), ctx, eventID, id, in)
+}
+
+// AddPlusOne mocks base method.
+func (m *MockGroupService) AddPlusOne(ctx context.Context, eventID, id uuid.UUID, in guests.PlusOneInput) (*guests.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlusOne", ctx, eventID, id, in)
+	ret0, _ := ret[0].(*guests.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPlusOne indicates an expected call of AddPlusOne.
+func (mr *MockGroupServiceMockRecorder) AddPlusOne(ctx, eventID, id, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlusOne", reflect.TypeOf((*MockGroupService)(nil).AddPlusOne), ctx, eventID, id, in)
+}
+
+// Create mocks base method.
+func (m *MockGroupService) Create(ctx context.Context, eventID uuid.UUID, in guests.CreateGroupInput) (*guests.GroupDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, eventID, in)
+	ret0, _ := ret[0].(*guests.GroupDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGroupServiceMockRecorder) Create(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupService)(nil).Create), ctx, eventID, in)
+}
+
+// Delete mocks base method.
+func (m *MockGroupService) Delete(ctx context.Context, eventID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupServiceMockRecorder) Delete(ctx, eventID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupService)(nil).Delete), ctx, eventID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockGroupService) GetByID(ctx context.Context, eventID, id uuid.UUID) (*guests.GroupDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, eventID, id)
+	ret0, _ := ret[0].(*guests.GroupDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGroupServiceMockRecorder) GetByID(ctx, eventID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupService)(nil).GetByID), ctx, eventID, id)
+}
+
+// InvitationRecipients mocks base method.
+func (m *MockGroupService) InvitationRecipients(ctx context.Context, eventID uuid.UUID) ([]*guests.InvitationRecipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvitationRecipients", ctx, eventID)
+	ret0, _ := ret[0].([]*guests.InvitationRecipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvitationRecipients indicates an expected call of InvitationRecipients.
+func (mr *MockGroupServiceMockRecorder) InvitationRecipients(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvitationRecipients", reflect.TypeOf((*MockGroupService)(nil).InvitationRecipients), ctx, eventID)
+}
+
+// List mocks base method.
+func (m *MockGroupService) List(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*dto.PageResponse[guests.GroupSummary], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, eventID, params)
+	ret0, _ := ret[0].(*dto.PageResponse[guests.GroupSummary])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGroupServiceMockRecorder) List(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGroupService)(nil).List), ctx, eventID, params)
+}
+
+// RSVP mocks base method.
+func (m *MockGroupService) RSVP(ctx context.Context, eventID, id uuid.UUID, in guests.GroupRSVPInput) (*guests.GroupDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RSVP", ctx, eventID, id, in)
+	ret0, _ := ret[0].(*guests.GroupDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RSVP indicates an expected call of RSVP.
+func (mr *MockGroupServiceMockRecorder) RSVP(ctx, eventID, id, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RSVP", reflect.TypeOf((*MockGroupService)(nil).RSVP), ctx, eventID, id, in)
+}
+
+// RemoveMember mocks base method.
+func (m *MockGroupService) RemoveMember(ctx context.Context, eventID, id, guestID uuid.UUID) (*guests.GroupDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, eventID, id, guestID)
+	ret0, _ := ret[0].(*guests.GroupDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockGroupServiceMockRecorder) RemoveMember(ctx, eventID, id, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockGroupService)(nil).RemoveMember), ctx, eventID, id, guestID)
+}
+
+// Update mocks base method.
+func (m *MockGroupService) Update(ctx context.Context, eventID, id uuid.UUID, in guests.UpdateGroupInput, ifMatch string) (*guests.GroupDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, eventID, id, in, ifMatch)
+	ret0, _ := ret[0].(*guests.GroupDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGroupServiceMockRecorder) Update(ctx, eventID, id, in, ifMatch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGroupService)(nil).Update), ctx, eventID, id, in, ifMatch)
+}
@@ -0,0 +1,194 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: GroupStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_group_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GroupStore
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupStore is a mock of GroupStore interface.
+type MockGroupStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupStoreMockRecorder is the mock recorder for MockGroupStore.
+type MockGroupStoreMockRecorder struct {
+	mock *MockGroupStore
+}
+
+// NewMockGroupStore creates a new mock instance.
+func NewMockGroupStore(ctrl *gomock.Controller) *MockGroupStore {
+	mock := &MockGroupStore{ctrl: ctrl}
+	mock.recorder = &MockGroupStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupStore) EXPECT() *MockGroupStoreMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockGroupStore) Assign(ctx context.Context, groupID uuid.UUID, guestIDs []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, groupID, guestIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockGroupStoreMockRecorder) Assign(ctx, groupID, guestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockGroupStore)(nil).Assign), ctx, groupID, guestIDs)
+}
+
+// EventExists mocks base method.
+func (m *MockGroupStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockGroupStoreMockRecorder) EventExists(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockGroupStore)(nil).EventExists), ctx, eventID)
+}
+
+// EventGuests mocks base method.
+func (m *MockGroupStore) EventGuests(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID) ([]*guests.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventGuests", ctx, eventID, ids)
+	ret0, _ := ret[0].([]*guests.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventGuests indicates an expected call of EventGuests.
+func (mr *MockGroupStoreMockRecorder) EventGuests(ctx, eventID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventGuests", reflect.TypeOf((*MockGroupStore)(nil).EventGuests), ctx, eventID, ids)
+}
+
+// InvitationRecipients mocks base method.
+func (m *MockGroupStore) InvitationRecipients(ctx context.Context, eventID uuid.UUID) ([]*guests.InvitationRecipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvitationRecipients", ctx, eventID)
+	ret0, _ := ret[0].([]*guests.InvitationRecipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvitationRecipients indicates an expected call of InvitationRecipients.
+func (mr *MockGroupStoreMockRecorder) InvitationRecipients(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvitationRecipients", reflect.TypeOf((*MockGroupStore)(nil).InvitationRecipients), ctx, eventID)
+}
+
+// ListGroups mocks base method.
+func (m *MockGroupStore) ListGroups(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*guests.GroupSummary, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, eventID, params)
+	ret0, _ := ret[0].([]*guests.GroupSummary)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockGroupStoreMockRecorder) ListGroups(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockGroupStore)(nil).ListGroups), ctx, eventID, params)
+}
+
+// LockPlusOnes mocks base method.
+func (m *MockGroupStore) LockPlusOnes(ctx context.Context, groupID uuid.UUID) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPlusOnes", ctx, groupID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LockPlusOnes indicates an expected call of LockPlusOnes.
+func (mr *MockGroupStoreMockRecorder) LockPlusOnes(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPlusOnes", reflect.TypeOf((*MockGroupStore)(nil).LockPlusOnes), ctx, groupID)
+}
+
+// Members mocks base method.
+func (m *MockGroupStore) Members(ctx context.Context, groupID uuid.UUID) ([]*guests.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members", ctx, groupID)
+	ret0, _ := ret[0].([]*guests.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockGroupStoreMockRecorder) Members(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockGroupStore)(nil).Members), ctx, groupID)
+}
+
+// Release mocks base method.
+func (m *MockGroupStore) Release(ctx context.Context, groupID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, groupID, guestIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockGroupStoreMockRecorder) Release(ctx, groupID, guestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockGroupStore)(nil).Release), ctx, groupID, guestIDs)
+}
+
+// SetRSVP mocks base method.
+func (m *MockGroupStore) SetRSVP(ctx context.Context, guestIDs []uuid.UUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRSVP", ctx, guestIDs, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRSVP indicates an expected call of SetRSVP.
+func (mr *MockGroupStoreMockRecorder) SetRSVP(ctx, guestIDs, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRSVP", reflect.TypeOf((*MockGroupStore)(nil).SetRSVP), ctx, guestIDs, status)
+}
+
+// TicketType mocks base method.
+func (m *MockGroupStore) TicketType(ctx context.Context, guestID uuid.UUID) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TicketType", ctx, guestID)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TicketType indicates an expected call of TicketType.
+func (mr *MockGroupStoreMockRecorder) TicketType(ctx, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TicketType", reflect.TypeOf((*MockGroupStore)(nil).TicketType), ctx, guestID)
+}
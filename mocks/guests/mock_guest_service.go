@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: GuestService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_guest_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GuestService
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGuestService is a mock of GuestService interface.
+type MockGuestService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuestServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGuestServiceMockRecorder is the mock recorder for MockGuestService.
+type MockGuestServiceMockRecorder struct {
+	mock *MockGuestService
+}
+
+// NewMockGuestService creates a new mock instance.
+func NewMockGuestService(ctrl *gomock.Controller) *MockGuestService {
+	mock := &MockGuestService{ctrl: ctrl}
+	mock.recorder = &MockGuestServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuestService) EXPECT() *MockGuestServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGuestService) Create(ctx context.Context, eventID uuid.UUID, in guests.CreateGuestInput) (*guests.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, eventID, in)
+	ret0, _ := ret[0].(*guests.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGuestServiceMockRecorder) Create(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGuestService)(nil).Create), ctx, eventID, in)
+}
+
+// Delete mocks base method.
+func (m *MockGuestService) Delete(ctx context.Context, eventID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGuestServiceMockRecorder) Delete(ctx, eventID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGuestService)(nil).Delete), ctx, eventID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockGuestService) GetByID(ctx context.Context, eventID, id uuid.UUID) (*guests.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, eventID, id)
+	ret0, _ := ret[0].(*guests.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGuestServiceMockRecorder) GetByID(ctx, eventID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGuestService)(nil).GetByID), ctx, eventID, id)
+}
+
+// List mocks base method.
+func (m *MockGuestService) List(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*dto.PageResponse[guests.Guest], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, eventID, params)
+	ret0, _ := ret[0].(*dto.PageResponse[guests.Guest])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGuestServiceMockRecorder) List(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGuestService)(nil).List), ctx, eventID, params)
+}
+
+// Update mocks base method.
+func (m *MockGuestService) Update(ctx context.Context, eventID, id uuid.UUID, in guests.UpdateGuestInput, ifMatch string) (*guests.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, eventID, id, in, ifMatch)
+	ret0, _ := ret[0].(*guests.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGuestServiceMockRecorder) Update(ctx, eventID, id, in, ifMatch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGuestService)(nil).Update), ctx, eventID, id, in, ifMatch)
+}
@@ -0,0 +1,60 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: GuestStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_guest_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests GuestStore
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGuestStore is a mock of GuestStore interface.
+type MockGuestStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuestStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockGuestStoreMockRecorder is the mock recorder for MockGuestStore.
+type MockGuestStoreMockRecorder struct {
+	mock *MockGuestStore
+}
+
+// NewMockGuestStore creates a new mock instance.
+func NewMockGuestStore(ctrl *gomock.Controller) *MockGuestStore {
+	mock := &MockGuestStore{ctrl: ctrl}
+	mock.recorder = &MockGuestStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuestStore) EXPECT() *MockGuestStoreMockRecorder {
+	return m.recorder
+}
+
+// ListGuests mocks base method.
+func (m *MockGuestStore) ListGuests(ctx context.Context, eventID uuid.UUID, params *query.ListParams, schema guests.Schema) ([]*guests.Guest, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuests", ctx, eventID, params, schema)
+	ret0, _ := ret[0].([]*guests.Guest)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGuests indicates an expected call of ListGuests.
+func (mr *MockGuestStoreMockRecorder) ListGuests(ctx, eventID, params, schema any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuests", reflect.TypeOf((*MockGuestStore)(nil).ListGuests), ctx, eventID, params, schema)
+}
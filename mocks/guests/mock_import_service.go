@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: ImportService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_import_service.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ImportService
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImportService is a mock of ImportService interface.
+type MockImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockImportServiceMockRecorder is the mock recorder for MockImportService.
+type MockImportServiceMockRecorder struct {
+	mock *MockImportService
+}
+
+// NewMockImportService creates a new mock instance.
+func NewMockImportService(ctrl *gomock.Controller) *MockImportService {
+	mock := &MockImportService{ctrl: ctrl}
+	mock.recorder = &MockImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportService) EXPECT() *MockImportServiceMockRecorder {
+	return m.recorder
+}
+
+// FailInterrupted mocks base method.
+func (m *MockImportService) FailInterrupted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailInterrupted", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailInterrupted indicates an expected call of FailInterrupted.
+func (mr *MockImportServiceMockRecorder) FailInterrupted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailInterrupted", reflect.TypeOf((*MockImportService)(nil).FailInterrupted), ctx)
+}
+
+// Get mocks base method.
+func (m *MockImportService) Get(ctx context.Context, eventID, importID uuid.UUID) (*guests.GuestImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, eventID, importID)
+	ret0, _ := ret[0].(*guests.GuestImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockImportServiceMockRecorder) Get(ctx, eventID, importID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImportService)(nil).Get), ctx, eventID, importID)
+}
+
+// Start mocks base method.
+func (m *MockImportService) Start(ctx context.Context, eventID uuid.UUID, in guests.StartImportInput) (*guests.GuestImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, eventID, in)
+	ret0, _ := ret[0].(*guests.GuestImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockImportServiceMockRecorder) Start(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockImportService)(nil).Start), ctx, eventID, in)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/guests (interfaces: ImportStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/guests/mock_import_store.go -package=mockguests github.com/biairmal/guest-management-be/internal/features/guests ImportStore
+//
+
+// Package mockguests is a generated GoMock package.
+package mockguests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	guests "github.com/biairmal/guest-management-be/internal/features/guests"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImportStore is a mock of ImportStore interface.
+type MockImportStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockImportStoreMockRecorder is the mock recorder for MockImportStore.
+type MockImportStoreMockRecorder struct {
+	mock *MockImportStore
+}
+
+// NewMockImportStore creates a new mock instance.
+func NewMockImportStore(ctrl *gomock.Controller) *MockImportStore {
+	mock := &MockImportStore{ctrl: ctrl}
+	mock.recorder = &MockImportStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportStore) EXPECT() *MockImportStoreMockRecorder {
+	return m.recorder
+}
+
+// EventTenant mocks base method.
+func (m *MockImportStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockImportStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockImportStore)(nil).EventTenant), ctx, eventID)
+}
+
+// ExistingEmails mocks base method.
+func (m *MockImportStore) ExistingEmails(ctx context.Context, eventID uuid.UUID) (map[string]struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistingEmails", ctx, eventID)
+	ret0, _ := ret[0].(map[string]struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistingEmails indicates an expected call of ExistingEmails.
+func (mr *MockImportStoreMockRecorder) ExistingEmails(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistingEmails", reflect.TypeOf((*MockImportStore)(nil).ExistingEmails), ctx, eventID)
+}
+
+// FailStale mocks base method.
+func (m *MockImportStore) FailStale(ctx context.Context, before time.Time, failure string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailStale", ctx, before, failure)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailStale indicates an expected call of FailStale.
+func (mr *MockImportStoreMockRecorder) FailStale(ctx, before, failure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailStale", reflect.TypeOf((*MockImportStore)(nil).FailStale), ctx, before, failure)
+}
+
+// InsertGuests mocks base method.
+func (m *MockImportStore) InsertGuests(ctx context.Context, arg1 []*guests.Guest) (map[string]struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertGuests", ctx, arg1)
+	ret0, _ := ret[0].(map[string]struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertGuests indicates an expected call of InsertGuests.
+func (mr *MockImportStoreMockRecorder) InsertGuests(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGuests", reflect.TypeOf((*MockImportStore)(nil).InsertGuests), ctx, arg1)
+}
+
+// Mapping mocks base method.
+func (m *MockImportStore) Mapping(ctx context.Context, tenantID uuid.UUID) (*guests.ColumnMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mapping", ctx, tenantID)
+	ret0, _ := ret[0].(*guests.ColumnMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mapping indicates an expected call of Mapping.
+func (mr *MockImportStoreMockRecorder) Mapping(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mapping", reflect.TypeOf((*MockImportStore)(nil).Mapping), ctx, tenantID)
+}
+
+// SaveMapping mocks base method.
+func (m_2 *MockImportStore) SaveMapping(ctx context.Context, tenantID uuid.UUID, m guests.ColumnMapping) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SaveMapping", ctx, tenantID, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMapping indicates an expected call of SaveMapping.
+func (mr *MockImportStoreMockRecorder) SaveMapping(ctx, tenantID, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMapping", reflect.TypeOf((*MockImportStore)(nil).SaveMapping), ctx, tenantID, m)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/portal (interfaces: Notifier)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/portal/mock_notifier.go -package=mockportal github.com/biairmal/guest-management-be/internal/features/portal Notifier
+//
+
+// Package mockportal is a generated GoMock package.
+package mockportal
+
+import (
+	context "context"
+	reflect "reflect"
+
+	portal "github.com/biairmal/guest-management-be/internal/features/portal"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// LinkRequested mocks base method.
+func (m *MockNotifier) LinkRequested(ctx context.Context, eventID, guestID uuid.UUID, link *portal.Link) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LinkRequested", ctx, eventID, guestID, link)
+}
+
+// LinkRequested indicates an expected call of LinkRequested.
+func (mr *MockNotifierMockRecorder) LinkRequested(ctx, eventID, guestID, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkRequested", reflect.TypeOf((*MockNotifier)(nil).LinkRequested), ctx, eventID, guestID, link)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/portal (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/portal/mock_service.go -package=mockportal github.com/biairmal/guest-management-be/internal/features/portal Service
+//
+
+// Package mockportal is a generated GoMock package.
+package mockportal
+
+import (
+	context "context"
+	reflect "reflect"
+
+	portal "github.com/biairmal/guest-management-be/internal/features/portal"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Link mocks base method.
+func (m *MockService) Link(ctx context.Context, eventID, guestID uuid.UUID) (*portal.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", ctx, eventID, guestID)
+	ret0, _ := ret[0].(*portal.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Link indicates an expected call of Link.
+func (mr *MockServiceMockRecorder) Link(ctx, eventID, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockService)(nil).Link), ctx, eventID, guestID)
+}
+
+// RequestLink mocks base method.
+func (m *MockService) RequestLink(ctx context.Context, eventID uuid.UUID, in portal.RequestLinkInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestLink", ctx, eventID, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestLink indicates an expected call of RequestLink.
+func (mr *MockServiceMockRecorder) RequestLink(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestLink", reflect.TypeOf((*MockService)(nil).RequestLink), ctx, eventID, in)
+}
+
+// Respond mocks base method.
+func (m *MockService) Respond(ctx context.Context, token string, in portal.RespondInput) (*portal.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", ctx, token, in)
+	ret0, _ := ret[0].(*portal.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockServiceMockRecorder) Respond(ctx, token, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockService)(nil).Respond), ctx, token, in)
+}
+
+// Ticket mocks base method.
+func (m *MockService) Ticket(ctx context.Context, token string) (*portal.TicketView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticket", ctx, token)
+	ret0, _ := ret[0].(*portal.TicketView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ticket indicates an expected call of Ticket.
+func (mr *MockServiceMockRecorder) Ticket(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticket", reflect.TypeOf((*MockService)(nil).Ticket), ctx, token)
+}
+
+// UpdateFields mocks base method.
+func (m *MockService) UpdateFields(ctx context.Context, token string, in portal.FieldsInput) (*portal.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFields", ctx, token, in)
+	ret0, _ := ret[0].(*portal.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFields indicates an expected call of UpdateFields.
+func (mr *MockServiceMockRecorder) UpdateFields(ctx, token, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFields", reflect.TypeOf((*MockService)(nil).UpdateFields), ctx, token, in)
+}
+
+// View mocks base method.
+func (m *MockService) View(ctx context.Context, token string) (*portal.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "View", ctx, token)
+	ret0, _ := ret[0].(*portal.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// View indicates an expected call of View.
+func (mr *MockServiceMockRecorder) View(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "View", reflect.TypeOf((*MockService)(nil).View), ctx, token)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/portal (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/portal/mock_store.go -package=mockportal github.com/biairmal/guest-management-be/internal/features/portal Store
+//
+
+// Package mockportal is a generated GoMock package.
+package mockportal
+
+import (
+	context "context"
+	reflect "reflect"
+
+	portal "github.com/biairmal/guest-management-be/internal/features/portal"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Event mocks base method.
+func (m *MockStore) Event(ctx context.Context, eventID uuid.UUID) (*portal.EventSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Event", ctx, eventID)
+	ret0, _ := ret[0].(*portal.EventSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Event indicates an expected call of Event.
+func (mr *MockStoreMockRecorder) Event(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Event", reflect.TypeOf((*MockStore)(nil).Event), ctx, eventID)
+}
+
+// EventTenant mocks base method.
+func (m *MockStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockStore)(nil).EventTenant), ctx, eventID)
+}
+
+// GuestByEmail mocks base method.
+func (m *MockStore) GuestByEmail(ctx context.Context, eventID uuid.UUID, email string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestByEmail", ctx, eventID, email)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestByEmail indicates an expected call of GuestByEmail.
+func (mr *MockStoreMockRecorder) GuestByEmail(ctx, eventID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestByEmail", reflect.TypeOf((*MockStore)(nil).GuestByEmail), ctx, eventID, email)
+}
+
+// Ticket mocks base method.
+func (m *MockStore) Ticket(ctx context.Context, guestID uuid.UUID) (*portal.TicketView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticket", ctx, guestID)
+	ret0, _ := ret[0].(*portal.TicketView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ticket indicates an expected call of Ticket.
+func (mr *MockStoreMockRecorder) Ticket(ctx, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticket", reflect.TypeOf((*MockStore)(nil).Ticket), ctx, guestID)
+}
@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/registration (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/registration/mock_service.go -package=mockregistration github.com/biairmal/guest-management-be/internal/features/registration Service
+//
+
+// Package mockregistration is a generated GoMock package.
+package mockregistration
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	registration "github.com/biairmal/guest-management-be/internal/features/registration"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Availability mocks base method.
+func (m *MockService) Availability(ctx context.Context, eventID uuid.UUID) (*registration.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Availability", ctx, eventID)
+	ret0, _ := ret[0].(*registration.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Availability indicates an expected call of Availability.
+func (mr *MockServiceMockRecorder) Availability(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Availability", reflect.TypeOf((*MockService)(nil).Availability), ctx, eventID)
+}
+
+// Pending mocks base method.
+func (m *MockService) Pending(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*dto.PageResponse[registration.Registrant], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx, eventID, params)
+	ret0, _ := ret[0].(*dto.PageResponse[registration.Registrant])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockServiceMockRecorder) Pending(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockService)(nil).Pending), ctx, eventID, params)
+}
+
+// Register mocks base method.
+func (m *MockService) Register(ctx context.Context, eventID uuid.UUID, in registration.RegisterInput, remoteIP string) (*registration.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, eventID, in, remoteIP)
+	ret0, _ := ret[0].(*registration.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockServiceMockRecorder) Register(ctx, eventID, in, remoteIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockService)(nil).Register), ctx, eventID, in, remoteIP)
+}
+
+// Review mocks base method.
+func (m *MockService) Review(ctx context.Context, eventID uuid.UUID, in registration.ReviewInput) (*registration.ReviewResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review", ctx, eventID, in)
+	ret0, _ := ret[0].(*registration.ReviewResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockServiceMockRecorder) Review(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockService)(nil).Review), ctx, eventID, in)
+}
+
+// Settings mocks base method.
+func (m *MockService) Settings(ctx context.Context, eventID uuid.UUID) (*registration.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settings", ctx, eventID)
+	ret0, _ := ret[0].(*registration.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Settings indicates an expected call of Settings.
+func (mr *MockServiceMockRecorder) Settings(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settings", reflect.TypeOf((*MockService)(nil).Settings), ctx, eventID)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockService) UpdateSettings(ctx context.Context, eventID uuid.UUID, in registration.SettingsInput) (*registration.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, eventID, in)
+	ret0, _ := ret[0].(*registration.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockServiceMockRecorder) UpdateSettings(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockService)(nil).UpdateSettings), ctx, eventID, in)
+}
@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/registration (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/registration/mock_store.go -package=mockregistration github.com/biairmal/guest-management-be/internal/features/registration Store
+//
+
+// Package mockregistration is a generated GoMock package.
+package mockregistration
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	registration "github.com/biairmal/guest-management-be/internal/features/registration"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockStore) Approve(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, eventID, guestIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockStoreMockRecorder) Approve(ctx, eventID, guestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockStore)(nil).Approve), ctx, eventID, guestIDs)
+}
+
+// LockSettings mocks base method.
+func (m *MockStore) LockSettings(ctx context.Context, eventID uuid.UUID) (*registration.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockSettings", ctx, eventID)
+	ret0, _ := ret[0].(*registration.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockSettings indicates an expected call of LockSettings.
+func (mr *MockStoreMockRecorder) LockSettings(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockSettings", reflect.TypeOf((*MockStore)(nil).LockSettings), ctx, eventID)
+}
+
+// MarkRegistered mocks base method.
+func (m *MockStore) MarkRegistered(ctx context.Context, guestID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRegistered", ctx, guestID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRegistered indicates an expected call of MarkRegistered.
+func (mr *MockStoreMockRecorder) MarkRegistered(ctx, guestID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRegistered", reflect.TypeOf((*MockStore)(nil).MarkRegistered), ctx, guestID, at)
+}
+
+// Pending mocks base method.
+func (m *MockStore) Pending(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*registration.Registrant, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx, eventID, params)
+	ret0, _ := ret[0].([]*registration.Registrant)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockStoreMockRecorder) Pending(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockStore)(nil).Pending), ctx, eventID, params)
+}
+
+// Reject mocks base method.
+func (m *MockStore) Reject(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, eventID, guestIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockStoreMockRecorder) Reject(ctx, eventID, guestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockStore)(nil).Reject), ctx, eventID, guestIDs)
+}
+
+// SaveSettings mocks base method.
+func (m *MockStore) SaveSettings(ctx context.Context, s *registration.Settings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSettings", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSettings indicates an expected call of SaveSettings.
+func (mr *MockStoreMockRecorder) SaveSettings(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSettings", reflect.TypeOf((*MockStore)(nil).SaveSettings), ctx, s)
+}
+
+// Settings mocks base method.
+func (m *MockStore) Settings(ctx context.Context, eventID uuid.UUID) (*registration.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settings", ctx, eventID)
+	ret0, _ := ret[0].(*registration.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Settings indicates an expected call of Settings.
+func (mr *MockStoreMockRecorder) Settings(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settings", reflect.TypeOf((*MockStore)(nil).Settings), ctx, eventID)
+}
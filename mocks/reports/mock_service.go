@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/reports (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/reports/mock_service.go -package=mockreports github.com/biairmal/guest-management-be/internal/features/reports Service
+//
+
+// Package mockreports is a generated GoMock package.
+package mockreports
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	reports "github.com/biairmal/guest-management-be/internal/features/reports"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Arrivals mocks base method.
+func (m *MockService) Arrivals(ctx context.Context, eventID uuid.UUID, w reports.Window) (*reports.Arrivals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Arrivals", ctx, eventID, w)
+	ret0, _ := ret[0].(*reports.Arrivals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Arrivals indicates an expected call of Arrivals.
+func (mr *MockServiceMockRecorder) Arrivals(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Arrivals", reflect.TypeOf((*MockService)(nil).Arrivals), ctx, eventID, w)
+}
+
+// Days mocks base method.
+func (m *MockService) Days(ctx context.Context, eventID uuid.UUID) ([]reports.DayAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Days", ctx, eventID)
+	ret0, _ := ret[0].([]reports.DayAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Days indicates an expected call of Days.
+func (mr *MockServiceMockRecorder) Days(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Days", reflect.TypeOf((*MockService)(nil).Days), ctx, eventID)
+}
+
+// Funnel mocks base method.
+func (m *MockService) Funnel(ctx context.Context, eventID uuid.UUID) (*reports.Funnel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Funnel", ctx, eventID)
+	ret0, _ := ret[0].(*reports.Funnel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Funnel indicates an expected call of Funnel.
+func (mr *MockServiceMockRecorder) Funnel(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Funnel", reflect.TypeOf((*MockService)(nil).Funnel), ctx, eventID)
+}
+
+// NoShows mocks base method.
+func (m *MockService) NoShows(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*dto.PageResponse[reports.NoShow], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NoShows", ctx, eventID, params)
+	ret0, _ := ret[0].(*dto.PageResponse[reports.NoShow])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NoShows indicates an expected call of NoShows.
+func (mr *MockServiceMockRecorder) NoShows(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NoShows", reflect.TypeOf((*MockService)(nil).NoShows), ctx, eventID, params)
+}
+
+// Operators mocks base method.
+func (m *MockService) Operators(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.OperatorScans, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Operators", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.OperatorScans)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Operators indicates an expected call of Operators.
+func (mr *MockServiceMockRecorder) Operators(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Operators", reflect.TypeOf((*MockService)(nil).Operators), ctx, eventID, w)
+}
+
+// TenantRollup mocks base method.
+func (m *MockService) TenantRollup(ctx context.Context, tenantID uuid.UUID, w reports.Window) (*reports.TenantRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantRollup", ctx, tenantID, w)
+	ret0, _ := ret[0].(*reports.TenantRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantRollup indicates an expected call of TenantRollup.
+func (mr *MockServiceMockRecorder) TenantRollup(ctx, tenantID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantRollup", reflect.TypeOf((*MockService)(nil).TenantRollup), ctx, tenantID, w)
+}
+
+// Throughput mocks base method.
+func (m *MockService) Throughput(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.StepThroughput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Throughput", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.StepThroughput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Throughput indicates an expected call of Throughput.
+func (mr *MockServiceMockRecorder) Throughput(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Throughput", reflect.TypeOf((*MockService)(nil).Throughput), ctx, eventID, w)
+}
+
+// Transitions mocks base method.
+func (m *MockService) Transitions(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.StepTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transitions", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.StepTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transitions indicates an expected call of Transitions.
+func (mr *MockServiceMockRecorder) Transitions(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transitions", reflect.TypeOf((*MockService)(nil).Transitions), ctx, eventID, w)
+}
@@ -0,0 +1,193 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/reports (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/reports/mock_store.go -package=mockreports github.com/biairmal/guest-management-be/internal/features/reports Store
+//
+
+// Package mockreports is a generated GoMock package.
+package mockreports
+
+import (
+	context "context"
+	reflect "reflect"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	reports "github.com/biairmal/guest-management-be/internal/features/reports"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Arrivals mocks base method.
+func (m *MockStore) Arrivals(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.Bucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Arrivals", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Arrivals indicates an expected call of Arrivals.
+func (mr *MockStoreMockRecorder) Arrivals(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Arrivals", reflect.TypeOf((*MockStore)(nil).Arrivals), ctx, eventID, w)
+}
+
+// Days mocks base method.
+func (m *MockStore) Days(ctx context.Context, eventID uuid.UUID) ([]reports.DayAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Days", ctx, eventID)
+	ret0, _ := ret[0].([]reports.DayAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Days indicates an expected call of Days.
+func (mr *MockStoreMockRecorder) Days(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Days", reflect.TypeOf((*MockStore)(nil).Days), ctx, eventID)
+}
+
+// EventExists mocks base method.
+func (m *MockStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockStoreMockRecorder) EventExists(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockStore)(nil).EventExists), ctx, eventID)
+}
+
+// Funnel mocks base method.
+func (m *MockStore) Funnel(ctx context.Context, eventID uuid.UUID) (*reports.Funnel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Funnel", ctx, eventID)
+	ret0, _ := ret[0].(*reports.Funnel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Funnel indicates an expected call of Funnel.
+func (mr *MockStoreMockRecorder) Funnel(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Funnel", reflect.TypeOf((*MockStore)(nil).Funnel), ctx, eventID)
+}
+
+// NoShows mocks base method.
+func (m *MockStore) NoShows(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*reports.NoShow, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NoShows", ctx, eventID, params)
+	ret0, _ := ret[0].([]*reports.NoShow)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// NoShows indicates an expected call of NoShows.
+func (mr *MockStoreMockRecorder) NoShows(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NoShows", reflect.TypeOf((*MockStore)(nil).NoShows), ctx, eventID, params)
+}
+
+// Operators mocks base method.
+func (m *MockStore) Operators(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.OperatorScans, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Operators", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.OperatorScans)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Operators indicates an expected call of Operators.
+func (mr *MockStoreMockRecorder) Operators(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Operators", reflect.TypeOf((*MockStore)(nil).Operators), ctx, eventID, w)
+}
+
+// TenantEvents mocks base method.
+func (m *MockStore) TenantEvents(ctx context.Context, tenantID uuid.UUID, w reports.Window) ([]reports.EventRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantEvents", ctx, tenantID, w)
+	ret0, _ := ret[0].([]reports.EventRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TenantEvents indicates an expected call of TenantEvents.
+func (mr *MockStoreMockRecorder) TenantEvents(ctx, tenantID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantEvents", reflect.TypeOf((*MockStore)(nil).TenantEvents), ctx, tenantID, w)
+}
+
+// TenantExists mocks base method.
+func (m *MockStore) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantExists", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TenantExists indicates an expected call of TenantExists.
+func (mr *MockStoreMockRecorder) TenantExists(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantExists", reflect.TypeOf((*MockStore)(nil).TenantExists), ctx, tenantID)
+}
+
+// Throughput mocks base method.
+func (m *MockStore) Throughput(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.StepThroughput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Throughput", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.StepThroughput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Throughput indicates an expected call of Throughput.
+func (mr *MockStoreMockRecorder) Throughput(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Throughput", reflect.TypeOf((*MockStore)(nil).Throughput), ctx, eventID, w)
+}
+
+// Transitions mocks base method.
+func (m *MockStore) Transitions(ctx context.Context, eventID uuid.UUID, w reports.Window) ([]reports.StepTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transitions", ctx, eventID, w)
+	ret0, _ := ret[0].([]reports.StepTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transitions indicates an expected call of Transitions.
+func (mr *MockStoreMockRecorder) Transitions(ctx, eventID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transitions", reflect.TypeOf((*MockStore)(nil).Transitions), ctx, eventID, w)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/scans (interfaces: ExportStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/scans/mock_export_store.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ExportStore
+//
+
+// Package mockscans is a generated GoMock package.
+package mockscans
+
+import (
+	context "context"
+	reflect "reflect"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	scans "github.com/biairmal/guest-management-be/internal/features/scans"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportStore is a mock of ExportStore interface.
+type MockExportStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockExportStoreMockRecorder is the mock recorder for MockExportStore.
+type MockExportStoreMockRecorder struct {
+	mock *MockExportStore
+}
+
+// NewMockExportStore creates a new mock instance.
+func NewMockExportStore(ctrl *gomock.Controller) *MockExportStore {
+	mock := &MockExportStore{ctrl: ctrl}
+	mock.recorder = &MockExportStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportStore) EXPECT() *MockExportStoreMockRecorder {
+	return m.recorder
+}
+
+// EventExists mocks base method.
+func (m *MockExportStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockExportStoreMockRecorder) EventExists(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockExportStore)(nil).EventExists), ctx, eventID)
+}
+
+// StreamScans mocks base method.
+func (m *MockExportStore) StreamScans(ctx context.Context, eventID uuid.UUID, params *query.ListParams, fn func(*scans.ScanExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamScans", ctx, eventID, params, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamScans indicates an expected call of StreamScans.
+func (mr *MockExportStoreMockRecorder) StreamScans(ctx, eventID, params, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamScans", reflect.TypeOf((*MockExportStore)(nil).StreamScans), ctx, eventID, params, fn)
+}
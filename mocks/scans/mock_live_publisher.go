@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/scans (interfaces: LivePublisher)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/scans/mock_live_publisher.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans LivePublisher
+//
+
+// Package mockscans is a generated GoMock package.
+package mockscans
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scans "github.com/biairmal/guest-management-be/internal/features/scans"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLivePublisher is a mock of LivePublisher interface.
+type MockLivePublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockLivePublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockLivePublisherMockRecorder is the mock recorder for MockLivePublisher.
+type MockLivePublisherMockRecorder struct {
+	mock *MockLivePublisher
+}
+
+// NewMockLivePublisher creates a new mock instance.
+func NewMockLivePublisher(ctrl *gomock.Controller) *MockLivePublisher {
+	mock := &MockLivePublisher{ctrl: ctrl}
+	mock.recorder = &MockLivePublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLivePublisher) EXPECT() *MockLivePublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishScan mocks base method.
+func (m *MockLivePublisher) PublishScan(ctx context.Context, scan *scans.ScanLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScan", ctx, scan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishScan indicates an expected call of PublishScan.
+func (mr *MockLivePublisherMockRecorder) PublishScan(ctx, scan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScan", reflect.TypeOf((*MockLivePublisher)(nil).PublishScan), ctx, scan)
+}
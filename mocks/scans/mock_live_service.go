@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/scans (interfaces: LiveService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/scans/mock_live_service.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans LiveService
+//
+
+// Package mockscans is a generated GoMock package.
+package mockscans
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scans "github.com/biairmal/guest-management-be/internal/features/scans"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLiveService is a mock of LiveService interface.
+type MockLiveService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveServiceMockRecorder is the mock recorder for MockLiveService.
+type MockLiveServiceMockRecorder struct {
+	mock *MockLiveService
+}
+
+// NewMockLiveService creates a new mock instance.
+func NewMockLiveService(ctrl *gomock.Controller) *MockLiveService {
+	mock := &MockLiveService{ctrl: ctrl}
+	mock.recorder = &MockLiveServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveService) EXPECT() *MockLiveServiceMockRecorder {
+	return m.recorder
+}
+
+// Watch mocks base method.
+func (m *MockLiveService) Watch(ctx context.Context, eventID uuid.UUID, send func(*scans.LiveSnapshot) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, eventID, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockLiveServiceMockRecorder) Watch(ctx, eventID, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockLiveService)(nil).Watch), ctx, eventID, send)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/scans (interfaces: LiveStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/scans/mock_live_store.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans LiveStore
+//
+
+// Package mockscans is a generated GoMock package.
+package mockscans
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scans "github.com/biairmal/guest-management-be/internal/features/scans"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLiveStore is a mock of LiveStore interface.
+type MockLiveStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveStoreMockRecorder is the mock recorder for MockLiveStore.
+type MockLiveStoreMockRecorder struct {
+	mock *MockLiveStore
+}
+
+// NewMockLiveStore creates a new mock instance.
+func NewMockLiveStore(ctrl *gomock.Controller) *MockLiveStore {
+	mock := &MockLiveStore{ctrl: ctrl}
+	mock.recorder = &MockLiveStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveStore) EXPECT() *MockLiveStoreMockRecorder {
+	return m.recorder
+}
+
+// Attendance mocks base method.
+func (m *MockLiveStore) Attendance(ctx context.Context, eventID uuid.UUID) (int, []scans.StepAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attendance", ctx, eventID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].([]scans.StepAttendance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Attendance indicates an expected call of Attendance.
+func (mr *MockLiveStoreMockRecorder) Attendance(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attendance", reflect.TypeOf((*MockLiveStore)(nil).Attendance), ctx, eventID)
+}
+
+// EventExists mocks base method.
+func (m *MockLiveStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockLiveStoreMockRecorder) EventExists(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockLiveStore)(nil).EventExists), ctx, eventID)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/scans (interfaces: ScanService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/scans/mock_scan_service.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ScanService
+//
+
+// Package mockscans is a generated GoMock package.
+package mockscans
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scans "github.com/biairmal/guest-management-be/internal/features/scans"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScanService is a mock of ScanService interface.
+type MockScanService struct {
+	ctrl     *gomock.Controller
+	recorder *MockScanServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockScanServiceMockRecorder is the mock recorder for MockScanService.
+type MockScanServiceMockRecorder struct {
+	mock *MockScanService
+}
+
+// NewMockScanService creates a new mock instance.
+func NewMockScanService(ctrl *gomock.Controller) *MockScanService {
+	mock := &MockScanService{ctrl: ctrl}
+	mock.recorder = &MockScanServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScanService) EXPECT() *MockScanServiceMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockScanService) Record(ctx context.Context, eventID uuid.UUID, credential string, in scans.ScanInput) (*scans.ScanLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, eventID, credential, in)
+	ret0, _ := ret[0].(*scans.ScanLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockScanServiceMockRecorder) Record(ctx, eventID, credential, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockScanService)(nil).Record), ctx, eventID, credential, in)
+}
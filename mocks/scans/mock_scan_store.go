@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/scans (interfaces: ScanStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/scans/mock_scan_store.go -package=mockscans github.com/biairmal/guest-management-be/internal/features/scans ScanStore
+//
+
+// Package mockscans is a generated GoMock package.
+package mockscans
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	events "github.com/biairmal/guest-management-be/internal/features/events"
+	scans "github.com/biairmal/guest-management-be/internal/features/scans"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScanStore is a mock of ScanStore interface.
+type MockScanStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockScanStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockScanStoreMockRecorder is the mock recorder for MockScanStore.
+type MockScanStoreMockRecorder struct {
+	mock *MockScanStore
+}
+
+// NewMockScanStore creates a new mock instance.
+func NewMockScanStore(ctrl *gomock.Controller) *MockScanStore {
+	mock := &MockScanStore{ctrl: ctrl}
+	mock.recorder = &MockScanStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScanStore) EXPECT() *MockScanStoreMockRecorder {
+	return m.recorder
+}
+
+// EventTenant mocks base method.
+func (m *MockScanStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockScanStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockScanStore)(nil).EventTenant), ctx, eventID)
+}
+
+// Insert mocks base method.
+func (m *MockScanStore) Insert(ctx context.Context, s *scans.ScanLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockScanStoreMockRecorder) Insert(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockScanStore)(nil).Insert), ctx, s)
+}
+
+// LockTicket mocks base method.
+func (m *MockScanStore) LockTicket(ctx context.Context, eventID uuid.UUID, qrCode string) (*scans.ScanTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTicket", ctx, eventID, qrCode)
+	ret0, _ := ret[0].(*scans.ScanTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockTicket indicates an expected call of LockTicket.
+func (mr *MockScanStoreMockRecorder) LockTicket(ctx, eventID, qrCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTicket", reflect.TypeOf((*MockScanStore)(nil).LockTicket), ctx, eventID, qrCode)
+}
+
+// MarkUsed mocks base method.
+func (m *MockScanStore) MarkUsed(ctx context.Context, ticketID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockScanStoreMockRecorder) MarkUsed(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockScanStore)(nil).MarkUsed), ctx, ticketID)
+}
+
+// Scanned mocks base method.
+func (m *MockScanStore) Scanned(ctx context.Context, ticketID, stepID uuid.UUID, since *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scanned", ctx, ticketID, stepID, since)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scanned indicates an expected call of Scanned.
+func (mr *MockScanStoreMockRecorder) Scanned(ctx, ticketID, stepID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scanned", reflect.TypeOf((*MockScanStore)(nil).Scanned), ctx, ticketID, stepID, since)
+}
+
+// Schedule mocks base method.
+func (m *MockScanStore) Schedule(ctx context.Context, eventID uuid.UUID) (events.Schedule, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, eventID)
+	ret0, _ := ret[0].(events.Schedule)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockScanStoreMockRecorder) Schedule(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockScanStore)(nil).Schedule), ctx, eventID)
+}
+
+// Step mocks base method.
+func (m *MockScanStore) Step(ctx context.Context, eventID, stepID uuid.UUID) (*scans.ScanStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Step", ctx, eventID, stepID)
+	ret0, _ := ret[0].(*scans.ScanStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Step indicates an expected call of Step.
+func (mr *MockScanStoreMockRecorder) Step(ctx, eventID, stepID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Step", reflect.TypeOf((*MockScanStore)(nil).Step), ctx, eventID, stepID)
+}
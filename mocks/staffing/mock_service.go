@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/staffing (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/staffing/mock_service.go -package=mockstaffing github.com/biairmal/guest-management-be/internal/features/staffing Service
+//
+
+// Package mockstaffing is a generated GoMock package.
+package mockstaffing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	staffing "github.com/biairmal/guest-management-be/internal/features/staffing"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockService) Assign(ctx context.Context, eventID uuid.UUID, in staffing.AssignInput) (*staffing.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, eventID, in)
+	ret0, _ := ret[0].(*staffing.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockServiceMockRecorder) Assign(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockService)(nil).Assign), ctx, eventID, in)
+}
+
+// BulkAssign mocks base method.
+func (m *MockService) BulkAssign(ctx context.Context, eventID uuid.UUID, in staffing.BulkAssignInput) (*staffing.BulkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkAssign", ctx, eventID, in)
+	ret0, _ := ret[0].(*staffing.BulkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkAssign indicates an expected call of BulkAssign.
+func (mr *MockServiceMockRecorder) BulkAssign(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkAssign", reflect.TypeOf((*MockService)(nil).BulkAssign), ctx, eventID, in)
+}
+
+// MyEvents mocks base method.
+func (m *MockService) MyEvents(ctx context.Context, includePast bool) ([]*staffing.Assignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MyEvents", ctx, includePast)
+	ret0, _ := ret[0].([]*staffing.Assignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MyEvents indicates an expected call of MyEvents.
+func (mr *MockServiceMockRecorder) MyEvents(ctx, includePast any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MyEvents", reflect.TypeOf((*MockService)(nil).MyEvents), ctx, includePast)
+}
+
+// Roster mocks base method.
+func (m *MockService) Roster(ctx context.Context, eventID uuid.UUID, params *query.ListParams) (*dto.PageResponse[staffing.Member], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roster", ctx, eventID, params)
+	ret0, _ := ret[0].(*dto.PageResponse[staffing.Member])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Roster indicates an expected call of Roster.
+func (mr *MockServiceMockRecorder) Roster(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roster", reflect.TypeOf((*MockService)(nil).Roster), ctx, eventID, params)
+}
+
+// Unassign mocks base method.
+func (m *MockService) Unassign(ctx context.Context, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockServiceMockRecorder) Unassign(ctx, eventID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockService)(nil).Unassign), ctx, eventID, userID)
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/staffing (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/staffing/mock_store.go -package=mockstaffing github.com/biairmal/guest-management-be/internal/features/staffing Store
+//
+
+// Package mockstaffing is a generated GoMock package.
+package mockstaffing
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	staffing "github.com/biairmal/guest-management-be/internal/features/staffing"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockStore) Assign(ctx context.Context, eventID, userID, roleID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, eventID, userID, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockStoreMockRecorder) Assign(ctx, eventID, userID, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockStore)(nil).Assign), ctx, eventID, userID, roleID)
+}
+
+// Assignments mocks base method.
+func (m *MockStore) Assignments(ctx context.Context, userID uuid.UUID, since time.Time) ([]*staffing.Assignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assignments", ctx, userID, since)
+	ret0, _ := ret[0].([]*staffing.Assignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assignments indicates an expected call of Assignments.
+func (mr *MockStoreMockRecorder) Assignments(ctx, userID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assignments", reflect.TypeOf((*MockStore)(nil).Assignments), ctx, userID, since)
+}
+
+// EventTenant mocks base method.
+func (m *MockStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockStore)(nil).EventTenant), ctx, eventID)
+}
+
+// Member mocks base method.
+func (m *MockStore) Member(ctx context.Context, eventID, userID uuid.UUID) (*staffing.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Member", ctx, eventID, userID)
+	ret0, _ := ret[0].(*staffing.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Member indicates an expected call of Member.
+func (mr *MockStoreMockRecorder) Member(ctx, eventID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Member", reflect.TypeOf((*MockStore)(nil).Member), ctx, eventID, userID)
+}
+
+// RoleExists mocks base method.
+func (m *MockStore) RoleExists(ctx context.Context, roleID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleExists", ctx, roleID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleExists indicates an expected call of RoleExists.
+func (mr *MockStoreMockRecorder) RoleExists(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleExists", reflect.TypeOf((*MockStore)(nil).RoleExists), ctx, roleID)
+}
+
+// Roster mocks base method.
+func (m *MockStore) Roster(ctx context.Context, eventID uuid.UUID, params *query.ListParams) ([]*staffing.Member, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roster", ctx, eventID, params)
+	ret0, _ := ret[0].([]*staffing.Member)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Roster indicates an expected call of Roster.
+func (mr *MockStoreMockRecorder) Roster(ctx, eventID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roster", reflect.TypeOf((*MockStore)(nil).Roster), ctx, eventID, params)
+}
+
+// Unassign mocks base method.
+func (m *MockStore) Unassign(ctx context.Context, eventID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockStoreMockRecorder) Unassign(ctx, eventID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockStore)(nil).Unassign), ctx, eventID, userID)
+}
+
+// User mocks base method.
+func (m *MockStore) User(ctx context.Context, userID uuid.UUID) (*staffing.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "User", ctx, userID)
+	ret0, _ := ret[0].(*staffing.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// User indicates an expected call of User.
+func (mr *MockStoreMockRecorder) User(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "User", reflect.TypeOf((*MockStore)(nil).User), ctx, userID)
+}
+
+// UsersByEmail mocks base method.
+func (m *MockStore) UsersByEmail(ctx context.Context, tenantID uuid.UUID, emails []string) (map[string]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsersByEmail", ctx, tenantID, emails)
+	ret0, _ := ret[0].(map[string]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsersByEmail indicates an expected call of UsersByEmail.
+func (mr *MockStoreMockRecorder) UsersByEmail(ctx, tenantID, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersByEmail", reflect.TypeOf((*MockStore)(nil).UsersByEmail), ctx, tenantID, emails)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/tickets (interfaces: Issuer)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/tickets/mock_issuer.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Issuer
+//
+
+// Package mocktickets is a generated GoMock package.
+package mocktickets
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tickets "github.com/biairmal/guest-management-be/internal/features/tickets"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIssuer is a mock of Issuer interface.
+type MockIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssuerMockRecorder
+	isgomock struct{}
+}
+
+// MockIssuerMockRecorder is the mock recorder for MockIssuer.
+type MockIssuerMockRecorder struct {
+	mock *MockIssuer
+}
+
+// NewMockIssuer creates a new mock instance.
+func NewMockIssuer(ctrl *gomock.Controller) *MockIssuer {
+	mock := &MockIssuer{ctrl: ctrl}
+	mock.recorder = &MockIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssuer) EXPECT() *MockIssuerMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockIssuer) Issue(ctx context.Context, req tickets.IssueRequest) (*tickets.IssueResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, req)
+	ret0, _ := ret[0].(*tickets.IssueResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockIssuerMockRecorder) Issue(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockIssuer)(nil).Issue), ctx, req)
+}
+
+// Promote mocks base method.
+func (m *MockIssuer) Promote(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promote", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Promote indicates an expected call of Promote.
+func (mr *MockIssuerMockRecorder) Promote(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promote", reflect.TypeOf((*MockIssuer)(nil).Promote), ctx, eventID)
+}
+
+// Replace mocks base method.
+func (m *MockIssuer) Replace(ctx context.Context, req tickets.IssueRequest) (*tickets.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, req)
+	ret0, _ := ret[0].(*tickets.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockIssuerMockRecorder) Replace(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockIssuer)(nil).Replace), ctx, req)
+}
+
+// Withdraw mocks base method.
+func (m *MockIssuer) Withdraw(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, eventID, guestIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockIssuerMockRecorder) Withdraw(ctx, eventID, guestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockIssuer)(nil).Withdraw), ctx, eventID, guestIDs)
+}
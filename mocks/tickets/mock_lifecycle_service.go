@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/tickets (interfaces: LifecycleService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/tickets/mock_lifecycle_service.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets LifecycleService
+//
+
+// Package mocktickets is a generated GoMock package.
+package mocktickets
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tickets "github.com/biairmal/guest-management-be/internal/features/tickets"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLifecycleService is a mock of LifecycleService interface.
+type MockLifecycleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLifecycleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLifecycleServiceMockRecorder is the mock recorder for MockLifecycleService.
+type MockLifecycleServiceMockRecorder struct {
+	mock *MockLifecycleService
+}
+
+// NewMockLifecycleService creates a new mock instance.
+func NewMockLifecycleService(ctrl *gomock.Controller) *MockLifecycleService {
+	mock := &MockLifecycleService{ctrl: ctrl}
+	mock.recorder = &MockLifecycleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLifecycleService) EXPECT() *MockLifecycleServiceMockRecorder {
+	return m.recorder
+}
+
+// Audit mocks base method.
+func (m *MockLifecycleService) Audit(ctx context.Context, eventID, ticketID uuid.UUID) ([]*tickets.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit", ctx, eventID, ticketID)
+	ret0, _ := ret[0].([]*tickets.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockLifecycleServiceMockRecorder) Audit(ctx, eventID, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockLifecycleService)(nil).Audit), ctx, eventID, ticketID)
+}
+
+// Invalidate mocks base method.
+func (m *MockLifecycleService) Invalidate(ctx context.Context, eventID, ticketID uuid.UUID, in tickets.InvalidateInput) (*tickets.ChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invalidate", ctx, eventID, ticketID, in)
+	ret0, _ := ret[0].(*tickets.ChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockLifecycleServiceMockRecorder) Invalidate(ctx, eventID, ticketID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockLifecycleService)(nil).Invalidate), ctx, eventID, ticketID, in)
+}
+
+// Reactivate mocks base method.
+func (m *MockLifecycleService) Reactivate(ctx context.Context, eventID, ticketID uuid.UUID, in tickets.ChangeInput) (*tickets.ChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", ctx, eventID, ticketID, in)
+	ret0, _ := ret[0].(*tickets.ChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockLifecycleServiceMockRecorder) Reactivate(ctx, eventID, ticketID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockLifecycleService)(nil).Reactivate), ctx, eventID, ticketID, in)
+}
+
+// Reissue mocks base method.
+func (m *MockLifecycleService) Reissue(ctx context.Context, eventID, ticketID uuid.UUID, in tickets.ChangeInput) (*tickets.ChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reissue", ctx, eventID, ticketID, in)
+	ret0, _ := ret[0].(*tickets.ChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reissue indicates an expected call of Reissue.
+func (mr *MockLifecycleServiceMockRecorder) Reissue(ctx, eventID, ticketID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reissue", reflect.TypeOf((*MockLifecycleService)(nil).Reissue), ctx, eventID, ticketID, in)
+}
+
+// Transfer mocks base method.
+func (m *MockLifecycleService) Transfer(ctx context.Context, eventID, ticketID uuid.UUID, in tickets.TransferInput) (*tickets.ChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, eventID, ticketID, in)
+	ret0, _ := ret[0].(*tickets.ChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockLifecycleServiceMockRecorder) Transfer(ctx, eventID, ticketID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockLifecycleService)(nil).Transfer), ctx, eventID, ticketID, in)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/tickets (interfaces: LifecycleStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/tickets/mock_lifecycle_store.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets LifecycleStore
+//
+
+// Package mocktickets is a generated GoMock package.
+package mocktickets
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tickets "github.com/biairmal/guest-management-be/internal/features/tickets"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLifecycleStore is a mock of LifecycleStore interface.
+type MockLifecycleStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockLifecycleStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockLifecycleStoreMockRecorder is the mock recorder for MockLifecycleStore.
+type MockLifecycleStoreMockRecorder struct {
+	mock *MockLifecycleStore
+}
+
+// NewMockLifecycleStore creates a new mock instance.
+func NewMockLifecycleStore(ctrl *gomock.Controller) *MockLifecycleStore {
+	mock := &MockLifecycleStore{ctrl: ctrl}
+	mock.recorder = &MockLifecycleStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLifecycleStore) EXPECT() *MockLifecycleStoreMockRecorder {
+	return m.recorder
+}
+
+// AddAudit mocks base method.
+func (m *MockLifecycleStore) AddAudit(ctx context.Context, e *tickets.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAudit", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAudit indicates an expected call of AddAudit.
+func (mr *MockLifecycleStoreMockRecorder) AddAudit(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAudit", reflect.TypeOf((*MockLifecycleStore)(nil).AddAudit), ctx, e)
+}
+
+// Audit mocks base method.
+func (m *MockLifecycleStore) Audit(ctx context.Context, eventID, ticketID uuid.UUID) ([]*tickets.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit", ctx, eventID, ticketID)
+	ret0, _ := ret[0].([]*tickets.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockLifecycleStoreMockRecorder) Audit(ctx, eventID, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockLifecycleStore)(nil).Audit), ctx, eventID, ticketID)
+}
+
+// EventTenant mocks base method.
+func (m *MockLifecycleStore) EventTenant(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTenant", ctx, eventID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventTenant indicates an expected call of EventTenant.
+func (mr *MockLifecycleStoreMockRecorder) EventTenant(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTenant", reflect.TypeOf((*MockLifecycleStore)(nil).EventTenant), ctx, eventID)
+}
+
+// SetStatus mocks base method.
+func (m *MockLifecycleStore) SetStatus(ctx context.Context, t *tickets.Ticket, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, t, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockLifecycleStoreMockRecorder) SetStatus(ctx, t, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockLifecycleStore)(nil).SetStatus), ctx, t, status)
+}
+
+// Ticket mocks base method.
+func (m *MockLifecycleStore) Ticket(ctx context.Context, eventID, ticketID uuid.UUID) (*tickets.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticket", ctx, eventID, ticketID)
+	ret0, _ := ret[0].(*tickets.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ticket indicates an expected call of Ticket.
+func (mr *MockLifecycleStoreMockRecorder) Ticket(ctx, eventID, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticket", reflect.TypeOf((*MockLifecycleStore)(nil).Ticket), ctx, eventID, ticketID)
+}
+
+// VoidScans mocks base method.
+func (m *MockLifecycleStore) VoidScans(ctx context.Context, ticketID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidScans", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidScans indicates an expected call of VoidScans.
+func (mr *MockLifecycleStoreMockRecorder) VoidScans(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidScans", reflect.TypeOf((*MockLifecycleStore)(nil).VoidScans), ctx, ticketID)
+}
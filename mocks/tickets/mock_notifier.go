@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/tickets (interfaces: Notifier)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/tickets/mock_notifier.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Notifier
+//
+
+// Package mocktickets is a generated GoMock package.
+package mocktickets
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tickets "github.com/biairmal/guest-management-be/internal/features/tickets"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Changed mocks base method.
+func (m *MockNotifier) Changed(ctx context.Context, entry *tickets.AuditEntry, ticket *tickets.Ticket) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Changed", ctx, entry, ticket)
+}
+
+// Changed indicates an expected call of Changed.
+func (mr *MockNotifierMockRecorder) Changed(ctx, entry, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Changed", reflect.TypeOf((*MockNotifier)(nil).Changed), ctx, entry, ticket)
+}
+
+// Promoted mocks base method.
+func (m *MockNotifier) Promoted(ctx context.Context, entry *tickets.WaitlistEntry, ticket *tickets.Ticket) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Promoted", ctx, entry, ticket)
+}
+
+// Promoted indicates an expected call of Promoted.
+func (mr *MockNotifierMockRecorder) Promoted(ctx, entry, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promoted", reflect.TypeOf((*MockNotifier)(nil).Promoted), ctx, entry, ticket)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/tickets (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/tickets/mock_service.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Service
+//
+
+// Package mocktickets is a generated GoMock package.
+package mocktickets
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tickets "github.com/biairmal/guest-management-be/internal/features/tickets"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Capacity mocks base method.
+func (m *MockService) Capacity(ctx context.Context, eventID uuid.UUID) (*tickets.CapacityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capacity", ctx, eventID)
+	ret0, _ := ret[0].(*tickets.CapacityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capacity indicates an expected call of Capacity.
+func (mr *MockServiceMockRecorder) Capacity(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capacity", reflect.TypeOf((*MockService)(nil).Capacity), ctx, eventID)
+}
+
+// Issue mocks base method.
+func (m *MockService) Issue(ctx context.Context, eventID, guestID uuid.UUID, in tickets.IssueInput) (*tickets.IssueResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, eventID, guestID, in)
+	ret0, _ := ret[0].(*tickets.IssueResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockServiceMockRecorder) Issue(ctx, eventID, guestID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockService)(nil).Issue), ctx, eventID, guestID, in)
+}
+
+// SetEventCapacity mocks base method.
+func (m *MockService) SetEventCapacity(ctx context.Context, eventID uuid.UUID, in tickets.CapacityInput) (*tickets.CapacityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventCapacity", ctx, eventID, in)
+	ret0, _ := ret[0].(*tickets.CapacityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventCapacity indicates an expected call of SetEventCapacity.
+func (mr *MockServiceMockRecorder) SetEventCapacity(ctx, eventID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventCapacity", reflect.TypeOf((*MockService)(nil).SetEventCapacity), ctx, eventID, in)
+}
+
+// SetTypeCapacity mocks base method.
+func (m *MockService) SetTypeCapacity(ctx context.Context, eventID, typeID uuid.UUID, in tickets.CapacityInput) (*tickets.CapacityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTypeCapacity", ctx, eventID, typeID, in)
+	ret0, _ := ret[0].(*tickets.CapacityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTypeCapacity indicates an expected call of SetTypeCapacity.
+func (mr *MockServiceMockRecorder) SetTypeCapacity(ctx, eventID, typeID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTypeCapacity", reflect.TypeOf((*MockService)(nil).SetTypeCapacity), ctx, eventID, typeID, in)
+}
+
+// Waitlist mocks base method.
+func (m *MockService) Waitlist(ctx context.Context, eventID uuid.UUID) ([]*tickets.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Waitlist", ctx, eventID)
+	ret0, _ := ret[0].([]*tickets.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Waitlist indicates an expected call of Waitlist.
+func (mr *MockServiceMockRecorder) Waitlist(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Waitlist", reflect.TypeOf((*MockService)(nil).Waitlist), ctx, eventID)
+}
@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/tickets (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/tickets/mock_store.go -package=mocktickets github.com/biairmal/guest-management-be/internal/features/tickets Store
+//
+
+// Package mocktickets is a generated GoMock package.
+package mocktickets
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tickets "github.com/biairmal/guest-management-be/internal/features/tickets"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockStore) Enqueue(ctx context.Context, e *tickets.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockStoreMockRecorder) Enqueue(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockStore)(nil).Enqueue), ctx, e)
+}
+
+// EventExists mocks base method.
+func (m *MockStore) EventExists(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExists", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventExists indicates an expected call of EventExists.
+func (mr *MockStoreMockRecorder) EventExists(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExists", reflect.TypeOf((*MockStore)(nil).EventExists), ctx, eventID)
+}
+
+// GuestState mocks base method.
+func (m *MockStore) GuestState(ctx context.Context, eventID, guestID uuid.UUID) (*tickets.GuestState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestState", ctx, eventID, guestID)
+	ret0, _ := ret[0].(*tickets.GuestState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestState indicates an expected call of GuestState.
+func (mr *MockStoreMockRecorder) GuestState(ctx, eventID, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestState", reflect.TypeOf((*MockStore)(nil).GuestState), ctx, eventID, guestID)
+}
+
+// InsertTicket mocks base method.
+func (m *MockStore) InsertTicket(ctx context.Context, t *tickets.Ticket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTicket", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTicket indicates an expected call of InsertTicket.
+func (mr *MockStoreMockRecorder) InsertTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTicket", reflect.TypeOf((*MockStore)(nil).InsertTicket), ctx, t)
+}
+
+// LockUsage mocks base method.
+func (m *MockStore) LockUsage(ctx context.Context, eventID uuid.UUID) (*tickets.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUsage", ctx, eventID)
+	ret0, _ := ret[0].(*tickets.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUsage indicates an expected call of LockUsage.
+func (mr *MockStoreMockRecorder) LockUsage(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUsage", reflect.TypeOf((*MockStore)(nil).LockUsage), ctx, eventID)
+}
+
+// Promoted mocks base method.
+func (m *MockStore) Promoted(ctx context.Context, entryID, ticketID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promoted", ctx, entryID, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Promoted indicates an expected call of Promoted.
+func (mr *MockStoreMockRecorder) Promoted(ctx, entryID, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promoted", reflect.TypeOf((*MockStore)(nil).Promoted), ctx, entryID, ticketID)
+}
+
+// Report mocks base method.
+func (m *MockStore) Report(ctx context.Context, eventID uuid.UUID) (*tickets.CapacityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, eventID)
+	ret0, _ := ret[0].(*tickets.CapacityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockStoreMockRecorder) Report(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockStore)(nil).Report), ctx, eventID)
+}
+
+// SetEventCapacity mocks base method.
+func (m *MockStore) SetEventCapacity(ctx context.Context, eventID uuid.UUID, capacity *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventCapacity", ctx, eventID, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEventCapacity indicates an expected call of SetEventCapacity.
+func (mr *MockStoreMockRecorder) SetEventCapacity(ctx, eventID, capacity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventCapacity", reflect.TypeOf((*MockStore)(nil).SetEventCapacity), ctx, eventID, capacity)
+}
+
+// SetTypeCapacity mocks base method.
+func (m *MockStore) SetTypeCapacity(ctx context.Context, eventID, typeID uuid.UUID, capacity *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTypeCapacity", ctx, eventID, typeID, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTypeCapacity indicates an expected call of SetTypeCapacity.
+func (mr *MockStoreMockRecorder) SetTypeCapacity(ctx, eventID, typeID, capacity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTypeCapacity", reflect.TypeOf((*MockStore)(nil).SetTypeCapacity), ctx, eventID, typeID, capacity)
+}
+
+// Waiting mocks base method.
+func (m *MockStore) Waiting(ctx context.Context, eventID uuid.UUID) ([]*tickets.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Waiting", ctx, eventID)
+	ret0, _ := ret[0].([]*tickets.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Waiting indicates an expected call of Waiting.
+func (mr *MockStoreMockRecorder) Waiting(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Waiting", reflect.TypeOf((*MockStore)(nil).Waiting), ctx, eventID)
+}
+
+// Withdraw mocks base method.
+func (m *MockStore) Withdraw(ctx context.Context, eventID uuid.UUID, guestIDs []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, eventID, guestIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockStoreMockRecorder) Withdraw(ctx, eventID, guestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockStore)(nil).Withdraw), ctx, eventID, guestIDs)
+}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/webhooks (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/webhooks/mock_service.go -package=mockwebhooks github.com/biairmal/guest-management-be/internal/features/webhooks Service
+//
+
+// Package mockwebhooks is a generated GoMock package.
+package mockwebhooks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/biairmal/go-sdk/lib/common/dto"
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	webhooks "github.com/biairmal/guest-management-be/internal/features/webhooks"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockService) Create(ctx context.Context, tenantID uuid.UUID, in webhooks.EndpointInput) (*webhooks.Secreted, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tenantID, in)
+	ret0, _ := ret[0].(*webhooks.Secreted)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceMockRecorder) Create(ctx, tenantID, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, tenantID, in)
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tenantID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, tenantID, id)
+}
+
+// Deliveries mocks base method.
+func (m *MockService) Deliveries(ctx context.Context, tenantID, id uuid.UUID, params *query.ListParams) (*dto.PageResponse[webhooks.Delivery], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliveries", ctx, tenantID, id, params)
+	ret0, _ := ret[0].(*dto.PageResponse[webhooks.Delivery])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deliveries indicates an expected call of Deliveries.
+func (mr *MockServiceMockRecorder) Deliveries(ctx, tenantID, id, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliveries", reflect.TypeOf((*MockService)(nil).Deliveries), ctx, tenantID, id, params)
+}
+
+// Get mocks base method.
+func (m *MockService) Get(ctx context.Context, tenantID, id uuid.UUID) (*webhooks.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tenantID, id)
+	ret0, _ := ret[0].(*webhooks.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceMockRecorder) Get(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, tenantID, id)
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, tenantID uuid.UUID) ([]*webhooks.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tenantID)
+	ret0, _ := ret[0].([]*webhooks.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, tenantID)
+}
+
+// Redeliver mocks base method.
+func (m *MockService) Redeliver(ctx context.Context, tenantID, id, deliveryID uuid.UUID) (*webhooks.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, tenantID, id, deliveryID)
+	ret0, _ := ret[0].(*webhooks.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockServiceMockRecorder) Redeliver(ctx, tenantID, id, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockService)(nil).Redeliver), ctx, tenantID, id, deliveryID)
+}
+
+// RotateSecret mocks base method.
+func (m *MockService) RotateSecret(ctx context.Context, tenantID, id uuid.UUID) (*webhooks.Secreted, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecret", ctx, tenantID, id)
+	ret0, _ := ret[0].(*webhooks.Secreted)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecret indicates an expected call of RotateSecret.
+func (mr *MockServiceMockRecorder) RotateSecret(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecret", reflect.TypeOf((*MockService)(nil).RotateSecret), ctx, tenantID, id)
+}
+
+// Update mocks base method.
+func (m *MockService) Update(ctx context.Context, tenantID, id uuid.UUID, in webhooks.EndpointInput) (*webhooks.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tenantID, id, in)
+	ret0, _ := ret[0].(*webhooks.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockServiceMockRecorder) Update(ctx, tenantID, id, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, tenantID, id, in)
+}
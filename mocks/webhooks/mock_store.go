@@ -0,0 +1,262 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/biairmal/guest-management-be/internal/features/webhooks (interfaces: Store)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../../mocks/webhooks/mock_store.go -package=mockwebhooks github.com/biairmal/guest-management-be/internal/features/webhooks Store
+//
+
+// Package mockwebhooks is a generated GoMock package.
+package mockwebhooks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	query "github.com/biairmal/guest-management-be/internal/core/query"
+	webhooks "github.com/biairmal/guest-management-be/internal/features/webhooks"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockStore) Claim(ctx context.Context, limit int, lease time.Duration) ([]*webhooks.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, limit, lease)
+	ret0, _ := ret[0].([]*webhooks.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockStoreMockRecorder) Claim(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockStore)(nil).Claim), ctx, limit, lease)
+}
+
+// CountOutcome mocks base method.
+func (m *MockStore) CountOutcome(ctx context.Context, endpointID uuid.UUID, ok bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOutcome", ctx, endpointID, ok)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOutcome indicates an expected call of CountOutcome.
+func (mr *MockStoreMockRecorder) CountOutcome(ctx, endpointID, ok any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOutcome", reflect.TypeOf((*MockStore)(nil).CountOutcome), ctx, endpointID, ok)
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockStore) DeleteEndpoint(ctx context.Context, tenantID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", ctx, tenantID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockStoreMockRecorder) DeleteEndpoint(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockStore)(nil).DeleteEndpoint), ctx, tenantID, id)
+}
+
+// Deliveries mocks base method.
+func (m *MockStore) Deliveries(ctx context.Context, endpointID uuid.UUID, params *query.ListParams) ([]*webhooks.Delivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliveries", ctx, endpointID, params)
+	ret0, _ := ret[0].([]*webhooks.Delivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Deliveries indicates an expected call of Deliveries.
+func (mr *MockStoreMockRecorder) Deliveries(ctx, endpointID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliveries", reflect.TypeOf((*MockStore)(nil).Deliveries), ctx, endpointID, params)
+}
+
+// Delivery mocks base method.
+func (m *MockStore) Delivery(ctx context.Context, endpointID, id uuid.UUID) (*webhooks.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delivery", ctx, endpointID, id)
+	ret0, _ := ret[0].(*webhooks.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delivery indicates an expected call of Delivery.
+func (mr *MockStoreMockRecorder) Delivery(ctx, endpointID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delivery", reflect.TypeOf((*MockStore)(nil).Delivery), ctx, endpointID, id)
+}
+
+// Disable mocks base method.
+func (m *MockStore) Disable(ctx context.Context, endpointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, endpointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockStoreMockRecorder) Disable(ctx, endpointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockStore)(nil).Disable), ctx, endpointID)
+}
+
+// Endpoint mocks base method.
+func (m *MockStore) Endpoint(ctx context.Context, tenantID, id uuid.UUID) (*webhooks.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Endpoint", ctx, tenantID, id)
+	ret0, _ := ret[0].(*webhooks.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Endpoint indicates an expected call of Endpoint.
+func (mr *MockStoreMockRecorder) Endpoint(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoint", reflect.TypeOf((*MockStore)(nil).Endpoint), ctx, tenantID, id)
+}
+
+// Endpoints mocks base method.
+func (m *MockStore) Endpoints(ctx context.Context, tenantID uuid.UUID) ([]*webhooks.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Endpoints", ctx, tenantID)
+	ret0, _ := ret[0].([]*webhooks.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Endpoints indicates an expected call of Endpoints.
+func (mr *MockStoreMockRecorder) Endpoints(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoints", reflect.TypeOf((*MockStore)(nil).Endpoints), ctx, tenantID)
+}
+
+// Enqueue mocks base method.
+func (m *MockStore) Enqueue(ctx context.Context, eventID uuid.UUID, eventType string, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, eventID, eventType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockStoreMockRecorder) Enqueue(ctx, eventID, eventType, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockStore)(nil).Enqueue), ctx, eventID, eventType, payload)
+}
+
+// InsertDelivery mocks base method.
+func (m *MockStore) InsertDelivery(ctx context.Context, d *webhooks.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDelivery", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDelivery indicates an expected call of InsertDelivery.
+func (mr *MockStoreMockRecorder) InsertDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDelivery", reflect.TypeOf((*MockStore)(nil).InsertDelivery), ctx, d)
+}
+
+// InsertEndpoint mocks base method.
+func (m *MockStore) InsertEndpoint(ctx context.Context, e *webhooks.Endpoint, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEndpoint", ctx, e, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertEndpoint indicates an expected call of InsertEndpoint.
+func (mr *MockStoreMockRecorder) InsertEndpoint(ctx, e, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEndpoint", reflect.TypeOf((*MockStore)(nil).InsertEndpoint), ctx, e, secret)
+}
+
+// Record mocks base method.
+func (m *MockStore) Record(ctx context.Context, deliveryID uuid.UUID, a *webhooks.Attempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, deliveryID, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockStoreMockRecorder) Record(ctx, deliveryID, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockStore)(nil).Record), ctx, deliveryID, a)
+}
+
+// SetSecret mocks base method.
+func (m *MockStore) SetSecret(ctx context.Context, tenantID, id uuid.UUID, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecret", ctx, tenantID, id, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecret indicates an expected call of SetSecret.
+func (mr *MockStoreMockRecorder) SetSecret(ctx, tenantID, id, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockStore)(nil).SetSecret), ctx, tenantID, id, secret)
+}
+
+// TenantExists mocks base method.
+func (m *MockStore) TenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantExists", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TenantExists indicates an expected call of TenantExists.
+func (mr *MockStoreMockRecorder) TenantExists(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantExists", reflect.TypeOf((*MockStore)(nil).TenantExists), ctx, tenantID)
+}
+
+// UpdateEndpoint mocks base method.
+func (m *MockStore) UpdateEndpoint(ctx context.Context, e *webhooks.Endpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpoint", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEndpoint indicates an expected call of UpdateEndpoint.
+func (mr *MockStoreMockRecorder) UpdateEndpoint(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpoint", reflect.TypeOf((*MockStore)(nil).UpdateEndpoint), ctx, e)
+}
@@ -11,7 +11,7 @@
 include $(SCRIPTS_DIR)/vars.mk
 
 # Packages that carry //go:generate mockgen directives.
-MOCK_PKGS := ./internal/features/events/... ./internal/core/validation/...
+MOCK_PKGS := ./internal/features/events/... ./internal/core/validation/... ./internal/core/repository/... ./internal/core/transaction/... ./internal/core/pubsub/... ./internal/core/ratelimit/... ./internal/features/guests/... ./internal/features/scans/... ./internal/features/reports/... ./internal/features/tickets/... ./internal/features/portal/... ./internal/features/registration/... ./internal/features/calendar/... ./internal/features/staffing/... ./internal/features/devices/... ./internal/features/webhooks/... ./internal/features/apikeys/...
 
 mocks: ## Regenerate app-interface mocks into the ./mocks module (go.uber.org/mock)
 	$(ECHO_EMPTY)
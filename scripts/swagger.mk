@@ -6,6 +6,12 @@
 # Fail-fast: no '-' prefix for install/generate. swagger-serve runs a local HTTP server.
 #
 # Annotate main or handlers with swag comments; then run make swagger-generate.
+# swag searches the entry point's directory and parses everything it imports,
+# operations included, so every package is known by its import path: searching
+# the module root instead parses internal packages twice, under two paths, and
+# leaves cross-package annotation types such as problem.Problem unresolvable.
+# A generic type from another package (dto.PageResponse[T]) must be imported by
+# the annotated file to resolve.
 # See: https://github.com/swaggo/swag#declarative-comments-format
 
 include $(SCRIPTS_DIR)/vars.mk
@@ -31,7 +37,8 @@ swagger-generate: ## Generate Swagger docs from annotations; main entry: $(MAIN_
 	$(ECHO_EMPTY)
 	@echo "$(INDENT)$(PREFIX_RUN)Generating Swagger docs (entry: $(MAIN_PATH), out: $(SWAGGER_OUTPUT_DIR))..."
 	@mkdir -p $(SWAGGER_OUTPUT_DIR)
-	@$(SWAG_BIN) init -g $(MAIN_PATH) -o $(SWAGGER_OUTPUT_DIR) --parseDependency --parseInternal
+	@$(SWAG_BIN) init -d $(dir $(MAIN_PATH)) -g $(notdir $(MAIN_PATH)) -o $(SWAGGER_OUTPUT_DIR) \
+		--parseDependencyLevel 3 --parseInternal
 	@echo "$(INDENT)$(PREFIX_OK)Swagger docs generated: $(SWAGGER_OUTPUT_DIR)"
 	$(ECHO_EMPTY)
 	@echo "$(INDENT)$(PREFIX_OK)PROCESS COMPLETED SUCCESSFULLY"